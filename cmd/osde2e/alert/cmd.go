@@ -63,12 +63,11 @@ func init() {
 
 func run(cmd *cobra.Command, argv []string) error {
 
-	if err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations); err != nil {
+	cfg, err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations)
+	if err != nil {
 		return fmt.Errorf("error loading initial state: %v", err)
 	}
 
 	mas := alert.GetMetricAlerts()
-	err := mas.Notify()
-
-	return err
+	return mas.Notify(cfg)
 }
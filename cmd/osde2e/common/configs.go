@@ -5,11 +5,12 @@ import (
 	"log"
 	"strings"
 
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/load"
 )
 
-// LoadConfigs loads config objects given the provided list of configs and a custom config
-func LoadConfigs(configString string, customConfig string, secretLocationsString string) error {
+// LoadConfigs loads config objects given the provided list of configs and a custom config and returns the run config
+func LoadConfigs(configString string, customConfig string, secretLocationsString string) (*config.RunConfig, error) {
 	var configs []string
 	if configString != "" {
 		configs = strings.Split(configString, ",")
@@ -20,14 +21,15 @@ func LoadConfigs(configString string, customConfig string, secretLocationsString
 		secretLocations = strings.Split(secretLocationsString, ",")
 	}
 
-	for _, config := range configs {
-		log.Printf("Will load config %s", config)
+	for _, name := range configs {
+		log.Printf("Will load config %s", name)
 	}
 
 	// Load configs
-	if err := load.Configs(configs, customConfig, secretLocations); err != nil {
-		return fmt.Errorf("error loading config: %v", err)
+	cfg, err := load.Configs(configs, customConfig, secretLocations)
+	if err != nil {
+		return nil, fmt.Errorf("error loading config: %v", err)
 	}
 
-	return nil
+	return cfg, nil
 }
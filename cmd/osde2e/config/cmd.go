@@ -0,0 +1,218 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/openshift/osde2e/cmd/osde2e/common"
+	"github.com/openshift/osde2e/cmd/osde2e/helpers"
+	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/openshift/osde2e/pkg/common/load"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	// import providers so their keys are bound and their secrets registered
+	_ "github.com/openshift/osde2e/pkg/common/providers"
+)
+
+// redacted replaces the values of sensitive keys.
+const redacted = "REDACTED"
+
+var Cmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspects the osde2e config.",
+	Long:  "Explains, validates and dumps the config osde2e would run with using the provided arguments.",
+}
+
+var explainCmd = &cobra.Command{
+	Use:   "explain [key...]",
+	Short: "Describes config keys and their effective values.",
+	Long:  "Describes every config key, or the given keys, along with their effective value and where it came from.",
+	RunE:  explain,
+}
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validates the config.",
+	Long:  "Loads the config, failing if it contains unknown keys or values of the wrong type.",
+	Args:  cobra.NoArgs,
+	RunE:  validate,
+}
+
+var dumpCmd = &cobra.Command{
+	Use:   "dump",
+	Short: "Prints the effective config as YAML.",
+	Long:  "Prints the effective merged config as YAML, commenting each value with where it came from.",
+	Args:  cobra.NoArgs,
+	RunE:  dump,
+}
+
+var args struct {
+	configString    string
+	customConfig    string
+	secretLocations string
+}
+
+func init() {
+	pfs := Cmd.PersistentFlags()
+	pfs.StringVar(
+		&args.configString,
+		"configs",
+		"",
+		"A comma separated list of built in configs to use",
+	)
+	Cmd.RegisterFlagCompletionFunc("configs", helpers.ConfigComplete)
+	pfs.StringVar(
+		&args.customConfig,
+		"custom-config",
+		"",
+		"Custom config file for osde2e",
+	)
+	pfs.StringVar(
+		&args.secretLocations,
+		"secret-locations",
+		"",
+		"A comma separated list of possible secret directory locations for loading secret configs.",
+	)
+
+	Cmd.AddCommand(explainCmd)
+	Cmd.AddCommand(validateCmd)
+	Cmd.AddCommand(dumpCmd)
+}
+
+func explain(cmd *cobra.Command, argv []string) error {
+	keys := config.Schema
+	if len(argv) > 0 {
+		keys = nil
+		for _, name := range argv {
+			key, ok := config.LookupKey(name)
+			if !ok {
+				return fmt.Errorf("unknown key %s", name)
+			}
+			keys = append(keys, key)
+		}
+	}
+
+	if _, err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations); err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	for i, key := range keys {
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+
+		fmt.Fprintf(out, "%s (%s)\n", key.Name, key.Type)
+		if key.Description != "" {
+			fmt.Fprintf(out, "  %s\n", key.Description)
+		}
+		if key.Env != "" {
+			fmt.Fprintf(out, "  env:     %s\n", key.Env)
+		}
+		if key.Secret != "" {
+			fmt.Fprintf(out, "  secret:  %s\n", key.Secret)
+		}
+		if key.Default != "" {
+			fmt.Fprintf(out, "  default: %s\n", key.Default)
+		}
+
+		source := load.Source(key.Name)
+		if source == "unset" {
+			fmt.Fprintf(out, "  value:   (unset)\n")
+		} else {
+			fmt.Fprintf(out, "  value:   %v (%s)\n", value(key), source)
+		}
+	}
+	return nil
+}
+
+func validate(cmd *cobra.Command, argv []string) error {
+	if _, err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), "Config is valid.")
+	return nil
+}
+
+func dump(cmd *cobra.Command, argv []string) error {
+	if _, err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations); err != nil {
+		return err
+	}
+
+	return writeYAML(cmd.OutOrStdout())
+}
+
+// writeYAML writes every key with a value as nested YAML. The schema is sorted, so keys in the same section
+// are always next to each other.
+func writeYAML(out io.Writer) error {
+	var section []string
+	for _, key := range config.Schema {
+		source := load.Source(key.Name)
+		if source == "unset" {
+			continue
+		}
+
+		path := strings.Split(key.Name, ".")
+		parents := path[:len(path)-1]
+
+		shared := 0
+		for shared < len(section) && shared < len(parents) && section[shared] == parents[shared] {
+			shared++
+		}
+		for i := shared; i < len(parents); i++ {
+			fmt.Fprintf(out, "%s%s:\n", indent(i), parents[i])
+		}
+		section = parents
+
+		data, err := yaml.Marshal(value(key))
+		if err != nil {
+			return fmt.Errorf("error marshalling %s: %v", key.Name, err)
+		}
+
+		lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+		name := indent(len(parents)) + path[len(path)-1]
+		if len(lines) == 1 && !strings.HasPrefix(lines[0], "- ") {
+			fmt.Fprintf(out, "%s: %s # %s\n", name, lines[0], source)
+			continue
+		}
+
+		fmt.Fprintf(out, "%s: # %s\n", name, source)
+		for _, line := range lines {
+			fmt.Fprintf(out, "%s%s\n", indent(len(parents)+1), line)
+		}
+	}
+	return nil
+}
+
+// value returns the effective value of key as its type.
+func value(key config.Key) interface{} {
+	if key.Sensitive() {
+		if viper.GetString(key.Name) != "" {
+			return redacted
+		}
+		return ""
+	}
+
+	switch key.Type {
+	case config.TypeBool:
+		return viper.GetBool(key.Name)
+	case config.TypeInt:
+		return viper.GetInt(key.Name)
+	case config.TypeFloat:
+		return viper.GetFloat64(key.Name)
+	case config.TypeList:
+		return viper.GetStringSlice(key.Name)
+	case config.TypeObject:
+		return viper.Get(key.Name)
+	default:
+		return viper.GetString(key.Name)
+	}
+}
+
+func indent(depth int) string {
+	return strings.Repeat("  ", depth)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/openshift/osde2e/cmd/osde2e/arguments"
 	"github.com/openshift/osde2e/cmd/osde2e/cleanup"
 	"github.com/openshift/osde2e/cmd/osde2e/completion"
+	"github.com/openshift/osde2e/cmd/osde2e/config"
 	"github.com/openshift/osde2e/cmd/osde2e/query"
 	"github.com/openshift/osde2e/cmd/osde2e/test"
 	"github.com/openshift/osde2e/cmd/osde2e/update"
@@ -48,6 +49,7 @@ func init() {
 	root.AddCommand(completion.Cmd)
 	root.AddCommand(alert.Cmd)
 	root.AddCommand(cleanup.Cmd)
+	root.AddCommand(config.Cmd)
 
 }
 
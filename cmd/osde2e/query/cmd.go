@@ -66,13 +66,14 @@ func init() {
 
 func run(cmd *cobra.Command, argv []string) error {
 
-	if err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations); err != nil {
+	cfg, err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations)
+	if err != nil {
 		return fmt.Errorf("error loading initial state: %v", err)
 	}
 
 	query := strings.Join(argv, " ")
 
-	client, err := prometheus.CreateClient()
+	client, err := prometheus.CreateClient(cfg.Prometheus)
 
 	if err != nil {
 		return fmt.Errorf("unable to create Prometheus client: %v", err)
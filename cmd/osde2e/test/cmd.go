@@ -2,11 +2,12 @@ package test
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/openshift/osde2e/cmd/osde2e/common"
 	"github.com/openshift/osde2e/cmd/osde2e/helpers"
 	"github.com/openshift/osde2e/pkg/common/config"
-	"github.com/openshift/osde2e/pkg/common/providers/ocmprovider"
+	"github.com/openshift/osde2e/pkg/common/matrix"
 	"github.com/openshift/osde2e/pkg/e2e"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -43,6 +44,7 @@ var args struct {
 	mustGather       bool
 	focusTests       string
 	skipTests        string
+	resume           string
 }
 
 func init() {
@@ -119,22 +121,44 @@ func init() {
 		"Control the Must Gather process at the end of a failed testing run.",
 	)
 
+	pfs.StringVar(
+		&args.resume,
+		"resume",
+		"",
+		"Resume the run in the given report directory from its last completed stage, reusing its cluster.",
+	)
+
 	viper.BindPFlag(config.Cluster.ID, Cmd.PersistentFlags().Lookup("cluster-id"))
-	viper.BindPFlag(ocmprovider.Env, Cmd.PersistentFlags().Lookup("environment"))
+	viper.BindPFlag(config.OCM.Env, Cmd.PersistentFlags().Lookup("environment"))
 	viper.BindPFlag(config.Kubeconfig.Path, Cmd.PersistentFlags().Lookup("kube-config"))
 	viper.BindPFlag(config.Cluster.DestroyAfterTest, Cmd.PersistentFlags().Lookup("destroy-cluster"))
 	viper.BindPFlag(config.Tests.SkipClusterHealthChecks, Cmd.PersistentFlags().Lookup("skip-health-check"))
 	viper.BindPFlag(config.Tests.GinkgoFocus, Cmd.PersistentFlags().Lookup("focus-tests"))
 	viper.BindPFlag(config.Tests.GinkgoSkip, Cmd.PersistentFlags().Lookup("skip-tests"))
 	viper.BindPFlag(config.MustGather, Cmd.PersistentFlags().Lookup("must-gather"))
+	viper.BindPFlag(config.Resume, Cmd.PersistentFlags().Lookup("resume"))
 }
 
 func run(cmd *cobra.Command, argv []string) error {
-	if err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations); err != nil {
+	cfg, err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations)
+	if err != nil {
 		return fmt.Errorf("error loading initial state: %v", err)
 	}
 
-	if e2e.RunTests() {
+	ctx, cancel := common.ContextWithSignals()
+	defer cancel()
+
+	// Each matrix entry runs this same command in its own process.
+	if matrix.Enabled(cfg) {
+		executable, err := os.Executable()
+		if err != nil {
+			return fmt.Errorf("error finding osde2e executable: %v", err)
+		}
+
+		return matrix.Run(ctx, cfg, append([]string{executable}, os.Args[1:]...))
+	}
+
+	if e2e.RunTests(ctx, cfg) {
 		return nil
 	}
 
@@ -65,11 +65,12 @@ func init() {
 }
 
 func run(cmd *cobra.Command, argv []string) error {
-	if err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations); err != nil {
+	cfg, err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations)
+	if err != nil {
 		return fmt.Errorf("error loading initial state: %v", err)
 	}
 
-	err := weather.GenerateWeatherReportForOSD(args.output, args.outputType)
+	err = weather.GenerateWeatherReportForOSD(cfg, args.output, args.outputType)
 
 	if err != nil {
 		return fmt.Errorf("error while generating report: %v", err)
@@ -46,11 +46,12 @@ func init() {
 }
 
 func run(cmd *cobra.Command, argv []string) error {
-	if err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations); err != nil {
+	cfg, err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations)
+	if err != nil {
 		return fmt.Errorf("error loading initial state: %v", err)
 	}
 
-	err := weather.SendReportToSlack()
+	err = weather.SendReportToSlack(cfg)
 
 	if err != nil {
 		return fmt.Errorf("error while sending report to slack: %v", err)
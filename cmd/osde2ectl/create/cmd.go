@@ -1,6 +1,7 @@
 package create
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -15,7 +16,6 @@ import (
 	"github.com/openshift/osde2e/cmd/osde2e/helpers"
 	clusterutil "github.com/openshift/osde2e/pkg/common/cluster"
 	"github.com/openshift/osde2e/pkg/common/config"
-	"github.com/openshift/osde2e/pkg/common/providers/ocmprovider"
 	"github.com/openshift/osde2e/pkg/common/versions"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -100,19 +100,23 @@ func init() {
 	)
 
 	viper.BindPFlag(config.Cluster.ID, Cmd.PersistentFlags().Lookup("cluster-id"))
-	viper.BindPFlag(ocmprovider.Env, Cmd.PersistentFlags().Lookup("environment"))
+	viper.BindPFlag(config.OCM.Env, Cmd.PersistentFlags().Lookup("environment"))
 	viper.BindPFlag(config.Kubeconfig.Path, Cmd.PersistentFlags().Lookup("kube-config"))
 
 	discardLogger = log.New(ioutil.Discard, "", 0)
 }
 
 func run(cmd *cobra.Command, argv []string) error {
-	if err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations); err != nil {
+	cfg, err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations)
+	if err != nil {
 		return fmt.Errorf("error loading initial state: %v", err)
 	}
 
+	ctx, cancel := common.ContextWithSignals()
+	defer cancel()
+
 	// configure cluster and upgrade versions
-	if err := versions.ChooseVersions(); err != nil {
+	if err := versions.ChooseVersions(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to configure versions: %v", err)
 	}
 
@@ -124,7 +128,7 @@ func run(cmd *cobra.Command, argv []string) error {
 		log.Printf("Provisioning %d clusters in batches of %d, waiting %d seconds in between.", args.numberOfClusters, args.batchSize, args.secondsBetweenBatches)
 	}
 
-	clustersDir := filepath.Join(viper.GetString(config.ReportDir), "clusters")
+	clustersDir := filepath.Join(cfg.State.ReportDir, "clusters")
 
 	if _, err := os.Stat(clustersDir); os.IsNotExist(err) {
 		err := os.Mkdir(clustersDir, os.FileMode(0755))
@@ -135,14 +139,14 @@ func run(cmd *cobra.Command, argv []string) error {
 	}
 
 	var successfulClustersCounter int32 = 0
-	createClusters(args.numberOfClusters, batchSize, args.secondsBetweenBatches, &successfulClustersCounter)
+	createClusters(ctx, cfg, args.numberOfClusters, batchSize, args.secondsBetweenBatches, &successfulClustersCounter)
 
 	fmt.Printf("Successfully provisioned %d/%d clusters.\n", successfulClustersCounter, args.numberOfClusters)
 
 	return nil
 }
 
-func createClusters(numClusters, batchSize, waitSecondsBetweenBatches int, successfulClustersCounter *int32) {
+func createClusters(ctx context.Context, cfg *config.RunConfig, numClusters, batchSize, waitSecondsBetweenBatches int, successfulClustersCounter *int32) {
 	totalBatches := int(math.Ceil(float64(numClusters) / float64(batchSize)))
 	batchWg := &sync.WaitGroup{}
 	batchWg.Add(totalBatches)
@@ -156,7 +160,7 @@ func createClusters(numClusters, batchSize, waitSecondsBetweenBatches int, succe
 		}
 
 		log.Printf("Provisioning %d clusters in batch %d", adjustedBatchSize, batchIteration)
-		go createBatch(batchIteration, adjustedBatchSize, batchWg, successfulClustersCounter)
+		go createBatch(ctx, cfg, batchIteration, adjustedBatchSize, batchWg, successfulClustersCounter)
 
 		if remainingClusters > batchSize {
 			log.Printf("Sleeping for %d seconds before next batch", waitSecondsBetweenBatches)
@@ -170,12 +174,12 @@ func createClusters(numClusters, batchSize, waitSecondsBetweenBatches int, succe
 	batchWg.Wait()
 }
 
-func createBatch(batchIteration int, numClustersInBatch int, batchWg *sync.WaitGroup, successfulClustersCounter *int32) {
+func createBatch(ctx context.Context, cfg *config.RunConfig, batchIteration int, numClustersInBatch int, batchWg *sync.WaitGroup, successfulClustersCounter *int32) {
 	wg := &sync.WaitGroup{}
 	wg.Add(numClustersInBatch)
 
 	for i := 0; i < numClustersInBatch; i++ {
-		go setupCluster(wg, successfulClustersCounter)
+		go setupCluster(ctx, cfg, wg, successfulClustersCounter)
 	}
 
 	wg.Wait()
@@ -183,9 +187,9 @@ func createBatch(batchIteration int, numClustersInBatch int, batchWg *sync.WaitG
 	batchWg.Done()
 }
 
-func setupCluster(wg *sync.WaitGroup, successfulClustersCounter *int32) {
+func setupCluster(ctx context.Context, cfg *config.RunConfig, wg *sync.WaitGroup, successfulClustersCounter *int32) {
 	defer wg.Done()
-	cluster, err := clusterutil.ProvisionCluster(discardLogger)
+	cluster, err := clusterutil.ProvisionCluster(ctx, cfg, discardLogger)
 
 	if err != nil {
 		if cluster != nil {
@@ -195,7 +199,7 @@ func setupCluster(wg *sync.WaitGroup, successfulClustersCounter *int32) {
 		}
 	} else {
 		log.Printf("Starting provisioning cluster %s.", cluster.ID())
-		outputFilePath := filepath.Join(viper.GetString(config.ReportDir), "clusters", cluster.ID()+".log")
+		outputFilePath := filepath.Join(cfg.State.ReportDir, "clusters", cluster.ID()+".log")
 
 		outputFile, err := os.Create(outputFilePath)
 		defer outputFile.Close()
@@ -221,7 +225,7 @@ func setupCluster(wg *sync.WaitGroup, successfulClustersCounter *int32) {
 
 				select {
 				case <-timeout:
-					isHealthy, _ := clusterutil.PollClusterHealth(cluster.ID(), discardLogger)
+					isHealthy, _ := clusterutil.PollClusterHealth(ctx, cfg, cluster.ID(), discardLogger)
 					if isHealthy {
 						fmt.Printf("Cluster %s is healthy (could be transient).\n", cluster.ID())
 					} else {
@@ -233,7 +237,7 @@ func setupCluster(wg *sync.WaitGroup, successfulClustersCounter *int32) {
 			}
 		}()
 
-		err = clusterutil.WaitForClusterReady(cluster.ID(), logger)
+		err = clusterutil.WaitForClusterReady(ctx, cfg, cluster.ID(), logger)
 
 		terminate <- true
 
@@ -6,8 +6,8 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/openshift/osde2e/cmd/osde2e/common"
 	"github.com/openshift/osde2e/cmd/osde2e/helpers"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/providers"
-	"github.com/openshift/osde2e/pkg/common/providers/ocmprovider"
 	"github.com/openshift/osde2e/pkg/common/spi"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -75,26 +75,28 @@ func init() {
 		"",
 		"Cluster provider environment to use.",
 	)
-	viper.BindPFlag(ocmprovider.Env, Cmd.PersistentFlags().Lookup("environment"))
+	viper.BindPFlag(config.OCM.Env, Cmd.PersistentFlags().Lookup("environment"))
 }
 
 func run(cmd *cobra.Command, argv []string) error {
 
-	var err error
-
-	if err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations); err != nil {
+	cfg, err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations)
+	if err != nil {
 		return fmt.Errorf("error loading initial state: %v", err)
 	}
 
 	clusterID := args.clusterID
 	owner := args.owner
 
+	ctx, cancel := common.ContextWithSignals()
+	defer cancel()
+
 	if clusterID != "" {
-		if provider, err = providers.ClusterProvider(); err != nil {
+		if provider, err = providers.ClusterProvider(cfg); err != nil {
 			return fmt.Errorf("could not setup cluster provider: %v", err)
 		}
 
-		cluster, err := provider.GetCluster(clusterID)
+		cluster, err := provider.GetCluster(ctx, clusterID)
 
 		if err != nil {
 			return fmt.Errorf("error retrieving cluster information: %v", err)
@@ -102,7 +104,7 @@ func run(cmd *cobra.Command, argv []string) error {
 
 		if properties := cluster.Properties(); properties["MadeByOSDe2e"] == "true" {
 			fmt.Printf("Deleting cluster %s...", clusterID)
-			if err = provider.DeleteCluster(clusterID); err != nil {
+			if err = provider.DeleteCluster(ctx, clusterID); err != nil {
 				fmt.Printf("Failed!\n")
 				return fmt.Errorf("error deleting cluster: %s", err.Error())
 			}
@@ -111,11 +113,11 @@ func run(cmd *cobra.Command, argv []string) error {
 			return fmt.Errorf("Cluster to be deleted was not created by osde2e")
 		}
 	} else if owner != "" {
-		if provider, err = providers.ClusterProvider(); err != nil {
+		if provider, err = providers.ClusterProvider(cfg); err != nil {
 			return fmt.Errorf("could not setup cluster provider: %v", err)
 		}
 
-		clusters, err := provider.ListClusters(fmt.Sprintf("properties.MadeByOSDe2e='true' and properties.OwnedBy='%s'", owner))
+		clusters, err := provider.ListClusters(ctx, fmt.Sprintf("properties.MadeByOSDe2e='true' and properties.OwnedBy='%s'", owner))
 
 		if err != nil {
 			return fmt.Errorf("error retrieving list of clusters: %v", err)
@@ -124,7 +126,7 @@ func run(cmd *cobra.Command, argv []string) error {
 		var allErrors *multierror.Error
 		for _, cluster := range clusters {
 			fmt.Printf("Deleting cluster %s... ", cluster.ID())
-			if err = provider.DeleteCluster(cluster.ID()); err != nil {
+			if err = provider.DeleteCluster(ctx, cluster.ID()); err != nil {
 				allErrors = multierror.Append(allErrors, fmt.Errorf("error deleting cluster: %v", err))
 				fmt.Printf("Failed!\n")
 			} else {
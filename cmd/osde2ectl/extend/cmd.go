@@ -95,21 +95,21 @@ func init() {
 
 func run(cmd *cobra.Command, argv []string) error {
 
-	var err error
-
-	if err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations); err != nil {
+	cfg, err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations)
+	if err != nil {
 		return fmt.Errorf("error loading initial state: %v", err)
 	}
 
-	viper.BindPFlag(config.Cluster.ID, cmd.PersistentFlags().Lookup("cluster-id"))
+	clusterID := cfg.State.ClusterID
 
-	clusterID := viper.GetString(config.Cluster.ID)
+	ctx, cancel := common.ContextWithSignals()
+	defer cancel()
 
-	if provider, err = providers.ClusterProvider(); err != nil {
+	if provider, err = providers.ClusterProvider(cfg); err != nil {
 		return fmt.Errorf("could not setup cluster provider: %v", err)
 	}
 
-	cluster, err := provider.GetCluster(clusterID)
+	cluster, err := provider.GetCluster(ctx, clusterID)
 
 	if err != nil {
 		return fmt.Errorf("error retrieving cluster information: %v", err)
@@ -119,7 +119,7 @@ func run(cmd *cobra.Command, argv []string) error {
 		return fmt.Errorf("Cluster was not created by osde2e")
 	}
 
-	if err = provider.ExtendExpiry(clusterID, args.hours, args.minutes, args.seconds); err != nil {
+	if err = provider.ExtendExpiry(ctx, clusterID, args.hours, args.minutes, args.seconds); err != nil {
 		return fmt.Errorf("error extending cluster expiry time: %s", err.Error())
 	}
 
@@ -1,6 +1,7 @@
 package get
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/openshift/osde2e/cmd/osde2e/common"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/providers"
 	"github.com/openshift/osde2e/pkg/common/providers/ocmprovider"
 	"github.com/openshift/osde2e/pkg/common/spi"
@@ -96,7 +98,8 @@ func init() {
 
 func run(cmd *cobra.Command, argv []string) error {
 
-	if err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations); err != nil {
+	cfg, err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations)
+	if err != nil {
 		return fmt.Errorf("error loading initial state: %v", err)
 	}
 
@@ -106,13 +109,16 @@ func run(cmd *cobra.Command, argv []string) error {
 		return fmt.Errorf("error retrieving kube-config information: %v", err)
 	}
 
-	if provider, err = providers.ClusterProvider(); err != nil {
+	if provider, err = providers.ClusterProvider(cfg); err != nil {
 		return fmt.Errorf("could not setup cluster provider: %v", err)
 	}
 
 	clusterID := args.clusterID
 
-	cluster, err := provider.GetCluster(clusterID)
+	ctx, cancel := common.ContextWithSignals()
+	defer cancel()
+
+	cluster, err := provider.GetCluster(ctx, clusterID)
 
 	timediff := cluster.ExpirationTimestamp().UTC().Sub(time.Now().UTC()).Minutes()
 
@@ -128,7 +134,7 @@ func run(cmd *cobra.Command, argv []string) error {
 	fmt.Printf("%-25s%-35s%-15s%-20s\n", cluster.Name(), cluster.ID(), cluster.State(), cluster.Properties()[ocmprovider.OwnedBy])
 
 	if kubeconfigStatus {
-		content, err := getKubeconfig(clusterID)
+		content, err := getKubeconfig(ctx, cfg, clusterID)
 		if err != nil {
 			return fmt.Errorf("Error getting the cluster's kubeconfig - %s", err)
 		}
@@ -166,13 +172,13 @@ func run(cmd *cobra.Command, argv []string) error {
 			if timediff <= 30 {
 				fmt.Println("Cluster expiry time is less than 30 minutes. Extending expiry time by 30 minutes.")
 				args.minutes = 30
-				if err = provider.ExtendExpiry(clusterID, 0, 30, 0); err != nil {
+				if err = provider.ExtendExpiry(ctx, clusterID, 0, 30, 0); err != nil {
 					return fmt.Errorf("error extending cluster expiry time: %s", err.Error())
 				}
 				fmt.Println("Extended cluster expiry time by :", args.hours, "h ", args.minutes, "m")
 			}
 		} else {
-			if err = provider.ExtendExpiry(clusterID, args.hours, args.minutes, 0); err != nil {
+			if err = provider.ExtendExpiry(ctx, clusterID, args.hours, args.minutes, 0); err != nil {
 				return fmt.Errorf("error extending cluster expiry time: %s", err.Error())
 			}
 			fmt.Println("Extended cluster expiry time by :", args.hours, "h ", args.minutes, "m")
@@ -182,14 +188,14 @@ func run(cmd *cobra.Command, argv []string) error {
 	return nil
 }
 
-func getKubeconfig(clusterID string) ([]byte, error) {
-	provider, err := providers.ClusterProvider()
+func getKubeconfig(ctx context.Context, cfg *config.RunConfig, clusterID string) ([]byte, error) {
+	provider, err := providers.ClusterProvider(cfg)
 	var kubeconfigBytes []byte
 	if err != nil {
 		return kubeconfigBytes, fmt.Errorf("could not setup cluster provider: %v", err)
 	}
 
-	if kubeconfigBytes, err = provider.ClusterKubeconfig(clusterID); err != nil {
+	if kubeconfigBytes, err = provider.ClusterKubeconfig(ctx, clusterID); err != nil {
 		return kubeconfigBytes, fmt.Errorf("could not get kubeconfig for cluster: %v", err)
 	}
 	return kubeconfigBytes, nil
@@ -53,19 +53,22 @@ func init() {
 }
 
 func run(cmd *cobra.Command, argv []string) error {
-	var provider spi.Provider
-	var err error
-	if err = common.LoadConfigs(args.configString, args.customConfig, args.secretLocations); err != nil {
+	cfg, err := common.LoadConfigs(args.configString, args.customConfig, args.secretLocations)
+	if err != nil {
 		return fmt.Errorf("error loading initial state: %v", err)
 	}
 
-	if provider, err = providers.ClusterProvider(); err != nil {
+	var provider spi.Provider
+	if provider, err = providers.ClusterProvider(cfg); err != nil {
 		return fmt.Errorf("could not setup cluster provider: %v", err)
 	}
 
 	metadata.Instance.SetEnvironment(provider.Environment())
 
-	clusters, err := provider.ListClusters("properties.MadeByOSDe2e='true'")
+	ctx, cancel := common.ContextWithSignals()
+	defer cancel()
+
+	clusters, err := provider.ListClusters(ctx, "properties.MadeByOSDe2e='true'")
 	if err != nil {
 		return err
 	}
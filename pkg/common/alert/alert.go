@@ -10,36 +10,32 @@ import (
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/metrics"
 	"github.com/slack-go/slack"
-	"github.com/spf13/viper"
 )
 
 // MetricAlerts is an array of LogMetric types with an easier lookup method
 type MetricAlerts []MetricAlert
 
-var once = sync.Once{}
+var (
+	metricAlerts      = MetricAlerts{}
+	metricAlertsMutex sync.Mutex
+)
 
-var metricAlerts = MetricAlerts{}
 var slackChannelCache = make(map[string]slack.Channel)
 
 // GetMetricAlerts will return the log metrics.
 func GetMetricAlerts() MetricAlerts {
-	once.Do(func() {
-		viper.Set("metricAlerts", metricAlerts)
-	})
-
-	tmp := viper.Get("metricAlerts")
-	ma, ok := tmp.(MetricAlerts)
-	if !ok {
-		log.Println("Error casting metricAlerts from Viper")
-	}
-
-	return ma
+	metricAlertsMutex.Lock()
+	defer metricAlertsMutex.Unlock()
+	return append(MetricAlerts{}, metricAlerts...)
 }
 
 // AddAlert adds an alert to an existing MetricAlerts object
 func (mas MetricAlerts) AddAlert(alert MetricAlert) MetricAlerts {
 	mas = append(mas, alert)
-	viper.Set("metricAlerts", mas)
+
+	metricAlertsMutex.Lock()
+	defer metricAlertsMutex.Unlock()
+	metricAlerts = mas
 	return mas
 }
 
@@ -70,16 +66,16 @@ type MetricAlert struct {
 	FailureThreshold int
 }
 
-// Notify prepares and then iterates through MetricAlerts to generate notifications
-func (mas MetricAlerts) Notify() error {
-	client, err := metrics.NewClient()
+// Notify prepares and then iterates through MetricAlerts to generate notifications with the config of cfg
+func (mas MetricAlerts) Notify(cfg *config.RunConfig) error {
+	client, err := metrics.NewClient(cfg)
 	if err != nil {
 		return fmt.Errorf("unable to create Prometheus client: %v", err)
 	}
 
 	for _, ma := range mas {
 		log.Printf("Checking %s", ma.Name)
-		if err := ma.Check(client); err != nil {
+		if err := ma.Check(cfg, client); err != nil {
 			return err
 		}
 	}
@@ -88,7 +84,7 @@ func (mas MetricAlerts) Notify() error {
 }
 
 // Check will query and notify depending on query results
-func (ma MetricAlert) Check(client *metrics.Client) error {
+func (ma MetricAlert) Check(cfg *config.RunConfig, client *metrics.Client) error {
 	results, err := client.ListFailedJUnitResultsByTestName(ma.QuerySafeName(), time.Now().Add(-24*time.Hour), time.Now())
 	if err != nil {
 		return err
@@ -96,7 +92,7 @@ func (ma MetricAlert) Check(client *metrics.Client) error {
 
 	if len(results) >= ma.FailureThreshold {
 		log.Printf("Alert triggered for %s: %d >= %d", ma.Name, len(results), ma.FailureThreshold)
-		sendSlackMessage(ma.SlackChannel, fmt.Sprintf("%s has seen %d failures in the last 24h", ma.Name, len(results)))
+		sendSlackMessage(cfg.Alert.SlackAPIToken, ma.SlackChannel, fmt.Sprintf("%s has seen %d failures in the last 24h", ma.Name, len(results)))
 	}
 
 	return nil
@@ -130,8 +126,8 @@ func RegisterGinkgoAlert(test, team, contact, slack, email string, threshold int
 	ma.AddAlert(testAlert)
 }
 
-func sendSlackMessage(channel, message string) error {
-	slackAPI := slack.New(viper.GetString(config.Alert.SlackAPIToken))
+func sendSlackMessage(token, channel, message string) error {
+	slackAPI := slack.New(token)
 	var slackChannel slack.Channel
 	var ok bool
 
@@ -10,17 +10,18 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/openshift/osde2e/pkg/common/config"
 )
 
-// ReadFromS3 reads a key from S3 using the global AWS context.
-func ReadFromS3(inputKey string) ([]byte, error) {
+// ReadFromS3 reads a key from S3 using the credentials in cfg.
+func ReadFromS3(cfg config.MetricsConfig, inputKey string) ([]byte, error) {
 	bucket, key, err := ParseS3URL(inputKey)
 
 	if err != nil {
 		return nil, fmt.Errorf("error trying to parse S3 URL: %v", err)
 	}
 
-	session, err := AWSSession.getSession()
+	session, err := newSession(cfg)
 
 	if err != nil {
 		return nil, err
@@ -42,15 +43,15 @@ func ReadFromS3(inputKey string) ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
-// WriteToS3 writes the given byte array to S3.
-func WriteToS3(outputKey string, data []byte) error {
+// WriteToS3 writes the given byte array to S3 using the credentials in cfg.
+func WriteToS3(cfg config.MetricsConfig, outputKey string, data []byte) error {
 	bucket, key, err := ParseS3URL(outputKey)
 
 	if err != nil {
 		return fmt.Errorf("error trying to parse S3 URL: %v", err)
 	}
 
-	session, err := AWSSession.getSession()
+	session, err := newSession(cfg)
 
 	if err != nil {
 		return err
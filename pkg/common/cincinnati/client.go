@@ -0,0 +1,229 @@
+// Package cincinnati fetches and queries the upgrade graphs served by Cincinnati, the OpenShift update service.
+package cincinnati
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+)
+
+// channelPrefixes are the kinds of channel a minor release may be in, from least to most stable.
+var channelPrefixes = []string{"candidate", "fast", "stable", "eus"}
+
+// graphs caches every graph loaded by this process, keyed by the URL or fixture it was loaded from. The map is only
+// locked to find an entry, so that fetching one graph doesn't hold up getting another.
+var graphs = struct {
+	sync.Mutex
+	cache map[string]*cachedGraph
+}{
+	cache: map[string]*cachedGraph{},
+}
+
+// cachedGraph is a graph cached in memory. It's locked while the graph is loaded, so each graph is only fetched once
+// however many goroutines want it.
+type cachedGraph struct {
+	sync.Mutex
+	graph *Graph
+
+	// loaded is when the graph was fetched from Cincinnati, or written to the disk cache.
+	loaded time.Time
+}
+
+// Client fetches graphs from Cincinnati. Graphs are cached in memory and, if a cache directory is set, on disk until
+// they expire. Graphs read from fixtures never expire.
+type Client struct {
+	// URL is the Cincinnati graph endpoint.
+	URL string
+
+	// Arch is the architecture of the graphs fetched.
+	Arch string
+
+	// CacheDir is a directory graphs are cached in between runs. Graphs are only cached in memory if unset.
+	CacheDir string
+
+	// CacheTTL is how long a cached graph is used before being fetched again.
+	CacheTTL time.Duration
+
+	// Fixtures is a directory of graphs named <channel>.json. If set, graphs are only ever read from it.
+	Fixtures string
+
+	// HTTPClient is used to fetch graphs.
+	HTTPClient *http.Client
+}
+
+// NewClient returns a client configured by cfg.
+func NewClient(cfg *config.RunConfig) *Client {
+	return &Client{
+		URL:        cfg.Cincinnati.URL,
+		Arch:       cfg.Cincinnati.Arch,
+		CacheDir:   cfg.Cincinnati.CacheDir,
+		CacheTTL:   time.Duration(cfg.Cincinnati.CacheTTLInMinutes) * time.Minute,
+		Fixtures:   cfg.Cincinnati.Fixtures,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Graph returns the graph of a channel.
+func (c *Client) Graph(channel string) (*Graph, error) {
+	graphURL, err := c.graphURL(channel)
+	if err != nil {
+		return nil, err
+	}
+
+	key := graphURL
+	if c.Fixtures != "" {
+		key = c.fixturePath(channel)
+	}
+
+	graphs.Lock()
+	cached, ok := graphs.cache[key]
+	if !ok {
+		cached = &cachedGraph{}
+		graphs.cache[key] = cached
+	}
+	graphs.Unlock()
+
+	cached.Lock()
+	defer cached.Unlock()
+
+	if cached.graph != nil && (c.Fixtures != "" || time.Since(cached.loaded) < c.CacheTTL) {
+		return cached.graph, nil
+	}
+
+	data, loaded, err := c.load(channel, graphURL)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := ParseGraph(channel, data)
+	if err != nil {
+		return nil, err
+	}
+
+	cached.graph, cached.loaded = g, loaded
+	return g, nil
+}
+
+// Channels returns every channel of the version's minor release that contains the version.
+func (c *Client) Channels(version *semver.Version) ([]string, error) {
+	channels := []string{}
+	var lastErr error
+	for _, prefix := range channelPrefixes {
+		channel := fmt.Sprintf("%s-%d.%d", prefix, version.Major(), version.Minor())
+
+		g, err := c.Graph(channel)
+		if err != nil {
+			// Not every kind of channel exists for every release.
+			lastErr = err
+			continue
+		}
+
+		if _, ok := g.Release(version); ok {
+			channels = append(channels, channel)
+		}
+	}
+
+	if len(channels) == 0 && lastErr != nil {
+		return nil, fmt.Errorf("unable to find channels for %s: %v", version, lastErr)
+	}
+	return channels, nil
+}
+
+func (c *Client) graphURL(channel string) (string, error) {
+	base, err := url.Parse(c.URL)
+	if err != nil {
+		return "", fmt.Errorf("invalid Cincinnati URL '%s': %v", c.URL, err)
+	}
+
+	query := base.Query()
+	query.Set("channel", channel)
+	query.Set("arch", c.Arch)
+	base.RawQuery = query.Encode()
+	return base.String(), nil
+}
+
+// load reads a graph from the fixtures, the disk cache or Cincinnati. It also returns when the graph was fetched, so
+// a graph from the disk cache isn't kept in memory for longer than it would have been on disk.
+func (c *Client) load(channel, graphURL string) ([]byte, time.Time, error) {
+	if c.Fixtures != "" {
+		data, err := ioutil.ReadFile(c.fixturePath(channel))
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("error reading fixture for channel %s: %v", channel, err)
+		}
+		return data, time.Now(), nil
+	}
+
+	cachePath := ""
+	if c.CacheDir != "" {
+		cachePath = filepath.Join(c.CacheDir, fmt.Sprintf("%s-%x.json", channel, sha256.Sum256([]byte(graphURL))))
+		if info, err := os.Stat(cachePath); err == nil && time.Since(info.ModTime()) < c.CacheTTL {
+			if data, err := ioutil.ReadFile(cachePath); err == nil {
+				return data, info.ModTime(), nil
+			}
+		}
+	}
+
+	fetched := time.Now()
+	data, err := c.fetch(graphURL)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if cachePath != "" {
+		if err = os.MkdirAll(c.CacheDir, os.ModePerm); err == nil {
+			err = ioutil.WriteFile(cachePath, data, 0644)
+		}
+		if err != nil {
+			log.Printf("Unable to cache graph for channel %s: %v", channel, err)
+		}
+	}
+
+	return data, fetched, nil
+}
+
+func (c *Client) fixturePath(channel string) string {
+	return filepath.Join(c.Fixtures, channel+".json")
+}
+
+func (c *Client) fetch(graphURL string) ([]byte, error) {
+	req, err := http.NewRequest("GET", graphURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Cincinnati request for URL '%s': %v", graphURL, err)
+	}
+
+	// Cincinnati requires an Accept header
+	req.Header.Set("Accept", "application/json")
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed for URL '%s': %v", graphURL, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading body: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request for URL '%s' returned %s: %s", graphURL, resp.Status, data)
+	}
+
+	return data, nil
+}
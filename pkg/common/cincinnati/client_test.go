@@ -0,0 +1,143 @@
+package cincinnati
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Masterminds/semver"
+)
+
+func TestClientFixtures(t *testing.T) {
+	fixtures, err := ioutil.TempDir("", "cincinnati-fixtures")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(fixtures)
+
+	for _, channel := range []string{"fast-4.6", "stable-4.6"} {
+		if err = ioutil.WriteFile(filepath.Join(fixtures, channel+".json"), []byte(testGraph), 0644); err != nil {
+			t.Fatalf("error writing fixture: %v", err)
+		}
+	}
+
+	// The URL is never used when there are fixtures.
+	client := &Client{URL: "http://127.0.0.1:0/graph", Arch: "amd64", Fixtures: fixtures}
+
+	g, err := client.Graph("stable-4.6")
+	if err != nil {
+		t.Fatalf("error getting graph: %v", err)
+	}
+	if g.Channel != "stable-4.6" || !g.HasEdge(semver.MustParse("4.6.8"), semver.MustParse("4.7.2")) {
+		t.Errorf("unexpected graph for stable-4.6: %+v", g)
+	}
+
+	if _, err = client.Graph("eus-4.6"); err == nil {
+		t.Errorf("expected an error getting a channel without a fixture")
+	}
+
+	channels, err := client.Channels(semver.MustParse("4.6.8"))
+	if err != nil {
+		t.Fatalf("error getting channels: %v", err)
+	}
+	if strings.Join(channels, ",") != "fast-4.6,stable-4.6" {
+		t.Errorf("expected 4.6.8 to be in fast-4.6 and stable-4.6, got %v", channels)
+	}
+}
+
+func TestClientDiskCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("Accept") != "application/json" || r.URL.Query().Get("arch") != "amd64" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, testGraph)
+	}))
+	defer server.Close()
+
+	cacheDir, err := ioutil.TempDir("", "cincinnati-cache")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(cacheDir)
+
+	client := &Client{URL: server.URL + "/graph", Arch: "amd64", CacheDir: cacheDir, CacheTTL: time.Hour}
+	if _, err = client.Graph("stable-4.7"); err != nil {
+		t.Fatalf("error getting graph: %v", err)
+	}
+
+	// A new process would only have the disk cache.
+	graphs.Lock()
+	graphs.cache = map[string]*cachedGraph{}
+	graphs.Unlock()
+
+	if _, err = client.Graph("stable-4.7"); err != nil {
+		t.Fatalf("error getting cached graph: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected the graph to be read from the disk cache, got %d requests", requests)
+	}
+
+	files, err := ioutil.ReadDir(cacheDir)
+	if err != nil || len(files) != 1 {
+		t.Fatalf("expected a single cached graph, got %v: %v", files, err)
+	}
+
+	// Once it expires the graph is fetched again.
+	expired := time.Now().Add(-2 * time.Hour)
+	if err = os.Chtimes(filepath.Join(cacheDir, files[0].Name()), expired, expired); err != nil {
+		t.Fatalf("error expiring cached graph: %v", err)
+	}
+
+	graphs.Lock()
+	graphs.cache = map[string]*cachedGraph{}
+	graphs.Unlock()
+
+	if _, err = client.Graph("stable-4.7"); err != nil {
+		t.Fatalf("error getting expired graph: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected the expired graph to be fetched again, got %d requests", requests)
+	}
+}
+
+func TestClientMemoryCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, testGraph)
+	}))
+	defer server.Close()
+
+	client := &Client{URL: server.URL + "/graph", Arch: "amd64", CacheTTL: time.Hour}
+	for i := 0; i < 2; i++ {
+		if _, err := client.Graph("stable-4.7"); err != nil {
+			t.Fatalf("error getting graph: %v", err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected the graph to be read from memory, got %d requests", requests)
+	}
+
+	// Once it expires the graph is fetched again.
+	graphs.Lock()
+	for _, cached := range graphs.cache {
+		cached.loaded = time.Now().Add(-2 * time.Hour)
+	}
+	graphs.Unlock()
+
+	if _, err := client.Graph("stable-4.7"); err != nil {
+		t.Fatalf("error getting expired graph: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected the expired graph to be fetched again, got %d requests", requests)
+	}
+}
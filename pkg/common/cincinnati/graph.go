@@ -0,0 +1,312 @@
+package cincinnati
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+)
+
+// channelsMetadataKey is the release metadata listing the channels a release is in.
+const channelsMetadataKey = "io.openshift.upgrades.graph.release.channels"
+
+// Release is a node of a Cincinnati graph.
+type Release struct {
+	// Version is the version of the release.
+	Version *semver.Version
+
+	// Payload is the release image.
+	Payload string
+
+	// Channels are the channels the release is in, if Cincinnati listed them.
+	Channels []string
+}
+
+// Risk is the reason an upgrade is blocked. Blocked upgrades are only conditionally recommended by Cincinnati.
+type Risk struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+	URL     string `json:"url"`
+}
+
+// Graph is the upgrade graph of a Cincinnati channel.
+type Graph struct {
+	// Channel is the channel the graph is for.
+	Channel string
+
+	// Releases are every release in the channel.
+	Releases []Release
+
+	// indexes maps versions to their index in Releases.
+	indexes map[string]int
+
+	// next maps the index of each release to the releases it can be upgraded to, newest first.
+	next map[int][]int
+
+	// blocked maps upgrades that are only conditionally recommended to the risks of doing so.
+	blocked map[edge][]Risk
+}
+
+// edge is an upgrade between two releases, given as their indexes.
+type edge struct {
+	from, to int
+}
+
+// graphJSON is a graph as returned by Cincinnati.
+type graphJSON struct {
+	Nodes []struct {
+		Version  string            `json:"version"`
+		Payload  string            `json:"payload"`
+		Metadata map[string]string `json:"metadata"`
+	} `json:"nodes"`
+	Edges            [][]int `json:"edges"`
+	ConditionalEdges []struct {
+		Edges []struct {
+			From string `json:"from"`
+			To   string `json:"to"`
+		} `json:"edges"`
+		Risks []Risk `json:"risks"`
+	} `json:"conditionalEdges"`
+}
+
+// ParseGraph parses the graph of a channel returned by Cincinnati.
+func ParseGraph(channel string, data []byte) (*Graph, error) {
+	var parsed graphJSON
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("error decoding graph for channel %s: %v", channel, err)
+	}
+
+	g := &Graph{
+		Channel: channel,
+		indexes: map[string]int{},
+		next:    map[int][]int{},
+		blocked: map[edge][]Risk{},
+	}
+
+	// Nodes that can't be parsed are left out, so keep track of where the rest end up.
+	nodeIndexes := map[int]int{}
+	for i, node := range parsed.Nodes {
+		version, err := semver.NewVersion(node.Version)
+		if err != nil {
+			log.Printf("Unable to parse version for %s, skipping", node.Version)
+			continue
+		}
+
+		release := Release{Version: version, Payload: node.Payload}
+		if channels := node.Metadata[channelsMetadataKey]; channels != "" {
+			release.Channels = strings.Split(channels, ",")
+		}
+
+		nodeIndexes[i] = len(g.Releases)
+		g.indexes[version.String()] = len(g.Releases)
+		g.Releases = append(g.Releases, release)
+	}
+
+	for _, e := range parsed.Edges {
+		if len(e) != 2 {
+			continue
+		}
+		from, fromOK := nodeIndexes[e[0]]
+		to, toOK := nodeIndexes[e[1]]
+		if fromOK && toOK {
+			g.next[from] = append(g.next[from], to)
+		}
+	}
+
+	for _, conditional := range parsed.ConditionalEdges {
+		for _, e := range conditional.Edges {
+			from, fromOK := g.index(e.From)
+			to, toOK := g.index(e.To)
+			if fromOK && toOK {
+				g.blocked[edge{from, to}] = append(g.blocked[edge{from, to}], conditional.Risks...)
+				g.next[from] = append(g.next[from], to)
+			}
+		}
+	}
+
+	for from, targets := range g.next {
+		sort.Slice(targets, func(i, j int) bool {
+			return g.Releases[targets[i]].Version.GreaterThan(g.Releases[targets[j]].Version)
+		})
+		g.next[from] = dedupe(targets)
+	}
+
+	return g, nil
+}
+
+// Release returns the release with the given version.
+func (g *Graph) Release(version *semver.Version) (Release, bool) {
+	i, ok := g.indexes[version.String()]
+	if !ok {
+		return Release{}, false
+	}
+	return g.Releases[i], true
+}
+
+// HasEdge returns true if Cincinnati recommends upgrading straight from one version to another.
+func (g *Graph) HasEdge(from, to *semver.Version) bool {
+	fromIndex, toIndex, ok := g.edgeIndexes(from, to)
+	if !ok {
+		return false
+	}
+
+	for _, target := range g.next[fromIndex] {
+		if target == toIndex {
+			return !g.isBlocked(fromIndex, toIndex)
+		}
+	}
+	return false
+}
+
+// Risks returns the risks of upgrading straight from one version to another. It is empty unless the upgrade is blocked.
+func (g *Graph) Risks(from, to *semver.Version) []Risk {
+	fromIndex, toIndex, ok := g.edgeIndexes(from, to)
+	if !ok {
+		return nil
+	}
+	return g.blocked[edge{fromIndex, toIndex}]
+}
+
+// Blocked returns true if upgrading straight from one version to another is only conditionally recommended.
+func (g *Graph) Blocked(from, to *semver.Version) bool {
+	return len(g.Risks(from, to)) > 0
+}
+
+// Next returns the versions that can be upgraded to from a version, newest first. Blocked upgrades are only
+// included if includeBlocked is true.
+func (g *Graph) Next(from *semver.Version, includeBlocked bool) []*semver.Version {
+	fromIndex, ok := g.index(from.String())
+	if !ok {
+		return nil
+	}
+
+	versions := []*semver.Version{}
+	for _, target := range g.targets(fromIndex, includeBlocked) {
+		versions = append(versions, g.Releases[target].Version)
+	}
+	return versions
+}
+
+// ShortestPath returns the versions to upgrade through to get from one version to another with the fewest hops,
+// ending with the version being upgraded to. Where there's more than one, the path through newer versions is
+// preferred. Blocked upgrades are only used if includeBlocked is true. It returns nil if there is no path.
+func (g *Graph) ShortestPath(from, to *semver.Version, includeBlocked bool) []*semver.Version {
+	fromIndex, toIndex, ok := g.edgeIndexes(from, to)
+	if !ok || fromIndex == toIndex {
+		return nil
+	}
+
+	// Breadth first search, remembering how each version was first reached.
+	previous := map[int]int{fromIndex: -1}
+	queue := []int{fromIndex}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current == toIndex {
+			path := []*semver.Version{}
+			for i := toIndex; i != fromIndex; i = previous[i] {
+				path = append([]*semver.Version{g.Releases[i].Version}, path...)
+			}
+			return path
+		}
+
+		for _, target := range g.targets(current, includeBlocked) {
+			if _, seen := previous[target]; !seen {
+				previous[target] = current
+				queue = append(queue, target)
+			}
+		}
+	}
+
+	return nil
+}
+
+// AllPaths returns every path from one version to another of at most maxHops upgrades, shortest first. Each path
+// ends with the version being upgraded to. Blocked upgrades are only used if includeBlocked is true.
+func (g *Graph) AllPaths(from, to *semver.Version, maxHops int, includeBlocked bool) [][]*semver.Version {
+	fromIndex, toIndex, ok := g.edgeIndexes(from, to)
+	if !ok || fromIndex == toIndex {
+		return nil
+	}
+
+	paths := [][]*semver.Version{}
+	visited := map[int]bool{fromIndex: true}
+	current := []*semver.Version{}
+
+	var walk func(at int)
+	walk = func(at int) {
+		if len(current) >= maxHops {
+			return
+		}
+
+		for _, target := range g.targets(at, includeBlocked) {
+			if visited[target] {
+				continue
+			}
+
+			current = append(current, g.Releases[target].Version)
+			if target == toIndex {
+				paths = append(paths, append([]*semver.Version{}, current...))
+			} else {
+				visited[target] = true
+				walk(target)
+				visited[target] = false
+			}
+			current = current[:len(current)-1]
+		}
+	}
+	walk(fromIndex)
+
+	sort.SliceStable(paths, func(i, j int) bool {
+		return len(paths[i]) < len(paths[j])
+	})
+	return paths
+}
+
+// targets returns the indexes of the releases that can be upgraded to from a release, newest first.
+func (g *Graph) targets(from int, includeBlocked bool) []int {
+	if includeBlocked {
+		return g.next[from]
+	}
+
+	targets := []int{}
+	for _, target := range g.next[from] {
+		if !g.isBlocked(from, target) {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+func (g *Graph) isBlocked(from, to int) bool {
+	return len(g.blocked[edge{from, to}]) > 0
+}
+
+func (g *Graph) index(version string) (int, bool) {
+	if parsed, err := semver.NewVersion(version); err == nil {
+		version = parsed.String()
+	}
+	i, ok := g.indexes[version]
+	return i, ok
+}
+
+func (g *Graph) edgeIndexes(from, to *semver.Version) (int, int, bool) {
+	fromIndex, fromOK := g.indexes[from.String()]
+	toIndex, toOK := g.indexes[to.String()]
+	return fromIndex, toIndex, fromOK && toOK
+}
+
+// dedupe removes adjacent duplicates from a sorted list.
+func dedupe(sorted []int) []int {
+	deduped := sorted[:0]
+	for i, value := range sorted {
+		if i == 0 || value != sorted[i-1] {
+			deduped = append(deduped, value)
+		}
+	}
+	return deduped
+}
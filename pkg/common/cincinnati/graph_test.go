@@ -0,0 +1,153 @@
+package cincinnati
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Masterminds/semver"
+)
+
+// testGraph is a channel where 4.5.1 can reach 4.7.2 in several ways, one of them through a blocked upgrade.
+const testGraph = `{
+	"nodes": [
+		{"version": "4.5.1", "payload": "quay.io/openshift-release-dev/ocp-release@sha256:451"},
+		{"version": "4.5.9", "payload": "quay.io/openshift-release-dev/ocp-release@sha256:459"},
+		{"version": "4.5.16", "payload": "quay.io/openshift-release-dev/ocp-release@sha256:4516"},
+		{"version": "4.6.1", "payload": "quay.io/openshift-release-dev/ocp-release@sha256:461"},
+		{"version": "4.6.8", "payload": "quay.io/openshift-release-dev/ocp-release@sha256:468",
+			"metadata": {"io.openshift.upgrades.graph.release.channels": "candidate-4.6,fast-4.6,stable-4.6"}},
+		{"version": "4.7.2", "payload": "quay.io/openshift-release-dev/ocp-release@sha256:472"},
+		{"version": "4.4.30", "payload": "quay.io/openshift-release-dev/ocp-release@sha256:4430"},
+		{"version": "not-a-version", "payload": "quay.io/openshift-release-dev/ocp-release@sha256:bad"}
+	],
+	"edges": [[0, 1], [0, 2], [1, 2], [1, 3], [2, 3], [3, 4], [4, 5], [7, 5]],
+	"conditionalEdges": [
+		{
+			"edges": [{"from": "4.5.16", "to": "4.6.8"}],
+			"risks": [{"name": "SDNUpgradeHang", "message": "Upgrades may hang.", "url": "https://example.com/risk"}]
+		}
+	]
+}`
+
+func parseTestGraph(t *testing.T) *Graph {
+	g, err := ParseGraph("stable-4.7", []byte(testGraph))
+	if err != nil {
+		t.Fatalf("error parsing graph: %v", err)
+	}
+	return g
+}
+
+func versions(path []*semver.Version) string {
+	strs := []string{}
+	for _, version := range path {
+		strs = append(strs, version.String())
+	}
+	return strings.Join(strs, ",")
+}
+
+func TestParseGraph(t *testing.T) {
+	g := parseTestGraph(t)
+
+	if len(g.Releases) != 7 {
+		t.Errorf("expected unparseable releases to be skipped, got %d releases", len(g.Releases))
+	}
+
+	release, ok := g.Release(semver.MustParse("4.6.8"))
+	if !ok || release.Payload != "quay.io/openshift-release-dev/ocp-release@sha256:468" || len(release.Channels) != 3 {
+		t.Errorf("unexpected release for 4.6.8: %+v", release)
+	}
+
+	if !g.HasEdge(semver.MustParse("4.5.1"), semver.MustParse("4.5.16")) {
+		t.Errorf("expected an edge from 4.5.1 to 4.5.16")
+	}
+	if g.HasEdge(semver.MustParse("4.5.1"), semver.MustParse("4.6.1")) {
+		t.Errorf("expected no edge from 4.5.1 to 4.6.1")
+	}
+
+	from, to := semver.MustParse("4.5.16"), semver.MustParse("4.6.8")
+	if g.HasEdge(from, to) || !g.Blocked(from, to) {
+		t.Errorf("expected the edge from 4.5.16 to 4.6.8 to be blocked")
+	}
+	if risks := g.Risks(from, to); len(risks) != 1 || risks[0].Name != "SDNUpgradeHang" {
+		t.Errorf("unexpected risks: %v", risks)
+	}
+
+	if next := versions(g.Next(from, false)); next != "4.6.1" {
+		t.Errorf("expected 4.5.16 to only upgrade to 4.6.1, got %s", next)
+	}
+	if next := versions(g.Next(from, true)); next != "4.6.8,4.6.1" {
+		t.Errorf("expected 4.5.16 to upgrade to 4.6.8 and 4.6.1 including blocked upgrades, got %s", next)
+	}
+}
+
+func TestShortestPath(t *testing.T) {
+	g := parseTestGraph(t)
+
+	tests := []struct {
+		name           string
+		from, to       string
+		includeBlocked bool
+		expected       string
+	}{
+		{
+			name:     "direct edge",
+			from:     "4.5.1",
+			to:       "4.5.16",
+			expected: "4.5.16",
+		},
+		{
+			name:     "skips z-streams and prefers newer versions",
+			from:     "4.5.1",
+			to:       "4.7.2",
+			expected: "4.5.16,4.6.1,4.6.8,4.7.2",
+		},
+		{
+			name:           "blocked edge",
+			from:           "4.5.1",
+			to:             "4.7.2",
+			includeBlocked: true,
+			expected:       "4.5.16,4.6.8,4.7.2",
+		},
+		{
+			name: "no path",
+			from: "4.4.30",
+			to:   "4.7.2",
+		},
+		{
+			name: "unknown version",
+			from: "4.5.1",
+			to:   "4.8.0",
+		},
+	}
+
+	for _, test := range tests {
+		path := g.ShortestPath(semver.MustParse(test.from), semver.MustParse(test.to), test.includeBlocked)
+		if actual := versions(path); actual != test.expected {
+			t.Errorf("%s: expected path %q, got %q", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestAllPaths(t *testing.T) {
+	g := parseTestGraph(t)
+	from, to := semver.MustParse("4.5.1"), semver.MustParse("4.6.8")
+
+	paths := g.AllPaths(from, to, 10, false)
+	actual := []string{}
+	for _, path := range paths {
+		actual = append(actual, versions(path))
+	}
+
+	expected := []string{
+		"4.5.16,4.6.1,4.6.8",
+		"4.5.9,4.6.1,4.6.8",
+		"4.5.9,4.5.16,4.6.1,4.6.8",
+	}
+	if strings.Join(actual, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected paths %v, got %v", expected, actual)
+	}
+
+	if paths = g.AllPaths(from, to, 3, true); len(paths) != 4 || versions(paths[0]) != "4.5.16,4.6.8" {
+		t.Errorf("expected the blocked upgrade to be the shortest of 4 paths, got %v", paths)
+	}
+}
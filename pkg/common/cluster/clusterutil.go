@@ -1,14 +1,16 @@
 package cluster
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/Masterminds/semver"
-	"github.com/hashicorp/go-multierror"
 	osconfig "github.com/openshift/client-go/config/clientset/versioned"
 	"github.com/openshift/osde2e/pkg/common/cluster/healthchecks"
 	"github.com/openshift/osde2e/pkg/common/config"
@@ -17,7 +19,6 @@ import (
 	"github.com/openshift/osde2e/pkg/common/providers"
 	"github.com/openshift/osde2e/pkg/common/spi"
 	"github.com/openshift/osde2e/pkg/common/util"
-	"github.com/spf13/viper"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -31,8 +32,8 @@ const (
 )
 
 // GetClusterVersion will get the current cluster version for the cluster.
-func GetClusterVersion(provider spi.Provider, clusterID string) (*semver.Version, error) {
-	restConfig, err := getRestConfig(provider, clusterID)
+func GetClusterVersion(ctx context.Context, cfg *config.RunConfig, provider spi.Provider, clusterID string) (*semver.Version, error) {
+	restConfig, err := getRestConfig(ctx, cfg, provider, clusterID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting rest config: %v", err)
 	}
@@ -42,7 +43,7 @@ func GetClusterVersion(provider spi.Provider, clusterID string) (*semver.Version
 		return nil, fmt.Errorf("error generating OpenShift Clientset: %v", err)
 	}
 
-	cvo, err := healthchecks.GetClusterVersionObject(oscfg.ConfigV1())
+	cvo, err := healthchecks.GetClusterVersionObject(ctx, oscfg.ConfigV1())
 	if err != nil {
 		return nil, fmt.Errorf("error getting cluster version object: %v", err)
 	}
@@ -56,47 +57,53 @@ func GetClusterVersion(provider spi.Provider, clusterID string) (*semver.Version
 }
 
 // ScaleCluster will scale the cluster up to the provided size.
-func ScaleCluster(clusterID string, numComputeNodes int) error {
-	provider, err := providers.ClusterProvider()
+func ScaleCluster(ctx context.Context, cfg *config.RunConfig, clusterID string, numComputeNodes int) error {
+	provider, err := providers.ClusterProvider(cfg)
 
 	if err != nil {
 		return fmt.Errorf("error getting cluster provisioning client: %v", err)
 	}
 
-	err = provider.ScaleCluster(clusterID, numComputeNodes)
+	err = provider.ScaleCluster(ctx, clusterID, numComputeNodes)
 	if err != nil {
 		return fmt.Errorf("error trying to scale cluster: %v", err)
 	}
 
-	return waitForClusterReadyWithOverrideAndExpectedNumberOfNodes(clusterID, nil, true)
+	return waitForClusterReadyWithOverrideAndExpectedNumberOfNodes(ctx, cfg, clusterID, nil, true)
 }
 
-// WaitForClusterReady blocks until the cluster is ready for testing.
-func WaitForClusterReady(clusterID string, logger *log.Logger) error {
-	return waitForClusterReadyWithOverrideAndExpectedNumberOfNodes(clusterID, logger, false)
+// WaitForClusterReady blocks until the cluster is ready for testing, the install timeout passes or ctx is done.
+func WaitForClusterReady(ctx context.Context, cfg *config.RunConfig, clusterID string, logger *log.Logger) error {
+	return waitForClusterReadyWithOverrideAndExpectedNumberOfNodes(ctx, cfg, clusterID, logger, false)
 }
 
-func waitForClusterReadyWithOverrideAndExpectedNumberOfNodes(clusterID string, logger *log.Logger, overrideSkipCheck bool) error {
+func waitForClusterReadyWithOverrideAndExpectedNumberOfNodes(ctx context.Context, cfg *config.RunConfig, clusterID string, logger *log.Logger, overrideSkipCheck bool) error {
 	logger = logging.CreateNewStdLoggerOrUseExistingLogger(logger)
 
-	provider, err := providers.ClusterProvider()
+	provider, err := providers.ClusterProvider(cfg)
 
 	if err != nil {
 		return fmt.Errorf("error getting cluster provisioning client: %v", err)
 	}
 
-	installTimeout := viper.GetInt64(config.Cluster.InstallTimeout)
+	installTimeout := cfg.Cluster.InstallTimeout
 	logger.Printf("Waiting %v minutes for cluster '%s' to be ready...\n", installTimeout, clusterID)
-	cleanRunsNeeded := viper.GetInt(config.Cluster.CleanCheckRuns)
+	cleanRunsNeeded := cfg.Cluster.CleanCheckRuns
 	cleanRuns := 0
 	errRuns := 0
 
 	clusterStarted := time.Now()
 	var readinessStarted time.Time
 	ocmReady := false
-	if !viper.GetBool(config.Tests.SkipClusterHealthChecks) || overrideSkipCheck {
-		return wait.PollImmediate(30*time.Second, time.Duration(installTimeout)*time.Minute, func() (bool, error) {
-			cluster, err := provider.GetCluster(clusterID)
+	if !cfg.Tests.SkipClusterHealthChecks || overrideSkipCheck {
+		waitCtx, cancel := context.WithTimeout(ctx, time.Duration(installTimeout)*time.Minute)
+		defer cancel()
+
+		report := healthchecks.NewReport()
+		defer writeHealthCheckReport(cfg, report, logger)
+
+		err = wait.PollImmediateUntil(30*time.Second, func() (bool, error) {
+			cluster, err := provider.GetCluster(waitCtx, clusterID)
 			if err != nil {
 				return false, fmt.Errorf("Unable to fetch cluster details from provider: %s", err)
 			}
@@ -111,7 +118,14 @@ func waitForClusterReadyWithOverrideAndExpectedNumberOfNodes(clusterID string, l
 
 					readinessStarted = time.Now()
 				}
-				if success, err := PollClusterHealth(clusterID, logger); success {
+				results, err := pollClusterHealth(waitCtx, cfg, clusterID, logger)
+				success := false
+				if err == nil && results != nil {
+					report.Add(results)
+					success, err = healthchecks.Healthy(results)
+				}
+
+				if success {
 					cleanRuns++
 					logger.Printf("Clean run %d/%d...", cleanRuns, cleanRunsNeeded)
 					errRuns = 0
@@ -146,101 +160,126 @@ func waitForClusterReadyWithOverrideAndExpectedNumberOfNodes(clusterID string, l
 				logger.Printf("Cluster is not ready, current status '%s'.", cluster.State())
 			}
 			return false, nil
-		})
+		}, waitCtx.Done())
+
+		// Distinguish between a job that was cancelled and one that simply ran out of time.
+		if err != nil && ctx.Err() != nil {
+			return fmt.Errorf("stopped waiting for cluster '%s': %v", clusterID, ctx.Err())
+		}
+
+		if err == wait.ErrWaitTimeout {
+			writeHealthTimeline(cfg, report, logger)
+		}
+		return err
 	}
 	return nil
 }
 
-// PollClusterHealth looks at CVO data to determine if a cluster is alive/healthy or not
-func PollClusterHealth(clusterID string, logger *log.Logger) (status bool, err error) {
+// writeHealthCheckReport writes the health check results for the current phase to the report directory.
+func writeHealthCheckReport(cfg *config.RunConfig, report *healthchecks.Report, logger *log.Logger) {
+	reportDir := cfg.State.ReportDir
+	if reportDir == "" {
+		return
+	}
+
+	phase := cfg.State.Phase
+	if phase == "" {
+		phase = "setup"
+	}
+
+	if err := os.MkdirAll(reportDir, os.ModePerm); err != nil {
+		logger.Printf("Error creating report directory: %v", err)
+		return
+	}
+
+	if err := report.WriteJUnit(filepath.Join(reportDir, fmt.Sprintf("junit_healthchecks_%s.xml", phase))); err != nil {
+		logger.Printf("Error writing health check report: %v", err)
+	}
+}
+
+// writeHealthTimeline writes the history of health checks to the report directory so it's clear what kept the cluster from being ready.
+func writeHealthTimeline(cfg *config.RunConfig, report *healthchecks.Report, logger *log.Logger) {
+	reportDir := cfg.State.ReportDir
+	if reportDir == "" {
+		return
+	}
+
+	if err := os.MkdirAll(reportDir, os.ModePerm); err != nil {
+		logger.Printf("Error creating report directory: %v", err)
+		return
+	}
+
+	if err := report.WriteTimeline(filepath.Join(reportDir, "health-timeline.json")); err != nil {
+		logger.Printf("Error writing health check timeline: %v", err)
+	}
+}
+
+// PollClusterHealth runs the health checks in the provider's profile to determine if a cluster is alive/healthy or not
+func PollClusterHealth(ctx context.Context, cfg *config.RunConfig, clusterID string, logger *log.Logger) (status bool, err error) {
+	results, err := pollClusterHealth(ctx, cfg, clusterID, logger)
+	if err != nil || results == nil {
+		return false, err
+	}
+
+	return healthchecks.Healthy(results)
+}
+
+// pollClusterHealth runs the health checks against a cluster and returns their results.
+// Results are nil if the cluster couldn't be reached.
+func pollClusterHealth(ctx context.Context, cfg *config.RunConfig, clusterID string, logger *log.Logger) ([]healthchecks.Result, error) {
 	logger = logging.CreateNewStdLoggerOrUseExistingLogger(logger)
 
-	provider, err := providers.ClusterProvider()
+	provider, err := providers.ClusterProvider(cfg)
 
 	if err != nil {
-		return false, fmt.Errorf("error getting cluster provisioning client: %v", err)
+		return nil, fmt.Errorf("error getting cluster provisioning client: %v", err)
 	}
 
 	logger.Print("Polling Cluster Health...\n")
-	restConfig, err := getRestConfig(provider, clusterID)
+	restConfig, err := getRestConfig(ctx, cfg, provider, clusterID)
 	if err != nil {
 		logger.Printf("Error generating Rest Config: %v\n", err)
-		return false, nil
+		return nil, nil
 	}
 
 	kubeClient, err := kubernetes.NewForConfig(restConfig)
 	if err != nil {
 		logger.Printf("Error generating Kube Clientset: %v\n", err)
-		return false, nil
+		return nil, nil
 	}
 
 	oscfg, err := osconfig.NewForConfig(restConfig)
 	if err != nil {
 		logger.Printf("Error generating OpenShift Clientset: %v\n", err)
-		return false, nil
+		return nil, nil
 	}
 
 	dynamicClient, err := dynamic.NewForConfig(restConfig)
 	if err != nil {
 		logger.Printf("Error generating Dynamic Clientset: %v\n", err)
-		return false, nil
+		return nil, nil
 	}
 
-	clusterHealthy := true
-
-	var healthErr *multierror.Error
-	switch provider.Type() {
-	case "moa":
-		fallthrough
-	case "ocm":
-		if check, err := healthchecks.CheckCVOReadiness(oscfg.ConfigV1(), logger); !check || err != nil {
-			healthErr = multierror.Append(healthErr, err)
-			clusterHealthy = false
-		}
-
-		if check, err := healthchecks.CheckNodeHealth(kubeClient.CoreV1(), logger); !check || err != nil {
-			healthErr = multierror.Append(healthErr, err)
-			clusterHealthy = false
-		}
-
-		if check, err := healthchecks.CheckMachinesObjectState(dynamicClient, logger); !check || err != nil {
-			healthErr = multierror.Append(healthErr, err)
-			clusterHealthy = false
-		}
-
-		if check, err := healthchecks.CheckOperatorReadiness(oscfg.ConfigV1(), logger); !check || err != nil {
-			healthErr = multierror.Append(healthErr, err)
-			clusterHealthy = false
-		}
-
-		if check, err := healthchecks.CheckPodHealth(kubeClient.CoreV1(), logger); !check || err != nil {
-			healthErr = multierror.Append(healthErr, err)
-			clusterHealthy = false
-		}
-
-		if check, err := healthchecks.CheckCerts(kubeClient.CoreV1(), logger); !check || err != nil {
-			healthErr = multierror.Append(healthErr, err)
-			clusterHealthy = false
-		}
-	default:
-		logger.Printf("No provisioner-specific logic for %s", provider.Type())
+	clients := healthchecks.Clients{
+		Kube:    kubeClient,
+		Config:  oscfg.ConfigV1(),
+		Dynamic: dynamicClient,
 	}
 
-	return clusterHealthy, healthErr.ErrorOrNil()
+	return healthchecks.Run(ctx, cfg, provider.Type(), clients, logger)
 }
 
-func getRestConfig(provider spi.Provider, clusterID string) (*rest.Config, error) {
+func getRestConfig(ctx context.Context, cfg *config.RunConfig, provider spi.Provider, clusterID string) (*rest.Config, error) {
 	var err error
 
 	var kubeconfigBytes []byte
-	kubeconfigContents := viper.GetString(config.Kubeconfig.Contents)
-	kubeconfigPath := viper.GetString(config.Kubeconfig.Path)
+	kubeconfigContents := cfg.State.Kubeconfig
+	kubeconfigPath := cfg.Kubeconfig.Path
 	if len(kubeconfigContents) == 0 && len(kubeconfigPath) == 0 {
-		if kubeconfigBytes, err = provider.ClusterKubeconfig(clusterID); err != nil {
+		if kubeconfigBytes, err = provider.ClusterKubeconfig(ctx, clusterID); err != nil {
 			return nil, fmt.Errorf("could not get kubeconfig for cluster: %v", err)
 		}
 	} else if len(kubeconfigPath) != 0 {
-		kubeconfigPath := viper.GetString(config.Kubeconfig.Path)
 		kubeconfigBytes, err = ioutil.ReadFile(kubeconfigPath)
 		if err != nil {
 			return nil, fmt.Errorf("failed reading '%s' which has been set as the TEST_KUBECONFIG: %v", kubeconfigPath, err)
@@ -258,15 +297,15 @@ func getRestConfig(provider spi.Provider, clusterID string) (*rest.Config, error
 }
 
 // ProvisionCluster will provision a cluster and immediately return.
-func ProvisionCluster(logger *log.Logger) (*spi.Cluster, error) {
+func ProvisionCluster(ctx context.Context, cfg *config.RunConfig, logger *log.Logger) (*spi.Cluster, error) {
 	logger = logging.CreateNewStdLoggerOrUseExistingLogger(logger)
 
 	// if TEST_KUBECONFIG has been set, skip configuring OCM
-	if len(viper.GetString(config.Kubeconfig.Contents)) > 0 || len(viper.GetString(config.Kubeconfig.Path)) > 0 {
-		return nil, useKubeconfig(logger)
+	if len(cfg.State.Kubeconfig) > 0 || len(cfg.Kubeconfig.Path) > 0 {
+		return nil, useKubeconfig(cfg, logger)
 	}
 
-	provider, err := providers.ClusterProvider()
+	provider, err := providers.ClusterProvider(cfg)
 
 	if err != nil {
 		return nil, fmt.Errorf("error getting cluster provisioning client: %v", err)
@@ -274,24 +313,24 @@ func ProvisionCluster(logger *log.Logger) (*spi.Cluster, error) {
 
 	var cluster *spi.Cluster
 	// create a new cluster if no ID is specified
-	clusterID := viper.GetString(config.Cluster.ID)
+	clusterID := cfg.State.ClusterID
 	if clusterID == "" {
-		name := viper.GetString(config.Cluster.Name)
+		name := cfg.State.ClusterName
 		if name == "" {
-			name = clusterName()
+			name = clusterName(cfg)
 		}
 
-		if clusterID, err = provider.LaunchCluster(name); err != nil {
+		if clusterID, err = provider.LaunchCluster(ctx, name); err != nil {
 			return nil, fmt.Errorf("could not launch cluster: %v", err)
 		}
 
-		if cluster, err = provider.GetCluster(clusterID); err != nil {
+		if cluster, err = provider.GetCluster(ctx, clusterID); err != nil {
 			return nil, fmt.Errorf("could not get cluster after launching: %v", err)
 		}
 	} else {
 		logger.Printf("CLUSTER_ID of '%s' was provided, skipping cluster creation and using it instead", clusterID)
 
-		cluster, err = provider.GetCluster(clusterID)
+		cluster, err = provider.GetCluster(ctx, clusterID)
 		if err != nil {
 			return nil, fmt.Errorf("could not retrieve cluster information from OCM: %v", err)
 		}
@@ -301,16 +340,16 @@ func ProvisionCluster(logger *log.Logger) (*spi.Cluster, error) {
 }
 
 // SetupCluster brings up a cluster, waits for it to be ready, then returns it's name.
-func SetupCluster(logger *log.Logger) (*spi.Cluster, error) {
+func SetupCluster(ctx context.Context, cfg *config.RunConfig, logger *log.Logger) (*spi.Cluster, error) {
 	logger = logging.CreateNewStdLoggerOrUseExistingLogger(logger)
 
-	cluster, err := ProvisionCluster(logger)
+	cluster, err := ProvisionCluster(ctx, cfg, logger)
 
 	if err != nil {
 		return cluster, fmt.Errorf("error provisioning cluster: %v", err)
 	}
 
-	if err = WaitForClusterReady(cluster.ID(), logger); err != nil {
+	if err = WaitForClusterReady(ctx, cfg, cluster.ID(), logger); err != nil {
 		return cluster, fmt.Errorf("failed waiting for cluster ready: %v", err)
 	}
 
@@ -318,8 +357,8 @@ func SetupCluster(logger *log.Logger) (*spi.Cluster, error) {
 }
 
 // useKubeconfig reads the path provided for a TEST_KUBECONFIG and uses it for testing.
-func useKubeconfig(logger *log.Logger) (err error) {
-	_, err = clientcmd.RESTConfigFromKubeConfig([]byte(viper.GetString(config.Kubeconfig.Contents)))
+func useKubeconfig(cfg *config.RunConfig, logger *log.Logger) (err error) {
+	_, err = clientcmd.RESTConfigFromKubeConfig([]byte(cfg.State.Kubeconfig))
 	if err != nil {
 		logger.Println("Not an existing Kubeconfig, attempting to read file instead...")
 	} else {
@@ -327,7 +366,7 @@ func useKubeconfig(logger *log.Logger) (err error) {
 		return nil
 	}
 
-	kubeconfigPath := viper.GetString(config.Kubeconfig.Path)
+	kubeconfigPath := cfg.Kubeconfig.Path
 	_, err = ioutil.ReadFile(kubeconfigPath)
 	if err != nil {
 		return fmt.Errorf("failed reading '%s' which has been set as the TEST_KUBECONFIG: %v", kubeconfigPath, err)
@@ -337,11 +376,11 @@ func useKubeconfig(logger *log.Logger) (err error) {
 }
 
 // clusterName returns a cluster name with a format which must be short enough to support all versions
-func clusterName() string {
-	vers := strings.TrimPrefix(viper.GetString(config.Cluster.Version), util.VersionPrefix)
+func clusterName(cfg *config.RunConfig) string {
+	vers := strings.TrimPrefix(cfg.State.ClusterVersion, util.VersionPrefix)
 	safeVersion := strings.Replace(vers, ".", "-", -1)
 
-	suffix := viper.GetString(config.Suffix)
+	suffix := cfg.State.Suffix
 
 	if suffix == "" {
 		suffix = util.RandomStr(3)
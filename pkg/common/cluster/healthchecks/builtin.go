@@ -0,0 +1,73 @@
+package healthchecks
+
+import (
+	"context"
+	"log"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+)
+
+func init() {
+	Register(Check{
+		Name:      "cluster-version-operator",
+		Providers: []string{"ocm", "moa", "crc"},
+		Run: func(ctx context.Context, cfg *config.RunConfig, clients Clients, logger *log.Logger) (Diagnostics, error) {
+			return CheckCVOReadiness(ctx, clients.Config, logger)
+		},
+	})
+
+	Register(Check{
+		Name: "nodes",
+		Run: func(ctx context.Context, cfg *config.RunConfig, clients Clients, logger *log.Logger) (Diagnostics, error) {
+			return CheckNodeHealth(ctx, clients.Kube.CoreV1(), logger)
+		},
+	})
+
+	Register(Check{
+		Name:      "machines",
+		Providers: []string{"ocm", "moa"},
+		Run: func(ctx context.Context, cfg *config.RunConfig, clients Clients, logger *log.Logger) (Diagnostics, error) {
+			return CheckMachinesObjectState(ctx, clients.Dynamic, logger)
+		},
+	})
+
+	Register(Check{
+		Name:      "operators",
+		Providers: []string{"ocm", "moa", "crc"},
+		Run: func(ctx context.Context, cfg *config.RunConfig, clients Clients, logger *log.Logger) (Diagnostics, error) {
+			return CheckOperatorReadiness(ctx, clients.Config, cfg.Tests.OperatorSkip, logger)
+		},
+	})
+
+	Register(Check{
+		Name: "pods",
+		Run: func(ctx context.Context, cfg *config.RunConfig, clients Clients, logger *log.Logger) (Diagnostics, error) {
+			return CheckPodHealth(ctx, clients.Kube.CoreV1(), logger)
+		},
+	})
+
+	Register(Check{
+		Name:      "certificates",
+		Providers: []string{"ocm", "moa"},
+		Run: func(ctx context.Context, cfg *config.RunConfig, clients Clients, logger *log.Logger) (Diagnostics, error) {
+			return CheckCerts(ctx, clients.Kube.CoreV1(), logger)
+		},
+	})
+
+	// OpenShift clusters get every check that applies to their provider, so CRC clusters skip machines and certificates.
+	RegisterProfile(Profile{
+		Name:   "openshift",
+		Checks: []string{"cluster-version-operator", "nodes", "machines", "operators", "pods", "certificates"},
+	}, "ocm", "moa", "crc")
+
+	// Plain Kubernetes clusters don't have the OpenShift APIs, so only look at nodes and pods.
+	RegisterProfile(Profile{
+		Name:   "kubernetes",
+		Checks: []string{"nodes", "pods"},
+	}, "local")
+
+	// Runs nothing, useful for providers whose clusters can't be reached.
+	RegisterProfile(Profile{
+		Name: "none",
+	}, "mock")
+}
@@ -24,7 +24,7 @@ var certCheck = certCheckData{
 }
 
 // CheckCerts will check for the presence of a cert issued by certman
-func CheckCerts(secretClient v1.CoreV1Interface, logger *log.Logger) (bool, error) {
+func CheckCerts(ctx context.Context, secretClient v1.CoreV1Interface, logger *log.Logger) (Diagnostics, error) {
 	logger = logging.CreateNewStdLoggerOrUseExistingLogger(logger)
 
 	if !certCheck.checkStarted {
@@ -35,13 +35,22 @@ func CheckCerts(secretClient v1.CoreV1Interface, logger *log.Logger) (bool, erro
 	listOpts := metav1.ListOptions{
 		LabelSelector: "certificate_request",
 	}
-	secrets, err := secretClient.Secrets("openshift-config").List(context.TODO(), listOpts)
+	secrets, err := secretClient.Secrets("openshift-config").List(ctx, listOpts)
 	if err != nil {
-		return false, fmt.Errorf("error trying to find issued certificate(s): %v", err)
+		return Diagnostics{}, fmt.Errorf("error trying to find issued certificate(s): %v", err)
 	}
+
+	diagnostics := Diagnostics{Healthy: true}
 	if len(secrets.Items) < 1 {
 		logger.Printf("Certificate(s) not yet issued.")
-		return false, nil
+		diagnostics.addObject(Object{
+			Kind:      "Secret",
+			Namespace: "openshift-config",
+			Name:      "certificate_request",
+			Reason:    "NotIssued",
+			Message:   "no secrets labelled certificate_request were found",
+		})
+		return diagnostics, nil
 	}
 
 	if !certCheck.certFound {
@@ -51,5 +60,5 @@ func CheckCerts(secretClient v1.CoreV1Interface, logger *log.Logger) (bool, erro
 
 	logger.Printf("Certificate(s) has been found.")
 
-	return true, nil
+	return diagnostics, nil
 }
@@ -1,6 +1,7 @@
 package healthchecks
 
 import (
+	"context"
 	"testing"
 
 	"github.com/openshift/osde2e/pkg/common/util"
@@ -55,15 +56,15 @@ func TestCerts(t *testing.T) {
 
 	for _, test := range tests {
 		kubeClient := kubernetes.NewSimpleClientset(test.objs...)
-		state, err := CheckCerts(kubeClient.CoreV1(), nil)
+		state, err := CheckCerts(context.Background(), kubeClient.CoreV1(), nil)
 
 		if err != nil {
 			t.Errorf("Unexpected error: %s", err)
 			return
 		}
 
-		if state != test.expected {
-			t.Errorf("%v: Expected value doesn't match returned value (%v, %v)", test.description, test.expected, state)
+		if state.Healthy != test.expected {
+			t.Errorf("%v: Expected value doesn't match returned value (%v, %v)", test.description, test.expected, state.Healthy)
 		}
 	}
 }
@@ -11,29 +11,43 @@ import (
 )
 
 // GetClusterVersionObject wlil get the cluster version object for the cluster.
-func GetClusterVersionObject(configClient configclient.ConfigV1Interface) (*v1.ClusterVersion, error) {
+func GetClusterVersionObject(ctx context.Context, configClient configclient.ConfigV1Interface) (*v1.ClusterVersion, error) {
 	getOpts := metav1.GetOptions{}
-	return configClient.ClusterVersions().Get(context.TODO(), "version", getOpts)
+	return configClient.ClusterVersions().Get(ctx, "version", getOpts)
 }
 
 // CheckCVOReadiness attempts to look at the state of the ClusterVersionOperator and returns true if things are healthy.
-func CheckCVOReadiness(configClient configclient.ConfigV1Interface, logger *log.Logger) (bool, error) {
+func CheckCVOReadiness(ctx context.Context, configClient configclient.ConfigV1Interface, logger *log.Logger) (Diagnostics, error) {
 	logger = logging.CreateNewStdLoggerOrUseExistingLogger(logger)
 
-	success := true
 	logger.Print("Checking that CVO says the cluster is healthy...")
 
-	cvInfo, err := GetClusterVersionObject(configClient)
+	cvInfo, err := GetClusterVersionObject(ctx, configClient)
 	if err != nil {
-		return false, err
+		return Diagnostics{}, err
 	}
 
+	var conditions []Condition
 	for _, v := range cvInfo.Status.Conditions {
 		if (v.Type != "Available" && v.Status != "False") && v.Type != "Upgradeable" && v.Type != "RetrievedUpdates" {
 			logger.Printf("CVO State not complete: %v: %v %v", v.Type, v.Status, v.Message)
-			success = false
+			conditions = append(conditions, Condition{
+				Type:    string(v.Type),
+				Status:  string(v.Status),
+				Reason:  v.Reason,
+				Message: v.Message,
+			})
 		}
 	}
 
-	return success, nil
+	diagnostics := Diagnostics{Healthy: true}
+	if len(conditions) > 0 {
+		diagnostics.addObject(Object{
+			Kind:       "ClusterVersion",
+			Name:       cvInfo.GetName(),
+			Conditions: conditions,
+		})
+	}
+
+	return diagnostics, nil
 }
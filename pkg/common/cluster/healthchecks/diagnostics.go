@@ -0,0 +1,78 @@
+package healthchecks
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Diagnostics describes what a health check found.
+type Diagnostics struct {
+	// Healthy is true if the check found nothing wrong.
+	Healthy bool `json:"healthy"`
+
+	// Objects are the objects keeping the cluster from being healthy.
+	Objects []Object `json:"objects,omitempty"`
+}
+
+// Object is a cluster object found to be unhealthy.
+type Object struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+
+	// Status is the phase or state the object was in, if it has one.
+	Status string `json:"status,omitempty"`
+
+	// Reason and Message explain why the object is unhealthy.
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+
+	// Conditions are the conditions that made the object unhealthy.
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// Condition is a status condition reported by an object.
+type Condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// addObject records an unhealthy object, marking the diagnostics unhealthy.
+func (d *Diagnostics) addObject(object Object) {
+	d.Healthy = false
+	d.Objects = append(d.Objects, object)
+}
+
+// key uniquely identifies the object.
+func (o Object) key() string {
+	return strings.Join([]string{o.Kind, o.Namespace, o.Name}, "/")
+}
+
+func (o Object) String() string {
+	name := o.Name
+	if o.Namespace != "" {
+		name = o.Namespace + "/" + o.Name
+	}
+
+	var reasons []string
+	if o.Status != "" {
+		reasons = append(reasons, o.Status)
+	}
+	if o.Reason != "" {
+		reasons = append(reasons, o.Reason)
+	}
+	for _, condition := range o.Conditions {
+		reason := fmt.Sprintf("%s=%s", condition.Type, condition.Status)
+		if condition.Reason != "" {
+			reason = fmt.Sprintf("%s (%s)", reason, condition.Reason)
+		}
+		reasons = append(reasons, reason)
+	}
+
+	if len(reasons) == 0 {
+		return fmt.Sprintf("%s %s", o.Kind, name)
+	}
+	return fmt.Sprintf("%s %s: %s", o.Kind, name, strings.Join(reasons, ", "))
+}
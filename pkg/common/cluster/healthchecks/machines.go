@@ -14,30 +14,46 @@ import (
 )
 
 // CheckMachinesObjectState lists all openshift machines and validates that they are "Running"
-func CheckMachinesObjectState(dynamicClient dynamic.Interface, logger *log.Logger) (bool, error) {
+func CheckMachinesObjectState(ctx context.Context, dynamicClient dynamic.Interface, logger *log.Logger) (Diagnostics, error) {
 	logger = logging.CreateNewStdLoggerOrUseExistingLogger(logger)
 
 	logger.Print("Checking that machines are healthy...")
 
 	mc := dynamicClient.Resource(schema.GroupVersionResource{Group: "machine.openshift.io", Resource: "machines", Version: "v1beta1"})
-	obj, err := mc.List(context.TODO(), metav1.ListOptions{})
+	obj, err := mc.List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return false, err
+		return Diagnostics{}, err
 	}
 	var runningPhase string = "Running"
 
+	diagnostics := Diagnostics{Healthy: true}
 	for _, item := range obj.Items {
 		var machine machineapi.Machine
 		err = runtime.DefaultUnstructuredConverter.
 			FromUnstructured(item.UnstructuredContent(), &machine)
 		if err != nil {
-			return false, fmt.Errorf("Error casting object: %s", err.Error())
+			return Diagnostics{}, fmt.Errorf("Error casting object: %s", err.Error())
 		}
 
 		if machine.Status.Phase == nil || *machine.Status.Phase != runningPhase {
 			logger.Printf("machine %s not ready", machine.Name)
-			return false, nil
+
+			object := Object{
+				Kind:      "Machine",
+				Namespace: machine.Namespace,
+				Name:      machine.Name,
+			}
+			if machine.Status.Phase != nil {
+				object.Status = *machine.Status.Phase
+			}
+			if machine.Status.ErrorReason != nil {
+				object.Reason = string(*machine.Status.ErrorReason)
+			}
+			if machine.Status.ErrorMessage != nil {
+				object.Message = *machine.Status.ErrorMessage
+			}
+			diagnostics.addObject(object)
 		}
 	}
-	return true, nil
+	return diagnostics, nil
 }
@@ -11,33 +11,49 @@ import (
 )
 
 // CheckNodeHealth attempts to look at the state of all operator and returns true if things are healthy.
-func CheckNodeHealth(nodeClient v1.CoreV1Interface, logger *log.Logger) (bool, error) {
+func CheckNodeHealth(ctx context.Context, nodeClient v1.CoreV1Interface, logger *log.Logger) (Diagnostics, error) {
 	logger = logging.CreateNewStdLoggerOrUseExistingLogger(logger)
 
-	success := true
 	logger.Print("Checking that all Nodes are running or completed...")
 
 	listOpts := metav1.ListOptions{}
-	list, err := nodeClient.Nodes().List(context.TODO(), listOpts)
+	list, err := nodeClient.Nodes().List(ctx, listOpts)
 	if err != nil {
-		return false, fmt.Errorf("error getting node list: %v", err)
+		return Diagnostics{}, fmt.Errorf("error getting node list: %v", err)
 	}
 
 	if len(list.Items) == 0 {
-		return false, fmt.Errorf("no nodes found")
+		return Diagnostics{}, fmt.Errorf("no nodes found")
 	}
 
+	diagnostics := Diagnostics{Healthy: true}
 	for _, node := range list.Items {
+		var conditions []Condition
 		for _, ns := range node.Status.Conditions {
 			if ns.Type != "Ready" && ns.Status == "True" {
 				logger.Printf("Node (%v) issue: %v=%v %v\n", node.ObjectMeta.Name, ns.Type, ns.Status, ns.Message)
-				success = false
 			} else if ns.Type == "Ready" && ns.Status != "True" {
 				logger.Printf("Node (%v) not ready: %v=%v %v\n", node.ObjectMeta.Name, ns.Type, ns.Status, ns.Message)
-				success = false
+			} else {
+				continue
 			}
+
+			conditions = append(conditions, Condition{
+				Type:    string(ns.Type),
+				Status:  string(ns.Status),
+				Reason:  ns.Reason,
+				Message: ns.Message,
+			})
+		}
+
+		if len(conditions) > 0 {
+			diagnostics.addObject(Object{
+				Kind:       "Node",
+				Name:       node.GetName(),
+				Conditions: conditions,
+			})
 		}
 	}
 
-	return success, nil
+	return diagnostics, nil
 }
@@ -1,6 +1,7 @@
 package healthchecks
 
 import (
+	"context"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
@@ -64,15 +65,19 @@ func TestCheckNodeHealth(t *testing.T) {
 
 	for _, test := range tests {
 		kubeClient := kubernetes.NewSimpleClientset(test.objs...)
-		state, err := CheckNodeHealth(kubeClient.CoreV1(), nil)
+		state, err := CheckNodeHealth(context.Background(), kubeClient.CoreV1(), nil)
 
 		if err != nil && !test.expectedError {
 			t.Errorf("Unexpected error: %s", err)
 			return
 		}
 
-		if state != test.expected {
-			t.Errorf("%v: Expected value doesn't match returned value (%v, %v)", test.description, test.expected, state)
+		if state.Healthy != test.expected {
+			t.Errorf("%v: Expected value doesn't match returned value (%v, %v)", test.description, test.expected, state.Healthy)
+		}
+
+		if !state.Healthy && !test.expectedError && (len(state.Objects) != 1 || len(state.Objects[0].Conditions) == 0) {
+			t.Errorf("%v: Expected the unhealthy node and its conditions to be reported, got %v", test.description, state.Objects)
 		}
 	}
 }
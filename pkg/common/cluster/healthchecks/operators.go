@@ -7,49 +7,61 @@ import (
 	"strings"
 
 	configclient "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1"
-	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/logging"
-	"github.com/spf13/viper"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // CheckOperatorReadiness attempts to look at the state of all operator and returns true if things are healthy.
-func CheckOperatorReadiness(configClient configclient.ConfigV1Interface, logger *log.Logger) (bool, error) {
+// operatorSkip is a comma separated list of operators to ignore.
+func CheckOperatorReadiness(ctx context.Context, configClient configclient.ConfigV1Interface, operatorSkip string, logger *log.Logger) (Diagnostics, error) {
 	logger = logging.CreateNewStdLoggerOrUseExistingLogger(logger)
 
-	success := true
 	logger.Print("Checking that all Operators are running or completed...")
 
 	listOpts := metav1.ListOptions{}
-	list, err := configClient.ClusterOperators().List(context.TODO(), listOpts)
+	list, err := configClient.ClusterOperators().List(ctx, listOpts)
 	if err != nil {
-		return false, fmt.Errorf("error getting cluster operator list: %v", err)
+		return Diagnostics{}, fmt.Errorf("error getting cluster operator list: %v", err)
 	}
 
 	if len(list.Items) == 0 {
-		return false, fmt.Errorf("no operators were found")
+		return Diagnostics{}, fmt.Errorf("no operators were found")
 	}
 
 	// Load the list of operators we want to ignore and skip.
-	operatorSkipString := viper.GetString(config.Tests.OperatorSkip)
 	operatorSkipList := make(map[string]string)
-	if len(operatorSkipString) > 0 {
-		operatorSkipVals := strings.Split(operatorSkipString, ",")
+	if len(operatorSkip) > 0 {
+		operatorSkipVals := strings.Split(operatorSkip, ",")
 		for _, val := range operatorSkipVals {
 			operatorSkipList[val] = ""
 		}
 	}
 
+	diagnostics := Diagnostics{Healthy: true}
 	for _, co := range list.Items {
 		if _, ok := operatorSkipList[co.GetName()]; !ok {
+			var conditions []Condition
 			for _, cos := range co.Status.Conditions {
 				if (cos.Type != "Available" && cos.Status != "False") && cos.Type != "Upgradeable" {
 					logger.Printf("Operator %v type %v is %v: %v", co.ObjectMeta.Name, cos.Type, cos.Status, cos.Message)
-					success = false
+					conditions = append(conditions, Condition{
+						Type:    string(cos.Type),
+						Status:  string(cos.Status),
+						Reason:  cos.Reason,
+						Message: cos.Message,
+					})
 				}
 			}
+
+			if len(conditions) > 0 {
+				diagnostics.addObject(Object{
+					Kind:       "ClusterOperator",
+					Name:       co.GetName(),
+					Conditions: conditions,
+				})
+			}
 		}
 	}
 
-	return success, nil
+	return diagnostics, nil
 }
@@ -1,12 +1,11 @@
 package healthchecks
 
 import (
+	"context"
 	"testing"
 
 	configv1 "github.com/openshift/api/config/v1"
 	fakeConfig "github.com/openshift/client-go/config/clientset/versioned/fake"
-	"github.com/openshift/osde2e/pkg/common/config"
-	"github.com/spf13/viper"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -91,18 +90,16 @@ func TestCheckOperatorReadiness(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		viper.Reset()
 		cfgClient := fakeConfig.NewSimpleClientset(test.objs...)
-		viper.Set(config.Tests.OperatorSkip, test.skip)
-		state, err := CheckOperatorReadiness(cfgClient.ConfigV1(), nil)
+		state, err := CheckOperatorReadiness(context.Background(), cfgClient.ConfigV1(), test.skip, nil)
 
 		if err != nil && !test.expectedError {
 			t.Errorf("Unexpected error: %s", err)
 			return
 		}
 
-		if state != test.expected {
-			t.Errorf("%v: Expected value doesn't match returned value (%v, %v)", test.description, test.expected, state)
+		if state.Healthy != test.expected {
+			t.Errorf("%v: Expected value doesn't match returned value (%v, %v)", test.description, test.expected, state.Healthy)
 		}
 	}
 }
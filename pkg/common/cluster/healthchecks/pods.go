@@ -12,7 +12,7 @@ import (
 )
 
 // CheckPodHealth attempts to look at the state of all pods and returns true if things are healthy.
-func CheckPodHealth(podClient v1.CoreV1Interface, logger *log.Logger) (bool, error) {
+func CheckPodHealth(ctx context.Context, podClient v1.CoreV1Interface, logger *log.Logger) (Diagnostics, error) {
 	logger = logging.CreateNewStdLoggerOrUseExistingLogger(logger)
 
 	var notReady []kubev1.Pod
@@ -20,21 +20,42 @@ func CheckPodHealth(podClient v1.CoreV1Interface, logger *log.Logger) (bool, err
 	logger.Print("Checking that all Pods are running or completed...")
 
 	listOpts := metav1.ListOptions{}
-	list, err := podClient.Pods(metav1.NamespaceAll).List(context.TODO(), listOpts)
+	list, err := podClient.Pods(metav1.NamespaceAll).List(ctx, listOpts)
 	if err != nil {
-		return false, fmt.Errorf("error getting pod list: %v", err)
+		return Diagnostics{}, fmt.Errorf("error getting pod list: %v", err)
 	}
 
 	if len(list.Items) == 0 {
-		return false, fmt.Errorf("pod list is empty. this should NOT happen")
+		return Diagnostics{}, fmt.Errorf("pod list is empty. this should NOT happen")
 	}
 
+	diagnostics := Diagnostics{Healthy: true}
 	for _, pod := range list.Items {
 		phase := pod.Status.Phase
 
 		if phase != kubev1.PodRunning && phase != kubev1.PodSucceeded {
+			object := Object{
+				Kind:      "Pod",
+				Namespace: pod.GetNamespace(),
+				Name:      pod.GetName(),
+				Status:    string(phase),
+				Reason:    pod.Status.Reason,
+				Message:   pod.Status.Message,
+			}
+			for _, condition := range pod.Status.Conditions {
+				if condition.Status != kubev1.ConditionTrue {
+					object.Conditions = append(object.Conditions, Condition{
+						Type:    string(condition.Type),
+						Status:  string(condition.Status),
+						Reason:  condition.Reason,
+						Message: condition.Message,
+					})
+				}
+			}
+			diagnostics.addObject(object)
+
 			if phase != kubev1.PodPending {
-				return false, fmt.Errorf("Pod %s errored: %s - %s", pod.GetName(), pod.Status.Reason, pod.Status.Message)
+				return diagnostics, fmt.Errorf("Pod %s errored: %s - %s", pod.GetName(), pod.Status.Reason, pod.Status.Message)
 			}
 			notReady = append(notReady, pod)
 			logger.Printf("%s is not ready. Phase: %s, Message: %s, Reason: %s", pod.Name, pod.Status.Phase, pod.Status.Message, pod.Status.Reason)
@@ -47,5 +68,5 @@ func CheckPodHealth(podClient v1.CoreV1Interface, logger *log.Logger) (bool, err
 
 	logger.Printf("%v%% of pods are currently alive: ", curRatio)
 
-	return len(notReady) == 0, nil
+	return diagnostics, nil
 }
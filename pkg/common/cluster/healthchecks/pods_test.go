@@ -1,6 +1,7 @@
 package healthchecks
 
 import (
+	"context"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
@@ -46,10 +47,16 @@ func TestCheckPodHealth(t *testing.T) {
 
 	for _, test := range tests {
 		kubeClient := kubernetes.NewSimpleClientset(test.objs...)
-		state, err := CheckPodHealth(kubeClient.CoreV1(), nil)
+		state, err := CheckPodHealth(context.Background(), kubeClient.CoreV1(), nil)
 
-		if state != test.expectedState {
-			t.Errorf("%v: Expected state doesn't match returned value (%v, %v)", test.description, test.expectedState, state)
+		if state.Healthy != test.expectedState {
+			t.Errorf("%v: Expected state doesn't match returned value (%v, %v)", test.description, test.expectedState, state.Healthy)
+		}
+
+		for _, object := range state.Objects {
+			if object.Kind != "Pod" || object.Status == string(v1.PodRunning) || object.Status == string(v1.PodSucceeded) {
+				t.Errorf("%v: Unexpected unhealthy object reported: %v", test.description, object)
+			}
 		}
 
 		if (err != nil && test.expectedError == false) || (err == nil && test.expectedError == true) {
@@ -0,0 +1,317 @@
+package healthchecks
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	configclient "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1"
+	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/openshift/osde2e/pkg/common/logging"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Severity determines what a failing health check means for the cluster.
+type Severity string
+
+const (
+	// SeverityCritical checks must pass for a cluster to be considered ready.
+	SeverityCritical Severity = "critical"
+
+	// SeverityInformational checks are reported, but failures don't stop a cluster from being ready.
+	SeverityInformational Severity = "informational"
+)
+
+// DefaultTimeout is how long a check may run when it doesn't specify a timeout.
+const DefaultTimeout = 1 * time.Minute
+
+// Clients are the clients available to health checks.
+type Clients struct {
+	Kube    kubernetes.Interface
+	Config  configclient.ConfigV1Interface
+	Dynamic dynamic.Interface
+}
+
+// Check is a single health check.
+type Check struct {
+	// Name uniquely identifies the check.
+	Name string
+
+	// Providers are the provider types the check applies to. An empty list applies to all providers.
+	Providers []string
+
+	// Severity is the default severity of the check.
+	Severity Severity
+
+	// Timeout is how long the check may run for.
+	Timeout time.Duration
+
+	// Run performs the check, returning diagnostics describing anything unhealthy.
+	Run func(ctx context.Context, cfg *config.RunConfig, clients Clients, logger *log.Logger) (Diagnostics, error)
+}
+
+// AppliesTo returns true if the check can run against clusters from the given provider.
+func (c Check) AppliesTo(providerType string) bool {
+	if len(c.Providers) == 0 {
+		return true
+	}
+
+	for _, provider := range c.Providers {
+		if provider == providerType {
+			return true
+		}
+	}
+	return false
+}
+
+// Profile is a named set of checks to run.
+type Profile struct {
+	// Name uniquely identifies the profile.
+	Name string
+
+	// Checks are the names of the checks to run.
+	Checks []string
+
+	// Informational are the names of checks whose failures are only reported.
+	Informational []string
+}
+
+type registry struct {
+	checks           map[string]Check
+	order            []string
+	profiles         map[string]Profile
+	providerProfiles map[string]string
+	mutex            sync.Mutex
+}
+
+var checkRegistry = &registry{
+	checks:           map[string]Check{},
+	profiles:         map[string]Profile{},
+	providerProfiles: map[string]string{},
+}
+
+// Register adds a health check to the registry. Checks run in the order they're registered.
+func Register(check Check) {
+	checkRegistry.mutex.Lock()
+	defer checkRegistry.mutex.Unlock()
+
+	if _, ok := checkRegistry.checks[check.Name]; ok {
+		panic(fmt.Sprintf("Duplicate health check name %s!", check.Name))
+	}
+
+	if check.Severity == "" {
+		check.Severity = SeverityCritical
+	}
+
+	if check.Timeout == 0 {
+		check.Timeout = DefaultTimeout
+	}
+
+	checkRegistry.checks[check.Name] = check
+	checkRegistry.order = append(checkRegistry.order, check.Name)
+}
+
+// RegisterProfile adds a profile to the registry. It is used by default for the given provider types.
+func RegisterProfile(profile Profile, providerTypes ...string) {
+	checkRegistry.mutex.Lock()
+	defer checkRegistry.mutex.Unlock()
+
+	if _, ok := checkRegistry.profiles[profile.Name]; ok {
+		panic(fmt.Sprintf("Duplicate health check profile name %s!", profile.Name))
+	}
+
+	checkRegistry.profiles[profile.Name] = profile
+	for _, providerType := range providerTypes {
+		checkRegistry.providerProfiles[providerType] = profile.Name
+	}
+}
+
+// Checks returns all registered checks in the order they were registered.
+func Checks() []Check {
+	checkRegistry.mutex.Lock()
+	defer checkRegistry.mutex.Unlock()
+
+	checks := []Check{}
+	for _, name := range checkRegistry.order {
+		checks = append(checks, checkRegistry.checks[name])
+	}
+	return checks
+}
+
+// ProfileFor returns the profile for a provider with any enabled, disabled and informational checks from
+// the config applied. A profile set in the config takes precedence over the provider's default profile.
+// Providers without a default profile run no checks.
+func ProfileFor(cfg *config.RunConfig, providerType string) (Profile, error) {
+	checkRegistry.mutex.Lock()
+	defer checkRegistry.mutex.Unlock()
+
+	profileName := cfg.HealthChecks.Profile
+	if profileName == "" {
+		profileName = checkRegistry.providerProfiles[providerType]
+	}
+
+	profile := Profile{Name: "none"}
+	if profileName != "" {
+		var ok bool
+		if profile, ok = checkRegistry.profiles[profileName]; !ok {
+			return Profile{}, fmt.Errorf("unknown health check profile '%s'", profileName)
+		}
+	}
+
+	enabled := map[string]bool{}
+	for _, name := range profile.Checks {
+		enabled[name] = true
+	}
+	for _, name := range splitList(cfg.HealthChecks.Enable) {
+		enabled[name] = true
+	}
+	for _, name := range splitList(cfg.HealthChecks.Disable) {
+		delete(enabled, name)
+	}
+
+	for name := range enabled {
+		if _, ok := checkRegistry.checks[name]; !ok {
+			return Profile{}, fmt.Errorf("unknown health check '%s'", name)
+		}
+	}
+
+	result := Profile{
+		Name:          profile.Name,
+		Informational: append(append([]string{}, profile.Informational...), splitList(cfg.HealthChecks.Informational)...),
+	}
+	for _, name := range checkRegistry.order {
+		if enabled[name] {
+			result.Checks = append(result.Checks, name)
+		}
+	}
+
+	return result, nil
+}
+
+// Result is the outcome of running a single health check.
+type Result struct {
+	// Name is the name of the check.
+	Name string
+
+	// Severity is the severity the check ran with.
+	Severity Severity
+
+	// Passed is true if the check found the cluster healthy.
+	Passed bool
+
+	// Objects are the unhealthy objects found by the check.
+	Objects []Object
+
+	// Skipped is true if the check didn't run. SkipReason explains why.
+	Skipped    bool
+	SkipReason string
+
+	// Err is any error encountered while running the check.
+	Err error
+
+	// Duration is how long the check took.
+	Duration time.Duration
+}
+
+// Run runs all registered checks using the profile for the given provider. Checks not in the profile or
+// which don't apply to the provider are reported as skipped.
+func Run(ctx context.Context, cfg *config.RunConfig, providerType string, clients Clients, logger *log.Logger) ([]Result, error) {
+	logger = logging.CreateNewStdLoggerOrUseExistingLogger(logger)
+
+	profile, err := ProfileFor(cfg, providerType)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := map[string]bool{}
+	for _, name := range profile.Checks {
+		enabled[name] = true
+	}
+
+	informational := map[string]bool{}
+	for _, name := range profile.Informational {
+		informational[name] = true
+	}
+
+	results := []Result{}
+	for _, check := range Checks() {
+		result := Result{
+			Name:     check.Name,
+			Severity: check.Severity,
+		}
+
+		if informational[check.Name] {
+			result.Severity = SeverityInformational
+		}
+
+		if !enabled[check.Name] {
+			result.Skipped = true
+			result.SkipReason = fmt.Sprintf("not enabled in profile %s", profile.Name)
+		} else if !check.AppliesTo(providerType) {
+			result.Skipped = true
+			result.SkipReason = fmt.Sprintf("does not apply to provider %s", providerType)
+			logger.Printf("Skipping health check %s, which does not apply to provider %s", check.Name, providerType)
+		} else {
+			runCheck(ctx, cfg, check, clients, logger, &result)
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// runCheck runs a single check within its timeout and records the outcome in result.
+func runCheck(ctx context.Context, cfg *config.RunConfig, check Check, clients Clients, logger *log.Logger, result *Result) {
+	checkCtx, cancel := context.WithTimeout(ctx, check.Timeout)
+	defer cancel()
+
+	started := time.Now()
+	diagnostics, err := check.Run(checkCtx, cfg, clients, logger)
+	result.Duration = time.Since(started)
+	result.Passed, result.Objects, result.Err = diagnostics.Healthy, diagnostics.Objects, err
+
+	if checkCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+		result.Passed = false
+		result.Err = fmt.Errorf("timed out after %v", check.Timeout)
+	}
+}
+
+// Healthy returns true if every critical check that ran passed, along with the errors encountered by those checks.
+func Healthy(results []Result) (bool, error) {
+	healthy := true
+	var errs []string
+
+	for _, result := range results {
+		if result.Skipped || result.Severity == SeverityInformational {
+			continue
+		}
+
+		if !result.Passed || result.Err != nil {
+			healthy = false
+		}
+
+		if result.Err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", result.Name, result.Err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return healthy, fmt.Errorf("%d health check(s) errored: %s", len(errs), strings.Join(errs, "; "))
+	}
+	return healthy, nil
+}
+
+func splitList(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,187 @@
+package healthchecks
+
+import (
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/onsi/ginkgo/reporters"
+	"github.com/openshift/osde2e/pkg/common/config"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetes "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestProfileFor(t *testing.T) {
+	var tests = []struct {
+		description   string
+		provider      string
+		profile       string
+		enable        string
+		disable       string
+		expected      []string
+		expectedError bool
+	}{
+		{"ocm default", "ocm", "", "", "", []string{"cluster-version-operator", "nodes", "machines", "operators", "pods", "certificates"}, false},
+		{"local default", "local", "", "", "", []string{"nodes", "pods"}, false},
+		{"mock default", "mock", "", "", "", nil, false},
+		{"provider without a profile", "unknown", "", "", "", nil, false},
+		{"profile override", "ocm", "kubernetes", "", "", []string{"nodes", "pods"}, false},
+		{"enable and disable", "local", "", "certificates, operators", "pods", []string{"nodes", "operators", "certificates"}, false},
+		{"unknown profile", "ocm", "missing", "", "", nil, true},
+		{"unknown check", "ocm", "", "missing", "", nil, true},
+	}
+
+	for _, test := range tests {
+		cfg := &config.RunConfig{}
+		cfg.HealthChecks.Profile = test.profile
+		cfg.HealthChecks.Enable = test.enable
+		cfg.HealthChecks.Disable = test.disable
+
+		profile, err := ProfileFor(cfg, test.provider)
+		if err != nil {
+			if !test.expectedError {
+				t.Errorf("%v: unexpected error: %v", test.description, err)
+			}
+			continue
+		}
+		if test.expectedError {
+			t.Errorf("%v: expected an error", test.description)
+		}
+
+		if !reflect.DeepEqual(profile.Checks, test.expected) {
+			t.Errorf("%v: expected checks %v, got %v", test.description, test.expected, profile.Checks)
+		}
+	}
+}
+
+func TestProviderChecks(t *testing.T) {
+	var tests = []struct {
+		provider string
+		expected []string
+	}{
+		{"ocm", []string{"cluster-version-operator", "nodes", "machines", "operators", "pods", "certificates"}},
+		{"moa", []string{"cluster-version-operator", "nodes", "machines", "operators", "pods", "certificates"}},
+		{"crc", []string{"cluster-version-operator", "nodes", "operators", "pods"}},
+		{"local", []string{"nodes", "pods"}},
+		{"mock", nil},
+	}
+
+	for _, test := range tests {
+		profile, err := ProfileFor(&config.RunConfig{}, test.provider)
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", test.provider, err)
+			continue
+		}
+
+		enabled := map[string]bool{}
+		for _, name := range profile.Checks {
+			enabled[name] = true
+		}
+
+		var runs []string
+		for _, check := range Checks() {
+			if enabled[check.Name] && check.AppliesTo(test.provider) {
+				runs = append(runs, check.Name)
+			}
+		}
+
+		if !reflect.DeepEqual(runs, test.expected) {
+			t.Errorf("%v: expected checks %v to run, got %v", test.provider, test.expected, runs)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	cfg := &config.RunConfig{}
+	cfg.HealthChecks.Enable = "certificates"
+	cfg.HealthChecks.Informational = "pods"
+
+	kubeClient := kubernetes.NewSimpleClientset(
+		node("node", []v1.NodeCondition{{Type: "Ready", Status: "True"}}),
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "default"},
+			Status:     v1.PodStatus{Phase: v1.PodPending},
+		},
+	)
+
+	results, err := Run(context.Background(), cfg, "local", Clients{Kube: kubeClient}, nil)
+	if err != nil {
+		t.Fatalf("error running checks: %v", err)
+	}
+
+	outcomes := map[string]Result{}
+	for _, result := range results {
+		outcomes[result.Name] = result
+	}
+
+	if result := outcomes["nodes"]; result.Skipped || !result.Passed {
+		t.Errorf("expected nodes check to pass, got %+v", result)
+	}
+	if result := outcomes["pods"]; result.Passed || result.Severity != SeverityInformational {
+		t.Errorf("expected informational pods check to fail, got %+v", result)
+	}
+	if result := outcomes["certificates"]; !result.Skipped {
+		t.Errorf("expected certificates check to be skipped for the local provider, got %+v", result)
+	}
+	if result := outcomes["machines"]; !result.Skipped {
+		t.Errorf("expected machines check to be skipped outside of the profile, got %+v", result)
+	}
+
+	if healthy, err := Healthy(results); !healthy || err != nil {
+		t.Errorf("expected informational failures to be ignored, got: %v, %v", healthy, err)
+	}
+
+	// Failing pods are critical once they aren't informational.
+	outcome := outcomes["pods"]
+	outcome.Severity = SeverityCritical
+	if healthy, _ := Healthy([]Result{outcome}); healthy {
+		t.Error("expected critical failure to make the cluster unhealthy")
+	}
+
+	report := NewReport()
+	report.Add(results)
+	report.Add([]Result{outcome})
+
+	dir, err := ioutil.TempDir("", "healthchecks")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "junit_healthchecks.xml")
+	if err = report.WriteJUnit(filename); err != nil {
+		t.Fatalf("error writing report: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("error reading report: %v", err)
+	}
+
+	var suite reporters.JUnitTestSuite
+	if err = xml.Unmarshal(data, &suite); err != nil {
+		t.Fatalf("error parsing report: %v", err)
+	}
+
+	if suite.Tests != len(Checks()) || suite.Failures != 1 {
+		t.Errorf("expected %d checks with 1 failure, got %d with %d", len(Checks()), suite.Tests, suite.Failures)
+	}
+
+	for _, testCase := range suite.TestCases {
+		switch testCase.Name {
+		case "[Health Check] pods":
+			if testCase.FailureMessage == nil || testCase.FailureMessage.Message != "Failed 2 of 2 polls, unhealthy: Pod default/pending: Pending" {
+				t.Errorf("expected pods to fail on both polls, got %+v", testCase.FailureMessage)
+			}
+		case "[Health Check] machines":
+			if testCase.Skipped == nil {
+				t.Error("expected machines to be skipped")
+			}
+		}
+	}
+}
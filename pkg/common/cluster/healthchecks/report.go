@@ -0,0 +1,295 @@
+package healthchecks
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onsi/ginkgo/reporters"
+)
+
+// Report collects health check results across polls so they can be written as JUnit or as a timeline.
+type Report struct {
+	checks    map[string]*reportEntry
+	order     []string
+	polls     []Poll
+	resources map[string]*ResourceHistory
+	resOrder  []string
+	now       func() time.Time
+	mutex     sync.Mutex
+}
+
+type reportEntry struct {
+	last     Result
+	runs     int
+	failures int
+	duration time.Duration
+}
+
+// NewReport creates an empty report.
+func NewReport() *Report {
+	return &Report{
+		checks:    map[string]*reportEntry{},
+		resources: map[string]*ResourceHistory{},
+		now:       time.Now,
+	}
+}
+
+// Timeline is the history of health checks across polls.
+type Timeline struct {
+	Started time.Time `json:"started"`
+	Ended   time.Time `json:"ended"`
+
+	// Polls are the results of each poll, in order.
+	Polls []Poll `json:"polls"`
+
+	// Resources are every object found unhealthy, with when and for how long.
+	Resources []ResourceHistory `json:"resources"`
+}
+
+// Poll is the results of the checks that ran in a single poll.
+type Poll struct {
+	Time   time.Time     `json:"time"`
+	Checks []CheckStatus `json:"checks"`
+}
+
+// CheckStatus is the outcome of a check in a single poll.
+type CheckStatus struct {
+	Name     string   `json:"name"`
+	Severity Severity `json:"severity"`
+	Passed   bool     `json:"passed"`
+	Error    string   `json:"error,omitempty"`
+	Objects  []Object `json:"objects,omitempty"`
+}
+
+// ResourceHistory is when an object was found unhealthy by a check.
+type ResourceHistory struct {
+	Check     string `json:"check"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+
+	// Flaps is the number of times the object became unhealthy again after recovering.
+	Flaps int `json:"flaps"`
+
+	// UnhealthySeconds is the total time the object was unhealthy for.
+	UnhealthySeconds float64 `json:"unhealthySeconds"`
+
+	// Periods are the spans of time the object was unhealthy. The last period has no end if it never recovered.
+	Periods []Period `json:"periods"`
+
+	// Last is the object as it was last seen unhealthy.
+	Last Object `json:"last"`
+}
+
+// Period is a span of time an object was unhealthy.
+type Period struct {
+	Start time.Time  `json:"start"`
+	End   *time.Time `json:"end,omitempty"`
+}
+
+// Add records the results of a single poll.
+func (r *Report) Add(results []Result) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	poll := Poll{Time: r.now()}
+	for _, result := range results {
+		entry, ok := r.checks[result.Name]
+		if !ok {
+			entry = &reportEntry{}
+			r.checks[result.Name] = entry
+			r.order = append(r.order, result.Name)
+		}
+
+		entry.last = result
+		if result.Skipped {
+			continue
+		}
+
+		entry.runs++
+		entry.duration += result.Duration
+		if !result.Passed || result.Err != nil {
+			entry.failures++
+		}
+
+		status := CheckStatus{
+			Name:     result.Name,
+			Severity: result.Severity,
+			Passed:   result.Passed,
+			Objects:  result.Objects,
+		}
+		if result.Err != nil {
+			status.Error = result.Err.Error()
+		}
+		poll.Checks = append(poll.Checks, status)
+
+		// A check that errored without finding anything can't say whether objects recovered.
+		if result.Err == nil || len(result.Objects) > 0 {
+			r.updateResources(poll.Time, result)
+		}
+	}
+	r.polls = append(r.polls, poll)
+}
+
+// updateResources opens periods for objects that are unhealthy and closes them for objects that recovered.
+func (r *Report) updateResources(now time.Time, result Result) {
+	unhealthy := map[string]bool{}
+	for _, object := range result.Objects {
+		key := result.Name + "/" + object.key()
+		unhealthy[key] = true
+
+		history, ok := r.resources[key]
+		if !ok {
+			history = &ResourceHistory{
+				Check:     result.Name,
+				Kind:      object.Kind,
+				Namespace: object.Namespace,
+				Name:      object.Name,
+			}
+			r.resources[key] = history
+			r.resOrder = append(r.resOrder, key)
+		}
+
+		history.Last = object
+		if len(history.Periods) == 0 || history.Periods[len(history.Periods)-1].End != nil {
+			if len(history.Periods) > 0 {
+				history.Flaps++
+			}
+			history.Periods = append(history.Periods, Period{Start: now})
+		}
+	}
+
+	for _, key := range r.resOrder {
+		history := r.resources[key]
+		if history.Check != result.Name || unhealthy[key] {
+			continue
+		}
+
+		if last := &history.Periods[len(history.Periods)-1]; last.End == nil {
+			end := now
+			last.End = &end
+		}
+	}
+}
+
+// Timeline returns the history of every poll and unhealthy object seen so far.
+func (r *Report) Timeline() Timeline {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	timeline := Timeline{
+		Polls:     append([]Poll{}, r.polls...),
+		Resources: []ResourceHistory{},
+	}
+	if len(r.polls) == 0 {
+		return timeline
+	}
+	timeline.Started = r.polls[0].Time
+	timeline.Ended = r.polls[len(r.polls)-1].Time
+
+	for _, key := range r.resOrder {
+		history := *r.resources[key]
+		history.Periods = append([]Period{}, history.Periods...)
+		for _, period := range history.Periods {
+			end := timeline.Ended
+			if period.End != nil {
+				end = *period.End
+			}
+			history.UnhealthySeconds += end.Sub(period.Start).Seconds()
+		}
+		timeline.Resources = append(timeline.Resources, history)
+	}
+
+	return timeline
+}
+
+// WriteTimeline writes the timeline as a JSON file.
+func (r *Report) WriteTimeline(filename string) error {
+	data, err := json.MarshalIndent(r.Timeline(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling health check timeline: %v", err)
+	}
+
+	if err = ioutil.WriteFile(filename, data, 0644); err != nil {
+		return fmt.Errorf("error writing health check timeline: %v", err)
+	}
+	return nil
+}
+
+// JUnit returns the report as a JUnit test suite. A check fails if it was critical and failed on the last poll.
+func (r *Report) JUnit() reporters.JUnitTestSuite {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	suite := reporters.JUnitTestSuite{
+		Name: "Health Checks",
+	}
+
+	for _, name := range r.order {
+		entry := r.checks[name]
+		testCase := reporters.JUnitTestCase{
+			ClassName: "Health Checks",
+			Name:      fmt.Sprintf("[Health Check] %s", name),
+			Time:      entry.duration.Seconds(),
+		}
+
+		if entry.runs == 0 {
+			testCase.Skipped = &reporters.JUnitSkipped{}
+			testCase.SystemOut = fmt.Sprintf("Skipped: %s", entry.last.SkipReason)
+		} else if entry.last.Passed && entry.last.Err == nil {
+			testCase.PassedMessage = &reporters.JUnitPassedMessage{
+				Message: fmt.Sprintf("Passed on the last poll, failed %d of %d polls", entry.failures, entry.runs),
+			}
+		} else {
+			message := fmt.Sprintf("Failed %d of %d polls", entry.failures, entry.runs)
+			if entry.last.Err != nil {
+				message = fmt.Sprintf("%s, last error: %v", message, entry.last.Err)
+			}
+			if len(entry.last.Objects) > 0 {
+				var objects []string
+				for _, object := range entry.last.Objects {
+					objects = append(objects, object.String())
+				}
+				message = fmt.Sprintf("%s, unhealthy: %s", message, strings.Join(objects, "; "))
+			}
+
+			if entry.last.Severity == SeverityInformational {
+				testCase.PassedMessage = &reporters.JUnitPassedMessage{
+					Message: fmt.Sprintf("Informational: %s", message),
+				}
+			} else {
+				testCase.FailureMessage = &reporters.JUnitFailureMessage{
+					Type:    string(entry.last.Severity),
+					Message: message,
+				}
+				suite.Failures++
+			}
+		}
+
+		suite.Tests++
+		suite.Time += testCase.Time
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	return suite
+}
+
+// WriteJUnit writes the report as a JUnit XML file.
+func (r *Report) WriteJUnit(filename string) error {
+	suite := r.JUnit()
+
+	data, err := xml.Marshal(&suite)
+	if err != nil {
+		return fmt.Errorf("error marshalling health check report: %v", err)
+	}
+
+	if err = ioutil.WriteFile(filename, data, 0644); err != nil {
+		return fmt.Errorf("error writing health check report: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package healthchecks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTimeline(t *testing.T) {
+	started := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+	now := started
+
+	report := NewReport()
+	report.now = func() time.Time {
+		return now
+	}
+
+	flapping := Object{Kind: "Pod", Namespace: "openshift-monitoring", Name: "prometheus-0", Status: "Pending"}
+	stuck := Object{Kind: "ClusterOperator", Name: "monitoring", Conditions: []Condition{{Type: "Degraded", Status: "True", Reason: "UpdatingPrometheusFailed"}}}
+
+	polls := [][]Result{
+		{{Name: "pods", Objects: []Object{flapping}}, {Name: "operators", Objects: []Object{stuck}}},
+		{{Name: "pods", Passed: true}, {Name: "operators", Objects: []Object{stuck}}},
+		{{Name: "pods", Objects: []Object{flapping}}, {Name: "operators", Objects: []Object{stuck}}},
+		// An error without diagnostics says nothing about whether the pod recovered.
+		{{Name: "pods", Err: fmt.Errorf("error getting pod list")}, {Name: "operators", Objects: []Object{stuck}}},
+		{{Name: "pods", Passed: true}, {Name: "operators", Objects: []Object{stuck}}, {Name: "machines", Skipped: true}},
+	}
+
+	for _, results := range polls {
+		report.Add(results)
+		now = now.Add(30 * time.Second)
+	}
+
+	timeline := report.Timeline()
+	if len(timeline.Polls) != 5 || !timeline.Started.Equal(started) || !timeline.Ended.Equal(started.Add(2*time.Minute)) {
+		t.Fatalf("unexpected polls in timeline: %d polls from %v to %v", len(timeline.Polls), timeline.Started, timeline.Ended)
+	}
+
+	if checks := timeline.Polls[4].Checks; len(checks) != 2 {
+		t.Errorf("expected skipped checks to be left out of polls, got %v", checks)
+	}
+
+	if len(timeline.Resources) != 2 {
+		t.Fatalf("expected 2 unhealthy resources, got %v", timeline.Resources)
+	}
+
+	pod := timeline.Resources[0]
+	if pod.Name != "prometheus-0" || pod.Flaps != 1 || len(pod.Periods) != 2 || pod.UnhealthySeconds != 90 {
+		t.Errorf("expected the pod to flap once and be unhealthy for 90s, got %+v", pod)
+	}
+
+	operator := timeline.Resources[1]
+	if operator.Name != "monitoring" || operator.Flaps != 0 || operator.Periods[0].End != nil || operator.UnhealthySeconds != 120 {
+		t.Errorf("expected the operator to be unhealthy for the whole timeline, got %+v", operator)
+	}
+
+	dir, err := ioutil.TempDir("", "healthchecks")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "health-timeline.json")
+	if err = report.WriteTimeline(filename); err != nil {
+		t.Fatalf("error writing timeline: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("error reading timeline: %v", err)
+	}
+
+	var written Timeline
+	if err = json.Unmarshal(data, &written); err != nil {
+		t.Fatalf("error parsing timeline: %v", err)
+	}
+	if len(written.Resources) != 2 || written.Resources[1].Last.Conditions[0].Reason != "UpdatingPrometheusFailed" {
+		t.Errorf("unexpected timeline written: %s", data)
+	}
+}
@@ -8,6 +8,10 @@ import (
 	"github.com/spf13/viper"
 )
 
+// DefaultPollingTimeout is the default Tests.PollingTimeout. Ginkgo test timeouts are set when the test tree is
+// built, before a run is configured, so they use this instead.
+const DefaultPollingTimeout = 30
+
 const (
 	// Provider is what provider to use to create/delete clusters.
 	Provider = "provider"
@@ -41,14 +45,11 @@ const (
 	// MustGather will run a Must-Gather process upon completion of the tests.
 	MustGather = "mustGather"
 
-	// InstalledWorkloads is an internal variable used to track currently installed workloads in this test run.
-	InstalledWorkloads = "installedWorkloads"
-
-	// Phase is an internal variable used to track the current set of tests being run (install, upgrade).
-	Phase = "phase"
-
 	// Project is both the project and SA automatically created to house all objects created during an osde2e-run
 	Project = "project"
+
+	// Resume is the report directory of an earlier run to resume from its last checkpoint.
+	Resume = "resume"
 )
 
 // This is a config key to secret file mapping. We will attempt to read in from secret files before loading anything else.
@@ -75,11 +76,50 @@ var Upgrade = struct {
 	// Image is the release image a cluster is upgraded to. If set, it overrides the release stream and upgrades.
 	Image string
 
-	// UpgradeVersionEqualToInstallVersion is true if the install version and upgrade versions are the same.
-	UpgradeVersionEqualToInstallVersion string
-
 	// MonitorRoutesDuringUpgrade will monitor the availability of routes whilst an upgrade takes place
 	MonitorRoutesDuringUpgrade string
+
+	// Path is a comma separated list of releases to upgrade through in order, e.g. "4.6.8,4.7.2". A release can be
+	// followed by "=" and its image. If set, it overrides the release name and image.
+	Path string
+
+	// PathFromCincinnati will upgrade to the selected release along the shortest path through the Cincinnati graph.
+	PathFromCincinnati string
+
+	// Mode is how the cluster is upgraded: "rollback", "unavailable-image" or "pause-machine-config-pools".
+	// The selected release is upgraded to normally if unset.
+	Mode string
+
+	// UnavailableImage is the image requested by the unavailable-image upgrade mode. It must not be pullable.
+	UnavailableImage string
+
+	// PausedMachineConfigPools is a comma separated list of pools paused by the pause-machine-config-pools upgrade mode.
+	PausedMachineConfigPools string
+
+	// Managed will upgrade the cluster by creating an upgrade policy with the provider, the way customers upgrade
+	// their clusters, instead of editing the ClusterVersion.
+	Managed string
+
+	// ManagedDelayInMinutes is how far in the future a managed upgrade is scheduled.
+	ManagedDelayInMinutes string
+
+	// Preflight will check release images are valid before upgrading to them.
+	Preflight string
+
+	// PreflightRequireSignature will fail the pre-flight checks of a release image that isn't signed.
+	PreflightRequireSignature string
+
+	// PreflightRequireEdge will fail the pre-flight checks of a release image that can't be upgraded to from the installed version.
+	PreflightRequireEdge string
+
+	// PreflightRequireImage will fail the pre-flight checks of a release image that can't be read from its registry.
+	PreflightRequireImage string
+
+	// SignatureStoreURL is the store release image signatures are looked up in.
+	SignatureStoreURL string
+
+	// RegistryAuthFile is a Docker config.json or pull secret used to read release images. They are read anonymously if unset.
+	RegistryAuthFile string
 }{
 	UpgradeToCISIfPossible:                "upgrade.upgradeToCISIfPossible",
 	OnlyUpgradeToZReleases:                "upgrade.onlyUpgradeToZReleases",
@@ -87,8 +127,131 @@ var Upgrade = struct {
 	ReleaseStream:                         "upgrade.releaseStream",
 	ReleaseName:                           "upgrade.releaseName",
 	Image:                                 "upgrade.image",
-	UpgradeVersionEqualToInstallVersion:   "upgrade.upgradeVersionEqualToInstallVersion",
 	MonitorRoutesDuringUpgrade:            "upgrade.monitorRoutesDuringUpgrade",
+	Path:                                  "upgrade.path",
+	PathFromCincinnati:                    "upgrade.pathFromCincinnati",
+	Mode:                                  "upgrade.mode",
+	UnavailableImage:                      "upgrade.unavailableImage",
+	PausedMachineConfigPools:              "upgrade.pausedMachineConfigPools",
+	Managed:                               "upgrade.managed",
+	ManagedDelayInMinutes:                 "upgrade.managedDelayInMinutes",
+	Preflight:                             "upgrade.preflight",
+	PreflightRequireSignature:             "upgrade.preflightRequireSignature",
+	PreflightRequireEdge:                  "upgrade.preflightRequireEdge",
+	PreflightRequireImage:                 "upgrade.preflightRequireImage",
+	SignatureStoreURL:                     "upgrade.signatureStoreURL",
+	RegistryAuthFile:                      "upgrade.registryAuthFile",
+}
+
+// Cincinnati config keys.
+var Cincinnati = struct {
+	// URL is the Cincinnati graph endpoint.
+	URL string
+
+	// Arch is the architecture of the graphs fetched from Cincinnati.
+	Arch string
+
+	// CacheDir is a directory graphs are cached in between runs. Graphs are only cached in memory if unset.
+	CacheDir string
+
+	// CacheTTLInMinutes is how long a graph cached in memory or on disk is used before being fetched again.
+	CacheTTLInMinutes string
+
+	// Fixtures is a directory of graphs named <channel>.json, which are used instead of fetching graphs.
+	Fixtures string
+}{
+	URL:               "cincinnati.url",
+	Arch:              "cincinnati.arch",
+	CacheDir:          "cincinnati.cacheDir",
+	CacheTTLInMinutes: "cincinnati.cacheTTLInMinutes",
+	Fixtures:          "cincinnati.fixtures",
+}
+
+// ReleaseController config keys.
+var ReleaseController = struct {
+	// URL is the base URL of the release controller.
+	URL string
+
+	// Fixtures is a directory of recorded release controller responses, which are used instead of fetching them.
+	Fixtures string
+
+	// RecordFixtures is a directory responses from the release controller are recorded in, to be used as fixtures later.
+	RecordFixtures string
+
+	// Phase is the phase of the nightlies selected from the release controller, e.g. Accepted or Rejected.
+	Phase string
+
+	// MinAgeInHours is how old a nightly must be to be selected from the release controller.
+	MinAgeInHours string
+
+	// InstallStream is a release stream to select the nightly to install from. The nightly must be offered by the provider.
+	InstallStream string
+}{
+	URL:            "releaseController.url",
+	Fixtures:       "releaseController.fixtures",
+	RecordFixtures: "releaseController.recordFixtures",
+	Phase:          "releaseController.phase",
+	MinAgeInHours:  "releaseController.minAgeInHours",
+	InstallStream:  "releaseController.installStream",
+}
+
+// RouteMonitors config keys.
+var RouteMonitors = struct {
+	// DuringInstall will monitor the availability of routes whilst the install phase tests run.
+	DuringInstall string
+
+	// Targets are targets to monitor in addition to the console, oauth, API servers and workload routes.
+	// See RouteMonitorTarget.
+	Targets string
+
+	// Rate is the number of requests per second sent to a target that doesn't set its own.
+	Rate string
+
+	// TimeoutInSeconds is how long a request to a target that doesn't set its own timeout can take.
+	TimeoutInSeconds string
+
+	// AvailabilitySLO is the ratio of requests that must succeed for a target that doesn't set its own SLO.
+	// It isn't asserted if zero.
+	AvailabilitySLO string
+
+	// LatencySLOInMilliseconds is the 99th percentile latency a target that doesn't set its own SLO must stay under.
+	// It isn't asserted if zero.
+	LatencySLOInMilliseconds string
+}{
+	DuringInstall:            "routeMonitors.duringInstall",
+	Targets:                  "routeMonitors.targets",
+	Rate:                     "routeMonitors.rate",
+	TimeoutInSeconds:         "routeMonitors.timeoutInSeconds",
+	AvailabilitySLO:          "routeMonitors.availabilitySLO",
+	LatencySLOInMilliseconds: "routeMonitors.latencySLOInMilliseconds",
+}
+
+// Disruption config keys.
+var Disruption = struct {
+	// Enabled deploys probe workloads before upgrading and measures how long they are disrupted by each upgrade.
+	Enabled string
+
+	// Namespace is the namespace the probe workloads are deployed in.
+	Namespace string
+
+	// Image is the image the probe workloads run. It must provide python3.
+	Image string
+
+	// TCPBudgetInSeconds is how long the TCP workload can be disrupted by an upgrade before the upgrade phase fails.
+	TCPBudgetInSeconds string
+
+	// HTTPBudgetInSeconds is how long the HTTP workload can be disrupted by an upgrade before the upgrade phase fails.
+	HTTPBudgetInSeconds string
+
+	// StatefulBudgetInSeconds is how long the PVC-backed workload can be disrupted by an upgrade before the upgrade phase fails.
+	StatefulBudgetInSeconds string
+}{
+	Enabled:                 "disruption.enabled",
+	Namespace:               "disruption.namespace",
+	Image:                   "disruption.image",
+	TCPBudgetInSeconds:      "disruption.tcpBudgetInSeconds",
+	HTTPBudgetInSeconds:     "disruption.httpBudgetInSeconds",
+	StatefulBudgetInSeconds: "disruption.statefulBudgetInSeconds",
 }
 
 // Kubeconfig config keys.
@@ -136,6 +299,12 @@ var Tests = struct {
 
 	// ServiceAccount defines what user the tests should run as. By default, osde2e uses system:admin
 	ServiceAccount string
+
+	// RunnerLogLines is how many lines of each runner container's log are echoed while it runs. -1 echoes all of them.
+	RunnerLogLines string
+
+	// OpenshiftTestShards is how many runner Pods the openshift-tests conformance suites are split across.
+	OpenshiftTestShards string
 }{
 
 	PollingTimeout:            "tests.pollingTimeout",
@@ -148,6 +317,53 @@ var Tests = struct {
 	SkipClusterHealthChecks:   "tests.skipClusterHealthChecks",
 	MetricsBucket:             "tests.metricsBucket",
 	ServiceAccount:            "tests.serviceAccount",
+	RunnerLogLines:            "tests.runnerLogLines",
+	OpenshiftTestShards:       "tests.openshiftTestShards",
+}
+
+// HealthChecks config keys.
+var HealthChecks = struct {
+	// Profile is the set of health checks to run. Defaults to the profile for the provider in use.
+	Profile string
+
+	// Enable is a comma separated list of health checks to run in addition to those in the profile.
+	Enable string
+
+	// Disable is a comma separated list of health checks from the profile not to run.
+	Disable string
+
+	// Informational is a comma separated list of health checks whose failures are reported but don't stop a cluster from being ready.
+	Informational string
+}{
+	Profile:       "healthChecks.profile",
+	Enable:        "healthChecks.enable",
+	Disable:       "healthChecks.disable",
+	Informational: "healthChecks.informational",
+}
+
+// Matrix config keys.
+var Matrix = struct {
+	// Versions is a comma separated list of cluster versions to run the suite against.
+	Versions string
+
+	// CloudProviders is a comma separated list of cloud providers to run the suite against.
+	CloudProviders string
+
+	// Regions is a comma separated list of regions to run the suite against. A region may be prefixed with a
+	// cloud provider, e.g. "gcp:us-east1", to only use it with that cloud provider.
+	Regions string
+
+	// Parallelism is the number of matrix entries run at once.
+	Parallelism string
+
+	// Entry is the name of the matrix entry being run. It is set by osde2e for each entry it runs.
+	Entry string
+}{
+	Versions:       "matrix.versions",
+	CloudProviders: "matrix.cloudProviders",
+	Regions:        "matrix.regions",
+	Parallelism:    "matrix.parallelism",
+	Entry:          "matrix.entry",
 }
 
 // Cluster config keys.
@@ -182,6 +398,12 @@ var Cluster = struct {
 	// NextReleaseAfterProdDefault will select the cluster image set that the given number of releases away from the the production default.
 	NextReleaseAfterProdDefault string
 
+	// InstallVersionConstraint will select a version matching a constraint expression, such as ">=4.6 <4.8, !prerelease".
+	InstallVersionConstraint string
+
+	// InstallVersionPick is how a version is picked from those matching InstallVersionConstraint.
+	InstallVersionPick string
+
 	// CleanCheckRuns lets us set the number of osd-verify checks we want to run before deeming a cluster "healthy"
 	CleanCheckRuns string
 
@@ -193,29 +415,30 @@ var Cluster = struct {
 
 	// Version is the version of the cluster being deployed.
 	Version string
-
-	// EnoughVersionsForOldestOrMiddleTest is true if there were enough versions for an older/middle test.
-	EnoughVersionsForOldestOrMiddleTest string
-
-	// PreviousVersionFromDefaultFound is true if a previous version from default was found.
-	PreviousVersionFromDefaultFound string
 }{
-	MultiAZ:                             "cluster.multiAZ",
-	DestroyAfterTest:                    "cluster.destroyAfterTest",
-	ExpiryInMinutes:                     "cluster.expiryInMinutes",
-	AfterTestWait:                       "cluster.afterTestWait",
-	InstallTimeout:                      "cluster.installTimeout",
-	UseLatestVersionForInstall:          "cluster.useLatestVersionForInstall",
-	UseMiddleClusterImageSetForInstall:  "cluster.useMiddleClusterVersionForInstall",
-	UseOldestClusterImageSetForInstall:  "cluster.useOldestClusterVersionForInstall",
-	DeltaReleaseFromDefault:             "cluster.deltaReleaseFromDefault",
-	NextReleaseAfterProdDefault:         "cluster.nextReleaseAfterProdDefault",
-	CleanCheckRuns:                      "cluster.cleanCheckRuns",
-	ID:                                  "cluster.id",
-	Name:                                "cluster.name",
-	Version:                             "cluster.version",
-	EnoughVersionsForOldestOrMiddleTest: "cluster.enoughVersionForOldestOrMiddleTest",
-	PreviousVersionFromDefaultFound:     "cluster.previousVersionFromDefaultFound",
+	MultiAZ:                            "cluster.multiAZ",
+	DestroyAfterTest:                   "cluster.destroyAfterTest",
+	ExpiryInMinutes:                    "cluster.expiryInMinutes",
+	AfterTestWait:                      "cluster.afterTestWait",
+	InstallTimeout:                     "cluster.installTimeout",
+	UseLatestVersionForInstall:         "cluster.useLatestVersionForInstall",
+	UseMiddleClusterImageSetForInstall: "cluster.useMiddleClusterImageSetForInstall",
+	UseOldestClusterImageSetForInstall: "cluster.useOldestClusterImageSetForInstall",
+	DeltaReleaseFromDefault:            "cluster.deltaReleaseFromDefault",
+	NextReleaseAfterProdDefault:        "cluster.nextReleaseAfterProdDefault",
+	InstallVersionConstraint:           "cluster.installVersionConstraint",
+	InstallVersionPick:                 "cluster.installVersionPick",
+	CleanCheckRuns:                     "cluster.cleanCheckRuns",
+	ID:                                 "cluster.id",
+	Name:                               "cluster.name",
+	Version:                            "cluster.version",
+}
+
+// DeprecatedKeys maps the old names of renamed config keys to the keys that replaced them. Configs using an old
+// name still work, but a warning is logged.
+var DeprecatedKeys = map[string]string{
+	"cluster.useMiddleClusterVersionForInstall": Cluster.UseMiddleClusterImageSetForInstall,
+	"cluster.useOldestClusterVersionForInstall": Cluster.UseOldestClusterImageSetForInstall,
 }
 
 // CloudProvider config keys.
@@ -314,6 +537,151 @@ var Alert = struct {
 	SlackAPIToken: "alert.slackAPIToken",
 }
 
+// Mock provider config keys.
+var Mock = struct {
+	// Env is the mock environment.
+	Env string
+
+	// ScenarioFile is a YAML file describing how the mock provider should behave.
+	ScenarioFile string
+}{
+	Env:          "mock.env",
+	ScenarioFile: "mock.scenarioFile",
+}
+
+// OCM provider config keys.
+var OCM = struct {
+	// Token is used to authenticate with OCM.
+	Token string
+
+	// Env is the OpenShift Dedicated environment used to provision clusters.
+	Env string
+
+	// Debug shows debug level messages when enabled.
+	Debug string
+
+	// NumRetries is the number of times to retry each OCM call.
+	NumRetries string
+
+	// ComputeMachineType is the specific cloud machine type to use for compute nodes.
+	ComputeMachineType string
+
+	// UserOverride will hard set the user assigned to the "owner" tag by the OCM provider.
+	UserOverride string
+}{
+	Token:              "ocm.token",
+	Env:                "ocm.env",
+	Debug:              "ocm.debug",
+	NumRetries:         "ocm.numRetries",
+	ComputeMachineType: "ocm.computeMachineType",
+	UserOverride:       "ocm.userOverride",
+}
+
+// MOA provider config keys.
+var MOA = struct {
+	// Env is the OpenShift Dedicated environment used to provision clusters.
+	Env string
+
+	// AWSAccessKeyID for provisioning clusters.
+	AWSAccessKeyID string
+
+	// AWSSecretAccessKey for provisioning clusters.
+	AWSSecretAccessKey string
+
+	// AWSRegion for provisioning clusters.
+	AWSRegion string
+
+	// MachineCIDR is the CIDR to use for machines.
+	MachineCIDR string
+
+	// ServiceCIDR is the CIDR to use for services.
+	ServiceCIDR string
+
+	// PodCIDR is the CIDR to use for pods.
+	PodCIDR string
+
+	// ComputeMachineType is instance size of the compute nodes in a cluster.
+	ComputeMachineType string
+
+	// ComputeNodes is number of compute nodes in a cluster.
+	ComputeNodes string
+
+	// HostPrefix is the prefix for the hosts produced by MOA.
+	HostPrefix string
+}{
+	Env:                "moa.env",
+	AWSAccessKeyID:     "moa.awsAccessKey",
+	AWSSecretAccessKey: "moa.awsSecretAccessKey",
+	AWSRegion:          "moa.awsRegion",
+	MachineCIDR:        "moa.machineCIDR",
+	ServiceCIDR:        "moa.serviceCIDR",
+	PodCIDR:            "moa.podCIDR",
+	ComputeMachineType: "moa.computeMachineType",
+	ComputeNodes:       "moa.computeNodes",
+	HostPrefix:         "moa.hostPrefix",
+}
+
+// CRC provider config keys.
+var CRC = struct {
+	// PullSecretFile is a file containing your pull secret
+	PullSecretFile string
+
+	// PullSecret is a string containing your pull secret
+	PullSecret string
+}{
+	PullSecretFile: "crc.pull_secret_file",
+	PullSecret:     "crc.pull_secret",
+}
+
+// Local provider config keys.
+var Local = struct {
+	// Mode is how the local provider gets its cluster: "kind" to create kind clusters, "envtest" to start a
+	// kube-apiserver and etcd using envtest, or "kubeconfig" to adopt the cluster an existing kubeconfig points to.
+	Mode string
+
+	// Kubeconfig is the kubeconfig adopted in kubeconfig mode. The default kubeconfig is used if this is empty.
+	Kubeconfig string
+
+	// KindBinary is the kind executable used in kind mode.
+	KindBinary string
+
+	// KindNodeImage is the node image kind clusters are created with. kind's default is used if this is empty.
+	KindNodeImage string
+
+	// Versions is a comma separated list of the versions reported by the local provider. The last is the default.
+	Versions string
+}{
+	Mode:          "local.mode",
+	Kubeconfig:    "local.kubeconfig",
+	KindBinary:    "local.kindBinary",
+	KindNodeImage: "local.kindNodeImage",
+	Versions:      "local.versions",
+}
+
+// Metrics config keys.
+var Metrics = struct {
+	// AWSAccessKeyID is the AWS access key used to read and write metrics in S3.
+	AWSAccessKeyID string
+
+	// AWSSecretAccessKey is the AWS secret access key used to read and write metrics in S3.
+	AWSSecretAccessKey string
+
+	// AWSRegion is the AWS region of the metrics bucket.
+	AWSRegion string
+
+	// MaxQueryTimeoutInSeconds is how long a metrics query can take.
+	MaxQueryTimeoutInSeconds string
+
+	// StepDurationInHours is the step of metrics range queries.
+	StepDurationInHours string
+}{
+	AWSAccessKeyID:           "metrics.awsAccessKeyId",
+	AWSSecretAccessKey:       "metrics.awsSecretAccessKey",
+	AWSRegion:                "metrics.awsRegion",
+	MaxQueryTimeoutInSeconds: "osde2e.metricsLib.maxQueryTimeoutInSeconds",
+	StepDurationInHours:      "osde2e.metricsLib.stepDurationInHours",
+}
+
 func init() {
 	// Here's where we bind environment variables to config options and set defaults
 
@@ -363,16 +731,117 @@ func init() {
 
 	viper.BindEnv(Upgrade.Image, "UPGRADE_IMAGE")
 
-	viper.SetDefault(Upgrade.UpgradeVersionEqualToInstallVersion, false)
-
 	viper.BindEnv(Upgrade.MonitorRoutesDuringUpgrade, "UPGRADE_MONITOR_ROUTES")
 	viper.SetDefault(Upgrade.MonitorRoutesDuringUpgrade, true)
 
+	viper.BindEnv(Upgrade.Path, "UPGRADE_PATH")
+
+	viper.SetDefault(Upgrade.PathFromCincinnati, false)
+	viper.BindEnv(Upgrade.PathFromCincinnati, "UPGRADE_PATH_FROM_CINCINNATI")
+
+	viper.BindEnv(Upgrade.Mode, "UPGRADE_MODE")
+
+	viper.SetDefault(Upgrade.UnavailableImage, "quay.io/openshift-release-dev/ocp-release@sha256:0000000000000000000000000000000000000000000000000000000000000000")
+	viper.BindEnv(Upgrade.UnavailableImage, "UPGRADE_UNAVAILABLE_IMAGE")
+
+	viper.SetDefault(Upgrade.PausedMachineConfigPools, "worker")
+	viper.BindEnv(Upgrade.PausedMachineConfigPools, "UPGRADE_PAUSED_MACHINE_CONFIG_POOLS")
+
+	viper.SetDefault(Upgrade.Managed, false)
+	viper.BindEnv(Upgrade.Managed, "UPGRADE_MANAGED")
+
+	viper.SetDefault(Upgrade.ManagedDelayInMinutes, 10)
+	viper.BindEnv(Upgrade.ManagedDelayInMinutes, "UPGRADE_MANAGED_DELAY_IN_MINUTES")
+
+	viper.SetDefault(Upgrade.Preflight, true)
+	viper.BindEnv(Upgrade.Preflight, "UPGRADE_PREFLIGHT")
+
+	viper.SetDefault(Upgrade.PreflightRequireSignature, false)
+	viper.BindEnv(Upgrade.PreflightRequireSignature, "UPGRADE_PREFLIGHT_REQUIRE_SIGNATURE")
+
+	viper.SetDefault(Upgrade.PreflightRequireEdge, false)
+	viper.BindEnv(Upgrade.PreflightRequireEdge, "UPGRADE_PREFLIGHT_REQUIRE_EDGE")
+
+	viper.SetDefault(Upgrade.PreflightRequireImage, false)
+	viper.BindEnv(Upgrade.PreflightRequireImage, "UPGRADE_PREFLIGHT_REQUIRE_IMAGE")
+
+	viper.SetDefault(Upgrade.SignatureStoreURL, "https://mirror.openshift.com/pub/openshift-v4/signatures/openshift/release")
+	viper.BindEnv(Upgrade.SignatureStoreURL, "UPGRADE_SIGNATURE_STORE_URL")
+
+	viper.BindEnv(Upgrade.RegistryAuthFile, "UPGRADE_REGISTRY_AUTH_FILE")
+
+	// ----- Cincinnati -----
+	viper.SetDefault(Cincinnati.URL, "https://api.openshift.com/api/upgrades_info/v1/graph")
+	viper.BindEnv(Cincinnati.URL, "CINCINNATI_URL")
+
+	viper.SetDefault(Cincinnati.Arch, "amd64")
+	viper.BindEnv(Cincinnati.Arch, "CINCINNATI_ARCH")
+
+	viper.BindEnv(Cincinnati.CacheDir, "CINCINNATI_CACHE_DIR")
+
+	viper.SetDefault(Cincinnati.CacheTTLInMinutes, 60)
+	viper.BindEnv(Cincinnati.CacheTTLInMinutes, "CINCINNATI_CACHE_TTL_IN_MINUTES")
+
+	viper.BindEnv(Cincinnati.Fixtures, "CINCINNATI_FIXTURES")
+
+	// ----- Release Controller -----
+	viper.SetDefault(ReleaseController.URL, "https://openshift-release.svc.ci.openshift.org")
+	viper.BindEnv(ReleaseController.URL, "RELEASE_CONTROLLER_URL")
+
+	viper.BindEnv(ReleaseController.Fixtures, "RELEASE_CONTROLLER_FIXTURES")
+
+	viper.BindEnv(ReleaseController.RecordFixtures, "RELEASE_CONTROLLER_RECORD_FIXTURES")
+
+	viper.SetDefault(ReleaseController.Phase, "Accepted")
+	viper.BindEnv(ReleaseController.Phase, "RELEASE_CONTROLLER_PHASE")
+
+	viper.SetDefault(ReleaseController.MinAgeInHours, 0)
+	viper.BindEnv(ReleaseController.MinAgeInHours, "RELEASE_CONTROLLER_MIN_AGE_IN_HOURS")
+
+	viper.BindEnv(ReleaseController.InstallStream, "RELEASE_CONTROLLER_INSTALL_STREAM")
+
+	// ----- Route Monitors -----
+	viper.SetDefault(RouteMonitors.DuringInstall, false)
+	viper.BindEnv(RouteMonitors.DuringInstall, "ROUTE_MONITOR_DURING_INSTALL")
+
+	viper.BindEnv(RouteMonitors.Targets, "ROUTE_MONITOR_TARGETS")
+
+	viper.SetDefault(RouteMonitors.Rate, 3)
+	viper.BindEnv(RouteMonitors.Rate, "ROUTE_MONITOR_RATE")
+
+	viper.SetDefault(RouteMonitors.TimeoutInSeconds, 3)
+	viper.BindEnv(RouteMonitors.TimeoutInSeconds, "ROUTE_MONITOR_TIMEOUT_IN_SECONDS")
+
+	viper.SetDefault(RouteMonitors.AvailabilitySLO, 0)
+	viper.BindEnv(RouteMonitors.AvailabilitySLO, "ROUTE_MONITOR_AVAILABILITY_SLO")
+
+	viper.SetDefault(RouteMonitors.LatencySLOInMilliseconds, 0)
+	viper.BindEnv(RouteMonitors.LatencySLOInMilliseconds, "ROUTE_MONITOR_LATENCY_SLO_IN_MILLISECONDS")
+
+	// ----- Disruption -----
+	viper.SetDefault(Disruption.Enabled, false)
+	viper.BindEnv(Disruption.Enabled, "DISRUPTION_ENABLED")
+
+	viper.SetDefault(Disruption.Namespace, "osde2e-disruption")
+	viper.BindEnv(Disruption.Namespace, "DISRUPTION_NAMESPACE")
+
+	viper.SetDefault(Disruption.Image, "registry.access.redhat.com/ubi8/python-38")
+	viper.BindEnv(Disruption.Image, "DISRUPTION_IMAGE")
+
+	viper.SetDefault(Disruption.TCPBudgetInSeconds, 30)
+	viper.BindEnv(Disruption.TCPBudgetInSeconds, "DISRUPTION_TCP_BUDGET_IN_SECONDS")
+
+	viper.SetDefault(Disruption.HTTPBudgetInSeconds, 30)
+	viper.BindEnv(Disruption.HTTPBudgetInSeconds, "DISRUPTION_HTTP_BUDGET_IN_SECONDS")
+
+	viper.SetDefault(Disruption.StatefulBudgetInSeconds, 180)
+	viper.BindEnv(Disruption.StatefulBudgetInSeconds, "DISRUPTION_STATEFUL_BUDGET_IN_SECONDS")
+
 	// ----- Kubeconfig -----
 	viper.BindEnv(Kubeconfig.Path, "TEST_KUBECONFIG")
 
 	// ----- Tests -----
-	viper.SetDefault(Tests.PollingTimeout, 30)
+	viper.SetDefault(Tests.PollingTimeout, DefaultPollingTimeout)
 	viper.BindEnv(Tests.PollingTimeout, "POLLING_TIMEOUT")
 
 	viper.BindEnv(Tests.GinkgoSkip, "GINKGO_SKIP")
@@ -390,13 +859,40 @@ func init() {
 	viper.BindEnv(Tests.OperatorSkip, "OPERATOR_SKIP")
 
 	viper.SetDefault(Tests.SkipClusterHealthChecks, false)
-	viper.BindEnv(Tests.OperatorSkip, "SKIP_CLUSTER_HEALTH_CHECKS")
+	viper.BindEnv(Tests.SkipClusterHealthChecks, "SKIP_CLUSTER_HEALTH_CHECKS")
 
 	viper.SetDefault(Tests.MetricsBucket, "osde2e-metrics")
 	viper.BindEnv(Tests.MetricsBucket, "METRICS_BUCKET")
 
 	viper.BindEnv(Tests.ServiceAccount, "SERVICE_ACCOUNT")
 
+	viper.SetDefault(Tests.RunnerLogLines, 1000)
+	viper.BindEnv(Tests.RunnerLogLines, "RUNNER_LOG_LINES")
+
+	viper.SetDefault(Tests.OpenshiftTestShards, 1)
+	viper.BindEnv(Tests.OpenshiftTestShards, "OPENSHIFT_TEST_SHARDS")
+
+	// ----- Health Checks -----
+	viper.BindEnv(HealthChecks.Profile, "HEALTH_CHECKS_PROFILE")
+
+	viper.BindEnv(HealthChecks.Enable, "HEALTH_CHECKS_ENABLE")
+
+	viper.BindEnv(HealthChecks.Disable, "HEALTH_CHECKS_DISABLE")
+
+	viper.BindEnv(HealthChecks.Informational, "HEALTH_CHECKS_INFORMATIONAL")
+
+	// ----- Matrix -----
+	viper.BindEnv(Matrix.Versions, "MATRIX_VERSIONS")
+
+	viper.BindEnv(Matrix.CloudProviders, "MATRIX_CLOUD_PROVIDERS")
+
+	viper.BindEnv(Matrix.Regions, "MATRIX_REGIONS")
+
+	viper.SetDefault(Matrix.Parallelism, 2)
+	viper.BindEnv(Matrix.Parallelism, "MATRIX_PARALLELISM")
+
+	viper.BindEnv(Matrix.Entry, "MATRIX_ENTRY")
+
 	// ----- Cluster -----
 	viper.SetDefault(Cluster.MultiAZ, false)
 	viper.BindEnv(Cluster.MultiAZ, "MULTI_AZ")
@@ -428,6 +924,11 @@ func init() {
 	viper.SetDefault(Cluster.NextReleaseAfterProdDefault, -1)
 	viper.BindEnv(Cluster.NextReleaseAfterProdDefault, "NEXT_RELEASE_AFTER_PROD_DEFAULT")
 
+	viper.BindEnv(Cluster.InstallVersionConstraint, "INSTALL_VERSION_CONSTRAINT")
+
+	viper.SetDefault(Cluster.InstallVersionPick, "newest")
+	viper.BindEnv(Cluster.InstallVersionPick, "INSTALL_VERSION_PICK")
+
 	viper.SetDefault(Cluster.CleanCheckRuns, 20)
 	viper.BindEnv(Cluster.CleanCheckRuns, "CLEAN_CHECK_RUNS")
 
@@ -437,10 +938,6 @@ func init() {
 
 	viper.BindEnv(Cluster.Version, "CLUSTER_VERSION")
 
-	viper.SetDefault(Cluster.EnoughVersionsForOldestOrMiddleTest, true)
-
-	viper.SetDefault(Cluster.PreviousVersionFromDefaultFound, true)
-
 	// ----- Cloud Provider -----
 	viper.SetDefault(CloudProvider.CloudProviderID, "aws")
 	viper.BindEnv(CloudProvider.CloudProviderID, "CLOUD_PROVIDER_ID")
@@ -490,6 +987,90 @@ func init() {
 
 	// ----- Alert ----
 	viper.BindEnv(Alert.SlackAPIToken, "SLACK_API_TOKEN")
+
+	// ----- Mock -----
+	viper.BindEnv(Mock.ScenarioFile, "MOCK_SCENARIO_FILE")
+
+	// ----- OCM -----
+	viper.BindEnv(OCM.Token, "OCM_TOKEN")
+	RegisterSecret(OCM.Token, "ocm-refresh-token")
+
+	viper.SetDefault(OCM.Env, "prod")
+	viper.BindEnv(OCM.Env, "OSD_ENV")
+
+	viper.SetDefault(OCM.Debug, false)
+	viper.BindEnv(OCM.Debug, "DEBUG_OSD")
+
+	viper.SetDefault(OCM.NumRetries, 3)
+	viper.BindEnv(OCM.NumRetries, "NUM_RETRIES")
+
+	viper.SetDefault(OCM.ComputeMachineType, "")
+	viper.BindEnv(OCM.ComputeMachineType, "OCM_COMPUTE_MACHINE_TYPE")
+
+	viper.BindEnv(OCM.UserOverride, "OCM_USER_OVERRIDE")
+
+	// ----- MOA -----
+	viper.SetDefault(MOA.Env, "prod")
+	viper.BindEnv(MOA.Env, "MOA_ENV")
+
+	viper.BindEnv(MOA.AWSAccessKeyID, "MOA_AWS_ACCESS_KEY_ID")
+	RegisterSecret(MOA.AWSAccessKeyID, "moa-aws-access-key")
+
+	viper.BindEnv(MOA.AWSSecretAccessKey, "MOA_AWS_SECRET_ACCESS_KEY")
+	RegisterSecret(MOA.AWSSecretAccessKey, "moa-aws-secret-access-key")
+
+	viper.BindEnv(MOA.AWSRegion, "MOA_AWS_REGION")
+	RegisterSecret(MOA.AWSRegion, "moa-aws-region")
+
+	viper.BindEnv(MOA.MachineCIDR, "MOA_MACHINE_CIDR")
+
+	viper.BindEnv(MOA.ServiceCIDR, "MOA_SERVICE_CIDR")
+
+	viper.BindEnv(MOA.PodCIDR, "MOA_POD_CIDR")
+
+	viper.BindEnv(MOA.ComputeMachineType, "MOA_COMPUTE_MACHINE_TYPE")
+
+	viper.BindEnv(MOA.ComputeNodes, "MOA_COMPUTE_NODES")
+
+	viper.BindEnv(MOA.HostPrefix, "MOA_HOST_PREFIX")
+
+	// ----- CRC -----
+	viper.BindEnv(CRC.PullSecretFile, "CRC_PULL_SECRET_FILE")
+
+	viper.BindEnv(CRC.PullSecret, "CRC_PULL_SECRET")
+
+	// ----- Local -----
+	viper.SetDefault(Local.Mode, "kind")
+	viper.BindEnv(Local.Mode, "LOCAL_MODE")
+
+	viper.BindEnv(Local.Kubeconfig, "LOCAL_KUBECONFIG")
+
+	viper.SetDefault(Local.KindBinary, "kind")
+	viper.BindEnv(Local.KindBinary, "KIND_BINARY")
+
+	viper.BindEnv(Local.KindNodeImage, "KIND_NODE_IMAGE")
+
+	viper.SetDefault(Local.Versions, "4.5.0")
+	viper.BindEnv(Local.Versions, "LOCAL_VERSIONS")
+
+	// ----- Metrics -----
+	viper.BindEnv(Metrics.AWSAccessKeyID, "METRICS_AWS_ACCESS_KEY_ID")
+	RegisterSecret(Metrics.AWSAccessKeyID, "metrics-aws-access-key")
+
+	viper.BindEnv(Metrics.AWSSecretAccessKey, "METRICS_AWS_SECRET_ACCESS_KEY")
+	RegisterSecret(Metrics.AWSSecretAccessKey, "metrics-aws-secret-access-key")
+
+	viper.BindEnv(Metrics.AWSRegion, "METRICS_AWS_REGION")
+	RegisterSecret(Metrics.AWSRegion, "metrics-aws-region")
+
+	// Set our max query timeout to 2 minutes for now.
+	viper.SetDefault(Metrics.MaxQueryTimeoutInSeconds, 120)
+	viper.BindEnv(Metrics.MaxQueryTimeoutInSeconds, "OSDE2E_METRICSLIB_MAX_QUERY_TIMEOUT_IN_SECONDS")
+
+	// Hard code our step duration to 4 for now. Our jobs are pretty coarse -- running every 4+ hours.
+	// We'll bake this into our client to prevent our users from getting oversampled data.
+	viper.SetDefault(Metrics.StepDurationInHours, 4)
+	viper.BindEnv(Metrics.StepDurationInHours, "OSDE2E_METRICSLIB_STEP_DURATION_IN_HOURS")
 }
 
 // PostProcess is a variety of post-processing commands that is intended to be run after a config is loaded.
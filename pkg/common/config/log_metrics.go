@@ -11,6 +11,9 @@ import (
 	"github.com/spf13/viper"
 )
 
+// logMetricsKey is the config key containing the list of log metrics. See LogMetric.
+const logMetricsKey = "logMetrics"
+
 // LogMetrics is an array of LogMetric types with an easier lookup method
 type LogMetrics []LogMetric
 
@@ -21,7 +24,7 @@ var logMetrics = LogMetrics{}
 // GetLogMetrics will return the log metrics.
 func GetLogMetrics() LogMetrics {
 	once.Do(func() {
-		viper.UnmarshalKey("logMetrics", &logMetrics)
+		viper.UnmarshalKey(logMetricsKey, &logMetrics)
 	})
 	return logMetrics
 }
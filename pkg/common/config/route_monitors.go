@@ -0,0 +1,57 @@
+package config
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/spf13/viper"
+)
+
+// RouteMonitorTarget is a URL or route to monitor. Fields that aren't set use the defaults of the routeMonitors keys.
+type RouteMonitorTarget struct {
+	// Name identifies the target in reports. It defaults to the target's host.
+	Name string `json:"name" yaml:"name"`
+
+	// URL is the URL to send requests to.
+	URL string `json:"url" yaml:"url"`
+
+	// Route is a route to send requests to over HTTPS, as <namespace>/<name>. It is used if URL isn't set.
+	Route string `json:"route" yaml:"route"`
+
+	// Path is appended to the route's host.
+	Path string `json:"path" yaml:"path"`
+
+	// Rate is the number of requests per second sent to the target.
+	Rate int `json:"rate" yaml:"rate"`
+
+	// TimeoutInSeconds is how long a request can take.
+	TimeoutInSeconds int `json:"timeoutInSeconds" yaml:"timeoutInSeconds"`
+
+	// ExpectedStatus is the status code of a successful request. Any 2xx or 3xx status is successful if unset.
+	ExpectedStatus int `json:"expectedStatus" yaml:"expectedStatus"`
+
+	// AvailabilitySLO is the ratio of requests that must succeed.
+	AvailabilitySLO float64 `json:"availabilitySLO" yaml:"availabilitySLO"`
+
+	// LatencySLOInMilliseconds is the 99th percentile latency the target must stay under.
+	LatencySLOInMilliseconds float64 `json:"latencySLOInMilliseconds" yaml:"latencySLOInMilliseconds"`
+}
+
+// routeMonitorTargets returns the configured route monitor targets. They're a list in YAML configs, or a JSON
+// list in the environment.
+func routeMonitorTargets() []RouteMonitorTarget {
+	var targets []RouteMonitorTarget
+	if raw, ok := viper.Get(RouteMonitors.Targets).(string); ok {
+		if raw != "" {
+			if err := json.Unmarshal([]byte(raw), &targets); err != nil {
+				log.Printf("Unable to parse route monitor targets: %v", err)
+			}
+		}
+		return targets
+	}
+
+	if err := viper.UnmarshalKey(RouteMonitors.Targets, &targets); err != nil {
+		log.Printf("Unable to parse route monitor targets: %v", err)
+	}
+	return targets
+}
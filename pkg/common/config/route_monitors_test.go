@@ -0,0 +1,40 @@
+package config
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestRouteMonitorTargets(t *testing.T) {
+	os.Setenv("ROUTE_MONITOR_TARGETS", `[{"name": "app", "route": "my-app/frontend", "path": "/health", "expectedStatus": 204}]`)
+	defer os.Unsetenv("ROUTE_MONITOR_TARGETS")
+
+	targets := routeMonitorTargets()
+	if len(targets) != 1 || targets[0].Route != "my-app/frontend" || targets[0].Path != "/health" || targets[0].ExpectedStatus != 204 {
+		t.Errorf("unexpected targets from the environment: %+v", targets)
+	}
+
+	os.Unsetenv("ROUTE_MONITOR_TARGETS")
+	viper.SetConfigType("yaml")
+	err := viper.MergeConfig(strings.NewReader(`
+routeMonitors:
+  targets:
+  - url: https://example.com
+    rate: 10
+    availabilitySLO: 0.99
+    latencySLOInMilliseconds: 250
+`))
+	if err != nil {
+		t.Fatalf("error merging config: %v", err)
+	}
+	defer viper.Set(RouteMonitors.Targets, nil)
+
+	targets = routeMonitorTargets()
+	if len(targets) != 1 || targets[0].URL != "https://example.com" || targets[0].Rate != 10 ||
+		targets[0].AvailabilitySLO != 0.99 || targets[0].LatencySLOInMilliseconds != 250 {
+		t.Errorf("unexpected targets from YAML: %+v", targets)
+	}
+}
@@ -0,0 +1,779 @@
+package config
+
+import (
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
+// RunConfig is the configuration of a single osde2e run. It is built once from the loaded config and passed
+// to everything that needs it, so that a run doesn't depend on global state.
+type RunConfig struct {
+	// Provider is what provider to use to create/delete clusters.
+	Provider string
+
+	// JobName lets you name the current e2e job run.
+	JobName string
+
+	// JobID is the ID designated by prow for this run.
+	JobID int
+
+	// BaseJobURL is the root location for all job artifacts.
+	BaseJobURL string
+
+	// BaseProwURL is the root location of Prow.
+	BaseProwURL string
+
+	// DryRun lets you run osde2e all the way up to the e2e tests then skips them.
+	DryRun bool
+
+	// MustGather will run a Must-Gather process upon completion of the tests.
+	MustGather bool
+
+	// Resume is the report directory of an earlier run to resume from its last checkpoint.
+	Resume string
+
+	Upgrade           UpgradeConfig
+	Cincinnati        CincinnatiConfig
+	ReleaseController ReleaseControllerConfig
+	RouteMonitors     RouteMonitorsConfig
+	Disruption        DisruptionConfig
+	Kubeconfig        KubeconfigConfig
+	Tests             TestsConfig
+	HealthChecks      HealthChecksConfig
+	Matrix            MatrixConfig
+	Cluster           ClusterConfig
+	CloudProvider     CloudProviderConfig
+	Addons            AddonsConfig
+	Scale             ScaleConfig
+	Mock              MockConfig
+	OCM               OCMConfig
+	MOA               MOAConfig
+	CRC               CRCConfig
+	Local             LocalConfig
+	Metrics           MetricsConfig
+	Prometheus        PrometheusConfig
+	Weather           WeatherConfig
+	Alert             AlertConfig
+
+	// State is the state of the run, which changes as the run progresses.
+	State *RunState
+}
+
+// UpgradeConfig is the upgrade configuration of a run.
+type UpgradeConfig struct {
+	// UpgradeToCISIfPossible will upgrade to the most recent cluster image set if it's newer than the install version.
+	UpgradeToCISIfPossible bool
+
+	// OnlyUpgradeToZReleases will restrict upgrades to selecting Z releases on stage/prod.
+	OnlyUpgradeToZReleases bool
+
+	// NextReleaseAfterProdDefaultForUpgrade will select the cluster image set that the given number of releases away from the the production default.
+	NextReleaseAfterProdDefaultForUpgrade int
+
+	// ReleaseStream used to retrieve latest release images. If set, it will be used to perform an upgrade.
+	ReleaseStream string
+
+	// MonitorRoutesDuringUpgrade will monitor the availability of routes whilst an upgrade takes place.
+	MonitorRoutesDuringUpgrade bool
+
+	// Path is a comma separated list of releases to upgrade through in order.
+	Path string
+
+	// PathFromCincinnati will upgrade to the selected release along the shortest path through the Cincinnati graph.
+	PathFromCincinnati bool
+
+	// Mode is how the cluster is upgraded: "rollback", "unavailable-image" or "pause-machine-config-pools".
+	Mode string
+
+	// UnavailableImage is the image requested by the unavailable-image upgrade mode.
+	UnavailableImage string
+
+	// PausedMachineConfigPools is a comma separated list of pools paused by the pause-machine-config-pools upgrade mode.
+	PausedMachineConfigPools string
+
+	// Managed will upgrade the cluster by creating an upgrade policy with the provider instead of editing the ClusterVersion.
+	Managed bool
+
+	// ManagedDelayInMinutes is how far in the future a managed upgrade is scheduled.
+	ManagedDelayInMinutes int
+
+	// Preflight will check release images are valid before upgrading to them.
+	Preflight bool
+
+	// PreflightRequireSignature will fail the pre-flight checks of a release image that isn't signed.
+	PreflightRequireSignature bool
+
+	// PreflightRequireEdge will fail the pre-flight checks of a release image that can't be upgraded to from the installed version.
+	PreflightRequireEdge bool
+
+	// PreflightRequireImage will fail the pre-flight checks of a release image that can't be read from its registry.
+	PreflightRequireImage bool
+
+	// SignatureStoreURL is the store release image signatures are looked up in.
+	SignatureStoreURL string
+
+	// RegistryAuthFile is a Docker config.json or pull secret used to read release images.
+	RegistryAuthFile string
+}
+
+// UpgradeTarget is a release a cluster is upgraded to.
+type UpgradeTarget struct {
+	// ReleaseName is the name of the release.
+	ReleaseName string
+
+	// Image is the release image. If blank, OpenShift will use Cincinnati to find it.
+	Image string
+}
+
+// CincinnatiConfig is the Cincinnati configuration of a run.
+type CincinnatiConfig struct {
+	// URL is the Cincinnati graph endpoint.
+	URL string
+
+	// Arch is the architecture of the graphs fetched from Cincinnati.
+	Arch string
+
+	// CacheDir is a directory graphs are cached in between runs.
+	CacheDir string
+
+	// CacheTTLInMinutes is how long a graph cached in memory or on disk is used before being fetched again.
+	CacheTTLInMinutes int
+
+	// Fixtures is a directory of graphs named <channel>.json, which are used instead of fetching graphs.
+	Fixtures string
+}
+
+// ReleaseControllerConfig is the release controller configuration of a run.
+type ReleaseControllerConfig struct {
+	// URL is the base URL of the release controller.
+	URL string
+
+	// Fixtures is a directory of recorded release controller responses, which are used instead of fetching them.
+	Fixtures string
+
+	// RecordFixtures is a directory responses from the release controller are recorded in.
+	RecordFixtures string
+
+	// Phase is the phase of the nightlies selected from the release controller.
+	Phase string
+
+	// MinAgeInHours is how old a nightly must be to be selected from the release controller.
+	MinAgeInHours int
+
+	// InstallStream is a release stream to select the nightly to install from.
+	InstallStream string
+}
+
+// RouteMonitorsConfig is the route monitor configuration of a run.
+type RouteMonitorsConfig struct {
+	// DuringInstall will monitor the availability of routes whilst the install phase tests run.
+	DuringInstall bool
+
+	// Targets are targets to monitor in addition to the console, oauth, API servers and workload routes.
+	Targets []RouteMonitorTarget
+
+	// Rate is the number of requests per second sent to a target that doesn't set its own.
+	Rate int
+
+	// TimeoutInSeconds is how long a request to a target that doesn't set its own timeout can take.
+	TimeoutInSeconds int
+
+	// AvailabilitySLO is the ratio of requests that must succeed for a target that doesn't set its own SLO.
+	AvailabilitySLO float64
+
+	// LatencySLOInMilliseconds is the 99th percentile latency a target that doesn't set its own SLO must stay under.
+	LatencySLOInMilliseconds float64
+}
+
+// DisruptionConfig is the workload disruption configuration of a run.
+type DisruptionConfig struct {
+	// Enabled deploys probe workloads before upgrading and measures how long they are disrupted by each upgrade.
+	Enabled bool
+
+	// Namespace is the namespace the probe workloads are deployed in.
+	Namespace string
+
+	// Image is the image the probe workloads run. It must provide python3.
+	Image string
+
+	// TCPBudgetInSeconds is how long the TCP workload can be disrupted by an upgrade before the upgrade phase fails.
+	TCPBudgetInSeconds int
+
+	// HTTPBudgetInSeconds is how long the HTTP workload can be disrupted by an upgrade before the upgrade phase fails.
+	HTTPBudgetInSeconds int
+
+	// StatefulBudgetInSeconds is how long the PVC-backed workload can be disrupted by an upgrade before the upgrade phase fails.
+	StatefulBudgetInSeconds int
+}
+
+// KubeconfigConfig is the kubeconfig configuration of a run.
+type KubeconfigConfig struct {
+	// Path is the filepath of an existing Kubeconfig.
+	Path string
+}
+
+// TestsConfig is the test configuration of a run.
+type TestsConfig struct {
+	// PollingTimeout is how long (in minutes) to wait for an object to be created before failing the test.
+	PollingTimeout float64
+
+	// GinkgoSkip is a regex passed to Ginkgo that skips any test suites matching the regex.
+	GinkgoSkip string
+
+	// GinkgoFocus is a regex passed to Ginkgo that focus on any test suites matching the regex.
+	GinkgoFocus string
+
+	// TestsToRun is a list of files which should be executed as part of a test suite.
+	TestsToRun []string
+
+	// SuppressSkipNotifications suppresses the notifications of skipped tests.
+	SuppressSkipNotifications bool
+
+	// OperatorSkip is a comma-delimited list of operator names to ignore health checks from.
+	OperatorSkip string
+
+	// SkipClusterHealthChecks skips the cluster health checks.
+	SkipClusterHealthChecks bool
+
+	// MetricsBucket is the bucket that metrics data will be uploaded to.
+	MetricsBucket string
+
+	// ServiceAccount defines what user the tests should run as.
+	ServiceAccount string
+
+	// RunnerLogLines is how many lines of each runner container's log are echoed while it runs. -1 echoes all of them.
+	RunnerLogLines int
+
+	// OpenshiftTestShards is how many runner Pods the openshift-tests conformance suites are split across.
+	OpenshiftTestShards int
+}
+
+// HealthChecksConfig is the health check configuration of a run.
+type HealthChecksConfig struct {
+	// Profile is the set of health checks to run. Defaults to the profile for the provider in use.
+	Profile string
+
+	// Enable is a comma separated list of health checks to run in addition to those in the profile.
+	Enable string
+
+	// Disable is a comma separated list of health checks from the profile not to run.
+	Disable string
+
+	// Informational is a comma separated list of health checks whose failures are reported but don't stop a cluster from being ready.
+	Informational string
+}
+
+// MatrixConfig is the matrix configuration of a run.
+type MatrixConfig struct {
+	// Versions is a comma separated list of cluster versions to run the suite against.
+	Versions string
+
+	// CloudProviders is a comma separated list of cloud providers to run the suite against.
+	CloudProviders string
+
+	// Regions is a comma separated list of regions to run the suite against.
+	Regions string
+
+	// Parallelism is the number of matrix entries run at once.
+	Parallelism int
+
+	// Entry is the name of the matrix entry being run.
+	Entry string
+}
+
+// ClusterConfig is the cluster configuration of a run.
+type ClusterConfig struct {
+	// MultiAZ deploys a cluster across multiple availability zones.
+	MultiAZ bool
+
+	// DestroyAfterTest set to true if you want to the cluster to be explicitly deleted after the test.
+	DestroyAfterTest bool
+
+	// ExpiryInMinutes is how long before a cluster expires and is deleted by OSD.
+	ExpiryInMinutes int
+
+	// AfterTestWait is how long to keep a cluster around after tests have run.
+	AfterTestWait int
+
+	// InstallTimeout is how long (in minutes) to wait before failing a cluster launch.
+	InstallTimeout int
+
+	// UseLatestVersionForInstall will select the latest cluster image set available for a fresh install.
+	UseLatestVersionForInstall bool
+
+	// UseMiddleClusterImageSetForInstall will select the cluster image set that is in the middle of the list of ordered cluster versions known to OCM.
+	UseMiddleClusterImageSetForInstall bool
+
+	// UseOldestClusterImageSetForInstall will select the cluster image set that is in the end of the list of ordered cluster versions known to OCM.
+	UseOldestClusterImageSetForInstall bool
+
+	// DeltaReleaseFromDefault will select the cluster image set that is the given number of releases from the current default in either direction.
+	DeltaReleaseFromDefault int
+
+	// NextReleaseAfterProdDefault will select the cluster image set that the given number of releases away from the the production default.
+	NextReleaseAfterProdDefault int
+
+	// InstallVersionConstraint will select a version matching a constraint expression, such as ">=4.6 <4.8, !prerelease".
+	InstallVersionConstraint string
+
+	// InstallVersionPick is how a version is picked from those matching InstallVersionConstraint: newest, oldest,
+	// random or default-relative:N.
+	InstallVersionPick string
+
+	// CleanCheckRuns is the number of health checks that must pass in a row before a cluster is deemed healthy.
+	CleanCheckRuns int
+}
+
+// CloudProviderConfig is the cloud provider configuration of a run.
+type CloudProviderConfig struct {
+	// CloudProviderID is the cloud provider ID to use to provision the cluster.
+	CloudProviderID string
+
+	// Region is the cloud provider region to use to provision the cluster.
+	Region string
+}
+
+// AddonsConfig is the addon configuration of a run.
+type AddonsConfig struct {
+	// IDsAtCreation is a comma separated list of IDs to create at cluster creation time.
+	IDsAtCreation string
+
+	// IDs is a comma separated list of IDs to install after a cluster is created.
+	IDs string
+
+	// TestHarnesses is a comma separated list of container images that will test the addon.
+	TestHarnesses string
+
+	// TestUser is the OpenShift user that the tests will run as.
+	TestUser string
+
+	// RunCleanup is whether the test harnesses should have a separate cleanup phase.
+	RunCleanup bool
+}
+
+// ScaleConfig is the scale test configuration of a run.
+type ScaleConfig struct {
+	// WorkloadsRepository is the git repository where the openshift-scale workloads are located.
+	WorkloadsRepository string
+
+	// WorkloadsRepositoryBranch is the branch of the git repository to use.
+	WorkloadsRepositoryBranch string
+}
+
+// MockConfig is the mock provider configuration of a run.
+type MockConfig struct {
+	// Env is the mock environment.
+	Env string
+
+	// ScenarioFile is a YAML file describing how the mock provider should behave.
+	ScenarioFile string
+}
+
+// OCMConfig is the OCM provider configuration of a run.
+type OCMConfig struct {
+	// Token is used to authenticate with OCM.
+	Token string
+
+	// Env is the OpenShift Dedicated environment used to provision clusters.
+	Env string
+
+	// Debug shows debug level messages when enabled.
+	Debug bool
+
+	// NumRetries is the number of times to retry each OCM call.
+	NumRetries int
+
+	// ComputeMachineType is the specific cloud machine type to use for compute nodes.
+	ComputeMachineType string
+
+	// UserOverride will hard set the user assigned to the "owner" tag by the OCM provider.
+	UserOverride string
+}
+
+// MOAConfig is the MOA provider configuration of a run.
+type MOAConfig struct {
+	// Env is the OpenShift Dedicated environment used to provision clusters.
+	Env string
+
+	// AWSAccessKeyID for provisioning clusters.
+	AWSAccessKeyID string
+
+	// AWSSecretAccessKey for provisioning clusters.
+	AWSSecretAccessKey string
+
+	// AWSRegion for provisioning clusters.
+	AWSRegion string
+
+	// MachineCIDR is the CIDR to use for machines.
+	MachineCIDR string
+
+	// ServiceCIDR is the CIDR to use for services.
+	ServiceCIDR string
+
+	// PodCIDR is the CIDR to use for pods.
+	PodCIDR string
+
+	// ComputeMachineType is instance size of the compute nodes in a cluster.
+	ComputeMachineType string
+
+	// ComputeNodes is number of compute nodes in a cluster.
+	ComputeNodes int
+
+	// HostPrefix is the prefix for the hosts produced by MOA.
+	HostPrefix int
+}
+
+// CRCConfig is the CRC provider configuration of a run.
+type CRCConfig struct {
+	// PullSecretFile is a file containing your pull secret
+	PullSecretFile string
+
+	// PullSecret is a string containing your pull secret
+	PullSecret string
+}
+
+// LocalConfig is the local provider configuration of a run.
+type LocalConfig struct {
+	// Mode is how the local provider gets its cluster: "kind", "envtest" or "kubeconfig".
+	Mode string
+
+	// Kubeconfig is the kubeconfig adopted in kubeconfig mode. The default kubeconfig is used if this is empty.
+	Kubeconfig string
+
+	// KindBinary is the kind executable used in kind mode.
+	KindBinary string
+
+	// KindNodeImage is the node image kind clusters are created with. kind's default is used if this is empty.
+	KindNodeImage string
+
+	// Versions is a comma separated list of the versions reported by the local provider. The last is the default.
+	Versions string
+}
+
+// MetricsConfig is the configuration of the metrics a run reads and writes.
+type MetricsConfig struct {
+	// AWSAccessKeyID is the AWS access key used to read and write metrics in S3.
+	AWSAccessKeyID string
+
+	// AWSSecretAccessKey is the AWS secret access key used to read and write metrics in S3.
+	AWSSecretAccessKey string
+
+	// AWSRegion is the AWS region of the metrics bucket.
+	AWSRegion string
+
+	// MaxQueryTimeoutInSeconds is how long a metrics query can take.
+	MaxQueryTimeoutInSeconds int
+
+	// StepDurationInHours is the step of metrics range queries.
+	StepDurationInHours int
+}
+
+// PrometheusConfig is the configuration of the Prometheus instance metrics are queried from.
+type PrometheusConfig struct {
+	// Address is the address of the Prometheus instance to connect to.
+	Address string
+
+	// BearerToken is the token needed for communicating with Prometheus.
+	BearerToken string
+}
+
+// WeatherConfig is the weather report configuration.
+type WeatherConfig struct {
+	// StartOfTimeWindowInHours is how many hours to look back through results.
+	StartOfTimeWindowInHours int
+
+	// NumberOfSamplesNecessary is how many samples are necessary for generating a report.
+	NumberOfSamplesNecessary int
+
+	// SlackWebhook is the webhook to use to post the weather report to slack.
+	SlackWebhook string
+
+	// JobAllowlist is a list of job regexes to consider in the weather report.
+	JobAllowlist string
+
+	// Provider is the provider tag to attach to an SD weather report.
+	Provider string
+}
+
+// AlertConfig is the alerting configuration.
+type AlertConfig struct {
+	// SlackAPIToken is a bot slack token
+	SlackAPIToken string
+}
+
+// RunState is the state of a run that changes as it progresses, such as the cluster being tested and the
+// current phase. Values that are known at the start of a run, like a provided cluster ID, are loaded from the
+// config.
+type RunState struct {
+	// ClusterID identifies the cluster being tested.
+	ClusterID string
+
+	// ClusterName is the name of the cluster being tested.
+	ClusterName string
+
+	// ClusterVersion is the version of the cluster being tested.
+	ClusterVersion string
+
+	// CloudProviderID is the cloud provider the cluster is running on.
+	CloudProviderID string
+
+	// Region is the cloud provider region the cluster is running in.
+	Region string
+
+	// Kubeconfig is the contents of the kubeconfig used to access the cluster.
+	Kubeconfig string
+
+	// UpgradeReleaseName is the name of the release the cluster is upgraded to.
+	UpgradeReleaseName string
+
+	// UpgradeImage is the release image the cluster is upgraded to.
+	UpgradeImage string
+
+	// UpgradePath is every release the cluster is upgraded through in order, the last being the selected upgrade.
+	// While upgrading, UpgradeReleaseName and UpgradeImage are the release of the current hop. It is empty if the
+	// cluster is upgraded straight to UpgradeReleaseName.
+	UpgradePath []UpgradeTarget
+
+	// UpgradeVersionEqualToInstallVersion is true if the install version and upgrade versions are the same.
+	UpgradeVersionEqualToInstallVersion bool
+
+	// EnoughVersionsForOldestOrMiddleTest is true if there were enough versions for an older/middle test.
+	EnoughVersionsForOldestOrMiddleTest bool
+
+	// PreviousVersionFromDefaultFound is true if a previous version from default was found.
+	PreviousVersionFromDefaultFound bool
+
+	// ReportDir is the location results are written.
+	ReportDir string
+
+	// Suffix is used at the end of test names to identify them.
+	Suffix string
+
+	// Phase is the current set of tests being run (install, upgrade).
+	Phase string
+
+	// Project is both the project and SA automatically created to house all objects created during the run.
+	Project string
+
+	mutex              sync.Mutex
+	installedWorkloads map[string]string
+}
+
+// NewRunConfig builds a RunConfig from the loaded config.
+func NewRunConfig() *RunConfig {
+	return &RunConfig{
+		Provider:    viper.GetString(Provider),
+		JobName:     viper.GetString(JobName),
+		JobID:       viper.GetInt(JobID),
+		BaseJobURL:  viper.GetString(BaseJobURL),
+		BaseProwURL: viper.GetString(BaseProwURL),
+		DryRun:      viper.GetBool(DryRun),
+		MustGather:  viper.GetBool(MustGather),
+		Resume:      viper.GetString(Resume),
+		Upgrade: UpgradeConfig{
+			UpgradeToCISIfPossible:                viper.GetBool(Upgrade.UpgradeToCISIfPossible),
+			OnlyUpgradeToZReleases:                viper.GetBool(Upgrade.OnlyUpgradeToZReleases),
+			NextReleaseAfterProdDefaultForUpgrade: viper.GetInt(Upgrade.NextReleaseAfterProdDefaultForUpgrade),
+			ReleaseStream:                         viper.GetString(Upgrade.ReleaseStream),
+			MonitorRoutesDuringUpgrade:            viper.GetBool(Upgrade.MonitorRoutesDuringUpgrade),
+			Path:                                  viper.GetString(Upgrade.Path),
+			PathFromCincinnati:                    viper.GetBool(Upgrade.PathFromCincinnati),
+			Mode:                                  viper.GetString(Upgrade.Mode),
+			UnavailableImage:                      viper.GetString(Upgrade.UnavailableImage),
+			PausedMachineConfigPools:              viper.GetString(Upgrade.PausedMachineConfigPools),
+			Managed:                               viper.GetBool(Upgrade.Managed),
+			ManagedDelayInMinutes:                 viper.GetInt(Upgrade.ManagedDelayInMinutes),
+			Preflight:                             viper.GetBool(Upgrade.Preflight),
+			PreflightRequireSignature:             viper.GetBool(Upgrade.PreflightRequireSignature),
+			PreflightRequireEdge:                  viper.GetBool(Upgrade.PreflightRequireEdge),
+			PreflightRequireImage:                 viper.GetBool(Upgrade.PreflightRequireImage),
+			SignatureStoreURL:                     viper.GetString(Upgrade.SignatureStoreURL),
+			RegistryAuthFile:                      viper.GetString(Upgrade.RegistryAuthFile),
+		},
+		Cincinnati: CincinnatiConfig{
+			URL:               viper.GetString(Cincinnati.URL),
+			Arch:              viper.GetString(Cincinnati.Arch),
+			CacheDir:          viper.GetString(Cincinnati.CacheDir),
+			CacheTTLInMinutes: viper.GetInt(Cincinnati.CacheTTLInMinutes),
+			Fixtures:          viper.GetString(Cincinnati.Fixtures),
+		},
+		ReleaseController: ReleaseControllerConfig{
+			URL:            viper.GetString(ReleaseController.URL),
+			Fixtures:       viper.GetString(ReleaseController.Fixtures),
+			RecordFixtures: viper.GetString(ReleaseController.RecordFixtures),
+			Phase:          viper.GetString(ReleaseController.Phase),
+			MinAgeInHours:  viper.GetInt(ReleaseController.MinAgeInHours),
+			InstallStream:  viper.GetString(ReleaseController.InstallStream),
+		},
+		RouteMonitors: RouteMonitorsConfig{
+			DuringInstall:            viper.GetBool(RouteMonitors.DuringInstall),
+			Targets:                  routeMonitorTargets(),
+			Rate:                     viper.GetInt(RouteMonitors.Rate),
+			TimeoutInSeconds:         viper.GetInt(RouteMonitors.TimeoutInSeconds),
+			AvailabilitySLO:          viper.GetFloat64(RouteMonitors.AvailabilitySLO),
+			LatencySLOInMilliseconds: viper.GetFloat64(RouteMonitors.LatencySLOInMilliseconds),
+		},
+		Disruption: DisruptionConfig{
+			Enabled:                 viper.GetBool(Disruption.Enabled),
+			Namespace:               viper.GetString(Disruption.Namespace),
+			Image:                   viper.GetString(Disruption.Image),
+			TCPBudgetInSeconds:      viper.GetInt(Disruption.TCPBudgetInSeconds),
+			HTTPBudgetInSeconds:     viper.GetInt(Disruption.HTTPBudgetInSeconds),
+			StatefulBudgetInSeconds: viper.GetInt(Disruption.StatefulBudgetInSeconds),
+		},
+		Kubeconfig: KubeconfigConfig{
+			Path: viper.GetString(Kubeconfig.Path),
+		},
+		Tests: TestsConfig{
+			PollingTimeout:            viper.GetFloat64(Tests.PollingTimeout),
+			GinkgoSkip:                viper.GetString(Tests.GinkgoSkip),
+			GinkgoFocus:               viper.GetString(Tests.GinkgoFocus),
+			TestsToRun:                viper.GetStringSlice(Tests.TestsToRun),
+			SuppressSkipNotifications: viper.GetBool(Tests.SuppressSkipNotifications),
+			OperatorSkip:              viper.GetString(Tests.OperatorSkip),
+			SkipClusterHealthChecks:   viper.GetBool(Tests.SkipClusterHealthChecks),
+			MetricsBucket:             viper.GetString(Tests.MetricsBucket),
+			ServiceAccount:            viper.GetString(Tests.ServiceAccount),
+			RunnerLogLines:            viper.GetInt(Tests.RunnerLogLines),
+			OpenshiftTestShards:       viper.GetInt(Tests.OpenshiftTestShards),
+		},
+		HealthChecks: HealthChecksConfig{
+			Profile:       viper.GetString(HealthChecks.Profile),
+			Enable:        viper.GetString(HealthChecks.Enable),
+			Disable:       viper.GetString(HealthChecks.Disable),
+			Informational: viper.GetString(HealthChecks.Informational),
+		},
+		Matrix: MatrixConfig{
+			Versions:       viper.GetString(Matrix.Versions),
+			CloudProviders: viper.GetString(Matrix.CloudProviders),
+			Regions:        viper.GetString(Matrix.Regions),
+			Parallelism:    viper.GetInt(Matrix.Parallelism),
+			Entry:          viper.GetString(Matrix.Entry),
+		},
+		Cluster: ClusterConfig{
+			MultiAZ:                            viper.GetBool(Cluster.MultiAZ),
+			DestroyAfterTest:                   viper.GetBool(Cluster.DestroyAfterTest),
+			ExpiryInMinutes:                    viper.GetInt(Cluster.ExpiryInMinutes),
+			AfterTestWait:                      viper.GetInt(Cluster.AfterTestWait),
+			InstallTimeout:                     viper.GetInt(Cluster.InstallTimeout),
+			UseLatestVersionForInstall:         viper.GetBool(Cluster.UseLatestVersionForInstall),
+			UseMiddleClusterImageSetForInstall: viper.GetBool(Cluster.UseMiddleClusterImageSetForInstall),
+			UseOldestClusterImageSetForInstall: viper.GetBool(Cluster.UseOldestClusterImageSetForInstall),
+			DeltaReleaseFromDefault:            viper.GetInt(Cluster.DeltaReleaseFromDefault),
+			NextReleaseAfterProdDefault:        viper.GetInt(Cluster.NextReleaseAfterProdDefault),
+			InstallVersionConstraint:           viper.GetString(Cluster.InstallVersionConstraint),
+			InstallVersionPick:                 viper.GetString(Cluster.InstallVersionPick),
+			CleanCheckRuns:                     viper.GetInt(Cluster.CleanCheckRuns),
+		},
+		CloudProvider: CloudProviderConfig{
+			CloudProviderID: viper.GetString(CloudProvider.CloudProviderID),
+			Region:          viper.GetString(CloudProvider.Region),
+		},
+		Addons: AddonsConfig{
+			IDsAtCreation: viper.GetString(Addons.IDsAtCreation),
+			IDs:           viper.GetString(Addons.IDs),
+			TestHarnesses: viper.GetString(Addons.TestHarnesses),
+			TestUser:      viper.GetString(Addons.TestUser),
+			RunCleanup:    viper.GetBool(Addons.RunCleanup),
+		},
+		Scale: ScaleConfig{
+			WorkloadsRepository:       viper.GetString(Scale.WorkloadsRepository),
+			WorkloadsRepositoryBranch: viper.GetString(Scale.WorkloadsRepositoryBranch),
+		},
+		Mock: MockConfig{
+			Env:          viper.GetString(Mock.Env),
+			ScenarioFile: viper.GetString(Mock.ScenarioFile),
+		},
+		OCM: OCMConfig{
+			Token:              viper.GetString(OCM.Token),
+			Env:                viper.GetString(OCM.Env),
+			Debug:              viper.GetBool(OCM.Debug),
+			NumRetries:         viper.GetInt(OCM.NumRetries),
+			ComputeMachineType: viper.GetString(OCM.ComputeMachineType),
+			UserOverride:       viper.GetString(OCM.UserOverride),
+		},
+		MOA: MOAConfig{
+			Env:                viper.GetString(MOA.Env),
+			AWSAccessKeyID:     viper.GetString(MOA.AWSAccessKeyID),
+			AWSSecretAccessKey: viper.GetString(MOA.AWSSecretAccessKey),
+			AWSRegion:          viper.GetString(MOA.AWSRegion),
+			MachineCIDR:        viper.GetString(MOA.MachineCIDR),
+			ServiceCIDR:        viper.GetString(MOA.ServiceCIDR),
+			PodCIDR:            viper.GetString(MOA.PodCIDR),
+			ComputeMachineType: viper.GetString(MOA.ComputeMachineType),
+			ComputeNodes:       viper.GetInt(MOA.ComputeNodes),
+			HostPrefix:         viper.GetInt(MOA.HostPrefix),
+		},
+		CRC: CRCConfig{
+			PullSecretFile: viper.GetString(CRC.PullSecretFile),
+			PullSecret:     viper.GetString(CRC.PullSecret),
+		},
+		Local: LocalConfig{
+			Mode:          viper.GetString(Local.Mode),
+			Kubeconfig:    viper.GetString(Local.Kubeconfig),
+			KindBinary:    viper.GetString(Local.KindBinary),
+			KindNodeImage: viper.GetString(Local.KindNodeImage),
+			Versions:      viper.GetString(Local.Versions),
+		},
+		Metrics: MetricsConfig{
+			AWSAccessKeyID:           viper.GetString(Metrics.AWSAccessKeyID),
+			AWSSecretAccessKey:       viper.GetString(Metrics.AWSSecretAccessKey),
+			AWSRegion:                viper.GetString(Metrics.AWSRegion),
+			MaxQueryTimeoutInSeconds: viper.GetInt(Metrics.MaxQueryTimeoutInSeconds),
+			StepDurationInHours:      viper.GetInt(Metrics.StepDurationInHours),
+		},
+		Prometheus: PrometheusConfig{
+			Address:     viper.GetString(Prometheus.Address),
+			BearerToken: viper.GetString(Prometheus.BearerToken),
+		},
+		Weather: WeatherConfig{
+			StartOfTimeWindowInHours: viper.GetInt(Weather.StartOfTimeWindowInHours),
+			NumberOfSamplesNecessary: viper.GetInt(Weather.NumberOfSamplesNecessary),
+			SlackWebhook:             viper.GetString(Weather.SlackWebhook),
+			JobAllowlist:             viper.GetString(Weather.JobAllowlist),
+			Provider:                 viper.GetString(Weather.Provider),
+		},
+		Alert: AlertConfig{
+			SlackAPIToken: viper.GetString(Alert.SlackAPIToken),
+		},
+		State: &RunState{
+			ClusterID:                           viper.GetString(Cluster.ID),
+			ClusterName:                         viper.GetString(Cluster.Name),
+			ClusterVersion:                      viper.GetString(Cluster.Version),
+			CloudProviderID:                     viper.GetString(CloudProvider.CloudProviderID),
+			Region:                              viper.GetString(CloudProvider.Region),
+			Kubeconfig:                          viper.GetString(Kubeconfig.Contents),
+			UpgradeReleaseName:                  viper.GetString(Upgrade.ReleaseName),
+			UpgradeImage:                        viper.GetString(Upgrade.Image),
+			EnoughVersionsForOldestOrMiddleTest: true,
+			PreviousVersionFromDefaultFound:     true,
+			ReportDir:                           viper.GetString(ReportDir),
+			Suffix:                              viper.GetString(Suffix),
+			Project:                             viper.GetString(Project),
+		},
+	}
+}
+
+// Workloads returns the workloads this run has installed, keyed by name with the project they were installed in.
+func (s *RunState) Workloads() map[string]string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	workloads := make(map[string]string, len(s.installedWorkloads))
+	for name, project := range s.installedWorkloads {
+		workloads[name] = project
+	}
+	return workloads
+}
+
+// AddWorkload records that a workload has been installed in project.
+func (s *RunState) AddWorkload(name, project string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.installedWorkloads == nil {
+		s.installedWorkloads = map[string]string{}
+	}
+	s.installedWorkloads[name] = project
+}
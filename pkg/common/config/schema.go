@@ -0,0 +1,138 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// KeyType is the type of value a config key holds.
+type KeyType string
+
+const (
+	// TypeString is a string value.
+	TypeString KeyType = "string"
+
+	// TypeBool is a boolean value.
+	TypeBool KeyType = "bool"
+
+	// TypeInt is an integer value.
+	TypeInt KeyType = "int"
+
+	// TypeFloat is a floating point value.
+	TypeFloat KeyType = "float"
+
+	// TypeList is a list of strings, or a whitespace separated string.
+	TypeList KeyType = "list"
+
+	// TypeObject is structured data that is unmarshalled by whatever uses it.
+	TypeObject KeyType = "object"
+)
+
+// Key describes a config key. The Schema is generated from the keys declared throughout osde2e.
+type Key struct {
+	// Name is the viper key.
+	Name string
+
+	// Type is the type of value the key holds.
+	Type KeyType
+
+	// Default is the default value of the key, if any.
+	Default string
+
+	// Env is the environment variable that sets the key, if any.
+	Env string
+
+	// Secret is the secret file the key is loaded from, if any.
+	Secret string
+
+	// Package is the package the key is declared in.
+	Package string
+
+	// Description is the documentation of the key.
+	Description string
+}
+
+// LookupKey returns the key with the given name. Like viper, names are case insensitive.
+func LookupKey(name string) (Key, bool) {
+	for _, key := range Schema {
+		if strings.EqualFold(key.Name, name) {
+			return key, true
+		}
+	}
+	return Key{}, false
+}
+
+// LookupDeprecatedKey returns the key that replaced a deprecated key name. Like viper, names are case insensitive.
+func LookupDeprecatedKey(name string) (Key, bool) {
+	for deprecated, replacement := range DeprecatedKeys {
+		if strings.EqualFold(deprecated, name) {
+			return LookupKey(replacement)
+		}
+	}
+	return Key{}, false
+}
+
+// Sensitive returns true if the value of the key shouldn't be printed.
+func (k Key) Sensitive() bool {
+	if k.Secret != "" {
+		return true
+	}
+
+	name := strings.ToLower(k.Name)
+	for _, word := range []string{"token", "secret", "password", "webhook"} {
+		if strings.Contains(name, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// Check returns an error if value can't be used as the key's type. Strings are parsed the same way viper
+// parses them, so values from the environment can be checked too.
+func (k Key) Check(value interface{}) error {
+	switch k.Type {
+	case TypeObject:
+		return nil
+	case TypeList:
+		switch value.(type) {
+		case []interface{}, []string, string:
+			return nil
+		}
+	case TypeString:
+		switch value.(type) {
+		case []interface{}, map[string]interface{}, map[interface{}]interface{}:
+		default:
+			return nil
+		}
+	case TypeBool:
+		switch v := value.(type) {
+		case bool:
+			return nil
+		case string:
+			if _, err := strconv.ParseBool(v); err == nil {
+				return nil
+			}
+		}
+	case TypeInt:
+		switch v := value.(type) {
+		case int, int32, int64, uint, uint32, uint64:
+			return nil
+		case string:
+			if _, err := strconv.ParseInt(v, 0, 0); err == nil {
+				return nil
+			}
+		}
+	case TypeFloat:
+		switch v := value.(type) {
+		case int, int32, int64, uint, uint32, uint64, float32, float64:
+			return nil
+		case string:
+			if _, err := strconv.ParseFloat(v, 64); err == nil {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("%s must be of type %s, got %v", k.Name, k.Type, value)
+}
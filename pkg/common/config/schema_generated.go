@@ -0,0 +1,1080 @@
+package config
+
+// DO NOT EDIT THIS FILE. It is generated by the Makefile.
+// It describes every config key bound, defaulted or read through viper in osde2e.
+
+// Schema is every known config key, sorted by name.
+var Schema = []Key{
+	{
+		Name:        "addons.idsAtCreation",
+		Type:        TypeString,
+		Env:         "ADDON_IDS_AT_CREATION",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "IDsAtCreation is a comma separated list of IDs to create at cluster creation time.",
+	},
+	{
+		Name:        "addons.runCleanup",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "ADDON_RUN_CLEANUP",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "RunCleanup is a boolean to specify whether the testHarnesses should have a separate cleanup phase. This phase would run at the end of all e2e testing",
+	},
+	{
+		Name:        "addons.testUser",
+		Type:        TypeString,
+		Default:     "system:serviceaccount:%s:cluster-admin",
+		Env:         "ADDON_TEST_USER",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "TestUser is the OpenShift user that the tests will run as If \"%s\" is detected in the TestUser string, it will evaluate that as the project namespace Example: \"system:serviceaccount:%s:dedicated-admin\" Evaluated: \"system:serviceaccount:osde2e-abc123:dedicated-admin\"",
+	},
+	{
+		Name:        "alert.slackAPIToken",
+		Type:        TypeString,
+		Env:         "SLACK_API_TOKEN",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "SlackAPIToken is a bot slack token",
+	},
+	{
+		Name:        "artifacts",
+		Type:        TypeString,
+		Env:         "ARTIFACTS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Artifacts is the artifacts location on prow. It is an alias for report dir.",
+	},
+	{
+		Name:        "baseJobURL",
+		Type:        TypeString,
+		Default:     "https://storage.googleapis.com/origin-ci-test/logs",
+		Env:         "BASE_JOB_URL",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "BaseJobURL is the root location for all job artifacts For example, https://storage.googleapis.com/origin-ci-test/logs/osde2e-prod-gcp-e2e-next/61/build-log.txt would be https://storage.googleapis.com/origin-ci-test/logs -- This is also our default",
+	},
+	{
+		Name:        "baseProwURL",
+		Type:        TypeString,
+		Default:     "https://deck-ci.apps.ci.l2s4.p1.openshiftapps.com",
+		Env:         "BASE_PROW_URL",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "BaseProwURL is the root location of Prow",
+	},
+	{
+		Name:        "cincinnati.arch",
+		Type:        TypeString,
+		Default:     "amd64",
+		Env:         "CINCINNATI_ARCH",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Arch is the architecture of the graphs fetched from Cincinnati.",
+	},
+	{
+		Name:        "cincinnati.cacheDir",
+		Type:        TypeString,
+		Env:         "CINCINNATI_CACHE_DIR",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "CacheDir is a directory graphs are cached in between runs. Graphs are only cached in memory if unset.",
+	},
+	{
+		Name:        "cincinnati.cacheTTLInMinutes",
+		Type:        TypeInt,
+		Default:     "60",
+		Env:         "CINCINNATI_CACHE_TTL_IN_MINUTES",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "CacheTTLInMinutes is how long a graph cached in memory or on disk is used before being fetched again.",
+	},
+	{
+		Name:        "cincinnati.fixtures",
+		Type:        TypeString,
+		Env:         "CINCINNATI_FIXTURES",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Fixtures is a directory of graphs named <channel>.json, which are used instead of fetching graphs.",
+	},
+	{
+		Name:        "cincinnati.url",
+		Type:        TypeString,
+		Default:     "https://api.openshift.com/api/upgrades_info/v1/graph",
+		Env:         "CINCINNATI_URL",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "URL is the Cincinnati graph endpoint.",
+	},
+	{
+		Name:        "cloudProvider.providerId",
+		Type:        TypeString,
+		Default:     "aws",
+		Env:         "CLOUD_PROVIDER_ID",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "CloudProviderID is the cloud provider ID to use to provision the cluster.",
+	},
+	{
+		Name:        "cloudProvider.region",
+		Type:        TypeString,
+		Default:     "us-east-1",
+		Env:         "CLOUD_PROVIDER_REGION",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Region is the cloud provider region to use to provision the cluster.",
+	},
+	{
+		Name:        "cluster.afterTestWait",
+		Type:        TypeInt,
+		Default:     "60",
+		Env:         "AFTER_TEST_CLUSTER_WAIT",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "AfterTestWait is how long to keep a cluster around after tests have run.",
+	},
+	{
+		Name:        "cluster.cleanCheckRuns",
+		Type:        TypeInt,
+		Default:     "20",
+		Env:         "CLEAN_CHECK_RUNS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "CleanCheckRuns lets us set the number of osd-verify checks we want to run before deeming a cluster \"healthy\"",
+	},
+	{
+		Name:        "cluster.deltaReleaseFromDefault",
+		Type:        TypeInt,
+		Default:     "0",
+		Env:         "DELTA_RELEASE_FROM_DEFAULT",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "DeltaReleaseFromDefault will select the cluster image set that is the given number of releases from the current default in either direction.",
+	},
+	{
+		Name:        "cluster.destroyAfterTest",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "DESTROY_CLUSTER",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "DestroyClusterAfterTest set to true if you want to the cluster to be explicitly deleted after the test.",
+	},
+	{
+		Name:        "cluster.expiryInMinutes",
+		Type:        TypeInt,
+		Default:     "210",
+		Env:         "CLUSTER_EXPIRY_IN_MINUTES",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "ExpiryInMinutes is how long before a cluster expires and is deleted by OSD.",
+	},
+	{
+		Name:        "cluster.id",
+		Type:        TypeString,
+		Env:         "CLUSTER_ID",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "ID identifies the cluster. If set at start, an existing cluster is tested.",
+	},
+	{
+		Name:        "cluster.installTimeout",
+		Type:        TypeInt,
+		Default:     "135",
+		Env:         "CLUSTER_UP_TIMEOUT",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "InstallTimeout is how long to wait before failing a cluster launch.",
+	},
+	{
+		Name:        "cluster.installVersionConstraint",
+		Type:        TypeString,
+		Env:         "INSTALL_VERSION_CONSTRAINT",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "InstallVersionConstraint will select a version matching a constraint expression, such as \">=4.6 <4.8, !prerelease\".",
+	},
+	{
+		Name:        "cluster.installVersionPick",
+		Type:        TypeString,
+		Default:     "newest",
+		Env:         "INSTALL_VERSION_PICK",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "InstallVersionPick is how a version is picked from those matching InstallVersionConstraint.",
+	},
+	{
+		Name:        "cluster.multiAZ",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "MULTI_AZ",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "MultiAZ deploys a cluster across multiple availability zones.",
+	},
+	{
+		Name:        "cluster.name",
+		Type:        TypeString,
+		Env:         "CLUSTER_NAME",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Name is the name of the cluster being created.",
+	},
+	{
+		Name:        "cluster.nextReleaseAfterProdDefault",
+		Type:        TypeInt,
+		Default:     "-1",
+		Env:         "NEXT_RELEASE_AFTER_PROD_DEFAULT",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "NextReleaseAfterProdDefault will select the cluster image set that the given number of releases away from the the production default.",
+	},
+	{
+		Name:        "cluster.useLatestVersionForInstall",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "USE_LATEST_VERSION_FOR_INSTALL",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "UseLatestVersionForInstall will select the latest cluster image set available for a fresh install.",
+	},
+	{
+		Name:        "cluster.useMiddleClusterImageSetForInstall",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "USE_MIDDLE_CLUSTER_IMAGE_SET_FOR_INSTALL",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "UseMiddleClusterImageSetForInstall will select the cluster image set that is in the middle of the list of ordered cluster versions known to OCM.",
+	},
+	{
+		Name:        "cluster.useOldestClusterImageSetForInstall",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "USE_OLDEST_CLUSTER_IMAGE_SET_FOR_INSTALL",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "UseOldestClusterImageSetForInstall will select the cluster image set that is in the end of the list of ordered cluster versions known to OCM.",
+	},
+	{
+		Name:        "cluster.version",
+		Type:        TypeString,
+		Env:         "CLUSTER_VERSION",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Version is the version of the cluster being deployed.",
+	},
+	{
+		Name:        "crc.pull_secret",
+		Type:        TypeString,
+		Env:         "CRC_PULL_SECRET",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "PullSecret is a string containing your pull secret",
+	},
+	{
+		Name:        "crc.pull_secret_file",
+		Type:        TypeString,
+		Env:         "CRC_PULL_SECRET_FILE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "PullSecretFile is a file containing your pull secret",
+	},
+	{
+		Name:        "disruption.enabled",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "DISRUPTION_ENABLED",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Enabled deploys probe workloads before upgrading and measures how long they are disrupted by each upgrade.",
+	},
+	{
+		Name:        "disruption.httpBudgetInSeconds",
+		Type:        TypeInt,
+		Default:     "30",
+		Env:         "DISRUPTION_HTTP_BUDGET_IN_SECONDS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "HTTPBudgetInSeconds is how long the HTTP workload can be disrupted by an upgrade before the upgrade phase fails.",
+	},
+	{
+		Name:        "disruption.image",
+		Type:        TypeString,
+		Default:     "registry.access.redhat.com/ubi8/python-38",
+		Env:         "DISRUPTION_IMAGE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Image is the image the probe workloads run. It must provide python3.",
+	},
+	{
+		Name:        "disruption.namespace",
+		Type:        TypeString,
+		Default:     "osde2e-disruption",
+		Env:         "DISRUPTION_NAMESPACE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Namespace is the namespace the probe workloads are deployed in.",
+	},
+	{
+		Name:        "disruption.statefulBudgetInSeconds",
+		Type:        TypeInt,
+		Default:     "180",
+		Env:         "DISRUPTION_STATEFUL_BUDGET_IN_SECONDS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "StatefulBudgetInSeconds is how long the PVC-backed workload can be disrupted by an upgrade before the upgrade phase fails.",
+	},
+	{
+		Name:        "disruption.tcpBudgetInSeconds",
+		Type:        TypeInt,
+		Default:     "30",
+		Env:         "DISRUPTION_TCP_BUDGET_IN_SECONDS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "TCPBudgetInSeconds is how long the TCP workload can be disrupted by an upgrade before the upgrade phase fails.",
+	},
+	{
+		Name:        "dryRun",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "DRY_RUN",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "DryRun lets you run osde2e all the way up to the e2e tests then skips them.",
+	},
+	{
+		Name:        "healthChecks.disable",
+		Type:        TypeString,
+		Env:         "HEALTH_CHECKS_DISABLE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Disable is a comma separated list of health checks from the profile not to run.",
+	},
+	{
+		Name:        "healthChecks.enable",
+		Type:        TypeString,
+		Env:         "HEALTH_CHECKS_ENABLE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Enable is a comma separated list of health checks to run in addition to those in the profile.",
+	},
+	{
+		Name:        "healthChecks.informational",
+		Type:        TypeString,
+		Env:         "HEALTH_CHECKS_INFORMATIONAL",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Informational is a comma separated list of health checks whose failures are reported but don't stop a cluster from being ready.",
+	},
+	{
+		Name:        "healthChecks.profile",
+		Type:        TypeString,
+		Env:         "HEALTH_CHECKS_PROFILE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Profile is the set of health checks to run. Defaults to the profile for the provider in use.",
+	},
+	{
+		Name:        "ids",
+		Type:        TypeString,
+		Env:         "ADDON_IDS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "IDs is a comma separated list of IDs to install after a cluster is created.",
+	},
+	{
+		Name:        "jobID",
+		Type:        TypeInt,
+		Default:     "-1",
+		Env:         "BUILD_NUMBER",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "JobID is the ID designated by prow for this run",
+	},
+	{
+		Name:        "jobName",
+		Type:        TypeString,
+		Env:         "JOB_NAME",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "JobName lets you name the current e2e job run",
+	},
+	{
+		Name:        "kubeconfig.contents",
+		Type:        TypeString,
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Contents is the actual contents of a valid Kubeconfig",
+	},
+	{
+		Name:        "kubeconfig.path",
+		Type:        TypeString,
+		Env:         "TEST_KUBECONFIG",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Path is the filepath of an existing Kubeconfig",
+	},
+	{
+		Name:        "local.kindBinary",
+		Type:        TypeString,
+		Default:     "kind",
+		Env:         "KIND_BINARY",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "KindBinary is the kind executable used in kind mode.",
+	},
+	{
+		Name:        "local.kindNodeImage",
+		Type:        TypeString,
+		Env:         "KIND_NODE_IMAGE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "KindNodeImage is the node image kind clusters are created with. kind's default is used if this is empty.",
+	},
+	{
+		Name:        "local.kubeconfig",
+		Type:        TypeString,
+		Env:         "LOCAL_KUBECONFIG",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Kubeconfig is the kubeconfig adopted in kubeconfig mode. The default kubeconfig is used if this is empty.",
+	},
+	{
+		Name:        "local.mode",
+		Type:        TypeString,
+		Default:     "kind",
+		Env:         "LOCAL_MODE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Mode is how the local provider gets its cluster: \"kind\" to create kind clusters, \"envtest\" to start a kube-apiserver and etcd using envtest, or \"kubeconfig\" to adopt the cluster an existing kubeconfig points to.",
+	},
+	{
+		Name:        "local.versions",
+		Type:        TypeString,
+		Default:     "4.5.0",
+		Env:         "LOCAL_VERSIONS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Versions is a comma separated list of the versions reported by the local provider. The last is the default.",
+	},
+	{
+		Name:        "logMetrics",
+		Type:        TypeObject,
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "logMetricsKey is the config key containing the list of log metrics. See LogMetric.",
+	},
+	{
+		Name:        "matrix.cloudProviders",
+		Type:        TypeString,
+		Env:         "MATRIX_CLOUD_PROVIDERS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "CloudProviders is a comma separated list of cloud providers to run the suite against.",
+	},
+	{
+		Name:        "matrix.entry",
+		Type:        TypeString,
+		Env:         "MATRIX_ENTRY",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Entry is the name of the matrix entry being run. It is set by osde2e for each entry it runs.",
+	},
+	{
+		Name:        "matrix.parallelism",
+		Type:        TypeInt,
+		Default:     "2",
+		Env:         "MATRIX_PARALLELISM",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Parallelism is the number of matrix entries run at once.",
+	},
+	{
+		Name:        "matrix.regions",
+		Type:        TypeString,
+		Env:         "MATRIX_REGIONS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Regions is a comma separated list of regions to run the suite against. A region may be prefixed with a cloud provider, e.g. \"gcp:us-east1\", to only use it with that cloud provider.",
+	},
+	{
+		Name:        "matrix.versions",
+		Type:        TypeString,
+		Env:         "MATRIX_VERSIONS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Versions is a comma separated list of cluster versions to run the suite against.",
+	},
+	{
+		Name:        "metrics.awsAccessKeyId",
+		Type:        TypeString,
+		Env:         "METRICS_AWS_ACCESS_KEY_ID",
+		Secret:      "metrics-aws-access-key",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "AWSAccessKeyID is the AWS access key used to read and write metrics in S3.",
+	},
+	{
+		Name:        "metrics.awsRegion",
+		Type:        TypeString,
+		Env:         "METRICS_AWS_REGION",
+		Secret:      "metrics-aws-region",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "AWSRegion is the AWS region of the metrics bucket.",
+	},
+	{
+		Name:        "metrics.awsSecretAccessKey",
+		Type:        TypeString,
+		Env:         "METRICS_AWS_SECRET_ACCESS_KEY",
+		Secret:      "metrics-aws-secret-access-key",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "AWSSecretAccessKey is the AWS secret access key used to read and write metrics in S3.",
+	},
+	{
+		Name:        "moa.awsAccessKey",
+		Type:        TypeString,
+		Env:         "MOA_AWS_ACCESS_KEY_ID",
+		Secret:      "moa-aws-access-key",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "AWSAccessKeyID for provisioning clusters.",
+	},
+	{
+		Name:        "moa.awsRegion",
+		Type:        TypeString,
+		Env:         "MOA_AWS_REGION",
+		Secret:      "moa-aws-region",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "AWSRegion for provisioning clusters.",
+	},
+	{
+		Name:        "moa.awsSecretAccessKey",
+		Type:        TypeString,
+		Env:         "MOA_AWS_SECRET_ACCESS_KEY",
+		Secret:      "moa-aws-secret-access-key",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "AWSSecretAccessKey for provisioning clusters.",
+	},
+	{
+		Name:        "moa.computeMachineType",
+		Type:        TypeString,
+		Env:         "MOA_COMPUTE_MACHINE_TYPE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "ComputeMachineType is instance size of the compute nodes in a cluster.",
+	},
+	{
+		Name:        "moa.computeNodes",
+		Type:        TypeInt,
+		Env:         "MOA_COMPUTE_NODES",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "ComputeNodes is number of compute nodes in a cluster.",
+	},
+	{
+		Name:        "moa.env",
+		Type:        TypeString,
+		Default:     "prod",
+		Env:         "MOA_ENV",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Env is the OpenShift Dedicated environment used to provision clusters.",
+	},
+	{
+		Name:        "moa.hostPrefix",
+		Type:        TypeInt,
+		Env:         "MOA_HOST_PREFIX",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "HostPrefix is the prefix for the hosts produced by MOA.",
+	},
+	{
+		Name:        "moa.machineCIDR",
+		Type:        TypeString,
+		Env:         "MOA_MACHINE_CIDR",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "MachineCIDR is the CIDR to use for machines.",
+	},
+	{
+		Name:        "moa.podCIDR",
+		Type:        TypeString,
+		Env:         "MOA_POD_CIDR",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "PodCIDR is the CIDR to use for pods.",
+	},
+	{
+		Name:        "moa.serviceCIDR",
+		Type:        TypeString,
+		Env:         "MOA_SERVICE_CIDR",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "ServiceCIDR is the CIDR to use for services.",
+	},
+	{
+		Name:        "mock.env",
+		Type:        TypeString,
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Env is the mock environment.",
+	},
+	{
+		Name:        "mock.scenarioFile",
+		Type:        TypeString,
+		Env:         "MOCK_SCENARIO_FILE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "ScenarioFile is a YAML file describing how the mock provider should behave.",
+	},
+	{
+		Name:        "mustGather",
+		Type:        TypeBool,
+		Default:     "true",
+		Env:         "MUST_GATHER",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "MustGather will run a Must-Gather process upon completion of the tests.",
+	},
+	{
+		Name:        "ocm.computeMachineType",
+		Type:        TypeString,
+		Env:         "OCM_COMPUTE_MACHINE_TYPE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "ComputeMachineType is the specific cloud machine type to use for compute nodes.",
+	},
+	{
+		Name:        "ocm.debug",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "DEBUG_OSD",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Debug shows debug level messages when enabled.",
+	},
+	{
+		Name:        "ocm.env",
+		Type:        TypeString,
+		Default:     "prod",
+		Env:         "OSD_ENV",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Env is the OpenShift Dedicated environment used to provision clusters.",
+	},
+	{
+		Name:        "ocm.numRetries",
+		Type:        TypeInt,
+		Default:     "3",
+		Env:         "NUM_RETRIES",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "NumRetries is the number of times to retry each OCM call.",
+	},
+	{
+		Name:        "ocm.token",
+		Type:        TypeString,
+		Env:         "OCM_TOKEN",
+		Secret:      "ocm-refresh-token",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Token is used to authenticate with OCM.",
+	},
+	{
+		Name:        "ocm.userOverride",
+		Type:        TypeString,
+		Env:         "OCM_USER_OVERRIDE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "UserOverride will hard set the user assigned to the \"owner\" tag by the OCM provider.",
+	},
+	{
+		Name:        "osde2e.metricsLib.maxQueryTimeoutInSeconds",
+		Type:        TypeInt,
+		Default:     "120",
+		Env:         "OSDE2E_METRICSLIB_MAX_QUERY_TIMEOUT_IN_SECONDS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "MaxQueryTimeoutInSeconds is how long a metrics query can take.",
+	},
+	{
+		Name:        "osde2e.metricsLib.stepDurationInHours",
+		Type:        TypeInt,
+		Default:     "4",
+		Env:         "OSDE2E_METRICSLIB_STEP_DURATION_IN_HOURS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "StepDurationInHours is the step of metrics range queries.",
+	},
+	{
+		Name:        "project",
+		Type:        TypeString,
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Project is both the project and SA automatically created to house all objects created during an osde2e-run",
+	},
+	{
+		Name:        "prometheus.address",
+		Type:        TypeString,
+		Env:         "PROMETHEUS_ADDRESS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Address is the address of the Prometheus instance to connect to.",
+	},
+	{
+		Name:        "prometheus.bearerToken",
+		Type:        TypeString,
+		Env:         "PROMETHEUS_BEARER_TOKEN",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "BearerToken is the token needed for communicating with Prometheus.",
+	},
+	{
+		Name:        "provider",
+		Type:        TypeString,
+		Default:     "ocm",
+		Env:         "PROVIDER",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Provider is what provider to use to create/delete clusters.",
+	},
+	{
+		Name:        "releaseController.fixtures",
+		Type:        TypeString,
+		Env:         "RELEASE_CONTROLLER_FIXTURES",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Fixtures is a directory of recorded release controller responses, which are used instead of fetching them.",
+	},
+	{
+		Name:        "releaseController.installStream",
+		Type:        TypeString,
+		Env:         "RELEASE_CONTROLLER_INSTALL_STREAM",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "InstallStream is a release stream to select the nightly to install from. The nightly must be offered by the provider.",
+	},
+	{
+		Name:        "releaseController.minAgeInHours",
+		Type:        TypeInt,
+		Default:     "0",
+		Env:         "RELEASE_CONTROLLER_MIN_AGE_IN_HOURS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "MinAgeInHours is how old a nightly must be to be selected from the release controller.",
+	},
+	{
+		Name:        "releaseController.phase",
+		Type:        TypeString,
+		Default:     "Accepted",
+		Env:         "RELEASE_CONTROLLER_PHASE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Phase is the phase of the nightlies selected from the release controller, e.g. Accepted or Rejected.",
+	},
+	{
+		Name:        "releaseController.recordFixtures",
+		Type:        TypeString,
+		Env:         "RELEASE_CONTROLLER_RECORD_FIXTURES",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "RecordFixtures is a directory responses from the release controller are recorded in, to be used as fixtures later.",
+	},
+	{
+		Name:        "releaseController.url",
+		Type:        TypeString,
+		Default:     "https://openshift-release.svc.ci.openshift.org",
+		Env:         "RELEASE_CONTROLLER_URL",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "URL is the base URL of the release controller.",
+	},
+	{
+		Name:        "reportDir",
+		Type:        TypeString,
+		Env:         "REPORT_DIR",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "ReportDir is the location JUnit XML results are written.",
+	},
+	{
+		Name:        "resume",
+		Type:        TypeString,
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Resume is the report directory of an earlier run to resume from its last checkpoint.",
+	},
+	{
+		Name:        "routeMonitors.availabilitySLO",
+		Type:        TypeFloat,
+		Default:     "0",
+		Env:         "ROUTE_MONITOR_AVAILABILITY_SLO",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "AvailabilitySLO is the ratio of requests that must succeed for a target that doesn't set its own SLO. It isn't asserted if zero.",
+	},
+	{
+		Name:        "routeMonitors.duringInstall",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "ROUTE_MONITOR_DURING_INSTALL",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "DuringInstall will monitor the availability of routes whilst the install phase tests run.",
+	},
+	{
+		Name:        "routeMonitors.latencySLOInMilliseconds",
+		Type:        TypeFloat,
+		Default:     "0",
+		Env:         "ROUTE_MONITOR_LATENCY_SLO_IN_MILLISECONDS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "LatencySLOInMilliseconds is the 99th percentile latency a target that doesn't set its own SLO must stay under. It isn't asserted if zero.",
+	},
+	{
+		Name:        "routeMonitors.rate",
+		Type:        TypeInt,
+		Default:     "3",
+		Env:         "ROUTE_MONITOR_RATE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Rate is the number of requests per second sent to a target that doesn't set its own.",
+	},
+	{
+		Name:        "routeMonitors.targets",
+		Type:        TypeObject,
+		Env:         "ROUTE_MONITOR_TARGETS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Targets are targets to monitor in addition to the console, oauth, API servers and workload routes. See RouteMonitorTarget.",
+	},
+	{
+		Name:        "routeMonitors.timeoutInSeconds",
+		Type:        TypeInt,
+		Default:     "3",
+		Env:         "ROUTE_MONITOR_TIMEOUT_IN_SECONDS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "TimeoutInSeconds is how long a request to a target that doesn't set its own timeout can take.",
+	},
+	{
+		Name:        "scale.workloadsRepository",
+		Type:        TypeString,
+		Default:     "https://github.com/openshift-scale/workloads",
+		Env:         "WORKLOADS_REPO",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "WorkloadsRepository is the git repository where the openshift-scale workloads are located.",
+	},
+	{
+		Name:        "scale.workloadsRepositoryBranch",
+		Type:        TypeString,
+		Default:     "master",
+		Env:         "WORKLOADS_REPO_BRANCH",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "WorkloadsRepositoryBranch is the branch of the git repository to use.",
+	},
+	{
+		Name:        "suffix",
+		Type:        TypeString,
+		Env:         "SUFFIX",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Suffix is used at the end of test names to identify them.",
+	},
+	{
+		Name:        "testHarnesses",
+		Type:        TypeString,
+		Env:         "ADDON_TEST_HARNESSES",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "TestHarnesses is a comma separated list of container images that will test the addon",
+	},
+	{
+		Name:        "tests.cleanRuns",
+		Type:        TypeString,
+		Env:         "CLEAN_RUNS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "CleanRuns is the number of times the test-version is run before skipping.",
+	},
+	{
+		Name:        "tests.focus",
+		Type:        TypeString,
+		Env:         "GINKGO_FOCUS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "GinkgoFocus is a regex passed to Ginkgo that focus on any test suites matching the regex. ex. \"Operator\"",
+	},
+	{
+		Name:        "tests.ginkgoSkip",
+		Type:        TypeString,
+		Env:         "GINKGO_SKIP",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "GinkgoSkip is a regex passed to Ginkgo that skips any test suites matching the regex. ex. \"Operator\"",
+	},
+	{
+		Name:        "tests.metricsBucket",
+		Type:        TypeString,
+		Default:     "osde2e-metrics",
+		Env:         "METRICS_BUCKET",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "MetricsBucket is the bucket that metrics data will be uploaded to.",
+	},
+	{
+		Name:        "tests.openshiftTestShards",
+		Type:        TypeInt,
+		Default:     "1",
+		Env:         "OPENSHIFT_TEST_SHARDS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "OpenshiftTestShards is how many runner Pods the openshift-tests conformance suites are split across.",
+	},
+	{
+		Name:        "tests.operatorSkip",
+		Type:        TypeString,
+		Default:     "insights",
+		Env:         "OPERATOR_SKIP",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "OperatorSkip is a comma-delimited list of operator names to ignore health checks from. ex. \"insights,telemetry\"",
+	},
+	{
+		Name:        "tests.pollingTimeout",
+		Type:        TypeFloat,
+		Default:     "30",
+		Env:         "POLLING_TIMEOUT",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "PollingTimeout is how long (in mimutes) to wait for an object to be created before failing the test.",
+	},
+	{
+		Name:        "tests.runnerLogLines",
+		Type:        TypeInt,
+		Default:     "1000",
+		Env:         "RUNNER_LOG_LINES",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "RunnerLogLines is how many lines of each runner container's log are echoed while it runs. -1 echoes all of them.",
+	},
+	{
+		Name:        "tests.serviceAccount",
+		Type:        TypeString,
+		Env:         "SERVICE_ACCOUNT",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "ServiceAccount defines what user the tests should run as. By default, osde2e uses system:admin",
+	},
+	{
+		Name:        "tests.skipClusterHealthChecks",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "SKIP_CLUSTER_HEALTH_CHECKS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "SkipClusterHealthChecks skips the cluster health checks. Useful when developing against a running cluster.",
+	},
+	{
+		Name:        "tests.suppressSkipNotifications",
+		Type:        TypeBool,
+		Default:     "true",
+		Env:         "SUPPRESS_SKIP_NOTIFICATIONS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "SuppressSkipNotifications suppresses the notifications of skipped tests",
+	},
+	{
+		Name:        "tests.testsToRun",
+		Type:        TypeList,
+		Env:         "TESTS_TO_RUN",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "TestsToRun is a list of files which should be executed as part of a test suite",
+	},
+	{
+		Name:        "upgrade.image",
+		Type:        TypeString,
+		Env:         "UPGRADE_IMAGE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Image is the release image a cluster is upgraded to. If set, it overrides the release stream and upgrades.",
+	},
+	{
+		Name:        "upgrade.managed",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "UPGRADE_MANAGED",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Managed will upgrade the cluster by creating an upgrade policy with the provider, the way customers upgrade their clusters, instead of editing the ClusterVersion.",
+	},
+	{
+		Name:        "upgrade.managedDelayInMinutes",
+		Type:        TypeInt,
+		Default:     "10",
+		Env:         "UPGRADE_MANAGED_DELAY_IN_MINUTES",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "ManagedDelayInMinutes is how far in the future a managed upgrade is scheduled.",
+	},
+	{
+		Name:        "upgrade.mode",
+		Type:        TypeString,
+		Env:         "UPGRADE_MODE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Mode is how the cluster is upgraded: \"rollback\", \"unavailable-image\" or \"pause-machine-config-pools\". The selected release is upgraded to normally if unset.",
+	},
+	{
+		Name:        "upgrade.monitorRoutesDuringUpgrade",
+		Type:        TypeBool,
+		Default:     "true",
+		Env:         "UPGRADE_MONITOR_ROUTES",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "MonitorRoutesDuringUpgrade will monitor the availability of routes whilst an upgrade takes place",
+	},
+	{
+		Name:        "upgrade.nextReleaseAfterProdDefaultForUpgrade",
+		Type:        TypeInt,
+		Default:     "-1",
+		Env:         "NEXT_RELEASE_AFTER_PROD_DEFAULT_FOR_UPGRADE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "NextReleaseAfterProdDefaultForUpgrade will select the cluster image set that the given number of releases away from the the production default.",
+	},
+	{
+		Name:        "upgrade.onlyUpgradeToZReleases",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "ONLY_UPGRADE_TO_Z_RELEASES",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "OnlyUpgradeToZReleases will restrict upgrades to selecting Z releases on stage/prod.",
+	},
+	{
+		Name:        "upgrade.path",
+		Type:        TypeString,
+		Env:         "UPGRADE_PATH",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Path is a comma separated list of releases to upgrade through in order, e.g. \"4.6.8,4.7.2\". A release can be followed by \"=\" and its image. If set, it overrides the release name and image.",
+	},
+	{
+		Name:        "upgrade.pathFromCincinnati",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "UPGRADE_PATH_FROM_CINCINNATI",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "PathFromCincinnati will upgrade to the selected release along the shortest path through the Cincinnati graph.",
+	},
+	{
+		Name:        "upgrade.pausedMachineConfigPools",
+		Type:        TypeString,
+		Default:     "worker",
+		Env:         "UPGRADE_PAUSED_MACHINE_CONFIG_POOLS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "PausedMachineConfigPools is a comma separated list of pools paused by the pause-machine-config-pools upgrade mode.",
+	},
+	{
+		Name:        "upgrade.preflight",
+		Type:        TypeBool,
+		Default:     "true",
+		Env:         "UPGRADE_PREFLIGHT",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Preflight will check release images are valid before upgrading to them.",
+	},
+	{
+		Name:        "upgrade.preflightRequireEdge",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "UPGRADE_PREFLIGHT_REQUIRE_EDGE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "PreflightRequireEdge will fail the pre-flight checks of a release image that can't be upgraded to from the installed version.",
+	},
+	{
+		Name:        "upgrade.preflightRequireImage",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "UPGRADE_PREFLIGHT_REQUIRE_IMAGE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "PreflightRequireImage will fail the pre-flight checks of a release image that can't be read from its registry.",
+	},
+	{
+		Name:        "upgrade.preflightRequireSignature",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "UPGRADE_PREFLIGHT_REQUIRE_SIGNATURE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "PreflightRequireSignature will fail the pre-flight checks of a release image that isn't signed.",
+	},
+	{
+		Name:        "upgrade.registryAuthFile",
+		Type:        TypeString,
+		Env:         "UPGRADE_REGISTRY_AUTH_FILE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "RegistryAuthFile is a Docker config.json or pull secret used to read release images. They are read anonymously if unset.",
+	},
+	{
+		Name:        "upgrade.releaseName",
+		Type:        TypeString,
+		Env:         "UPGRADE_RELEASE_NAME",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "ReleaseName is the name of the release in a release stream.",
+	},
+	{
+		Name:        "upgrade.releaseStream",
+		Type:        TypeString,
+		Env:         "UPGRADE_RELEASE_STREAM",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "ReleaseStream used to retrieve latest release images. If set, it will be used to perform an upgrade.",
+	},
+	{
+		Name:        "upgrade.signatureStoreURL",
+		Type:        TypeString,
+		Default:     "https://mirror.openshift.com/pub/openshift-v4/signatures/openshift/release",
+		Env:         "UPGRADE_SIGNATURE_STORE_URL",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "SignatureStoreURL is the store release image signatures are looked up in.",
+	},
+	{
+		Name:        "upgrade.unavailableImage",
+		Type:        TypeString,
+		Default:     "quay.io/openshift-release-dev/ocp-release@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+		Env:         "UPGRADE_UNAVAILABLE_IMAGE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "UnavailableImage is the image requested by the unavailable-image upgrade mode. It must not be pullable.",
+	},
+	{
+		Name:        "upgrade.upgradeToCISIfPossible",
+		Type:        TypeBool,
+		Default:     "false",
+		Env:         "UPGRADE_TO_CIS_IF_POSSIBLE",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "UpgradeToCISIfPossible will upgrade to the most recent cluster image set if it's newer than the install version",
+	},
+	{
+		Name:        "weather.jobAllowlist",
+		Type:        TypeString,
+		Default:     "osde2e-.*-aws-e2e-.*",
+		Env:         "JOB_ALLOWLIST",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "JobAllowlist is a list of job regexes to consider in the weather report.",
+	},
+	{
+		Name:        "weather.numberOfSamplesNecessary",
+		Type:        TypeInt,
+		Default:     "3",
+		Env:         "NUMBER_OF_SAMPLES_NECESSARY",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "NumberOfSamplesNecessary is how many samples are necessary for generating a report.",
+	},
+	{
+		Name:        "weather.provider",
+		Type:        TypeString,
+		Default:     "aws",
+		Env:         "WEATHER_PROVIDER",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "Provider is the provider tag to attach to an SD weather report.",
+	},
+	{
+		Name:        "weather.slackWebhook",
+		Type:        TypeString,
+		Env:         "SLACK_WEBHOOK",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "SlackWebhook is the webhook to use to post the weather report to slack.",
+	},
+	{
+		Name:        "weather.startOfTimeWindowInHours",
+		Type:        TypeInt,
+		Default:     "24",
+		Env:         "START_OF_TIME_WINDOW_IN_HOURS",
+		Package:     "github.com/openshift/osde2e/pkg/common/config",
+		Description: "StartOfTimeWindowInHours is how many hours to look back through results.",
+	},
+}
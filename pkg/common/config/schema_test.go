@@ -0,0 +1,56 @@
+package config
+
+import "testing"
+
+func TestLookupKey(t *testing.T) {
+	for _, name := range []string{Tests.SkipClusterHealthChecks, "cluster.useoldestclusterimagesetforinstall", logMetricsKey} {
+		if _, ok := LookupKey(name); !ok {
+			t.Errorf("expected %s to be in the schema", name)
+		}
+	}
+
+	if _, ok := LookupKey("cluster.useMiddleClusterVersionForInstall"); ok {
+		t.Errorf("expected an unknown key not to be found")
+	}
+
+	if key, ok := LookupDeprecatedKey("cluster.useMiddleClusterVersionForInstall"); !ok || key.Name != Cluster.UseMiddleClusterImageSetForInstall {
+		t.Errorf("expected a deprecated key to be replaced by %s, got %+v", Cluster.UseMiddleClusterImageSetForInstall, key)
+	}
+
+	key, _ := LookupKey(Tests.SkipClusterHealthChecks)
+	if key.Type != TypeBool || key.Env != "SKIP_CLUSTER_HEALTH_CHECKS" || key.Default != "false" {
+		t.Errorf("unexpected key %+v", key)
+	}
+}
+
+func TestKeyCheck(t *testing.T) {
+	tests := []struct {
+		Type  KeyType
+		Value interface{}
+		Valid bool
+	}{
+		{TypeString, "abc", true},
+		{TypeString, 1, true},
+		{TypeString, []interface{}{"abc"}, false},
+		{TypeBool, true, true},
+		{TypeBool, "false", true},
+		{TypeBool, "sometimes", false},
+		{TypeInt, 3, true},
+		{TypeInt, "-1", true},
+		{TypeInt, 1.5, false},
+		{TypeFloat, 30, true},
+		{TypeFloat, "2.5", true},
+		{TypeFloat, map[string]interface{}{}, false},
+		{TypeList, []interface{}{"a", "b"}, true},
+		{TypeList, "a b", true},
+		{TypeList, 1, false},
+		{TypeObject, map[string]interface{}{}, true},
+	}
+
+	for _, test := range tests {
+		err := Key{Name: "key", Type: test.Type}.Check(test.Value)
+		if valid := err == nil; valid != test.Valid {
+			t.Errorf("expected %v as a %s to be valid: %t, got %v", test.Value, test.Type, test.Valid, err)
+		}
+	}
+}
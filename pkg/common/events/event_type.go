@@ -19,6 +19,28 @@ const (
 	// UpgradeFailed when the upgrade failed
 	UpgradeFailed EventType = "UpgradeFailed"
 
+	// UpgradePreflightFailed when a release image failed its pre-flight checks, before the cluster was touched
+	UpgradePreflightFailed EventType = "UpgradePreflightFailed"
+
+	// UpgradeRollbackSuccessful when an upgrade was rolled back part way through and the cluster returned to its
+	// original release
+	UpgradeRollbackSuccessful EventType = "UpgradeRollbackSuccessful"
+
+	// UpgradeRollbackFailed when an upgrade couldn't be rolled back
+	UpgradeRollbackFailed EventType = "UpgradeRollbackFailed"
+
+	// UpgradeUnavailableImageSuccessful when an upgrade to an unavailable image was refused and the cluster stayed healthy
+	UpgradeUnavailableImageSuccessful EventType = "UpgradeUnavailableImageSuccessful"
+
+	// UpgradeUnavailableImageFailed when an upgrade to an unavailable image was started or left the cluster unhealthy
+	UpgradeUnavailableImageFailed EventType = "UpgradeUnavailableImageFailed"
+
+	// UpgradePausedMachineConfigPoolsSuccessful when paused machine config pools held back an upgrade until unpaused
+	UpgradePausedMachineConfigPoolsSuccessful EventType = "UpgradePausedMachineConfigPoolsSuccessful"
+
+	// UpgradePausedMachineConfigPoolsFailed when paused machine config pools rolled out early or didn't roll out once unpaused
+	UpgradePausedMachineConfigPoolsFailed EventType = "UpgradePausedMachineConfigPoolsFailed"
+
 	// NoHiveLogs when no logs from Hive were collected after a cluster provisioning event
 	NoHiveLogs EventType = "NoHiveLogs"
 
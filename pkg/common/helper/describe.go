@@ -0,0 +1,46 @@
+package helper
+
+import (
+	"sync"
+
+	"github.com/onsi/ginkgo"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+)
+
+// describeBlock is a Ginkgo container waiting for the config of the run it's part of.
+type describeBlock struct {
+	text string
+	body func(cfg *config.RunConfig)
+}
+
+var (
+	describeBlocks      []describeBlock
+	describeBlocksMutex sync.Mutex
+)
+
+// Describe registers a Ginkgo container whose body is passed the config of the run. Test packages register their
+// tests when they're imported, before a run is configured, so the container is only added to the Ginkgo tree by
+// BuildTests.
+func Describe(text string, body func(cfg *config.RunConfig)) bool {
+	describeBlocksMutex.Lock()
+	defer describeBlocksMutex.Unlock()
+
+	describeBlocks = append(describeBlocks, describeBlock{text: text, body: body})
+	return true
+}
+
+// BuildTests adds every container registered with Describe to the Ginkgo tree, configured by cfg. Ginkgo can't remove
+// containers from its tree, so each is only added once.
+func BuildTests(cfg *config.RunConfig) {
+	describeBlocksMutex.Lock()
+	defer describeBlocksMutex.Unlock()
+
+	for _, block := range describeBlocks {
+		body := block.body
+		ginkgo.Describe(block.text, func() {
+			body(cfg)
+		})
+	}
+	describeBlocks = nil
+}
@@ -0,0 +1,25 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+)
+
+func TestBuildTests(t *testing.T) {
+	var built []*config.RunConfig
+	Describe("[Suite: test] Describe", func(cfg *config.RunConfig) {
+		built = append(built, cfg)
+	})
+
+	cfg := &config.RunConfig{}
+	BuildTests(cfg)
+	if len(built) != 1 || built[0] != cfg {
+		t.Fatalf("expected the block to be built once with the run config, got %v", built)
+	}
+
+	BuildTests(&config.RunConfig{})
+	if len(built) != 1 {
+		t.Errorf("expected the block not to be built again, got %v", built)
+	}
+}
@@ -8,13 +8,11 @@ import (
 	"log"
 	"math/rand"
 	"strings"
-	"sync"
 	"text/template"
 	"time"
 
 	"github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	"github.com/spf13/viper"
 
 	projectv1 "github.com/openshift/api/project/v1"
 	v1 "k8s.io/api/core/v1"
@@ -33,26 +31,23 @@ func init() {
 }
 
 // Init is a common helper function to import the run state into Helper
-func Init() *H {
-	h := &H{
-		mutex: sync.Mutex{},
-	}
-	return h
+func Init(cfg *config.RunConfig) *H {
+	return &H{cfg: cfg}
 }
 
-// New instantiates a helper function to be used within a Ginkgo Test block
-func New() *H {
-	h := Init()
+// New instantiates a helper function for the run configured by cfg to be used within a Ginkgo Test block
+func New(cfg *config.RunConfig) *H {
+	h := Init(cfg)
 	ginkgo.BeforeEach(h.SetupWrapper)
 
 	return h
 }
 
 // NewOutsideGinkgo instantiates a helper function while not within a Ginkgo Test Block
-func NewOutsideGinkgo() *H {
+func NewOutsideGinkgo(cfg *config.RunConfig) *H {
 	defer ginkgo.GinkgoRecover()
 
-	h := Init()
+	h := Init(cfg)
 	h.OutsideGinkgo = true
 	err := h.Setup()
 	if err != nil {
@@ -68,9 +63,14 @@ type H struct {
 	OutsideGinkgo  bool
 
 	// internal
+	cfg        *config.RunConfig
 	restConfig *rest.Config
 	proj       *projectv1.Project
-	mutex      sync.Mutex
+}
+
+// Config returns the config of the run the helper is part of.
+func (h *H) Config() *config.RunConfig {
+	return h.cfg
 }
 
 // SetupWrapper is a Ginkgo-Friendly setup function to pass to BeforeEach
@@ -83,14 +83,19 @@ func (h *H) SetupWrapper() {
 func (h *H) Setup() error {
 	var err error
 
-	h.restConfig, err = clientcmd.RESTConfigFromKubeConfig([]byte(viper.GetString(config.Kubeconfig.Contents)))
+	cfg := h.Config()
+	if cfg == nil {
+		return fmt.Errorf("no run config has been set")
+	}
+
+	h.restConfig, err = clientcmd.RESTConfigFromKubeConfig([]byte(cfg.State.Kubeconfig))
 	if h.OutsideGinkgo && err != nil {
 		return fmt.Errorf("error generating restconfig: %s", err.Error())
 	}
 
 	Expect(err).ShouldNot(HaveOccurred(), "failed to configure client")
 
-	project := viper.GetString(config.Project)
+	project := cfg.State.Project
 	if project == "" {
 		// setup project and dedicated-admin account to run tests
 		// the service account is provisioned but only used when specified
@@ -98,7 +103,7 @@ func (h *H) Setup() error {
 		suffix := util.RandomStr(5)
 		project = "osde2e-" + suffix
 
-		viper.Set(config.Project, project)
+		cfg.State.Project = project
 		log.Printf("Setup called for %s", project)
 
 		h.proj, err = h.createProject(suffix)
@@ -123,7 +128,7 @@ func (h *H) Setup() error {
 	}
 
 	// Set the default service account for future helper-method-calls
-	h.SetServiceAccount(viper.GetString(config.Tests.ServiceAccount))
+	h.SetServiceAccount(cfg.Tests.ServiceAccount)
 
 	return nil
 
@@ -133,13 +138,13 @@ func (h *H) Setup() error {
 func (h *H) Cleanup() {
 	var err error
 
-	h.restConfig, err = clientcmd.RESTConfigFromKubeConfig([]byte(viper.GetString(config.Kubeconfig.Contents)))
+	h.restConfig, err = clientcmd.RESTConfigFromKubeConfig([]byte(h.Config().State.Kubeconfig))
 	Expect(err).ShouldNot(HaveOccurred(), "failed to configure client")
 
 	// Set the SA back to the default. This is required for cleanup in case other helper calls switched SAs
-	h.SetServiceAccount(viper.GetString(config.Tests.ServiceAccount))
+	h.SetServiceAccount(h.Config().Tests.ServiceAccount)
 
-	project := viper.GetString(config.Project)
+	project := h.Config().State.Project
 	if h.proj == nil && project != "" {
 		log.Printf("Setting project name to %s", project)
 		h.proj, err = h.Project().ProjectV1().Projects().Get(context.TODO(), project, metav1.GetOptions{})
@@ -289,7 +294,7 @@ func (h *H) SetProjectByName(projectName string) (*H, error) {
 
 // GetWorkloads returns a list of workloads this osde2e run has installed
 func (h *H) GetWorkloads() map[string]string {
-	return viper.GetStringMapString(config.InstalledWorkloads)
+	return h.Config().State.Workloads()
 }
 
 // GetWorkload takes a workload name and returns true or false depending on if it's installed
@@ -303,11 +308,7 @@ func (h *H) GetWorkload(name string) (string, bool) {
 
 // AddWorkload uniquely appends a workload to the workloads list
 func (h *H) AddWorkload(name, project string) {
-	h.mutex.Lock()
-	installedWorkloads := h.GetWorkloads()
-	installedWorkloads[name] = project
-	viper.Set(config.InstalledWorkloads, installedWorkloads)
-	h.mutex.Unlock()
+	h.Config().State.AddWorkload(name, project)
 }
 
 // ConvertTemplateToString takes a template and uses the provided data interface to construct a command string
@@ -323,12 +324,12 @@ func (h *H) ConvertTemplateToString(template *template.Template, data interface{
 func (h *H) InspectState() {
 	var err error
 
-	h.restConfig, err = clientcmd.RESTConfigFromKubeConfig([]byte(viper.GetString(config.Kubeconfig.Contents)))
+	h.restConfig, err = clientcmd.RESTConfigFromKubeConfig([]byte(h.Config().State.Kubeconfig))
 	Expect(err).ShouldNot(HaveOccurred(), "failed to configure client")
 
 	// Set the SA back to the default. This is required for inspection in case other helper calls switched SAs
-	h.SetServiceAccount(viper.GetString(config.Tests.ServiceAccount))
-	project := viper.GetString(config.Project)
+	h.SetServiceAccount(h.Config().Tests.ServiceAccount)
+	project := h.Config().State.Project
 
 	if h.proj == nil && project != "" {
 		log.Printf("Setting project name to %s", project)
@@ -39,6 +39,7 @@ func (h *H) inspect(projectName string) error {
 	r.Name = "osde2e-project"
 	r.Tarball = true
 	stopCh := make(chan struct{})
+	defer r.Cleanup()
 
 	err := r.Run(inspectTimeoutInSeconds, stopCh)
 	if err != nil {
@@ -6,9 +6,7 @@ import (
 	"path/filepath"
 
 	. "github.com/onsi/gomega"
-	"github.com/spf13/viper"
 
-	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/runner"
 )
 
@@ -19,10 +17,15 @@ func (h *H) RunnerWithNoCommand() *runner.Runner {
 	// setup clients
 	r.Kube = h.Kube()
 	r.Image = h.Image()
+	r.RestConfig = h.restConfig
 
 	// setup tests
 	r.Namespace = h.CurrentProject()
 	r.PodSpec.ServiceAccountName = h.GetNamespacedServiceAccount()
+
+	// setup results
+	r.LogDir = filepath.Join(h.Config().State.ReportDir, h.Config().State.Phase)
+	r.LogLines = h.Config().Tests.RunnerLogLines
 	return r
 }
 
@@ -37,7 +40,7 @@ func (h *H) Runner(cmd string) *runner.Runner {
 // WriteResults dumps runner results into the ReportDir.
 func (h *H) WriteResults(results map[string][]byte) {
 	for filename, data := range results {
-		dst := filepath.Join(viper.GetString(config.ReportDir), viper.GetString(config.Phase), filename)
+		dst := filepath.Join(h.Config().State.ReportDir, h.Config().State.Phase, filename)
 		err := os.MkdirAll(filepath.Dir(dst), os.FileMode(0755))
 		Expect(err).NotTo(HaveOccurred())
 		err = ioutil.WriteFile(dst, data, os.ModePerm)
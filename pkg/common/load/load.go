@@ -1,13 +1,15 @@
 package load
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"log"
-	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/markbates/pkger"
 	"github.com/openshift/osde2e/pkg/common/config"
@@ -30,20 +32,31 @@ var defaultConfigs = []string{
 	"log-metrics",
 }
 
-// Configs will populate viper with specified configs.
-func Configs(configs []string, customConfig string, secretLocations []string) error {
+// sources is the config file each key was last loaded from and overrides is where keys set directly in viper
+// came from, both by lower case key name. Viper keys are case insensitive.
+var sources = map[string]string{}
+var overrides = map[string]string{}
+var sourcesMutex = sync.Mutex{}
+
+// Configs will populate viper with specified configs and return the configuration for the run.
+func Configs(configs []string, customConfig string, secretLocations []string) (*config.RunConfig, error) {
 	// This used to be complicated, but now we just lean on Viper for everything.
+	// 0. Make sure the environment variables we bind can be parsed.
+	if err := validateEnv(); err != nil {
+		return nil, err
+	}
+
 	// 1. Load default configs. These are configs that will always be enabled for every run.
 	for _, config := range defaultConfigs {
 		if err := loadYAMLFromConfigs(config); err != nil {
-			return fmt.Errorf("error loading config from YAML: %v", err)
+			return nil, fmt.Errorf("error loading config from YAML: %v", err)
 		}
 	}
 
 	// 2. Load pre-canned YAML configs.
 	for _, config := range configs {
 		if err := loadYAMLFromConfigs(config); err != nil {
-			return fmt.Errorf("error loading config from YAML: %v", err)
+			return nil, fmt.Errorf("error loading config from YAML: %v", err)
 		}
 	}
 
@@ -51,7 +64,7 @@ func Configs(configs []string, customConfig string, secretLocations []string) er
 	if customConfig != "" {
 		log.Printf("Custom YAML config provided, loading from %s", customConfig)
 		if err := loadYAMLFromFile(customConfig); err != nil {
-			return fmt.Errorf("error loading custom config from YAML: %v", err)
+			return nil, fmt.Errorf("error loading custom config from YAML: %v", err)
 		}
 	}
 
@@ -63,28 +76,163 @@ func Configs(configs []string, customConfig string, secretLocations []string) er
 		}
 	}
 
-	// 4. Config post-processing.
+	// 5. Config post-processing.
 	config.PostProcess()
+	if viper.GetString(config.Artifacts) != "" {
+		setSource(overrides, config.ReportDir, Source(config.Artifacts))
+	}
+
+	// 6. Build the run config. Nothing should need to read viper after this.
+	return config.NewRunConfig(), nil
+}
+
+// Source returns where the value of key came from: a secret, an environment variable, a config file or the
+// default. Keys set by command line flags are reported as coming from wherever they would have otherwise.
+func Source(key string) string {
+	sourcesMutex.Lock()
+	override, overridden := overrides[strings.ToLower(key)]
+	source, loaded := sources[strings.ToLower(key)]
+	sourcesMutex.Unlock()
+
+	if overridden {
+		return override
+	}
+
+	schemaKey, known := config.LookupKey(key)
+	if known && schemaKey.Env != "" && os.Getenv(schemaKey.Env) != "" {
+		return "env " + schemaKey.Env
+	}
+
+	if loaded {
+		return source
+	}
+
+	if known && schemaKey.Default != "" {
+		return "default"
+	}
+	return "unset"
+}
+
+// setSource records the source of key in into.
+func setSource(into map[string]string, key, source string) {
+	sourcesMutex.Lock()
+	into[strings.ToLower(key)] = source
+	sourcesMutex.Unlock()
+}
+
+// validateEnv checks that the environment variables bound to keys can be parsed as the key's type.
+func validateEnv() error {
+	var problems []string
+	for _, key := range config.Schema {
+		if key.Env == "" {
+			continue
+		}
+
+		if value := os.Getenv(key.Env); value != "" {
+			if err := key.Check(value); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: %v", key.Env, err))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid environment: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// mergeYAML validates a YAML config against the schema and merges it into viper.
+func mergeYAML(source string, data []byte) error {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("error parsing %s: %v", source, err)
+	}
+
+	var problems []string
+	settings := map[string]interface{}{}
+	for _, name := range v.AllKeys() {
+		path := name
+		key, ok := lookupKey(name)
+		if !ok {
+			if key, ok = config.LookupDeprecatedKey(name); ok {
+				log.Printf("Key %s in %s is deprecated, use %s instead", name, source, key.Name)
+				path = strings.ToLower(key.Name)
+			}
+		}
+		if !ok {
+			problems = append(problems, fmt.Sprintf("unknown key %s", name))
+			continue
+		}
+
+		if err := key.Check(v.Get(name)); err != nil {
+			problems = append(problems, err.Error())
+		}
+		setPath(settings, path, v.Get(name))
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("invalid %s: %s", source, strings.Join(problems, "; "))
+	}
+
+	if err := viper.MergeConfigMap(settings); err != nil {
+		return err
+	}
 
+	for _, name := range v.AllKeys() {
+		key, ok := lookupKey(name)
+		if !ok {
+			key, _ = config.LookupDeprecatedKey(name)
+		}
+		setSource(sources, key.Name, source)
+	}
 	return nil
 }
 
+// setPath sets the value of a key in nested settings, where each part of its name is a level of nesting.
+func setPath(settings map[string]interface{}, path string, value interface{}) {
+	parts := strings.Split(path, ".")
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := settings[part].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			settings[part] = next
+		}
+		settings = next
+	}
+	settings[parts[len(parts)-1]] = value
+}
+
+// lookupKey finds the key for a name from a YAML config. Anything nested under an object key belongs to it.
+func lookupKey(name string) (config.Key, bool) {
+	if key, ok := config.LookupKey(name); ok {
+		return key, true
+	}
+
+	for _, key := range config.Schema {
+		if key.Type == config.TypeObject && strings.HasPrefix(name, strings.ToLower(key.Name)+".") {
+			return key, true
+		}
+	}
+	return config.Key{}, false
+}
+
 // loadYAMLFromConfigs accepts a config name and attempts to unmarshal the config from the /configs directory.
 func loadYAMLFromConfigs(name string) error {
-	var file http.File
-	var err error
-
-	if file, err = pkger.Open(filepath.Join("/configs", name+".yaml")); err != nil {
+	file, err := pkger.Open(filepath.Join("/configs", name+".yaml"))
+	if err != nil {
 		return fmt.Errorf("error trying to open config %s: %v", name, err)
 	}
 
 	defer file.Close()
 
-	if err = viper.MergeConfig(file); err != nil {
-		return err
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return fmt.Errorf("error reading config %s: %v", name, err)
 	}
 
-	return nil
+	return mergeYAML("config "+name, data)
 }
 
 // loadYAMLFromFile accepts file info and attempts to unmarshal the file into the // config.
@@ -103,17 +251,12 @@ func loadYAMLFromFile(name string) error {
 
 	path = filepath.Clean(path)
 
-	fh, err := os.Open(path)
+	data, err := ioutil.ReadFile(path)
 	if err != nil {
 		return err
 	}
-	defer fh.Close()
 
-	if err = viper.MergeConfig(fh); err != nil {
-		return err
-	}
-
-	return nil
+	return mergeYAML("custom config "+path, data)
 }
 
 // loadSecretFileIntoKey will attempt to load the contents of a secret file into the given key.
@@ -129,6 +272,7 @@ func loadSecretFileIntoKey(key string, filename string, secretLocations []string
 			}
 			log.Printf("Found secret for key %s.", key)
 			viper.Set(key, strings.TrimSpace(string(data)))
+			setSource(overrides, key, "secret "+fullFilename)
 			return nil
 		}
 	}
@@ -0,0 +1,102 @@
+package load
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/spf13/viper"
+)
+
+// resetConfig resets viper and the recorded sources of keys once a test is done, so configs it merges don't leak
+// into other tests.
+func resetConfig(t *testing.T) {
+	t.Cleanup(func() {
+		viper.Reset()
+
+		sourcesMutex.Lock()
+		sources = map[string]string{}
+		overrides = map[string]string{}
+		sourcesMutex.Unlock()
+	})
+}
+
+func TestMergeYAML(t *testing.T) {
+	resetConfig(t)
+
+	tests := []struct {
+		Name     string
+		YAML     string
+		Problems []string
+	}{
+		{
+			Name: "valid",
+			YAML: "cluster:\n  multiAZ: true\n  expiryInMinutes: 60\ntests:\n  testsToRun:\n  - '[Suite: e2e]'\nlogMetrics:\n- name: eof\n  regex: EOF\n",
+		},
+		{
+			Name:     "unknown key",
+			YAML:     "cluster:\n  useNewestClusterVersionForInstall: true\n",
+			Problems: []string{"unknown key cluster.usenewestclusterversionforinstall"},
+		},
+		{
+			Name: "deprecated key",
+			YAML: "cluster:\n  useOldestClusterVersionForInstall: true\n",
+		},
+		{
+			Name:     "wrong types",
+			YAML:     "dryRun: often\ncluster:\n  installTimeout: soon\n",
+			Problems: []string{"dryRun must be of type bool", "cluster.installTimeout must be of type int"},
+		},
+	}
+
+	for _, test := range tests {
+		err := mergeYAML("config "+test.Name, []byte(test.YAML))
+		if len(test.Problems) == 0 {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.Name, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Errorf("%s: expected an error", test.Name)
+			continue
+		}
+		for _, problem := range test.Problems {
+			if !strings.Contains(err.Error(), problem) {
+				t.Errorf("%s: expected error to contain %q, got %v", test.Name, problem, err)
+			}
+		}
+	}
+
+	if !viper.GetBool(config.Cluster.MultiAZ) {
+		t.Errorf("expected the valid config to be merged")
+	}
+	if viper.GetBool(config.DryRun) {
+		t.Errorf("expected the invalid config not to be merged")
+	}
+	if !viper.GetBool(config.Cluster.UseOldestClusterImageSetForInstall) {
+		t.Errorf("expected the deprecated key to set %s", config.Cluster.UseOldestClusterImageSetForInstall)
+	}
+	if source := Source(config.Cluster.UseOldestClusterImageSetForInstall); source != "config deprecated key" {
+		t.Errorf("expected %s to come from the config with the deprecated key, got %s", config.Cluster.UseOldestClusterImageSetForInstall, source)
+	}
+}
+
+func TestSource(t *testing.T) {
+	resetConfig(t)
+
+	if err := mergeYAML("config source", []byte("cluster:\n  name: merged\n")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if source := Source(config.Cluster.Name); source != "config source" {
+		t.Errorf("expected the name to come from the config, got %s", source)
+	}
+	if source := Source(config.Cluster.InstallTimeout); source != "default" {
+		t.Errorf("expected the install timeout to come from its default, got %s", source)
+	}
+	if source := Source(config.Upgrade.Image); source != "unset" {
+		t.Errorf("expected the upgrade image to be unset, got %s", source)
+	}
+}
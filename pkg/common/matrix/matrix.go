@@ -0,0 +1,296 @@
+// Package matrix runs the osde2e suite against many clusters at once.
+//
+// osde2e keeps some of the state of a run in global metadata and the Ginkgo suite, so each entry in the
+// matrix is run as a separate osde2e process with its own config, report directory and metadata. Once every
+// entry has finished their results are merged into a single JUnit file and Prometheus file.
+package matrix
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+)
+
+var invalidNameChars = regexp.MustCompile("[^a-zA-Z0-9.-]+")
+
+// Entry is a single combination of version, cloud provider and region. Empty fields use the value from the
+// config.
+type Entry struct {
+	Version       string
+	CloudProvider string
+	Region        string
+}
+
+// Name uniquely identifies the entry. It is used as the name of the entry's report directory.
+func (e Entry) Name() string {
+	var parts []string
+	for _, part := range []string{e.Version, e.CloudProvider, e.Region} {
+		if part != "" {
+			parts = append(parts, invalidNameChars.ReplaceAllString(part, "_"))
+		}
+	}
+
+	if len(parts) == 0 {
+		return "default"
+	}
+	return strings.Join(parts, "-")
+}
+
+// Env returns the environment variables that configure an osde2e process to run the entry.
+func (e Entry) Env(reportDir string) []string {
+	env := []string{
+		"MATRIX_ENTRY=" + e.Name(),
+		"REPORT_DIR=" + reportDir,
+		"ARTIFACTS=" + reportDir,
+	}
+
+	if e.Version != "" {
+		env = append(env, "CLUSTER_VERSION="+e.Version)
+	}
+	if e.CloudProvider != "" {
+		env = append(env, "CLOUD_PROVIDER_ID="+e.CloudProvider)
+	}
+	if e.Region != "" {
+		env = append(env, "CLOUD_PROVIDER_REGION="+e.Region)
+	}
+	return env
+}
+
+// Result is the outcome of running a single entry.
+type Result struct {
+	Entry    Entry
+	Err      error
+	Duration time.Duration
+}
+
+// Enabled returns true if a matrix has been configured and this process isn't already running an entry of it.
+func Enabled(cfg *config.RunConfig) bool {
+	if cfg.Matrix.Entry != "" {
+		return false
+	}
+
+	return cfg.Matrix.Versions != "" || cfg.Matrix.CloudProviders != "" || cfg.Matrix.Regions != ""
+}
+
+// Entries returns every combination of the configured versions, cloud providers and regions.
+func Entries(cfg *config.RunConfig) []Entry {
+	versions := splitList(cfg.Matrix.Versions)
+	cloudProviders := splitList(cfg.Matrix.CloudProviders)
+	regions := splitList(cfg.Matrix.Regions)
+
+	entries := []Entry{}
+	for _, version := range versions {
+		for _, cloudProvider := range cloudProviders {
+			for _, region := range regions {
+				// Regions prefixed with a cloud provider only apply to that cloud provider.
+				if i := strings.Index(region, ":"); i >= 0 {
+					if region[:i] != cloudProvider && cloudProvider != "" {
+						continue
+					}
+					region = region[i+1:]
+				}
+
+				entries = append(entries, Entry{
+					Version:       version,
+					CloudProvider: cloudProvider,
+					Region:        region,
+				})
+			}
+		}
+	}
+
+	return entries
+}
+
+// Run runs command once for every entry in the matrix, merging the results into the report directory.
+// command is the osde2e invocation to run, which is configured for each entry using environment variables.
+func Run(ctx context.Context, cfg *config.RunConfig, command []string) error {
+	if cfg.State.ClusterID != "" || cfg.Kubeconfig.Path != "" {
+		return fmt.Errorf("a matrix can't be run against an existing cluster")
+	}
+
+	if cfg.Resume != "" {
+		return fmt.Errorf("a matrix can't be resumed, resume its entries instead")
+	}
+
+	reportDir := cfg.State.ReportDir
+	if reportDir == "" {
+		var err error
+		if reportDir, err = ioutil.TempDir("", ""); err != nil {
+			return fmt.Errorf("error creating temporary directory: %v", err)
+		}
+
+		log.Printf("Writing files to temporary directory %s", reportDir)
+		cfg.State.ReportDir = reportDir
+	}
+
+	if err := os.MkdirAll(reportDir, os.ModePerm); err != nil {
+		return fmt.Errorf("error creating report directory: %v", err)
+	}
+
+	entries := Entries(cfg)
+	if len(entries) == 0 {
+		return fmt.Errorf("the matrix has no entries")
+	}
+
+	parallelism := cfg.Matrix.Parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	log.Printf("Running %d matrix entries, %d at a time...", len(entries), parallelism)
+
+	results := make([]Result, len(entries))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, entry := range entries {
+		wg.Add(1)
+		go func(i int, entry Entry) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				results[i] = Result{Entry: entry, Err: fmt.Errorf("not started: %v", ctx.Err())}
+				return
+			}
+			defer func() { <-sem }()
+
+			results[i] = runEntry(ctx, command, entry, filepath.Join(reportDir, entry.Name()))
+		}(i, entry)
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			log.Printf("Matrix entry %s failed after %v: %v", result.Entry.Name(), result.Duration.Round(time.Second), result.Err)
+		} else {
+			log.Printf("Matrix entry %s passed after %v", result.Entry.Name(), result.Duration.Round(time.Second))
+		}
+	}
+
+	if err := MergeJUnit(reportDir, results); err != nil {
+		return fmt.Errorf("error merging JUnit results: %v", err)
+	}
+
+	if err := MergePrometheus(reportDir, cfg.JobName, entries); err != nil {
+		return fmt.Errorf("error merging Prometheus metrics: %v", err)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d matrix entries failed", failed, len(entries))
+	}
+	return nil
+}
+
+// runEntry runs command for a single entry, prefixing its output with the entry's name.
+// If ctx is cancelled the process is asked to stop so that it can still clean up after itself.
+func runEntry(ctx context.Context, command []string, entry Entry, reportDir string) (result Result) {
+	result.Entry = entry
+	started := time.Now()
+	defer func() {
+		result.Duration = time.Since(started)
+	}()
+
+	if err := os.MkdirAll(reportDir, os.ModePerm); err != nil {
+		result.Err = fmt.Errorf("error creating report directory: %v", err)
+		return result
+	}
+
+	output := newPrefixWriter(os.Stdout, fmt.Sprintf("[%s] ", entry.Name()))
+	defer output.Close()
+
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Env = append(os.Environ(), entry.Env(reportDir)...)
+	cmd.Stdout = output
+	cmd.Stderr = output
+
+	log.Printf("Starting matrix entry %s...", entry.Name())
+	if err := cmd.Start(); err != nil {
+		result.Err = fmt.Errorf("error starting osde2e: %v", err)
+		return result
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	select {
+	case result.Err = <-done:
+	case <-ctx.Done():
+		log.Printf("Stopping matrix entry %s...", entry.Name())
+		cmd.Process.Signal(syscall.SIGTERM)
+		result.Err = <-done
+		if result.Err == nil {
+			result.Err = ctx.Err()
+		}
+	}
+
+	return result
+}
+
+// prefixWriter writes each line written to it to w with a prefix.
+type prefixWriter struct {
+	*io.PipeWriter
+	done chan struct{}
+}
+
+func newPrefixWriter(w io.Writer, prefix string) *prefixWriter {
+	reader, writer := io.Pipe()
+	p := &prefixWriter{
+		PipeWriter: writer,
+		done:       make(chan struct{}),
+	}
+
+	go func() {
+		defer close(p.done)
+		scanner := bufio.NewScanner(reader)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			fmt.Fprintf(w, "%s%s\n", prefix, scanner.Text())
+		}
+		// Drain anything left so writers never block.
+		io.Copy(ioutil.Discard, reader)
+	}()
+
+	return p
+}
+
+// Close flushes any remaining output.
+func (p *prefixWriter) Close() error {
+	err := p.PipeWriter.Close()
+	<-p.done
+	return err
+}
+
+// splitList splits a comma separated list. An empty list is treated as a single empty item, which uses
+// the value from the config.
+func splitList(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	if len(items) == 0 {
+		return []string{""}
+	}
+	return items
+}
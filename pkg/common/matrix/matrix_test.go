@@ -0,0 +1,132 @@
+package matrix
+
+import (
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/onsi/ginkgo/reporters"
+	"github.com/openshift/osde2e/pkg/common/config"
+)
+
+// fakeOSDE2E writes results like an osde2e run would, failing on GCP.
+const fakeOSDE2E = `#!/bin/sh
+echo "running $CLUSTER_VERSION on $CLOUD_PROVIDER_ID in $CLOUD_PROVIDER_REGION"
+mkdir -p "$REPORT_DIR/install"
+cat > "$REPORT_DIR/install/junit_abc.xml" <<END
+<testsuite name="OSD e2e suite" tests="1" failures="0" errors="0" time="1"><testcase name="[install] test" classname="OSD e2e suite" time="1"></testcase></testsuite>
+END
+cat > "$REPORT_DIR/$MATRIX_ENTRY.job.metrics.prom" <<END
+# TYPE cicd_jUnitResult gauge
+cicd_jUnitResult{cloud_provider="$CLOUD_PROVIDER_ID",testname="test"} 1
+END
+[ "$CLOUD_PROVIDER_ID" != "gcp" ]
+`
+
+func TestEntries(t *testing.T) {
+	cfg := &config.RunConfig{}
+	if Enabled(cfg) {
+		t.Error("expected the matrix to be disabled without any versions, cloud providers or regions")
+	}
+
+	cfg.Matrix.Versions = "openshift-v4.4.9, openshift-v4.5.2"
+	cfg.Matrix.CloudProviders = "aws,gcp"
+	cfg.Matrix.Regions = "aws:us-east-1,aws:eu-west-1,gcp:us-east1"
+
+	if !Enabled(cfg) {
+		t.Error("expected the matrix to be enabled")
+	}
+
+	entries := Entries(cfg)
+	if len(entries) != 6 {
+		t.Fatalf("expected 6 entries, got %v", entries)
+	}
+
+	names := map[string]bool{}
+	for _, entry := range entries {
+		names[entry.Name()] = true
+	}
+	for _, expected := range []string{"openshift-v4.4.9-aws-us-east-1", "openshift-v4.5.2-aws-eu-west-1", "openshift-v4.5.2-gcp-us-east1"} {
+		if !names[expected] {
+			t.Errorf("expected entry %s, got %v", expected, names)
+		}
+	}
+
+	cfg.Matrix.Entry = entries[0].Name()
+	if Enabled(cfg) {
+		t.Error("expected the matrix to be disabled when running an entry")
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "matrix")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	script := filepath.Join(dir, "osde2e")
+	if err = ioutil.WriteFile(script, []byte(fakeOSDE2E), 0700); err != nil {
+		t.Fatalf("error writing fake osde2e: %v", err)
+	}
+
+	reportDir := filepath.Join(dir, "report")
+	cfg := &config.RunConfig{
+		JobName: "job",
+		State:   &config.RunState{ReportDir: reportDir},
+	}
+	cfg.Matrix.Versions = "4.5.2"
+	cfg.Matrix.CloudProviders = "aws,gcp"
+	cfg.Matrix.Parallelism = 2
+
+	if err = Run(context.Background(), cfg, []string{script}); err == nil || !strings.Contains(err.Error(), "1 of 2") {
+		t.Errorf("expected one entry to fail, got %v", err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(reportDir, "junit_matrix.xml"))
+	if err != nil {
+		t.Fatalf("error reading merged JUnit: %v", err)
+	}
+
+	var suite reporters.JUnitTestSuite
+	if err = xml.Unmarshal(data, &suite); err != nil {
+		t.Fatalf("error parsing merged JUnit: %v", err)
+	}
+
+	if suite.Tests != 4 || suite.Failures != 1 {
+		t.Errorf("expected 4 tests with 1 failure, got %d with %d", suite.Tests, suite.Failures)
+	}
+
+	failed := map[string]bool{}
+	for _, testCase := range suite.TestCases {
+		failed[testCase.Name] = testCase.FailureMessage != nil
+	}
+	if entryFailed, ok := failed["[Matrix] 4.5.2-gcp"]; !ok || !entryFailed {
+		t.Errorf("expected the gcp entry to fail, got %v", failed)
+	}
+	if testFailed, ok := failed["[4.5.2-aws] [install] test"]; !ok || testFailed {
+		t.Errorf("expected the aws entry's test to be merged, got %v", failed)
+	}
+
+	metrics, err := ioutil.ReadFile(filepath.Join(reportDir, "matrix.job.metrics.prom"))
+	if err != nil {
+		t.Fatalf("error reading merged metrics: %v", err)
+	}
+
+	for _, expected := range []string{
+		`cicd_jUnitResult{cloud_provider="aws",testname="test",matrix_entry="4.5.2-aws"} 1`,
+		`cicd_jUnitResult{cloud_provider="gcp",testname="test",matrix_entry="4.5.2-gcp"} 1`,
+	} {
+		if !strings.Contains(string(metrics), expected) {
+			t.Errorf("expected merged metrics to contain %s, got:\n%s", expected, metrics)
+		}
+	}
+
+	if strings.Count(string(metrics), "# TYPE cicd_jUnitResult") != 1 {
+		t.Errorf("expected metric families to be merged, got:\n%s", metrics)
+	}
+}
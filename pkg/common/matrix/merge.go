@@ -0,0 +1,196 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/onsi/ginkgo/reporters"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const (
+	// junitFileName is the name of the merged JUnit file.
+	junitFileName = "junit_matrix.xml"
+
+	// prometheusFileNamePattern is the name of the merged Prometheus file, including the job name.
+	prometheusFileNamePattern = "matrix.%s.metrics.prom"
+
+	// entryLabel is the label added to merged metrics to identify the entry they came from.
+	entryLabel = "matrix_entry"
+)
+
+var (
+	junitFileRegex      = regexp.MustCompile("^junit.*\\.xml$")
+	prometheusFileRegex = regexp.MustCompile("^.*\\.metrics\\.prom$")
+)
+
+// MergeJUnit combines the JUnit results of every entry into a single file in the report directory. Test
+// names are prefixed with the entry they came from. Each entry also gets a test case of its own, so that
+// entries which failed before producing any results are still reported.
+func MergeJUnit(reportDir string, results []Result) error {
+	suite := reporters.JUnitTestSuite{
+		Name: "osde2e matrix",
+	}
+
+	for _, result := range results {
+		name := result.Entry.Name()
+
+		testCase := reporters.JUnitTestCase{
+			ClassName: "Matrix",
+			Name:      fmt.Sprintf("[Matrix] %s", name),
+			Time:      result.Duration.Seconds(),
+		}
+		if result.Err != nil {
+			testCase.FailureMessage = &reporters.JUnitFailureMessage{
+				Message: result.Err.Error(),
+			}
+			suite.Failures++
+		} else {
+			testCase.PassedMessage = &reporters.JUnitPassedMessage{
+				Message: "Passed",
+			}
+		}
+		suite.Tests++
+		suite.TestCases = append(suite.TestCases, testCase)
+
+		entrySuites, err := readJUnit(filepath.Join(reportDir, name))
+		if err != nil {
+			return fmt.Errorf("error reading results for %s: %v", name, err)
+		}
+
+		for _, entrySuite := range entrySuites {
+			for _, testCase := range entrySuite.TestCases {
+				testCase.Name = fmt.Sprintf("[%s] %s", name, testCase.Name)
+				suite.TestCases = append(suite.TestCases, testCase)
+			}
+			suite.Tests += entrySuite.Tests
+			suite.Failures += entrySuite.Failures
+			suite.Errors += entrySuite.Errors
+			suite.Time += entrySuite.Time
+		}
+	}
+
+	data, err := xml.Marshal(&suite)
+	if err != nil {
+		return fmt.Errorf("error marshalling JUnit: %v", err)
+	}
+
+	return ioutil.WriteFile(filepath.Join(reportDir, junitFileName), data, 0644)
+}
+
+// readJUnit reads the JUnit files an osde2e run wrote to its report directory and phase directories.
+func readJUnit(reportDir string) ([]reporters.JUnitTestSuite, error) {
+	suites := []reporters.JUnitTestSuite{}
+
+	err := filepath.Walk(reportDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Only the report directory and its phase directories hold results.
+		if info.IsDir() {
+			if rel, _ := filepath.Rel(reportDir, path); rel != "." && strings.Contains(rel, string(filepath.Separator)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !junitFileRegex.MatchString(info.Name()) {
+			return nil
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		var suite reporters.JUnitTestSuite
+		if err = xml.Unmarshal(data, &suite); err != nil {
+			return fmt.Errorf("error parsing %s: %v", path, err)
+		}
+		suites = append(suites, suite)
+		return nil
+	})
+
+	return suites, err
+}
+
+// MergePrometheus combines the Prometheus metrics of every entry into a single file in the report directory.
+// Each metric is labelled with the entry it came from.
+func MergePrometheus(reportDir, jobName string, entries []Entry) error {
+	families := map[string]*dto.MetricFamily{}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		labelName, labelValue := entryLabel, name
+
+		files, err := ioutil.ReadDir(filepath.Join(reportDir, name))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		for _, file := range files {
+			if file.IsDir() || !prometheusFileRegex.MatchString(file.Name()) {
+				continue
+			}
+
+			data, err := ioutil.ReadFile(filepath.Join(reportDir, name, file.Name()))
+			if err != nil {
+				return err
+			}
+
+			var parser expfmt.TextParser
+			entryFamilies, err := parser.TextToMetricFamilies(bytes.NewReader(data))
+			if err != nil {
+				return fmt.Errorf("error parsing metrics for %s: %v", name, err)
+			}
+
+			for familyName, entryFamily := range entryFamilies {
+				family, ok := families[familyName]
+				if !ok {
+					family = &dto.MetricFamily{
+						Name: entryFamily.Name,
+						Help: entryFamily.Help,
+						Type: entryFamily.Type,
+					}
+					families[familyName] = family
+				}
+
+				for _, metric := range entryFamily.Metric {
+					metric.Label = append(metric.Label, &dto.LabelPair{
+						Name:  &labelName,
+						Value: &labelValue,
+					})
+					family.Metric = append(family.Metric, metric)
+				}
+			}
+		}
+	}
+
+	names := []string{}
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := &bytes.Buffer{}
+	encoder := expfmt.NewEncoder(buf, expfmt.FmtText)
+	for _, name := range names {
+		if err := encoder.Encode(families[name]); err != nil {
+			return fmt.Errorf("error encoding metric family: %v", err)
+		}
+	}
+
+	filename := fmt.Sprintf(prometheusFileNamePattern, jobName)
+	return ioutil.WriteFile(filepath.Join(reportDir, filename), buf.Bytes(), 0644)
+}
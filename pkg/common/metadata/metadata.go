@@ -33,20 +33,37 @@ type Metadata struct {
 	UpgradeVersionSource string `json:"upgrade-version-source,omitempty"`
 
 	// Metrics
-	TimeToOCMReportingInstalled float64            `json:"time-to-ocm-reporting-installed,string"`
-	TimeToClusterReady          float64            `json:"time-to-cluster-ready,string"`
-	TimeToUpgradedCluster       float64            `json:"time-to-upgraded-cluster,string"`
-	TimeToUpgradedClusterReady  float64            `json:"time-to-upgraded-cluster-ready,string"`
-	TimeToCertificateIssued     float64            `json:"time-to-certificate-issued,string"`
-	InstallPhasePassRate        float64            `json:"install-phase-pass-rate,string"`
-	UpgradePhasePassRate        float64            `json:"upgrade-phase-pass-rate,string"`
-	LogMetrics                  map[string]int     `json:"log-metrics"`
-	RouteLatencies              map[string]float64 `json:"route-latencies"`
-	RouteThroughputs            map[string]float64 `json:"route-throughputs"`
-	RouteAvailabilities         map[string]float64 `json:"route-availabilities"`
+	TimeToOCMReportingInstalled   float64                `json:"time-to-ocm-reporting-installed,string"`
+	TimeToClusterReady            float64                `json:"time-to-cluster-ready,string"`
+	TimeToUpgradedCluster         float64                `json:"time-to-upgraded-cluster,string"`
+	TimeToUpgradedClusterReady    float64                `json:"time-to-upgraded-cluster-ready,string"`
+	TimeToCertificateIssued       float64                `json:"time-to-certificate-issued,string"`
+	InstallPhasePassRate          float64                `json:"install-phase-pass-rate,string"`
+	UpgradePhasePassRate          float64                `json:"upgrade-phase-pass-rate,string"`
+	LogMetrics                    map[string]int         `json:"log-metrics"`
+	RouteLatencies                map[string]float64     `json:"route-latencies"`
+	RouteThroughputs              map[string]float64     `json:"route-throughputs"`
+	RouteAvailabilities           map[string]float64     `json:"route-availabilities"`
+	UpgradeOperatorTimes          map[string]float64     `json:"upgrade-operator-times"`
+	UpgradeMachineConfigPoolTimes map[string]float64     `json:"upgrade-machine-config-pool-times"`
+	WorkloadDisruptions           map[string]float64     `json:"workload-disruptions"`
+	UpgradeHops                   map[string]*UpgradeHop `json:"upgrade-hops,omitempty"`
 
 	// Internal variables
 	ReportDir string `json:"-"`
+
+	// currentUpgradeHop is the phase of the upgrade hop in progress, if any.
+	currentUpgradeHop string
+}
+
+// UpgradeHop houses the metadata of a single hop of an upgrade path, keyed by the phase of its tests.
+type UpgradeHop struct {
+	Version                string             `json:"version"`
+	TimeToUpgradedCluster  float64            `json:"time-to-upgraded-cluster,string"`
+	PassRate               float64            `json:"pass-rate,string"`
+	OperatorTimes          map[string]float64 `json:"operator-times,omitempty"`
+	MachineConfigPoolTimes map[string]float64 `json:"machine-config-pool-times,omitempty"`
+	WorkloadDisruptions    map[string]float64 `json:"workload-disruptions,omitempty"`
 }
 
 // Instance is the global metadata instance
@@ -60,6 +77,9 @@ func init() {
 	Instance.RouteLatencies = make(map[string]float64)
 	Instance.RouteThroughputs = make(map[string]float64)
 	Instance.RouteAvailabilities = make(map[string]float64)
+	Instance.UpgradeOperatorTimes = make(map[string]float64)
+	Instance.UpgradeMachineConfigPoolTimes = make(map[string]float64)
+	Instance.WorkloadDisruptions = make(map[string]float64)
 }
 
 // Next are a bunch of setter functions that allow us
@@ -125,8 +145,31 @@ func (m *Metadata) SetTimeToClusterReady(timeToClusterReady float64) {
 	m.WriteToJSON(m.ReportDir)
 }
 
-// SetTimeToUpgradedCluster sets the time it took for the cluster to install an upgrade
+// SetUpgradeHop starts recording metadata for a hop of an upgrade path to the given version
+func (m *Metadata) SetUpgradeHop(hopPhase, version string) {
+	if m.UpgradeHops == nil {
+		m.UpgradeHops = make(map[string]*UpgradeHop)
+	}
+	if _, ok := m.UpgradeHops[hopPhase]; !ok {
+		m.UpgradeHops[hopPhase] = &UpgradeHop{PassRate: -1.0}
+	}
+	m.UpgradeHops[hopPhase].Version = version
+	m.currentUpgradeHop = hopPhase
+	m.WriteToJSON(m.ReportDir)
+}
+
+// SetTimeToUpgradedCluster sets the time it took for the cluster to install an upgrade. During an upgrade path
+// it is recorded for the current hop, and the total is the time spent upgrading through every hop so far.
 func (m *Metadata) SetTimeToUpgradedCluster(timeToUpgradedCluster float64) {
+	if hop, ok := m.UpgradeHops[m.currentUpgradeHop]; ok {
+		hop.TimeToUpgradedCluster = timeToUpgradedCluster
+
+		timeToUpgradedCluster = 0
+		for _, hop := range m.UpgradeHops {
+			timeToUpgradedCluster += hop.TimeToUpgradedCluster
+		}
+	}
+
 	m.TimeToUpgradedCluster = timeToUpgradedCluster
 	m.WriteToJSON(m.ReportDir)
 }
@@ -143,12 +186,16 @@ func (m *Metadata) SetTimeToCertificateIssued(timeToCertificateIssued float64) {
 	m.WriteToJSON(m.ReportDir)
 }
 
-// SetPassRate sets the passrate metadata metric for the given phase
+// SetPassRate sets the passrate metadata metric for the given phase. The upgrade phase pass rate of an upgrade
+// path is that of the latest hop.
 func (m *Metadata) SetPassRate(currentPhase string, passRate float64) {
 	if currentPhase == phase.InstallPhase {
 		m.InstallPhasePassRate = passRate
 	} else if currentPhase == phase.UpgradePhase {
 		m.UpgradePhasePassRate = passRate
+	} else if hop, ok := m.UpgradeHops[currentPhase]; ok {
+		hop.PassRate = passRate
+		m.UpgradePhasePassRate = passRate
 	} else {
 		// This is a developer issue, so this should fail ungracefully.
 		panic(fmt.Sprintf("Invalid phase: %s, couldn't set pass rate.", currentPhase))
@@ -195,6 +242,57 @@ func (m *Metadata) SetRouteAvailability(route string, availability float64) {
 	m.WriteToJSON(m.ReportDir)
 }
 
+// SetUpgradeOperatorTime sets how long after the upgrade started the given cluster operator was upgraded
+// (measured in seconds). During an upgrade path it is also recorded for the current hop.
+func (m *Metadata) SetUpgradeOperatorTime(operator string, seconds float64) {
+	if m.UpgradeOperatorTimes == nil {
+		m.UpgradeOperatorTimes = make(map[string]float64)
+	}
+	m.UpgradeOperatorTimes[operator] = seconds
+
+	if hop, ok := m.UpgradeHops[m.currentUpgradeHop]; ok {
+		if hop.OperatorTimes == nil {
+			hop.OperatorTimes = make(map[string]float64)
+		}
+		hop.OperatorTimes[operator] = seconds
+	}
+	m.WriteToJSON(m.ReportDir)
+}
+
+// SetUpgradeMachineConfigPoolTime sets how long the given machine config pool took to roll out the upgrade
+// (measured in seconds). During an upgrade path it is also recorded for the current hop.
+func (m *Metadata) SetUpgradeMachineConfigPoolTime(pool string, seconds float64) {
+	if m.UpgradeMachineConfigPoolTimes == nil {
+		m.UpgradeMachineConfigPoolTimes = make(map[string]float64)
+	}
+	m.UpgradeMachineConfigPoolTimes[pool] = seconds
+
+	if hop, ok := m.UpgradeHops[m.currentUpgradeHop]; ok {
+		if hop.MachineConfigPoolTimes == nil {
+			hop.MachineConfigPoolTimes = make(map[string]float64)
+		}
+		hop.MachineConfigPoolTimes[pool] = seconds
+	}
+	m.WriteToJSON(m.ReportDir)
+}
+
+// SetWorkloadDisruption sets how long the given workload was unavailable during the upgrade
+// (measured in seconds). During an upgrade path it is also recorded for the current hop.
+func (m *Metadata) SetWorkloadDisruption(workload string, seconds float64) {
+	if m.WorkloadDisruptions == nil {
+		m.WorkloadDisruptions = make(map[string]float64)
+	}
+	m.WorkloadDisruptions[workload] = seconds
+
+	if hop, ok := m.UpgradeHops[m.currentUpgradeHop]; ok {
+		if hop.WorkloadDisruptions == nil {
+			hop.WorkloadDisruptions = make(map[string]float64)
+		}
+		hop.WorkloadDisruptions[workload] = seconds
+	}
+	m.WriteToJSON(m.ReportDir)
+}
+
 // WriteToJSON will marshall the metadata struct and write it into the given file.
 func (m *Metadata) WriteToJSON(reportDir string) (err error) {
 	var data []byte
@@ -112,3 +112,47 @@ func writeAndTestMetadata(m *Metadata) (err error) {
 
 	return nil
 }
+
+func TestUpgradeHops(t *testing.T) {
+	m := &Metadata{UpgradeVersion: "openshift-v4.7.2"}
+
+	m.SetUpgradeHop("upgrade-1", "openshift-v4.6.8")
+	m.SetTimeToUpgradedCluster(100)
+	m.SetPassRate("upgrade-1", 0.5)
+
+	m.SetUpgradeHop("upgrade-2", "openshift-v4.7.2")
+	m.SetTimeToUpgradedCluster(200)
+
+	if m.TimeToUpgradedCluster != 300 {
+		t.Errorf("expected the time to upgraded cluster to be the total of every hop, got %v", m.TimeToUpgradedCluster)
+	}
+
+	if hop := m.UpgradeHops["upgrade-1"]; hop.Version != "openshift-v4.6.8" || hop.TimeToUpgradedCluster != 100 || hop.PassRate != 0.5 {
+		t.Errorf("unexpected metadata for the first hop: %+v", hop)
+	}
+
+	if hop := m.UpgradeHops["upgrade-2"]; hop.TimeToUpgradedCluster != 200 || hop.PassRate != -1.0 {
+		t.Errorf("unexpected metadata for the second hop: %+v", hop)
+	}
+
+	m.SetPassRate("upgrade-2", 1)
+	if m.UpgradePhasePassRate != 1 {
+		t.Errorf("expected the upgrade phase pass rate to be that of the latest hop, got %v", m.UpgradePhasePassRate)
+	}
+
+	m.SetUpgradeOperatorTime("kube-apiserver", 50)
+	m.SetUpgradeMachineConfigPoolTime("worker", 150)
+	if m.UpgradeOperatorTimes["kube-apiserver"] != 50 || m.UpgradeMachineConfigPoolTimes["worker"] != 150 {
+		t.Errorf("unexpected upgrade times: %v %v", m.UpgradeOperatorTimes, m.UpgradeMachineConfigPoolTimes)
+	}
+	if hop := m.UpgradeHops["upgrade-2"]; hop.OperatorTimes["kube-apiserver"] != 50 || hop.MachineConfigPoolTimes["worker"] != 150 {
+		t.Errorf("expected upgrade times to be recorded for the current hop: %+v", hop)
+	}
+	if hop := m.UpgradeHops["upgrade-1"]; len(hop.OperatorTimes) != 0 {
+		t.Errorf("expected no upgrade times for the first hop: %+v", hop)
+	}
+
+	if err := writeAndTestMetadata(m); err != nil {
+		t.Errorf("error while testing metadata: %v", err)
+	}
+}
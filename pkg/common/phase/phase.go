@@ -1,9 +1,28 @@
 package phase
 
+import (
+	"fmt"
+	"strings"
+)
+
 const (
 	// InstallPhase is the install phase.
 	InstallPhase = "install"
 
 	// UpgradePhase is the upgrade phase.
 	UpgradePhase = "upgrade"
-)
\ No newline at end of file
+)
+
+// UpgradeHopPhase is the phase of tests run after the given hop of an upgrade path, counting from 1. Upgrading
+// straight to a release is the upgrade phase, while each hop of a longer path has its own phase, e.g. upgrade-2.
+func UpgradeHopPhase(hop, hops int) string {
+	if hops <= 1 {
+		return UpgradePhase
+	}
+	return fmt.Sprintf("%s-%d", UpgradePhase, hop)
+}
+
+// IsUpgradePhase returns true if phase is the upgrade phase or the phase of a hop of an upgrade path.
+func IsUpgradePhase(phase string) bool {
+	return phase == UpgradePhase || strings.HasPrefix(phase, UpgradePhase+"-")
+}
@@ -1,6 +1,7 @@
 package crc
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -19,8 +20,8 @@ import (
 	"github.com/code-ready/crc/pkg/crc/validation"
 	"github.com/code-ready/crc/pkg/crc/version"
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	osde2eConfig "github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
-	"github.com/spf13/viper"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	// Specifically using this for YAMLToJSON
@@ -52,6 +53,7 @@ const (
 
 // Provider for unit testing.
 type Provider struct {
+	cfg         *osde2eConfig.RunConfig
 	env         string
 	clusters    map[string]*spi.Cluster
 	kubeconfigs map[string]string
@@ -67,11 +69,11 @@ func init() {
 		kubeconfigs: map[string]string{},
 	}
 
-	spi.RegisterProvider("crc", func() (spi.Provider, error) { return New() })
+	spi.RegisterProvider("crc", func(cfg *osde2eConfig.RunConfig) (spi.Provider, error) { return New(cfg) })
 }
 
-// New creates a new Provider.
-func New() (*Provider, error) {
+// New creates a new Provider for the run configured by cfg.
+func New(cfg *osde2eConfig.RunConfig) (*Provider, error) {
 	if err := crcConfig.InitViper(); err != nil {
 		logging.Fatal(err.Error())
 	}
@@ -79,19 +81,24 @@ func New() (*Provider, error) {
 	preflight.RegisterSettings()
 	crcConfig.SetDefaults()
 
+	provider.cfg = cfg
 	provider.env = "crc"
 
 	return provider, nil
 }
 
 // LaunchCluster CRCs a launch cluster operation.
-func (m *Provider) LaunchCluster(clusterName string) (string, error) {
+func (m *Provider) LaunchCluster(ctx context.Context, clusterName string) (string, error) {
 	home, err := os.UserHomeDir()
 	if err != nil {
 		log.Fatalf("Error discerning home directory: %s", err.Error())
 	}
 
-	config.PullSecretFile.Name = viper.GetString(CRCPullSecretFile)
+	config.PullSecretFile.Name = m.cfg.CRC.PullSecretFile
+
+	if err := ctx.Err(); err != nil {
+		return "", fmt.Errorf("not starting CRC: %v", err)
+	}
 
 	preflight.SetupHost()
 
@@ -101,7 +108,7 @@ func (m *Provider) LaunchCluster(clusterName string) (string, error) {
 		Name:          ClusterName,
 		Memory:        8196,
 		CPUs:          4,
-		GetPullSecret: getPullSecretFileContent,
+		GetPullSecret: m.getPullSecretFileContent,
 		BundlePath:    filepath.Join(home, BundleCache, fmt.Sprintf("crc_libvirt_%s.crcbundle", OpenShiftVersion)),
 	}
 
@@ -117,7 +124,10 @@ func (m *Provider) LaunchCluster(clusterName string) (string, error) {
 		return "", fmt.Errorf("Unexpected status of the OpenShift cluster: %s", commandResult.Status)
 	}
 
-	wait.PollImmediate(1*time.Second, 1*time.Minute, func() (bool, error) {
+	pollCtx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+	defer cancel()
+
+	wait.PollImmediateUntil(1*time.Second, func() (bool, error) {
 		status, err := machine.Status(machine.ClusterStatusConfig{
 			Name: ClusterName,
 		})
@@ -128,7 +138,7 @@ func (m *Provider) LaunchCluster(clusterName string) (string, error) {
 			return false, nil
 		}
 		return true, nil
-	})
+	}, pollCtx.Done())
 
 	kubeconfig := filepath.Join(home, "/.crc/machines/crc/kubeconfig")
 
@@ -149,8 +159,8 @@ func (m *Provider) LaunchCluster(clusterName string) (string, error) {
 }
 
 // DeleteCluster CRCs a delete cluster operation.
-func (m *Provider) DeleteCluster(clusterID string) error {
-	cluster, err := m.GetCluster(clusterID)
+func (m *Provider) DeleteCluster(ctx context.Context, clusterID string) error {
+	cluster, err := m.GetCluster(ctx, clusterID)
 	if err != nil {
 		return err
 	}
@@ -162,18 +172,18 @@ func (m *Provider) DeleteCluster(clusterID string) error {
 }
 
 // ScaleCluster CRCs a scale cluster operation.
-func (m *Provider) ScaleCluster(clusterID string, numComputeNodes int) error {
+func (m *Provider) ScaleCluster(ctx context.Context, clusterID string, numComputeNodes int) error {
 	return fmt.Errorf("scaling is currently unsupported for CRC clusters")
 }
 
 // ListClusters returns a list of CRC Clusters based on a filter
 // TODO
-func (m *Provider) ListClusters(query string) ([]*spi.Cluster, error) {
+func (m *Provider) ListClusters(ctx context.Context, query string) ([]*spi.Cluster, error) {
 	return nil, nil
 }
 
 // GetCluster CRCs a get cluster operation.
-func (m *Provider) GetCluster(clusterID string) (cluster *spi.Cluster, err error) {
+func (m *Provider) GetCluster(ctx context.Context, clusterID string) (cluster *spi.Cluster, err error) {
 	var ok bool
 	if cluster, ok = m.clusters[clusterID]; !ok {
 		err = fmt.Errorf("Cluster not found: %s", clusterID)
@@ -184,7 +194,7 @@ func (m *Provider) GetCluster(clusterID string) (cluster *spi.Cluster, err error
 // ClusterKubeconfig CRCs a cluster kubeconfig operation.
 // We are looking for a file path for the CRC Kubeconfig. This is in YAML.
 // OSDe2e expects a kubeconfig that is JSON. So, we gotta do some conversion.
-func (m *Provider) ClusterKubeconfig(clusterID string) ([]byte, error) {
+func (m *Provider) ClusterKubeconfig(ctx context.Context, clusterID string) ([]byte, error) {
 	var kubeconfig string
 	var ok bool
 	if kubeconfig, ok = m.kubeconfigs[clusterID]; !ok {
@@ -205,7 +215,7 @@ func (m *Provider) ClusterKubeconfig(clusterID string) ([]byte, error) {
 }
 
 // CheckQuota CRCs a check quota operation.
-func (m *Provider) CheckQuota() (bool, error) {
+func (m *Provider) CheckQuota(ctx context.Context) (bool, error) {
 	if len(m.clusters) > 0 {
 		return false, fmt.Errorf("only one CRC cluster may be used at a time")
 	}
@@ -213,12 +223,12 @@ func (m *Provider) CheckQuota() (bool, error) {
 }
 
 // InstallAddons CRCs an install addons operation.
-func (m *Provider) InstallAddons(clusterID string, addonIDs []string) (int, error) {
+func (m *Provider) InstallAddons(ctx context.Context, clusterID string, addonIDs []string) (int, error) {
 	return 0, nil
 }
 
 // Versions CRCs a versions operation.
-func (m *Provider) Versions() (*spi.VersionList, error) {
+func (m *Provider) Versions(ctx context.Context) (*spi.VersionList, error) {
 	versions := []*spi.Version{
 		spi.NewVersionBuilder().
 			Version(semver.MustParse("4.4.3")).
@@ -233,7 +243,7 @@ func (m *Provider) Versions() (*spi.VersionList, error) {
 }
 
 // Logs is not applicable in a CRC cluster.
-func (m *Provider) Logs(clusterID string) (map[string][]byte, error) {
+func (m *Provider) Logs(ctx context.Context, clusterID string) (map[string][]byte, error) {
 	return nil, nil
 }
 
@@ -243,7 +253,7 @@ func (m *Provider) Environment() string {
 }
 
 // Metrics is a stub function for now
-func (m *Provider) Metrics(clusterID string) (*v1.ClusterMetrics, error) {
+func (m *Provider) Metrics(ctx context.Context, clusterID string) (*v1.ClusterMetrics, error) {
 	return &v1.ClusterMetrics{}, nil
 }
 
@@ -262,21 +272,21 @@ func (m *Provider) Type() string {
 	return "crc"
 }
 
-func getPullSecretFileContent() (string, error) {
-	crcPullSecretFile := viper.GetString(CRCPullSecretFile)
-	if CRCPullSecretFile != "" {
+func (m *Provider) getPullSecretFileContent() (string, error) {
+	crcPullSecretFile := m.cfg.CRC.PullSecretFile
+	crcPullSecret := m.cfg.CRC.PullSecret
+	if crcPullSecretFile != "" {
 		// Read the file content
 		data, err := ioutil.ReadFile(config.PullSecretFile.Name)
 		if err != nil {
 			return "", errors.New(err.Error())
 		}
-		viper.Set(CRCPullSecret, string(data))
+		crcPullSecret = string(data)
 		config.PullSecretFile.Name = crcPullSecretFile
 	} else {
 		return "", fmt.Errorf("no pull secret file set")
 	}
 
-	crcPullSecret := viper.GetString(CRCPullSecret)
 	if crcPullSecret == "" {
 		return "", fmt.Errorf("no pull secret found")
 	}
@@ -287,6 +297,11 @@ func getPullSecretFileContent() (string, error) {
 }
 
 // ExtendExpiry extends the expiration time of an existing cluster
-func (m *Provider) ExtendExpiry(clusterID string, hours uint64, minutes uint64, seconds uint64) error {
+func (m *Provider) ExtendExpiry(ctx context.Context, clusterID string, hours uint64, minutes uint64, seconds uint64) error {
 	return fmt.Errorf("ExtendExpiry is unsupported by CRC clusters")
 }
+
+// UpgradeCluster is unsupported, since CRC clusters can only be upgraded by editing the ClusterVersion.
+func (m *Provider) UpgradeCluster(ctx context.Context, clusterID string, version string, t time.Time) error {
+	return fmt.Errorf("UpgradeCluster is unsupported by CRC clusters")
+}
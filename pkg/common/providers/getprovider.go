@@ -3,11 +3,9 @@ package providers
 import (
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
-	"github.com/spf13/viper"
 )
 
-// ClusterProvider returns the provisioner configured by the config object.
-func ClusterProvider() (spi.Provider, error) {
-	provider := viper.GetString(config.Provider)
-	return spi.GetProvider(provider)
+// ClusterProvider returns the provisioner configured by the run config.
+func ClusterProvider(cfg *config.RunConfig) (spi.Provider, error) {
+	return spi.GetProvider(cfg.Provider, cfg)
 }
@@ -0,0 +1,125 @@
+package local
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/client-go/rest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+)
+
+// envtestCluster is a control plane started by envtest. It's only a kube-apiserver and etcd, so it has no nodes and
+// runs no pods.
+type envtestCluster struct {
+	environment *envtest.Environment
+	kubeconfig  []byte
+}
+
+// startEnvtest starts a control plane named clusterID. envtest looks for the kube-apiserver and etcd binaries in the
+// directory named by KUBEBUILDER_ASSETS, or /usr/local/kubebuilder/bin.
+func (p *Provider) startEnvtest(clusterID string) error {
+	useExistingCluster := false
+	environment := &envtest.Environment{UseExistingCluster: &useExistingCluster}
+
+	restConfig, err := environment.Start()
+	if err != nil {
+		return fmt.Errorf("couldn't start envtest control plane: %v", err)
+	}
+
+	kubeconfig, err := envtestKubeconfig(clusterID, restConfig)
+	if err != nil {
+		if stopErr := environment.Stop(); stopErr != nil {
+			log.Printf("Error stopping envtest control plane '%s': %v", clusterID, stopErr)
+		}
+		return err
+	}
+	log.Printf("Started envtest control plane '%s' at %s", clusterID, restConfig.Host)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.envtests[clusterID] = &envtestCluster{environment: environment, kubeconfig: kubeconfig}
+	return nil
+}
+
+// stopEnvtest stops the control plane named clusterID.
+func (p *Provider) stopEnvtest(clusterID string) error {
+	p.mutex.Lock()
+	cluster, ok := p.envtests[clusterID]
+	delete(p.envtests, clusterID)
+	p.mutex.Unlock()
+
+	if !ok {
+		return fmt.Errorf("cluster not found: %s", clusterID)
+	}
+
+	if err := cluster.environment.Stop(); err != nil {
+		return fmt.Errorf("couldn't stop envtest control plane '%s': %v", clusterID, err)
+	}
+	return nil
+}
+
+// envtestKubeconfigFor returns the kubeconfig of the control plane named clusterID.
+func (p *Provider) envtestKubeconfigFor(clusterID string) ([]byte, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	cluster, ok := p.envtests[clusterID]
+	if !ok {
+		return nil, fmt.Errorf("cluster not found: %s", clusterID)
+	}
+	return cluster.kubeconfig, nil
+}
+
+// envtestClusterIDs returns the names of the running control planes.
+func (p *Provider) envtestClusterIDs() []string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	clusterIDs := []string{}
+	for clusterID := range p.envtests {
+		clusterIDs = append(clusterIDs, clusterID)
+	}
+	return clusterIDs
+}
+
+// envtestKubeconfig turns the client config envtest returns into a kubeconfig whose only context is name.
+func envtestKubeconfig(name string, restConfig *rest.Config) ([]byte, error) {
+	kubeconfig := clientcmdv1.Config{
+		APIVersion: "v1",
+		Kind:       "Config",
+		Clusters: []clientcmdv1.NamedCluster{{
+			Name: name,
+			Cluster: clientcmdv1.Cluster{
+				Server:                   restConfig.Host,
+				CertificateAuthorityData: restConfig.CAData,
+			},
+		}},
+		AuthInfos: []clientcmdv1.NamedAuthInfo{{
+			Name: name,
+			AuthInfo: clientcmdv1.AuthInfo{
+				ClientCertificateData: restConfig.CertData,
+				ClientKeyData:         restConfig.KeyData,
+				Token:                 restConfig.BearerToken,
+				Username:              restConfig.Username,
+				Password:              restConfig.Password,
+			},
+		}},
+		Contexts: []clientcmdv1.NamedContext{{
+			Name: name,
+			Context: clientcmdv1.Context{
+				Cluster:  name,
+				AuthInfo: name,
+			},
+		}},
+		CurrentContext: name,
+	}
+
+	data, err := yaml.Marshal(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't write kubeconfig for envtest control plane '%s': %v", name, err)
+	}
+	return data, nil
+}
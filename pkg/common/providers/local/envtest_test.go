@@ -0,0 +1,64 @@
+package local
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestEnvtestKubeconfig(t *testing.T) {
+	kubeconfig, err := envtestKubeconfig("osde2e-abc", &rest.Config{Host: "http://127.0.0.1:12345"})
+	if err != nil {
+		t.Fatalf("error creating kubeconfig: %v", err)
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		t.Fatalf("error reading kubeconfig: %v", err)
+	}
+	if restConfig.Host != "http://127.0.0.1:12345" {
+		t.Errorf("expected the kubeconfig to point to the control plane, got %s", restConfig.Host)
+	}
+
+	loaded, err := clientcmd.Load(kubeconfig)
+	if err != nil || loaded.CurrentContext != "osde2e-abc" {
+		t.Errorf("expected the current context to be the cluster, got: %v, %v", loaded, err)
+	}
+}
+
+func TestEnvtestMissingBinaries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "local-provider")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	defer os.Setenv("KUBEBUILDER_ASSETS", os.Getenv("KUBEBUILDER_ASSETS"))
+	os.Setenv("KUBEBUILDER_ASSETS", dir)
+
+	cfg := config.NewRunConfig()
+	cfg.Local.Mode = ModeEnvtest
+
+	provider, err := New(cfg)
+	if err != nil {
+		t.Fatalf("error creating provider: %v", err)
+	}
+
+	if _, err = provider.LaunchCluster(context.Background(), "osde2e-abc"); err == nil {
+		t.Error("expected an error starting a control plane without its binaries")
+	}
+
+	clusters, err := provider.ListClusters(context.Background(), "")
+	if err != nil || len(clusters) != 0 {
+		t.Errorf("expected no clusters after failing to start one, got: %v, %v", clusters, err)
+	}
+
+	if err = provider.DeleteCluster(context.Background(), "osde2e-abc"); err == nil {
+		t.Error("expected an error deleting a control plane that wasn't started")
+	}
+}
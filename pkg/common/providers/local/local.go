@@ -0,0 +1,463 @@
+// Package local provides clusters running on the local machine. It can create kind clusters, start envtest
+// control planes, or adopt an existing cluster through its kubeconfig.
+package local
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os/exec"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver"
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/openshift/osde2e/pkg/common/spi"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// ModeKind creates clusters using kind.
+	ModeKind = "kind"
+
+	// ModeKubeconfig adopts the cluster an existing kubeconfig points to.
+	ModeKubeconfig = "kubeconfig"
+
+	// ModeEnvtest starts a kube-apiserver and etcd using envtest.
+	ModeEnvtest = "envtest"
+
+	// CloudProvider indicates that the cluster is running locally.
+	CloudProvider = "local"
+
+	// Region indicates that the cluster is running locally.
+	Region = "local"
+
+	// madeByOSDe2ePrefix is the prefix of cluster names created by osde2e.
+	madeByOSDe2ePrefix = "osde2e-"
+
+	// kindWaitTimeout is how long kind waits for the control plane to become ready.
+	kindWaitTimeout = "5m"
+
+	// apiTimeout is how long to wait for the API server when checking cluster health.
+	apiTimeout = 10 * time.Second
+)
+
+// propertyClauseRegex matches a single "properties.key='value'" clause in a list query.
+var propertyClauseRegex = regexp.MustCompile(`^properties\.(\w+)\s*=\s*'([^']*)'$`)
+
+// Provider manages clusters running on the local machine.
+type Provider struct {
+	cfg            *config.RunConfig
+	mode           string
+	kindBinary     string
+	kindNodeImage  string
+	kubeconfigPath string
+	versions       *spi.VersionList
+
+	// clusterVersions are the versions requested for clusters launched by this provider.
+	clusterVersions map[string]string
+
+	// envtests are the envtest control planes started by this provider.
+	envtests map[string]*envtestCluster
+
+	mutex sync.Mutex
+}
+
+func init() {
+	spi.RegisterProvider("local", func(cfg *config.RunConfig) (spi.Provider, error) { return New(cfg) })
+}
+
+// New creates a new local Provider for the run configured by cfg.
+func New(cfg *config.RunConfig) (*Provider, error) {
+	mode := cfg.Local.Mode
+	if mode != ModeKind && mode != ModeKubeconfig && mode != ModeEnvtest {
+		return nil, fmt.Errorf("unknown local provider mode '%s', must be %s, %s or %s", mode, ModeKind, ModeKubeconfig, ModeEnvtest)
+	}
+
+	versions, err := parseVersions(cfg.Local.Versions)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeconfigPath := cfg.Local.Kubeconfig
+	if kubeconfigPath == "" {
+		kubeconfigPath = clientcmd.NewDefaultClientConfigLoadingRules().GetDefaultFilename()
+	}
+
+	return &Provider{
+		cfg:             cfg,
+		mode:            mode,
+		kindBinary:      cfg.Local.KindBinary,
+		kindNodeImage:   cfg.Local.KindNodeImage,
+		kubeconfigPath:  kubeconfigPath,
+		versions:        versions,
+		clusterVersions: map[string]string{},
+		envtests:        map[string]*envtestCluster{},
+	}, nil
+}
+
+// parseVersions turns a comma separated list of versions into a version list. The last version is the default.
+func parseVersions(versionsString string) (*spi.VersionList, error) {
+	versions := []*spi.Version{}
+	versionStrings := strings.Split(versionsString, ",")
+	for i, versionString := range versionStrings {
+		version, err := semver.NewVersion(strings.TrimSpace(versionString))
+		if err != nil {
+			return nil, fmt.Errorf("invalid local provider version '%s': %v", versionString, err)
+		}
+
+		versions = append(versions, spi.NewVersionBuilder().
+			Version(version).
+			Default(i == len(versionStrings)-1).
+			Build())
+	}
+
+	return spi.NewVersionListBuilder().
+		AvailableVersions(versions).
+		DefaultVersionOverride(nil).
+		Build(), nil
+}
+
+// LaunchCluster creates a kind cluster, starts an envtest control plane, or adopts the cluster in the kubeconfig.
+func (p *Provider) LaunchCluster(ctx context.Context, clusterName string) (string, error) {
+	var clusterID string
+
+	switch p.mode {
+	case ModeKubeconfig:
+		adopted, err := p.adoptedClusterID()
+		if err != nil {
+			return "", err
+		}
+		log.Printf("Adopting cluster '%s' from kubeconfig %s instead of launching %s", adopted, p.kubeconfigPath, clusterName)
+		clusterID = adopted
+	case ModeEnvtest:
+		if err := p.startEnvtest(clusterName); err != nil {
+			return "", err
+		}
+		clusterID = clusterName
+	default:
+		args := []string{"create", "cluster", "--name", clusterName, "--wait", kindWaitTimeout}
+		if p.kindNodeImage != "" {
+			args = append(args, "--image", p.kindNodeImage)
+		}
+
+		if _, err := p.kind(ctx, args...); err != nil {
+			return "", fmt.Errorf("couldn't create kind cluster: %v", err)
+		}
+		clusterID = clusterName
+	}
+
+	// Adopted clusters and envtest control planes run whatever version they have, which GetCluster discovers.
+	if p.mode != ModeKind {
+		return clusterID, nil
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.clusterVersions[clusterID] = p.cfg.State.ClusterVersion
+	return clusterID, nil
+}
+
+// DeleteCluster deletes a kind cluster or stops an envtest control plane. Adopted clusters are left running, since
+// osde2e didn't create them.
+func (p *Provider) DeleteCluster(ctx context.Context, clusterID string) error {
+	switch p.mode {
+	case ModeKubeconfig:
+		log.Printf("Not deleting adopted cluster '%s'", clusterID)
+	case ModeEnvtest:
+		if err := p.stopEnvtest(clusterID); err != nil {
+			return err
+		}
+	default:
+		if _, err := p.kind(ctx, "delete", "cluster", "--name", clusterID); err != nil {
+			return fmt.Errorf("couldn't delete kind cluster '%s': %v", clusterID, err)
+		}
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	delete(p.clusterVersions, clusterID)
+	return nil
+}
+
+// ScaleCluster is unsupported for local clusters.
+func (p *Provider) ScaleCluster(ctx context.Context, clusterID string, numComputeNodes int) error {
+	return fmt.Errorf("scaling is currently unsupported for local clusters")
+}
+
+// ListClusters returns the local clusters matching the query. Only queries made up of "properties.key='value'"
+// clauses joined with "and" are understood; any other clause is ignored.
+func (p *Provider) ListClusters(ctx context.Context, query string) ([]*spi.Cluster, error) {
+	clusterIDs, err := p.clusterIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wantedProperties := map[string]string{}
+	for _, clause := range strings.Split(query, " and ") {
+		if matches := propertyClauseRegex.FindStringSubmatch(strings.TrimSpace(clause)); matches != nil {
+			wantedProperties[matches[1]] = matches[2]
+		}
+	}
+
+	clusters := []*spi.Cluster{}
+	for _, clusterID := range clusterIDs {
+		properties := clusterProperties(clusterID)
+
+		matches := true
+		for key, value := range wantedProperties {
+			if properties[key] != value {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			cluster, err := p.GetCluster(ctx, clusterID)
+			if err != nil {
+				return nil, err
+			}
+			clusters = append(clusters, cluster)
+		}
+	}
+
+	return clusters, nil
+}
+
+// GetCluster returns a local cluster. Its state is determined by whether its API server responds. Clusters this
+// provider didn't launch, like adopted clusters, report the version of their API server.
+func (p *Provider) GetCluster(ctx context.Context, clusterID string) (*spi.Cluster, error) {
+	clusterIDs, err := p.clusterIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	for _, id := range clusterIDs {
+		if id == clusterID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("cluster not found: %s", clusterID)
+	}
+
+	state := spi.ClusterStateReady
+	var version string
+	kubeconfig, err := p.ClusterKubeconfig(ctx, clusterID)
+	if err != nil {
+		log.Printf("Unable to get kubeconfig for cluster '%s': %v", clusterID, err)
+		state = spi.ClusterStateInstalling
+	} else if version, err = serverVersion(kubeconfig); err != nil {
+		log.Printf("API server for cluster '%s' isn't responding: %v", clusterID, err)
+		state = spi.ClusterStateInstalling
+	}
+
+	p.mutex.Lock()
+	if requested := p.clusterVersions[clusterID]; requested != "" {
+		version = requested
+	}
+	p.mutex.Unlock()
+
+	return spi.NewClusterBuilder().
+		ID(clusterID).
+		Name(clusterID).
+		Version(version).
+		State(state).
+		CloudProvider(CloudProvider).
+		Region(Region).
+		Flavour(p.mode).
+		Addons([]string{}).
+		Properties(clusterProperties(clusterID)).
+		Build(), nil
+}
+
+// ClusterKubeconfig returns the kubeconfig for a local cluster.
+func (p *Provider) ClusterKubeconfig(ctx context.Context, clusterID string) ([]byte, error) {
+	if p.mode == ModeKind {
+		kubeconfig, err := p.kind(ctx, "get", "kubeconfig", "--name", clusterID)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get kubeconfig for kind cluster '%s': %v", clusterID, err)
+		}
+		return kubeconfig, nil
+	}
+
+	if p.mode == ModeEnvtest {
+		return p.envtestKubeconfigFor(clusterID)
+	}
+
+	adopted, err := p.adoptedClusterID()
+	if err != nil {
+		return nil, err
+	}
+
+	if adopted != clusterID {
+		return nil, fmt.Errorf("cluster '%s' isn't the current context of kubeconfig %s", clusterID, p.kubeconfigPath)
+	}
+
+	kubeconfig, err := ioutil.ReadFile(p.kubeconfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read kubeconfig %s: %v", p.kubeconfigPath, err)
+	}
+	return kubeconfig, nil
+}
+
+// CheckQuota is always true for adopted clusters and envtest, and true for kind as long as kind is available.
+func (p *Provider) CheckQuota(ctx context.Context) (bool, error) {
+	if p.mode == ModeKind {
+		if _, err := exec.LookPath(p.kindBinary); err != nil {
+			return false, fmt.Errorf("kind isn't available: %v", err)
+		}
+	}
+	return true, nil
+}
+
+// InstallAddons is unsupported for local clusters.
+func (p *Provider) InstallAddons(ctx context.Context, clusterID string, addonIDs []string) (int, error) {
+	for _, addonID := range addonIDs {
+		if addonID != "" {
+			return 0, fmt.Errorf("addons are unsupported for local clusters")
+		}
+	}
+	return 0, nil
+}
+
+// Versions returns the static list of versions from the config.
+func (p *Provider) Versions(ctx context.Context) (*spi.VersionList, error) {
+	return p.versions, nil
+}
+
+// Logs is not applicable to local clusters.
+func (p *Provider) Logs(ctx context.Context, clusterID string) (map[string][]byte, error) {
+	return map[string][]byte{}, nil
+}
+
+// Environment returns the mode the local provider is running in.
+func (p *Provider) Environment() string {
+	return p.mode
+}
+
+// Metrics is not applicable to local clusters.
+func (p *Provider) Metrics(ctx context.Context, clusterID string) (*v1.ClusterMetrics, error) {
+	return &v1.ClusterMetrics{}, nil
+}
+
+// UpgradeSource returns Cincinnati, although local clusters can't be upgraded.
+func (p *Provider) UpgradeSource() spi.UpgradeSource {
+	return spi.CincinnatiSource
+}
+
+// CincinnatiChannel returns the stable channel, although local clusters can't be upgraded.
+func (p *Provider) CincinnatiChannel() spi.CincinnatiChannel {
+	return spi.CincinnatiStableChannel
+}
+
+// Type returns the provisioner type: local
+func (p *Provider) Type() string {
+	return "local"
+}
+
+// ExtendExpiry is unsupported, since local clusters don't expire.
+func (p *Provider) ExtendExpiry(ctx context.Context, clusterID string, hours uint64, minutes uint64, seconds uint64) error {
+	return fmt.Errorf("ExtendExpiry is unsupported by local clusters")
+}
+
+// UpgradeCluster is unsupported, since local clusters can only be upgraded by editing the ClusterVersion.
+func (p *Provider) UpgradeCluster(ctx context.Context, clusterID string, version string, t time.Time) error {
+	return fmt.Errorf("UpgradeCluster is unsupported by local clusters")
+}
+
+// clusterIDs returns the IDs of all local clusters.
+func (p *Provider) clusterIDs(ctx context.Context) ([]string, error) {
+	if p.mode == ModeKubeconfig {
+		clusterID, err := p.adoptedClusterID()
+		if err != nil {
+			return nil, err
+		}
+		return []string{clusterID}, nil
+	}
+
+	if p.mode == ModeEnvtest {
+		return p.envtestClusterIDs(), nil
+	}
+
+	output, err := p.kind(ctx, "get", "clusters")
+	if err != nil {
+		return nil, fmt.Errorf("couldn't list kind clusters: %v", err)
+	}
+
+	clusterIDs := []string{}
+	for _, line := range strings.Split(string(output), "\n") {
+		// kind reports "No kind clusters found." on stderr, so only cluster names end up here.
+		if line = strings.TrimSpace(line); line != "" {
+			clusterIDs = append(clusterIDs, line)
+		}
+	}
+	return clusterIDs, nil
+}
+
+// adoptedClusterID returns the ID of the adopted cluster, which is the current context of the kubeconfig.
+func (p *Provider) adoptedClusterID() (string, error) {
+	kubeconfig, err := clientcmd.LoadFromFile(p.kubeconfigPath)
+	if err != nil {
+		return "", fmt.Errorf("couldn't load kubeconfig %s: %v", p.kubeconfigPath, err)
+	}
+
+	if kubeconfig.CurrentContext == "" {
+		return "", fmt.Errorf("kubeconfig %s has no current context", p.kubeconfigPath)
+	}
+	return kubeconfig.CurrentContext, nil
+}
+
+// kind runs kind with the given arguments and returns its output.
+func (p *Provider) kind(ctx context.Context, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, p.kindBinary, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("'%s %s' failed: %v: %s", p.kindBinary, strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// clusterProperties returns the properties of a local cluster. Clusters osde2e named are considered made by osde2e.
+func clusterProperties(clusterID string) map[string]string {
+	properties := map[string]string{}
+	if strings.HasPrefix(clusterID, madeByOSDe2ePrefix) {
+		properties["MadeByOSDe2e"] = "true"
+	}
+	return properties
+}
+
+// serverVersion returns the version reported by the /version endpoint of the API server in the kubeconfig. It
+// returns an error if the API server doesn't respond.
+func serverVersion(kubeconfig []byte) (string, error) {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return "", fmt.Errorf("invalid kubeconfig: %v", err)
+	}
+	restConfig.Timeout = apiTimeout
+
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return "", fmt.Errorf("couldn't create client: %v", err)
+	}
+
+	info, err := kubeClient.Discovery().ServerVersion()
+	if err != nil {
+		return "", err
+	}
+	return info.GitVersion, nil
+}
@@ -0,0 +1,193 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/openshift/osde2e/pkg/common/spi"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const kubeconfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- name: %[2]s
+  cluster:
+    server: %[1]s
+contexts:
+- name: %[2]s
+  context:
+    cluster: %[2]s
+    user: %[2]s
+current-context: %[2]s
+users:
+- name: %[2]s
+  user:
+    token: fake
+`
+
+// fakeKind is a kind replacement that knows about two clusters and records the commands it is given.
+const fakeKind = `#!/bin/sh
+echo "$@" >> "$(dirname "$0")/commands"
+case "$1 $2" in
+  "get clusters")
+    printf 'osde2e-abc\nsomeone-else\n' ;;
+  "get kubeconfig")
+    cat "$(dirname "$0")/kubeconfig" ;;
+esac
+`
+
+func newAPIServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"major":"1","minor":"18","gitVersion":"v1.18.2"}`))
+	}))
+}
+
+func TestAdoptKubeconfig(t *testing.T) {
+	server := newAPIServer()
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "local-provider")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	kubeconfigPath := filepath.Join(dir, "kubeconfig")
+	if err = ioutil.WriteFile(kubeconfigPath, []byte(fmt.Sprintf(kubeconfigTemplate, server.URL, "envtest")), 0600); err != nil {
+		t.Fatalf("error writing kubeconfig: %v", err)
+	}
+
+	cfg := config.NewRunConfig()
+	cfg.Local.Mode = ModeKubeconfig
+	cfg.Local.Kubeconfig = kubeconfigPath
+	cfg.Local.Versions = "4.4.0,4.5.0"
+	cfg.State.ClusterVersion = "openshift-v4.5.0"
+	provider, err := New(cfg)
+	if err != nil {
+		t.Fatalf("error creating provider: %v", err)
+	}
+
+	clusterID, err := provider.LaunchCluster(context.Background(), "osde2e-abc")
+	if err != nil || clusterID != "envtest" {
+		t.Fatalf("expected the envtest cluster to be adopted, got: %s, %v", clusterID, err)
+	}
+
+	cluster, err := provider.GetCluster(context.Background(), clusterID)
+	if err != nil {
+		t.Fatalf("error getting cluster: %v", err)
+	}
+	if cluster.State() != spi.ClusterStateReady {
+		t.Errorf("expected cluster to be ready, got %s", cluster.State())
+	}
+	if cluster.Version() != "v1.18.2" {
+		t.Errorf("expected the adopted cluster to report the version of its API server, got %s", cluster.Version())
+	}
+
+	kubeconfig, err := provider.ClusterKubeconfig(context.Background(), clusterID)
+	if err != nil {
+		t.Fatalf("error getting kubeconfig: %v", err)
+	}
+	if restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig); err != nil || restConfig.Host != server.URL {
+		t.Errorf("invalid kubeconfig provided: %v", err)
+	}
+
+	// The adopted cluster wasn't made by osde2e, so it must never be cleaned up.
+	if clusters, err := provider.ListClusters(context.Background(), "properties.MadeByOSDe2e='true'"); err != nil || len(clusters) != 0 {
+		t.Errorf("expected no clusters made by osde2e, got: %v, %v", clusters, err)
+	}
+
+	versions, err := provider.Versions(context.Background())
+	if err != nil {
+		t.Fatalf("error getting versions: %v", err)
+	}
+	if len(versions.AvailableVersions()) != 2 || versions.Default().String() != "4.5.0" {
+		t.Errorf("unexpected versions: %v", versions.AvailableVersions())
+	}
+
+	server.Close()
+	if cluster, err = provider.GetCluster(context.Background(), clusterID); err != nil || cluster.State() != spi.ClusterStateInstalling {
+		t.Errorf("expected cluster to not be ready once the API server is gone, got: %v, %v", cluster, err)
+	}
+}
+
+func TestKind(t *testing.T) {
+	server := newAPIServer()
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "local-provider")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	kindPath := filepath.Join(dir, "kind")
+	if err = ioutil.WriteFile(kindPath, []byte(fakeKind), 0700); err != nil {
+		t.Fatalf("error writing fake kind: %v", err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "kubeconfig"), []byte(fmt.Sprintf(kubeconfigTemplate, server.URL, "kind-osde2e-abc")), 0600); err != nil {
+		t.Fatalf("error writing kubeconfig: %v", err)
+	}
+
+	cfg := config.NewRunConfig()
+	cfg.Local.Mode = ModeKind
+	cfg.Local.KindBinary = kindPath
+	cfg.Local.KindNodeImage = "kindest/node:v1.18.2"
+	cfg.Local.Versions = "4.5.0"
+
+	provider, err := New(cfg)
+	if err != nil {
+		t.Fatalf("error creating provider: %v", err)
+	}
+
+	if hasQuota, err := provider.CheckQuota(context.Background()); !hasQuota || err != nil {
+		t.Errorf("expected quota when kind is available, got: %v, %v", hasQuota, err)
+	}
+
+	clusterID, err := provider.LaunchCluster(context.Background(), "osde2e-abc")
+	if err != nil || clusterID != "osde2e-abc" {
+		t.Fatalf("error launching cluster: %s, %v", clusterID, err)
+	}
+
+	clusters, err := provider.ListClusters(context.Background(), "properties.MadeByOSDe2e='true'")
+	if err != nil {
+		t.Fatalf("error listing clusters: %v", err)
+	}
+	if len(clusters) != 1 || clusters[0].ID() != "osde2e-abc" || clusters[0].State() != spi.ClusterStateReady {
+		t.Errorf("expected only the ready osde2e cluster to be listed, got %v", clusters)
+	}
+
+	if existing, err := provider.GetCluster(context.Background(), "someone-else"); err != nil || existing.Version() != "v1.18.2" {
+		t.Errorf("expected a kind cluster osde2e didn't launch to report the version of its API server, got: %v, %v", existing, err)
+	}
+
+	if _, err = provider.GetCluster(context.Background(), "missing"); err == nil {
+		t.Error("expected an error getting a cluster kind doesn't know about")
+	}
+
+	if err = provider.DeleteCluster(context.Background(), clusterID); err != nil {
+		t.Fatalf("error deleting cluster: %v", err)
+	}
+
+	commands, err := ioutil.ReadFile(filepath.Join(dir, "commands"))
+	if err != nil {
+		t.Fatalf("error reading kind commands: %v", err)
+	}
+	for _, expected := range []string{
+		"create cluster --name osde2e-abc --wait 5m --image kindest/node:v1.18.2",
+		"delete cluster --name osde2e-abc",
+	} {
+		if !strings.Contains(string(commands), expected) {
+			t.Errorf("expected kind to be run with '%s', got:\n%s", expected, commands)
+		}
+	}
+}
@@ -1,32 +1,31 @@
 package moaprovider
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"time"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/openshift/moactl/pkg/cluster"
-	"github.com/openshift/osde2e/pkg/common/config"
-	"github.com/spf13/viper"
 )
 
 // LaunchCluster will provision an AWS cluster.
-func (m *MOAProvider) LaunchCluster(clusterName string) (string, error) {
+func (m *MOAProvider) LaunchCluster(ctx context.Context, clusterName string) (string, error) {
 	clustersClient := m.ocmProvider.GetConnection().ClustersMgmt().V1().Clusters()
 
 	// Calculate an expiration date for the cluster so that it will be automatically deleted if
 	// we happen to forget to do it:
 	var expiration time.Time
 
-	expiryInMinutes := viper.GetDuration(config.Cluster.ExpiryInMinutes)
+	expiryInMinutes := time.Duration(m.cfg.Cluster.ExpiryInMinutes)
 	if expiryInMinutes > 0 {
 		expiration = time.Now().Add(expiryInMinutes * time.Minute).UTC() // UTC() to workaround SDA-1567.
 	}
 
 	var err error
 	var machineCIDRParsed = &net.IPNet{}
-	machineCIDRString := viper.GetString(MachineCIDR)
+	machineCIDRString := m.cfg.MOA.MachineCIDR
 	if machineCIDRString != "" {
 		_, machineCIDRParsed, err = net.ParseCIDR(machineCIDRString)
 
@@ -36,9 +35,9 @@ func (m *MOAProvider) LaunchCluster(clusterName string) (string, error) {
 	}
 
 	var serviceCIDRParsed = &net.IPNet{}
-	serviceCIDRString := viper.GetString(ServiceCIDR)
+	serviceCIDRString := m.cfg.MOA.ServiceCIDR
 	if serviceCIDRString != "" {
-		_, serviceCIDRParsed, err = net.ParseCIDR(ServiceCIDR)
+		_, serviceCIDRParsed, err = net.ParseCIDR(serviceCIDRString)
 
 		if err != nil {
 			return "", fmt.Errorf("error while parsing service CIDR: %v", err)
@@ -46,7 +45,7 @@ func (m *MOAProvider) LaunchCluster(clusterName string) (string, error) {
 	}
 
 	var podCIDRParsed = &net.IPNet{}
-	podCIDRString := viper.GetString(PodCIDR)
+	podCIDRString := m.cfg.MOA.PodCIDR
 	if podCIDRString != "" {
 		_, podCIDRParsed, err = net.ParseCIDR(podCIDRString)
 
@@ -63,21 +62,26 @@ func (m *MOAProvider) LaunchCluster(clusterName string) (string, error) {
 
 	var createdCluster *cmv1.Cluster
 
-	callAndSetAWSSession(func() {
+	// moactl doesn't accept a context, so the best we can do is refuse to start a creation we no longer want.
+	if err = ctx.Err(); err != nil {
+		return "", fmt.Errorf("not creating cluster: %v", err)
+	}
+
+	m.callAndSetAWSSession(func() {
 		clusterSpec := cluster.Spec{
 			Name:               clusterName,
-			Region:             viper.GetString(config.CloudProvider.Region),
-			MultiAZ:            viper.GetBool(config.Cluster.MultiAZ),
-			Version:            viper.GetString(config.Cluster.Version),
+			Region:             m.cfg.CloudProvider.Region,
+			MultiAZ:            m.cfg.Cluster.MultiAZ,
+			Version:            m.cfg.State.ClusterVersion,
 			Expiration:         expiration,
-			ComputeMachineType: viper.GetString(ComputeMachineType),
-			ComputeNodes:       viper.GetInt(ComputeNodes),
+			ComputeMachineType: m.cfg.MOA.ComputeMachineType,
+			ComputeNodes:       m.cfg.MOA.ComputeNodes,
 
 			CustomProperties: clusterProperties,
 			MachineCIDR:      *machineCIDRParsed,
 			ServiceCIDR:      *serviceCIDRParsed,
 			PodCIDR:          *podCIDRParsed,
-			HostPrefix:       viper.GetInt(HostPrefix),
+			HostPrefix:       m.cfg.MOA.HostPrefix,
 		}
 
 		createdCluster, err = cluster.CreateCluster(clustersClient, clusterSpec)
@@ -4,29 +4,31 @@ package moaprovider
 import (
 	"fmt"
 
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/providers/ocmprovider"
 	"github.com/openshift/osde2e/pkg/common/spi"
-	"github.com/spf13/viper"
 )
 
 func init() {
-	spi.RegisterProvider("moa", func() (spi.Provider, error) { return New() })
+	spi.RegisterProvider("moa", func(cfg *config.RunConfig) (spi.Provider, error) { return New(cfg) })
 }
 
 // MOAProvider will provision clusters via MOA.
 type MOAProvider struct {
+	cfg         *config.RunConfig
 	ocmProvider *ocmprovider.OCMProvider
 }
 
-// New will create a new MOAProvider.
-func New() (*MOAProvider, error) {
-	ocmProvider, err := ocmprovider.NewWithEnv(viper.GetString(Env))
+// New will create a new MOAProvider for the run configured by cfg.
+func New(cfg *config.RunConfig) (*MOAProvider, error) {
+	ocmProvider, err := ocmprovider.NewWithEnv(cfg, cfg.MOA.Env)
 
 	if err != nil {
 		return nil, fmt.Errorf("error creating OCM provider for MOA provider: %v", err)
 	}
 
 	return &MOAProvider{
+		cfg:         cfg,
 		ocmProvider: ocmProvider,
 	}, nil
 }
@@ -3,13 +3,11 @@ package moaprovider
 import (
 	"os"
 	"strings"
-
-	"github.com/spf13/viper"
 )
 
 // At the moment, moactl requires AWS sessions to be set globally. To get around that, we'll use this
 // helper method here so that we can set environment variables and restore them before returning from the function.
-func callAndSetAWSSession(f func()) {
+func (m *MOAProvider) callAndSetAWSSession(f func()) {
 	var env []string
 	defer func() {
 		os.Clearenv()
@@ -20,9 +18,9 @@ func callAndSetAWSSession(f func()) {
 	}()
 
 	env = os.Environ()
-	os.Setenv("AWS_ACCESS_KEY_ID", viper.GetString(AWSAccessKeyID))
-	os.Setenv("AWS_SECRET_ACCESS_KEY", viper.GetString(AWSSecretAccessKey))
-	os.Setenv("AWS_REGION", viper.GetString(AWSRegion))
+	os.Setenv("AWS_ACCESS_KEY_ID", m.cfg.MOA.AWSAccessKeyID)
+	os.Setenv("AWS_SECRET_ACCESS_KEY", m.cfg.MOA.AWSSecretAccessKey)
+	os.Setenv("AWS_REGION", m.cfg.MOA.AWSRegion)
 
 	f()
 }
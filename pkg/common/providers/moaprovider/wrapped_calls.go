@@ -1,6 +1,9 @@
 package moaprovider
 
 import (
+	"context"
+	"time"
+
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/openshift/osde2e/pkg/common/spi"
 )
@@ -8,48 +11,48 @@ import (
 // The rest of the SPI functions will be wrapped by the OCM provider until the MOA provider can be adequately refactored.
 
 // DeleteCluster will call DeleteCluster from the OCM provider.
-func (m *MOAProvider) DeleteCluster(clusterID string) error {
-	return m.ocmProvider.DeleteCluster(clusterID)
+func (m *MOAProvider) DeleteCluster(ctx context.Context, clusterID string) error {
+	return m.ocmProvider.DeleteCluster(ctx, clusterID)
 }
 
 // ScaleCluster will call ScaleCluster from the OCM provider.
-func (m *MOAProvider) ScaleCluster(clusterID string, numComputeNodes int) error {
-	return m.ocmProvider.ScaleCluster(clusterID, numComputeNodes)
+func (m *MOAProvider) ScaleCluster(ctx context.Context, clusterID string, numComputeNodes int) error {
+	return m.ocmProvider.ScaleCluster(ctx, clusterID, numComputeNodes)
 }
 
 // ListClusters will call ListClusters from the OCM provider.
-func (m *MOAProvider) ListClusters(query string) ([]*spi.Cluster, error) {
-	return m.ocmProvider.ListClusters(query)
+func (m *MOAProvider) ListClusters(ctx context.Context, query string) ([]*spi.Cluster, error) {
+	return m.ocmProvider.ListClusters(ctx, query)
 }
 
 // GetCluster will call GetCluster from the OCM provider.
-func (m *MOAProvider) GetCluster(clusterID string) (*spi.Cluster, error) {
-	return m.ocmProvider.GetCluster(clusterID)
+func (m *MOAProvider) GetCluster(ctx context.Context, clusterID string) (*spi.Cluster, error) {
+	return m.ocmProvider.GetCluster(ctx, clusterID)
 }
 
 // ClusterKubeconfig will call ClusterKubeconfig from the OCM provider.
-func (m *MOAProvider) ClusterKubeconfig(clusterID string) ([]byte, error) {
-	return m.ocmProvider.ClusterKubeconfig(clusterID)
+func (m *MOAProvider) ClusterKubeconfig(ctx context.Context, clusterID string) ([]byte, error) {
+	return m.ocmProvider.ClusterKubeconfig(ctx, clusterID)
 }
 
 // CheckQuota will call CheckQuota from the OCM provider.
-func (m *MOAProvider) CheckQuota() (bool, error) {
-	return m.ocmProvider.CheckQuota()
+func (m *MOAProvider) CheckQuota(ctx context.Context) (bool, error) {
+	return m.ocmProvider.CheckQuota(ctx)
 }
 
 // InstallAddons will call InstallAddons from the OCM provider.
-func (m *MOAProvider) InstallAddons(clusterID string, addonIDs []string) (int, error) {
-	return m.ocmProvider.InstallAddons(clusterID, addonIDs)
+func (m *MOAProvider) InstallAddons(ctx context.Context, clusterID string, addonIDs []string) (int, error) {
+	return m.ocmProvider.InstallAddons(ctx, clusterID, addonIDs)
 }
 
 // Versions will call Versions from the OCM provider.
-func (m *MOAProvider) Versions() (*spi.VersionList, error) {
-	return m.ocmProvider.Versions()
+func (m *MOAProvider) Versions(ctx context.Context) (*spi.VersionList, error) {
+	return m.ocmProvider.Versions(ctx)
 }
 
 // Logs will call Logs from the OCM provider.
-func (m *MOAProvider) Logs(clusterID string) (map[string][]byte, error) {
-	return m.ocmProvider.Logs(clusterID)
+func (m *MOAProvider) Logs(ctx context.Context, clusterID string) (map[string][]byte, error) {
+	return m.ocmProvider.Logs(ctx, clusterID)
 }
 
 // Environment will call Environment from the OCM provider.
@@ -58,8 +61,8 @@ func (m *MOAProvider) Environment() string {
 }
 
 // Metrics will call Metrics from the OCM provider.
-func (m *MOAProvider) Metrics(clusterID string) (*v1.ClusterMetrics, error) {
-	return m.ocmProvider.Metrics(clusterID)
+func (m *MOAProvider) Metrics(ctx context.Context, clusterID string) (*v1.ClusterMetrics, error) {
+	return m.ocmProvider.Metrics(ctx, clusterID)
 }
 
 // UpgradeSource will call UpgradeSource from the OCM provider.
@@ -73,6 +76,11 @@ func (m *MOAProvider) CincinnatiChannel() spi.CincinnatiChannel {
 }
 
 // ExtendExpiry extends the expiration time of an existing cluster
-func (m *MOAProvider) ExtendExpiry(clusterID string, hours uint64, minutes uint64, seconds uint64) error {
-	return m.ocmProvider.ExtendExpiry(clusterID, hours, minutes, seconds)
+func (m *MOAProvider) ExtendExpiry(ctx context.Context, clusterID string, hours uint64, minutes uint64, seconds uint64) error {
+	return m.ocmProvider.ExtendExpiry(ctx, clusterID, hours, minutes, seconds)
+}
+
+// UpgradeCluster will call UpgradeCluster from the OCM provider.
+func (m *MOAProvider) UpgradeCluster(ctx context.Context, clusterID string, version string, t time.Time) error {
+	return m.ocmProvider.UpgradeCluster(ctx, clusterID, version, t)
 }
@@ -1,6 +0,0 @@
-package mock
-
-const (
-	// Env is the mock environment.
-	Env = "mock.env"
-)
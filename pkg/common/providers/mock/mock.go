@@ -1,9 +1,13 @@
 package mock
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"regexp"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Masterminds/semver"
@@ -12,7 +16,6 @@ import (
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
-	"github.com/spf13/viper"
 )
 
 const (
@@ -21,23 +24,128 @@ const (
 
 	// MockRegion indicates that the region used is just a mock.
 	MockRegion = "mock-region"
+
+	// mockFlavour is the flavour given to all mock clusters.
+	mockFlavour = "osd-4"
+
+	// defaultComputeNodes is the number of compute nodes a newly launched mock cluster has.
+	defaultComputeNodes = 4
 )
 
+// propertyClauseRegex matches a single "properties.key='value'" clause in a list query.
+var propertyClauseRegex = regexp.MustCompile(`^properties\.(\w+)\s*=\s*'([^']*)'$`)
+
 // MockProvider for unit testing.
 type MockProvider struct {
+	cfg      *config.RunConfig
 	env      string
-	clusters map[string]*spi.Cluster
+	clusters map[string]*mockCluster
 	versions *spi.VersionList
+	scenario *Scenario
+
+	// calls counts how many times each method has been called, for error injection.
+	calls map[string]int
+
+	// now is the clock used for state transitions.
+	now func() time.Time
+
+	mutex sync.Mutex
+}
+
+// mockCluster is the internal state of a mock cluster.
+type mockCluster struct {
+	id              string
+	name            string
+	version         string
+	launched        time.Time
+	state           spi.ClusterState
+	expiration      time.Time
+	addons          []string
+	numComputeNodes int
+	properties      map[string]string
+
+	// upgradeVersion is the version the cluster reports once upgradeAt has passed.
+	upgradeVersion string
+	upgradeAt      time.Time
 }
 
 func init() {
-	spi.RegisterProvider("mock", func() (spi.Provider, error) { return New() })
+	spi.RegisterProvider("mock", func(cfg *config.RunConfig) (spi.Provider, error) { return New(cfg) })
+}
+
+// New creates a new MockProvider. If a scenario file is configured, it will drive the provider's behaviour.
+func New(cfg *config.RunConfig) (*MockProvider, error) {
+	scenario := &Scenario{}
+
+	if cfg.Mock.ScenarioFile != "" {
+		var err error
+		if scenario, err = LoadScenario(cfg.Mock.ScenarioFile); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.Mock.Env != "" {
+		scenario.Environment = cfg.Mock.Env
+	}
+
+	return NewWithScenario(cfg, scenario)
 }
 
-// New creates a new MockProvider.
-func New() (*MockProvider, error) {
-	env := viper.GetString(Env)
-	// Here we set a default
+// NewWithScenario creates a new MockProvider driven by the given scenario.
+func NewWithScenario(cfg *config.RunConfig, scenario *Scenario) (*MockProvider, error) {
+	if err := scenario.validate(); err != nil {
+		return nil, fmt.Errorf("invalid mock scenario: %v", err)
+	}
+
+	m := &MockProvider{
+		cfg:      cfg,
+		env:      scenario.Environment,
+		clusters: map[string]*mockCluster{},
+		scenario: scenario,
+		calls:    map[string]int{},
+		now:      time.Now,
+	}
+
+	if len(scenario.Versions) > 0 {
+		versionList, err := scenario.versionList()
+		if err != nil {
+			return nil, err
+		}
+		m.versions = versionList
+	} else {
+		m.versions = defaultVersionList()
+	}
+
+	for _, c := range scenario.Clusters {
+		state := c.State
+		if state == "" {
+			state = spi.ClusterStateReady
+		}
+
+		// Clusters that don't say when they expire last as long as clusters launched by the mock.
+		expiresIn := time.Duration(c.ExpiresIn)
+		if expiresIn == 0 {
+			expiresIn = time.Duration(cfg.Cluster.ExpiryInMinutes) * time.Minute
+		}
+
+		m.clusters[c.ID] = &mockCluster{
+			id:              c.ID,
+			name:            c.Name,
+			version:         c.Version,
+			launched:        m.now(),
+			state:           state,
+			expiration:      m.now().Add(expiresIn),
+			addons:          []string{},
+			numComputeNodes: defaultComputeNodes,
+			properties:      c.Properties,
+		}
+	}
+
+	return m, nil
+}
+
+// defaultVersionList is the version list used when the scenario doesn't provide one.
+func defaultVersionList() *spi.VersionList {
 	versions := []*spi.Version{
 		spi.NewVersionBuilder().
 			Version(semver.MustParse("1.2.3")).
@@ -53,73 +161,200 @@ func New() (*MockProvider, error) {
 			Build(),
 	}
 
-	versionList := spi.NewVersionListBuilder().
+	return spi.NewVersionListBuilder().
 		AvailableVersions(versions).
 		DefaultVersionOverride(nil).
 		Build()
+}
+
+// simulate applies any latency and injected errors the scenario defines for the given method.
+func (m *MockProvider) simulate(ctx context.Context, method string) error {
+	if latency, ok := m.scenario.Latency[method]; ok && latency > 0 {
+		select {
+		case <-time.After(time.Duration(latency)):
+		case <-ctx.Done():
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.calls[method]++
+	call := m.calls[method]
+
+	if injected, ok := m.scenario.Errors[method]; ok {
+		if call > injected.Skip && (injected.Times == 0 || call <= injected.Skip+injected.Times) {
+			return fmt.Errorf("%s", injected.Message)
+		}
+	}
+
+	return nil
+}
+
+// toSPICluster converts the internal cluster state into an SPI cluster. The mutex must be held.
+func (m *MockProvider) toSPICluster(c *mockCluster) *spi.Cluster {
+	state := c.state
+	if state == "" {
+		state = m.scenario.stateAt(m.now().Sub(c.launched))
+	}
+
+	version := c.version
+	if c.upgradeVersion != "" && !m.now().Before(c.upgradeAt) {
+		version = c.upgradeVersion
+	}
+
+	addons := make([]string, len(c.addons))
+	copy(addons, c.addons)
+
+	return spi.NewClusterBuilder().
+		ID(c.id).
+		Name(c.name).
+		Version(version).
+		State(state).
+		CloudProvider(MockCloudProvider).
+		Region(MockRegion).
+		ExpirationTimestamp(c.expiration).
+		Flavour(mockFlavour).
+		Addons(addons).
+		NumComputeNodes(c.numComputeNodes).
+		Properties(c.properties).
+		Build()
+}
 
-	return &MockProvider{
-		env:      env,
-		clusters: map[string]*spi.Cluster{},
-		versions: versionList,
-	}, nil
+// quotaExhausted returns true if no more clusters can be launched. The mutex must be held.
+func (m *MockProvider) quotaExhausted() bool {
+	return m.scenario.Quota != nil && len(m.clusters) >= m.scenario.Quota.Clusters
 }
 
 // LaunchCluster mocks a launch cluster operation.
-func (m *MockProvider) LaunchCluster(clusterName string) (string, error) {
+func (m *MockProvider) LaunchCluster(ctx context.Context, clusterName string) (string, error) {
+	if err := m.simulate(ctx, "LaunchCluster"); err != nil {
+		return "", err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.quotaExhausted() {
+		return "", fmt.Errorf("quota exhausted: %d clusters already exist", len(m.clusters))
+	}
+
 	clusterID := uuid.New().String()
 	if m.env == "fail" {
 		clusterID = m.env
 	}
 
-	m.clusters[clusterID] = spi.NewClusterBuilder().
-		ID(clusterID).
-		Name(clusterName).
-		Version(viper.GetString(config.Cluster.Version)).
-		State(spi.ClusterStateReady).
-		CloudProvider(MockCloudProvider).
-		Region(MockRegion).
-		ExpirationTimestamp(time.Now()).
-		Flavour("osd-4").
-		Build()
+	m.clusters[clusterID] = &mockCluster{
+		id:              clusterID,
+		name:            clusterName,
+		version:         m.cfg.State.ClusterVersion,
+		launched:        m.now(),
+		expiration:      m.now().Add(time.Duration(m.cfg.Cluster.ExpiryInMinutes) * time.Minute),
+		addons:          []string{},
+		numComputeNodes: defaultComputeNodes,
+		properties: map[string]string{
+			"MadeByOSDe2e": "true",
+		},
+	}
 
 	return clusterID, nil
 }
 
 // DeleteCluster mocks a delete cluster operation.
-func (m *MockProvider) DeleteCluster(clusterID string) error {
+func (m *MockProvider) DeleteCluster(ctx context.Context, clusterID string) error {
 	if clusterID == "fail" {
 		return fmt.Errorf("fake error deleting cluster")
 	}
 
+	if err := m.simulate(ctx, "DeleteCluster"); err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
 	delete(m.clusters, clusterID)
 	return nil
 }
 
-// ListClusters mocks a list cluster operation.
-func (m *MockProvider) ListClusters(query string) ([]*spi.Cluster, error) {
-	return nil, nil
+// ListClusters mocks a list cluster operation. Only queries made up of "properties.key='value'" clauses
+// joined with "and" are understood; any other clause is ignored.
+func (m *MockProvider) ListClusters(ctx context.Context, query string) ([]*spi.Cluster, error) {
+	if err := m.simulate(ctx, "ListClusters"); err != nil {
+		return nil, err
+	}
+
+	wantedProperties := map[string]string{}
+	for _, clause := range strings.Split(query, " and ") {
+		if matches := propertyClauseRegex.FindStringSubmatch(strings.TrimSpace(clause)); matches != nil {
+			wantedProperties[matches[1]] = matches[2]
+		}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	clusters := []*spi.Cluster{}
+	for _, c := range m.clusters {
+		matches := true
+		for key, value := range wantedProperties {
+			if c.properties[key] != value {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			clusters = append(clusters, m.toSPICluster(c))
+		}
+	}
+
+	return clusters, nil
 }
 
 // GetCluster mocks a get cluster operation.
-func (m *MockProvider) GetCluster(clusterID string) (*spi.Cluster, error) {
+func (m *MockProvider) GetCluster(ctx context.Context, clusterID string) (*spi.Cluster, error) {
 	if clusterID == "fail" {
 		return nil, fmt.Errorf("failed to get versions: Some fake error")
 	}
 
+	if err := m.simulate(ctx, "GetCluster"); err != nil {
+		return nil, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
 	if cluster, ok := m.clusters[clusterID]; ok {
-		return cluster, nil
+		return m.toSPICluster(cluster), nil
 	}
 	return nil, fmt.Errorf("couldn't find cluster in mock provider")
 }
 
 // ScaleCluster mocks a scale cluster operation.
-func (m *MockProvider) ScaleCluster(clusterID string, numComputeNodes int) error {
-	return fmt.Errorf("scale cluster is currently unsupported by the mock provider")
+func (m *MockProvider) ScaleCluster(ctx context.Context, clusterID string, numComputeNodes int) error {
+	if err := m.simulate(ctx, "ScaleCluster"); err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	cluster, ok := m.clusters[clusterID]
+	if !ok {
+		return fmt.Errorf("couldn't find cluster in mock provider")
+	}
+
+	cluster.numComputeNodes = numComputeNodes
+	return nil
 }
 
 // ClusterKubeconfig mocks a cluster kubeconfig operation.
-func (m *MockProvider) ClusterKubeconfig(clusterID string) ([]byte, error) {
+func (m *MockProvider) ClusterKubeconfig(ctx context.Context, clusterID string) ([]byte, error) {
 	var (
 		fileReader http.File
 		err        error
@@ -128,6 +363,10 @@ func (m *MockProvider) ClusterKubeconfig(clusterID string) ([]byte, error) {
 	if clusterID == "fail" {
 		return nil, fmt.Errorf("failed to get versions: Some fake error")
 	}
+
+	if err = m.simulate(ctx, "ClusterKubeconfig"); err != nil {
+		return nil, err
+	}
 	// This kubeconfig is valid and can be parsed, but attmping to use it will cause failures :)
 
 	if fileReader, err = pkger.Open("/assets/providers/mock/kubeconfig"); err != nil {
@@ -142,60 +381,93 @@ func (m *MockProvider) ClusterKubeconfig(clusterID string) ([]byte, error) {
 }
 
 // CheckQuota mocks a check quota operation.
-func (m *MockProvider) CheckQuota() (bool, error) {
+func (m *MockProvider) CheckQuota(ctx context.Context) (bool, error) {
 	if m.env == "fail" {
 		return false, fmt.Errorf("failed to get versions: Some fake error")
 	}
 
+	if err := m.simulate(ctx, "CheckQuota"); err != nil {
+		return false, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
 	// By default this will pass.
-	// If you want a purposeful CheckQuota failure, you should set up a `fail` environment
-	return true, nil
+	// If you want a purposeful CheckQuota failure, you should set up a `fail` environment or a quota in the scenario.
+	return !m.quotaExhausted(), nil
 }
 
 // InstallAddons mocks an install addons operation.
-func (m *MockProvider) InstallAddons(clusterID string, addonIDs []string) (int, error) {
+func (m *MockProvider) InstallAddons(ctx context.Context, clusterID string, addonIDs []string) (int, error) {
 	if clusterID == "fail" {
 		return 0, fmt.Errorf("failed to get versions: Some fake error")
 	}
 
-	cluster, err := m.GetCluster(clusterID)
-	if err != nil {
-		return 0, fmt.Errorf("Unable to retrieve cluster: %s", err.Error())
-	}
-	// We can't access the addons field directly so we have to rebuild the cluster object from scratch
-	// This is fine as any real provider would call an external API to update or retrieve addons and
-	// we lose no state doing this.
-	m.clusters[clusterID] = spi.NewClusterBuilder().
-		ID(clusterID).
-		Name(cluster.Name()).
-		Version(cluster.Version()).
-		State(cluster.State()).
-		CloudProvider(cluster.CloudProvider()).
-		Region(cluster.Region()).
-		ExpirationTimestamp(cluster.ExpirationTimestamp()).
-		Flavour(cluster.Flavour()).
-		Addons(addonIDs).
-		Build()
+	if err := m.simulate(ctx, "InstallAddons"); err != nil {
+		return 0, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 
-	return len(addonIDs), nil
+	cluster, ok := m.clusters[clusterID]
+	if !ok {
+		return 0, fmt.Errorf("Unable to retrieve cluster: couldn't find cluster in mock provider")
+	}
+
+	num := 0
+	for _, addonID := range addonIDs {
+		switch m.scenario.Addons[addonID] {
+		case AddonFailed:
+			return num, fmt.Errorf("failed to install addon %s", addonID)
+		case AddonUnavailable:
+			continue
+		}
+
+		alreadyInstalled := false
+		for _, installed := range cluster.addons {
+			if installed == addonID {
+				alreadyInstalled = true
+				break
+			}
+		}
+
+		if !alreadyInstalled {
+			cluster.addons = append(cluster.addons, addonID)
+			num++
+		}
+	}
+
+	return num, nil
 }
 
 // Versions mocks a versions operation.
-func (m *MockProvider) Versions() (*spi.VersionList, error) {
+func (m *MockProvider) Versions(ctx context.Context) (*spi.VersionList, error) {
 	if m.env == "fail" {
 		return nil, fmt.Errorf("Fake error returning version list")
 	}
 
-	return m.versions, nil
+	if err := m.simulate(ctx, "Versions"); err != nil {
+		return nil, err
+	}
 
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.versions, nil
 }
 
 // Logs mocks a logs operation.
-func (m *MockProvider) Logs(clusterID string) (map[string][]byte, error) {
+func (m *MockProvider) Logs(ctx context.Context, clusterID string) (map[string][]byte, error) {
 	if clusterID == "fail" {
 		return nil, fmt.Errorf("failed to get versions: Some fake error")
 	}
 
+	if err := m.simulate(ctx, "Logs"); err != nil {
+		return nil, err
+	}
+
 	logs := make(map[string][]byte)
 	logs["logs.txt"] = []byte("Here is some lovely log content.")
 	logs["build.log"] = []byte("Additional logs with a different name.")
@@ -209,7 +481,7 @@ func (m *MockProvider) Environment() string {
 }
 
 // Metrics is a stub function for now
-func (m *MockProvider) Metrics(clusterID string) (*v1.ClusterMetrics, error) {
+func (m *MockProvider) Metrics(ctx context.Context, clusterID string) (*v1.ClusterMetrics, error) {
 	return nil, nil
 }
 
@@ -226,15 +498,62 @@ func (m *MockProvider) CincinnatiChannel() spi.CincinnatiChannel {
 // SetVersionList lets us provide novel versions allowing us to properly flex
 // version selection using the Mock provider
 func (m *MockProvider) SetVersionList(list *spi.VersionList) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
 	m.versions = list
 }
 
+// SetClock replaces the clock used to drive cluster state transitions.
+func (m *MockProvider) SetClock(now func() time.Time) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.now = now
+}
+
 // Type returns the provisioner type: mock
 func (m *MockProvider) Type() string {
 	return "mock"
 }
 
 // ExtendExpiry extends the expiration time of an existing cluster
-func (m *MockProvider) ExtendExpiry(clusterID string, hours uint64, minutes uint64, seconds uint64) error {
-	return fmt.Errorf("ExtendExpiry is unsupported by mock clusters")
+func (m *MockProvider) ExtendExpiry(ctx context.Context, clusterID string, hours uint64, minutes uint64, seconds uint64) error {
+	if err := m.simulate(ctx, "ExtendExpiry"); err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	cluster, ok := m.clusters[clusterID]
+	if !ok {
+		return fmt.Errorf("couldn't find cluster in mock provider")
+	}
+
+	cluster.expiration = cluster.expiration.Add(time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second)
+	return nil
+}
+
+// UpgradeCluster mocks scheduling an upgrade. The cluster reports the new version once the upgrade is due.
+func (m *MockProvider) UpgradeCluster(ctx context.Context, clusterID string, version string, t time.Time) error {
+	if err := m.simulate(ctx, "UpgradeCluster"); err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	cluster, ok := m.clusters[clusterID]
+	if !ok {
+		return fmt.Errorf("couldn't find cluster in mock provider")
+	}
+
+	if cluster.upgradeVersion != "" && m.now().Before(cluster.upgradeAt) {
+		return fmt.Errorf("an upgrade to %s is already scheduled for cluster %s", cluster.upgradeVersion, clusterID)
+	}
+
+	cluster.upgradeVersion = version
+	cluster.upgradeAt = t
+	return nil
 }
@@ -1,26 +1,29 @@
 package mock
 
 import (
+	"context"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/Masterminds/semver"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
-	"github.com/spf13/viper"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
 func TestClusterInteraction(t *testing.T) {
 	mockProvider := makeMockProviderWithEnv("mockEnv")
 
-	if hasQuota, err := mockProvider.CheckQuota(); !hasQuota || err != nil {
+	if hasQuota, err := mockProvider.CheckQuota(context.Background()); !hasQuota || err != nil {
 		t.Errorf("expected quota or no error, got: %v, %v", hasQuota, err.Error())
 	}
 
-	clusterID1, _ := mockProvider.LaunchCluster("cluster1")
-	clusterID2, _ := mockProvider.LaunchCluster("cluster2")
+	clusterID1, _ := mockProvider.LaunchCluster(context.Background(), "cluster1")
+	clusterID2, _ := mockProvider.LaunchCluster(context.Background(), "cluster2")
 
-	cluster1, err := mockProvider.GetCluster(clusterID1)
+	cluster1, err := mockProvider.GetCluster(context.Background(), clusterID1)
 
 	if err != nil {
 		t.Errorf("error trying to get cluster 1: %v", err)
@@ -30,7 +33,7 @@ func TestClusterInteraction(t *testing.T) {
 		t.Errorf("cluster IDs did not match for cluster 1. Expected %s, got %s", clusterID1, cluster1.ID())
 	}
 
-	cluster2, err := mockProvider.GetCluster(clusterID2)
+	cluster2, err := mockProvider.GetCluster(context.Background(), clusterID2)
 
 	if err != nil {
 		t.Errorf("error trying to get cluster 2: %v", err)
@@ -40,9 +43,9 @@ func TestClusterInteraction(t *testing.T) {
 		t.Errorf("cluster IDs did not match for cluster 2. Expected %s, got %s", clusterID2, cluster2.ID())
 	}
 
-	mockProvider.DeleteCluster(clusterID1)
+	mockProvider.DeleteCluster(context.Background(), clusterID1)
 
-	_, err = mockProvider.GetCluster(clusterID1)
+	_, err = mockProvider.GetCluster(context.Background(), clusterID1)
 
 	if err == nil {
 		t.Errorf("expected error when retrieving cluster 1 after deletion")
@@ -57,7 +60,7 @@ func TestIntentionalFailures(t *testing.T) {
 	mockProvider := makeMockProviderWithEnv("fail")
 
 	// Quota Check
-	quotaCheck, err := mockProvider.CheckQuota()
+	quotaCheck, err := mockProvider.CheckQuota(context.Background())
 	if quotaCheck {
 		t.Error("expected quota to be false for fail environment")
 	}
@@ -65,37 +68,54 @@ func TestIntentionalFailures(t *testing.T) {
 		t.Error("expected error to occur while checking quota")
 	}
 
-	clusterID1, _ := mockProvider.LaunchCluster("cluster1")
+	clusterID1, _ := mockProvider.LaunchCluster(context.Background(), "cluster1")
 
 	// ClusterKubeconfig
-	if kubeconfig, err := mockProvider.ClusterKubeconfig(clusterID1); kubeconfig != nil || err == nil {
+	if kubeconfig, err := mockProvider.ClusterKubeconfig(context.Background(), clusterID1); kubeconfig != nil || err == nil {
 		t.Errorf("expected error to occur retrieving clusterkubeconfig: %v, %v", kubeconfig, err)
 	}
 
 	// InstallAddons
-	if addonsInstalled, err := mockProvider.InstallAddons(clusterID1, []string{"addon1", "addon2"}); addonsInstalled != 0 || err == nil {
+	if addonsInstalled, err := mockProvider.InstallAddons(context.Background(), clusterID1, []string{"addon1", "addon2"}); addonsInstalled != 0 || err == nil {
 		t.Errorf("expected error to occur installing addons: %v, %v", addonsInstalled, err)
 	}
 
 	// Versions
-	if versions, err := mockProvider.Versions(); versions != nil || err == nil {
+	if versions, err := mockProvider.Versions(context.Background()); versions != nil || err == nil {
 		t.Errorf("expected error to occur retrieving versions: %v, %v", versions, err)
 	}
 
 	// Logs
-	if logs, err := mockProvider.Logs(clusterID1); logs != nil || err == nil {
+	if logs, err := mockProvider.Logs(context.Background(), clusterID1); logs != nil || err == nil {
 		t.Errorf("expected error to occur retrieving logs: %v, %v", logs, err)
 	}
 }
 
+func TestCancelledContext(t *testing.T) {
+	mockProvider := makeMockProviderWithEnv("mockEnv")
+
+	clusterID1, _ := mockProvider.LaunchCluster(context.Background(), "cluster1")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := mockProvider.LaunchCluster(ctx, "cluster2"); err != context.Canceled {
+		t.Errorf("expected launching with a cancelled context to fail with %v, got: %v", context.Canceled, err)
+	}
+
+	if _, err := mockProvider.GetCluster(ctx, clusterID1); err != context.Canceled {
+		t.Errorf("expected getting a cluster with a cancelled context to fail with %v, got: %v", context.Canceled, err)
+	}
+}
+
 func TestMockAddons(t *testing.T) {
 	mockProvider := makeMockProviderWithEnv("mockEnv")
 
-	clusterID1, _ := mockProvider.LaunchCluster("cluster1")
+	clusterID1, _ := mockProvider.LaunchCluster(context.Background(), "cluster1")
 
 	toInstall := []string{"addon1", "addon2"}
 
-	numInstalled, err := mockProvider.InstallAddons(clusterID1, toInstall)
+	numInstalled, err := mockProvider.InstallAddons(context.Background(), clusterID1, toInstall)
 	if err != nil {
 		t.Errorf("expected no error, got: %s", err.Error())
 	}
@@ -104,7 +124,7 @@ func TestMockAddons(t *testing.T) {
 		t.Errorf("expected numInstalled to be 2, got %d", numInstalled)
 	}
 
-	cluster1, err := mockProvider.GetCluster(clusterID1)
+	cluster1, err := mockProvider.GetCluster(context.Background(), clusterID1)
 	if err != nil {
 		t.Errorf("error when retrieving cluster: %s", err.Error())
 	}
@@ -123,9 +143,9 @@ func TestMockAddons(t *testing.T) {
 func TestClusterkubeconfig(t *testing.T) {
 	mockProvider := makeMockProviderWithEnv("mockEnv")
 
-	clusterID1, _ := mockProvider.LaunchCluster("cluster1")
+	clusterID1, _ := mockProvider.LaunchCluster(context.Background(), "cluster1")
 
-	kubeconfig, err := mockProvider.ClusterKubeconfig(clusterID1)
+	kubeconfig, err := mockProvider.ClusterKubeconfig(context.Background(), clusterID1)
 	if err != nil {
 		t.Errorf("expected no error, got %s", err.Error())
 	}
@@ -138,7 +158,7 @@ func TestClusterkubeconfig(t *testing.T) {
 func TestVersions(t *testing.T) {
 	mockProvider := makeMockProviderWithEnv("mockEnv")
 
-	versions, err := mockProvider.Versions()
+	versions, err := mockProvider.Versions(context.Background())
 	if err != nil {
 		t.Errorf("error retrieving provider versions: %s", err.Error())
 	}
@@ -174,7 +194,7 @@ func TestSetVersionList(t *testing.T) {
 
 	mockProvider.SetVersionList(versionList)
 
-	versions, err := mockProvider.Versions()
+	versions, err := mockProvider.Versions(context.Background())
 	if err != nil {
 		t.Errorf("error retrieving provider versions: %s", err.Error())
 	}
@@ -189,12 +209,290 @@ func TestSetVersionList(t *testing.T) {
 	}
 }
 
+func TestVersionsConcurrently(t *testing.T) {
+	mockProvider := makeMockProviderWithEnv("mockEnv")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			mockProvider.SetVersionList(defaultVersionList())
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := mockProvider.Versions(context.Background()); err != nil {
+				t.Errorf("error retrieving provider versions: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+const testScenario = `
+environment: scenario
+states:
+- state: installing
+  after: 1m
+- state: ready
+  after: 5m
+errors:
+  ClusterKubeconfig:
+    message: credentials are not available yet
+    skip: 1
+    times: 2
+quota:
+  clusters: 2
+addons:
+  broken-addon: failed
+  missing-addon: unavailable
+versions:
+- version: 4.4.9
+- version: 4.5.2
+  default: true
+clusters:
+- id: existing
+  name: existing-cluster
+  version: openshift-v4.4.9
+  properties:
+    MadeByOSDe2e: "true"
+  expiresIn: -1h
+`
+
+func makeMockProviderWithScenario(t *testing.T) *MockProvider {
+	scenario, err := ParseScenario([]byte(testScenario))
+	if err != nil {
+		t.Fatalf("error parsing scenario: %v", err)
+	}
+
+	mockProvider, err := NewWithScenario(config.NewRunConfig(), scenario)
+	if err != nil {
+		t.Fatalf("error creating mock provider: %v", err)
+	}
+	return mockProvider
+}
+
+func TestScenarioStateTransitions(t *testing.T) {
+	mockProvider := makeMockProviderWithScenario(t)
+
+	now := time.Now()
+	mockProvider.SetClock(func() time.Time { return now })
+
+	clusterID, err := mockProvider.LaunchCluster(context.Background(), "cluster1")
+	if err != nil {
+		t.Fatalf("error launching cluster: %v", err)
+	}
+
+	tests := []struct {
+		elapsed  time.Duration
+		expected spi.ClusterState
+	}{
+		{0, spi.ClusterStatePending},
+		{time.Minute, spi.ClusterStateInstalling},
+		{4 * time.Minute, spi.ClusterStateInstalling},
+		{5 * time.Minute, spi.ClusterStateReady},
+	}
+
+	for _, test := range tests {
+		elapsed := test.elapsed
+		mockProvider.SetClock(func() time.Time { return now.Add(elapsed) })
+
+		cluster, err := mockProvider.GetCluster(context.Background(), clusterID)
+		if err != nil {
+			t.Fatalf("error getting cluster: %v", err)
+		}
+
+		if cluster.State() != test.expected {
+			t.Errorf("expected state %s after %v, got %s", test.expected, test.elapsed, cluster.State())
+		}
+	}
+}
+
+func TestUpgradeCluster(t *testing.T) {
+	mockProvider := makeMockProviderWithScenario(t)
+
+	now := time.Now()
+	mockProvider.SetClock(func() time.Time { return now })
+
+	clusterID, err := mockProvider.LaunchCluster(context.Background(), "cluster1")
+	if err != nil {
+		t.Fatalf("error launching cluster: %v", err)
+	}
+
+	if err = mockProvider.UpgradeCluster(context.Background(), clusterID, "openshift-v4.5.3", now.Add(10*time.Minute)); err != nil {
+		t.Fatalf("error upgrading cluster: %v", err)
+	}
+	if err = mockProvider.UpgradeCluster(context.Background(), clusterID, "openshift-v4.5.3", now.Add(10*time.Minute)); err == nil {
+		t.Errorf("expected a second upgrade to be rejected while one is scheduled")
+	}
+
+	cluster, _ := mockProvider.GetCluster(context.Background(), clusterID)
+	if cluster.Version() == "openshift-v4.5.3" {
+		t.Errorf("expected the cluster not to be upgraded before the upgrade is due")
+	}
+
+	mockProvider.SetClock(func() time.Time { return now.Add(10 * time.Minute) })
+	cluster, _ = mockProvider.GetCluster(context.Background(), clusterID)
+	if cluster.Version() != "openshift-v4.5.3" {
+		t.Errorf("expected the cluster to be upgraded once the upgrade is due, got %s", cluster.Version())
+	}
+}
+
+func TestScenarioInjectedErrors(t *testing.T) {
+	mockProvider := makeMockProviderWithScenario(t)
+
+	expected := []bool{false, true, true, false}
+	for i, shouldFail := range expected {
+		_, err := mockProvider.ClusterKubeconfig(context.Background(), "existing")
+		if shouldFail && err == nil {
+			t.Errorf("expected call %d to fail", i+1)
+		} else if !shouldFail && err != nil {
+			t.Errorf("expected call %d to succeed, got: %v", i+1, err)
+		}
+	}
+}
+
+func TestScenarioQuota(t *testing.T) {
+	mockProvider := makeMockProviderWithScenario(t)
+
+	if hasQuota, err := mockProvider.CheckQuota(context.Background()); !hasQuota || err != nil {
+		t.Errorf("expected quota to be available, got: %v, %v", hasQuota, err)
+	}
+
+	if _, err := mockProvider.LaunchCluster(context.Background(), "cluster1"); err != nil {
+		t.Errorf("expected launch to succeed, got: %v", err)
+	}
+
+	if hasQuota, err := mockProvider.CheckQuota(context.Background()); hasQuota || err != nil {
+		t.Errorf("expected quota to be exhausted, got: %v, %v", hasQuota, err)
+	}
+
+	if _, err := mockProvider.LaunchCluster(context.Background(), "cluster2"); err == nil {
+		t.Error("expected launch to fail once quota is exhausted")
+	}
+}
+
+func TestScenarioAddons(t *testing.T) {
+	mockProvider := makeMockProviderWithScenario(t)
+
+	numInstalled, err := mockProvider.InstallAddons(context.Background(), "existing", []string{"addon1", "missing-addon"})
+	if err != nil || numInstalled != 1 {
+		t.Errorf("expected one addon to be installed, got: %d, %v", numInstalled, err)
+	}
+
+	if _, err := mockProvider.InstallAddons(context.Background(), "existing", []string{"broken-addon"}); err == nil {
+		t.Error("expected installing a broken addon to fail")
+	}
+
+	cluster, err := mockProvider.GetCluster(context.Background(), "existing")
+	if err != nil {
+		t.Fatalf("error getting cluster: %v", err)
+	}
+
+	if !reflect.DeepEqual(cluster.Addons(), []string{"addon1"}) {
+		t.Errorf("unexpected addons installed: %v", cluster.Addons())
+	}
+}
+
+func TestScenarioListAndScale(t *testing.T) {
+	mockProvider := makeMockProviderWithScenario(t)
+
+	clusterID, _ := mockProvider.LaunchCluster(context.Background(), "cluster1")
+
+	clusters, err := mockProvider.ListClusters(context.Background(), "properties.MadeByOSDe2e='true' and properties.Other='value'")
+	if err != nil {
+		t.Fatalf("error listing clusters: %v", err)
+	}
+	if len(clusters) != 0 {
+		t.Errorf("expected no clusters to match, got %d", len(clusters))
+	}
+
+	clusters, err = mockProvider.ListClusters(context.Background(), "properties.MadeByOSDe2e='true'")
+	if err != nil {
+		t.Fatalf("error listing clusters: %v", err)
+	}
+	if len(clusters) != 2 {
+		t.Errorf("expected 2 clusters, got %d", len(clusters))
+	}
+
+	if err := mockProvider.ScaleCluster(context.Background(), clusterID, 9); err != nil {
+		t.Fatalf("error scaling cluster: %v", err)
+	}
+
+	cluster, _ := mockProvider.GetCluster(context.Background(), clusterID)
+	if cluster.NumComputeNodes() != 9 {
+		t.Errorf("expected 9 compute nodes, got %d", cluster.NumComputeNodes())
+	}
+
+	existing, _ := mockProvider.GetCluster(context.Background(), "existing")
+	if !existing.ExpirationTimestamp().Before(time.Now()) {
+		t.Errorf("expected existing cluster to have expired, expires at %v", existing.ExpirationTimestamp())
+	}
+}
+
+func TestScenarioDefaultExpiry(t *testing.T) {
+	scenario, err := ParseScenario([]byte("clusters:\n- id: unexpiring\n  name: unexpiring-cluster\n"))
+	if err != nil {
+		t.Fatalf("error parsing scenario: %v", err)
+	}
+
+	cfg := config.NewRunConfig()
+	cfg.Cluster.ExpiryInMinutes = 210
+
+	mockProvider, err := NewWithScenario(cfg, scenario)
+	if err != nil {
+		t.Fatalf("error creating mock provider: %v", err)
+	}
+
+	now := time.Now()
+	mockProvider.SetClock(func() time.Time { return now })
+
+	cluster, err := mockProvider.GetCluster(context.Background(), "unexpiring")
+	if err != nil {
+		t.Fatalf("error getting cluster: %v", err)
+	}
+	if !cluster.ExpirationTimestamp().After(now.Add(209 * time.Minute)) {
+		t.Errorf("expected a cluster without an expiry to last as long as launched clusters, expires at %v", cluster.ExpirationTimestamp())
+	}
+}
+
+func TestScenarioVersions(t *testing.T) {
+	mockProvider := makeMockProviderWithScenario(t)
+
+	versions, err := mockProvider.Versions(context.Background())
+	if err != nil {
+		t.Fatalf("error retrieving provider versions: %v", err)
+	}
+
+	if len(versions.AvailableVersions()) != 2 {
+		t.Errorf("unexpected versionList length. Expected 2, got: %d", len(versions.AvailableVersions()))
+	}
+
+	if versions.Default().String() != "4.5.2" {
+		t.Errorf("unexpected default version. Expected 4.5.2, got: %s", versions.Default().String())
+	}
+
+	if mockProvider.Environment() != "scenario" {
+		t.Errorf("unexpected environment: %s", mockProvider.Environment())
+	}
+}
+
+func TestInvalidScenario(t *testing.T) {
+	if _, err := ParseScenario([]byte("addons:\n  addon1: maybe\n")); err == nil {
+		t.Error("expected an unknown addon outcome to be rejected")
+	}
+
+	if _, err := ParseScenario([]byte("states:\n- state: ready\n  after: 1m\n- state: installing\n  after: 30s\n")); err == nil {
+		t.Error("expected out of order states to be rejected")
+	}
+}
+
 func makeMockProviderWithEnv(env string) *MockProvider {
-	viper.Reset()
-	viper.Set(Env, env)
+	cfg := config.NewRunConfig()
+	cfg.Mock.Env = env
 	// Setting the environment to fail will cause multiple common interactions to fail intentionally
 	// Creation / retrieval / deletion of a cluster should all still work though. Some baseline
 	// functionality should always work.
-	mockProvider, _ := New()
+	mockProvider, _ := New(cfg)
 	return mockProvider
 }
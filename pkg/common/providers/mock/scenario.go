@@ -0,0 +1,250 @@
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/Masterminds/semver"
+	"github.com/ghodss/yaml"
+	"github.com/openshift/osde2e/pkg/common/spi"
+)
+
+// Scenario describes how the mock provider should behave over the course of a run.
+//
+// An example scenario:
+//
+//	environment: int
+//	states:
+//	- state: pending
+//	- state: installing
+//	  after: 30s
+//	- state: ready
+//	  after: 2m
+//	latency:
+//	  GetCluster: 500ms
+//	errors:
+//	  ClusterKubeconfig:
+//	    message: credentials are not available yet
+//	    times: 2
+//	quota:
+//	  clusters: 1
+//	addons:
+//	  broken-addon: failed
+//	versions:
+//	- version: 4.4.9
+//	- version: 4.5.2
+//	  default: true
+type Scenario struct {
+	// Environment is returned by Environment() and is checked for the legacy "fail" behaviour.
+	Environment string `json:"environment,omitempty"`
+
+	// States is the timeline a newly launched cluster moves through. Each state is entered once the
+	// given amount of time has passed since launch. Clusters are immediately ready if this is empty.
+	States []ScenarioState `json:"states,omitempty"`
+
+	// Latency is an artificial delay added to the named provider methods.
+	Latency map[string]Duration `json:"latency,omitempty"`
+
+	// Errors are failures injected into the named provider methods.
+	Errors map[string]ScenarioError `json:"errors,omitempty"`
+
+	// Quota restricts how many clusters may exist at once.
+	Quota *ScenarioQuota `json:"quota,omitempty"`
+
+	// Addons maps addon IDs to the outcome of installing them. Unlisted addons install successfully.
+	Addons map[string]AddonOutcome `json:"addons,omitempty"`
+
+	// Versions is the list of versions reported by the provider.
+	Versions []ScenarioVersion `json:"versions,omitempty"`
+
+	// DefaultVersionOverride is reported as the default version override of the version list.
+	DefaultVersionOverride string `json:"defaultVersionOverride,omitempty"`
+
+	// Clusters are clusters that already exist when the provider is created.
+	Clusters []ScenarioCluster `json:"clusters,omitempty"`
+}
+
+// ScenarioState is a single step in a cluster's lifecycle.
+type ScenarioState struct {
+	// State is the cluster state to report.
+	State spi.ClusterState `json:"state"`
+
+	// After is how long after launch the cluster enters this state.
+	After Duration `json:"after,omitempty"`
+}
+
+// ScenarioError is an error injected into a provider method.
+type ScenarioError struct {
+	// Message is the error message returned.
+	Message string `json:"message"`
+
+	// Skip is the number of calls that succeed before the error starts being returned.
+	Skip int `json:"skip,omitempty"`
+
+	// Times is the number of calls that fail. Zero means every call after Skip fails.
+	Times int `json:"times,omitempty"`
+}
+
+// ScenarioQuota describes the quota available to the mock provider.
+type ScenarioQuota struct {
+	// Clusters is the number of clusters that can exist at the same time.
+	Clusters int `json:"clusters"`
+}
+
+// AddonOutcome is the result of installing an addon.
+type AddonOutcome string
+
+const (
+	// AddonInstalled addons are installed onto the cluster.
+	AddonInstalled AddonOutcome = "installed"
+
+	// AddonFailed addons cause InstallAddons to return an error.
+	AddonFailed AddonOutcome = "failed"
+
+	// AddonUnavailable addons are skipped, as if they weren't enabled.
+	AddonUnavailable AddonOutcome = "unavailable"
+)
+
+// ScenarioVersion is a version offered by the mock provider.
+type ScenarioVersion struct {
+	// Version is the semantic version.
+	Version string `json:"version"`
+
+	// Default is true if this is the default version.
+	Default bool `json:"default,omitempty"`
+}
+
+// ScenarioCluster is a cluster that exists before the run starts.
+type ScenarioCluster struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Version    string            `json:"version,omitempty"`
+	State      spi.ClusterState  `json:"state,omitempty"`
+	Properties map[string]string `json:"properties,omitempty"`
+
+	// ExpiresIn is the time until the cluster expires, relative to when the provider is created.
+	// Negative values produce clusters that have already expired. Clusters without it last as long as clusters
+	// launched by the mock provider.
+	ExpiresIn Duration `json:"expiresIn,omitempty"`
+}
+
+// Duration is a time.Duration that is read from a string such as "1m30s".
+type Duration time.Duration
+
+// UnmarshalJSON parses a duration string.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("durations must be strings: %v", err)
+	}
+
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(parsed)
+	return nil
+}
+
+// MarshalJSON writes a duration string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// LoadScenario reads a scenario from a YAML file.
+func LoadScenario(filename string) (*Scenario, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading mock scenario %s: %v", filename, err)
+	}
+
+	return ParseScenario(data)
+}
+
+// ParseScenario parses a YAML scenario.
+func ParseScenario(data []byte) (*Scenario, error) {
+	scenario := &Scenario{}
+	if err := yaml.Unmarshal(data, scenario); err != nil {
+		return nil, fmt.Errorf("error parsing mock scenario: %v", err)
+	}
+
+	if err := scenario.validate(); err != nil {
+		return nil, fmt.Errorf("invalid mock scenario: %v", err)
+	}
+
+	return scenario, nil
+}
+
+// versionList builds the version list described by the scenario.
+func (s *Scenario) versionList() (*spi.VersionList, error) {
+	versions := []*spi.Version{}
+	for _, v := range s.Versions {
+		version, err := semver.NewVersion(v.Version)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %s: %v", v.Version, err)
+		}
+		versions = append(versions, spi.NewVersionBuilder().
+			Version(version).
+			Default(v.Default).
+			Build())
+	}
+
+	var override *semver.Version
+	if s.DefaultVersionOverride != "" {
+		var err error
+		if override, err = semver.NewVersion(s.DefaultVersionOverride); err != nil {
+			return nil, fmt.Errorf("invalid default version override %s: %v", s.DefaultVersionOverride, err)
+		}
+	}
+
+	return spi.NewVersionListBuilder().
+		AvailableVersions(versions).
+		DefaultVersionOverride(override).
+		Build(), nil
+}
+
+// stateAt returns the state a cluster launched elapsed ago should be in.
+func (s *Scenario) stateAt(elapsed time.Duration) spi.ClusterState {
+	state := spi.ClusterStateReady
+	if len(s.States) > 0 {
+		state = spi.ClusterStatePending
+	}
+
+	for _, step := range s.States {
+		if elapsed >= time.Duration(step.After) {
+			state = step.State
+		}
+	}
+
+	return state
+}
+
+func (s *Scenario) validate() error {
+	var last time.Duration
+	for i, step := range s.States {
+		if step.State == "" {
+			return fmt.Errorf("state %d has no state set", i)
+		}
+		if time.Duration(step.After) < last {
+			return fmt.Errorf("state %d (%s) happens before the state preceding it", i, step.State)
+		}
+		last = time.Duration(step.After)
+	}
+
+	for addon, outcome := range s.Addons {
+		switch outcome {
+		case AddonInstalled, AddonFailed, AddonUnavailable:
+		default:
+			return fmt.Errorf("addon %s has unknown outcome %s", addon, outcome)
+		}
+	}
+
+	if _, err := s.versionList(); err != nil {
+		return err
+	}
+
+	return nil
+}
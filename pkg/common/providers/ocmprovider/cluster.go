@@ -1,6 +1,7 @@
 package ocmprovider
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"math/rand"
@@ -9,9 +10,7 @@ import (
 	"time"
 
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
-	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
-	"github.com/spf13/viper"
 )
 
 const (
@@ -26,21 +25,21 @@ const (
 )
 
 // LaunchCluster setups an new cluster using the OSD API and returns it's ID.
-func (o *OCMProvider) LaunchCluster(clusterName string) (string, error) {
+func (o *OCMProvider) LaunchCluster(ctx context.Context, clusterName string) (string, error) {
 	// choose flavour based on config
 	flavourID := DefaultFlavour
 
-	multiAZ := viper.GetBool(config.Cluster.MultiAZ)
-	computeMachineType := viper.GetString(ComputeMachineType)
-	region := viper.GetString(config.CloudProvider.Region)
-	cloudProvider := viper.GetString(config.CloudProvider.CloudProviderID)
+	multiAZ := o.cfg.Cluster.MultiAZ
+	computeMachineType := o.cfg.OCM.ComputeMachineType
+	region := o.cfg.CloudProvider.Region
+	cloudProvider := o.cfg.CloudProvider.CloudProviderID
 
 	// If a region is set to "random", it will poll OCM for all the regions available
 	// It then will pull a random entry from the list of regions and set the ID to that
 	if region == "random" {
 		regionsClient := o.conn.ClustersMgmt().V1().CloudProviders().CloudProvider(cloudProvider).Regions().List()
 
-		regions, err := regionsClient.Send()
+		regions, err := regionsClient.SendContext(ctx)
 		if err != nil {
 			return "", err
 		}
@@ -54,8 +53,8 @@ func (o *OCMProvider) LaunchCluster(clusterName string) (string, error) {
 			}
 		}
 
-		// Update the Config with the selected random region
-		viper.Set(config.CloudProvider.Region, region)
+		// Update the run state with the selected random region
+		o.cfg.State.Region = region
 	}
 
 	nodeBuilder := &v1.ClusterNodesBuilder{}
@@ -74,12 +73,12 @@ func (o *OCMProvider) LaunchCluster(clusterName string) (string, error) {
 			ID(region)).
 		MultiAZ(multiAZ).
 		Version(v1.NewVersion().
-			ID(viper.GetString(config.Cluster.Version))).
+			ID(o.cfg.State.ClusterVersion)).
 		CloudProvider(v1.NewCloudProvider().
 			ID(cloudProvider)).
 		Properties(clusterProperties)
 
-	expiryInMinutes := viper.GetDuration(config.Cluster.ExpiryInMinutes)
+	expiryInMinutes := time.Duration(o.cfg.Cluster.ExpiryInMinutes)
 	if expiryInMinutes > 0 {
 		// Calculate an expiration date for the cluster so that it will be automatically deleted if
 		// we happen to forget to do it:
@@ -92,7 +91,7 @@ func (o *OCMProvider) LaunchCluster(clusterName string) (string, error) {
 	// Currently set to 9 nodes. Whatever it is, must be divisible by 3.
 	if multiAZ {
 		nodeBuilder = nodeBuilder.Compute(9)
-		newCluster = newCluster.MultiAZ(viper.GetBool(config.Cluster.MultiAZ))
+		newCluster = newCluster.MultiAZ(multiAZ)
 	}
 
 	if computeMachineType != "" {
@@ -102,7 +101,7 @@ func (o *OCMProvider) LaunchCluster(clusterName string) (string, error) {
 
 	newCluster = newCluster.Nodes(nodeBuilder)
 
-	IDsAtCreationString := viper.GetString(config.Addons.IDsAtCreation)
+	IDsAtCreationString := o.cfg.Addons.IDsAtCreation
 	if len(IDsAtCreationString) > 0 {
 		addons := []*v1.AddOnInstallationBuilder{}
 		IDsAtCreation := strings.Split(IDsAtCreationString, ",")
@@ -120,11 +119,11 @@ func (o *OCMProvider) LaunchCluster(clusterName string) (string, error) {
 
 	var resp *v1.ClustersAddResponse
 
-	err = retryer().Do(func() error {
+	err = o.retryer.Do(func() error {
 		var err error
 		resp, err = o.conn.ClustersMgmt().V1().Clusters().Add().
 			Body(cluster).
-			Send()
+			SendContext(ctx)
 
 		if resp != nil && resp.Error() != nil {
 			return errResp(resp.Error())
@@ -144,10 +143,10 @@ func (o *OCMProvider) GenerateProperties() (map[string]string, error) {
 	var username string
 
 	// If JobID is not equal to -1, then we're running on prow.
-	if viper.GetInt(config.JobID) != -1 {
+	if o.cfg.JobID != -1 {
 		username = "prow"
-	} else if viper.GetString(UserOverride) != "" {
-		username = viper.GetString(UserOverride)
+	} else if o.cfg.OCM.UserOverride != "" {
+		username = o.cfg.OCM.UserOverride
 	} else {
 
 		user, err := user.Current()
@@ -166,14 +165,14 @@ func (o *OCMProvider) GenerateProperties() (map[string]string, error) {
 }
 
 // DeleteCluster requests the deletion of clusterID.
-func (o *OCMProvider) DeleteCluster(clusterID string) error {
+func (o *OCMProvider) DeleteCluster(ctx context.Context, clusterID string) error {
 	var resp *v1.ClusterDeleteResponse
 
-	err := retryer().Do(func() error {
+	err := o.retryer.Do(func() error {
 		var err error
 		resp, err = o.conn.ClustersMgmt().V1().Clusters().Cluster(clusterID).
 			Delete().
-			Send()
+			SendContext(ctx)
 
 		if err != nil {
 			return fmt.Errorf("couldn't delete cluster '%s': %v", clusterID, err)
@@ -195,11 +194,11 @@ func (o *OCMProvider) DeleteCluster(clusterID string) error {
 }
 
 // ScaleCluster will grow or shink the cluster to the desired number of compute nodes.
-func (o *OCMProvider) ScaleCluster(clusterID string, numComputeNodes int) error {
+func (o *OCMProvider) ScaleCluster(ctx context.Context, clusterID string, numComputeNodes int) error {
 	var resp *v1.ClusterUpdateResponse
 
 	// Get the current state of the cluster
-	ocmCluster, err := o.getOCMCluster(clusterID)
+	ocmCluster, err := o.getOCMCluster(ctx, clusterID)
 
 	if err != nil {
 		return err
@@ -219,11 +218,11 @@ func (o *OCMProvider) ScaleCluster(clusterID string, numComputeNodes int) error
 		return fmt.Errorf("error while building scaled cluster object: %v", err)
 	}
 
-	err = retryer().Do(func() error {
+	err = o.retryer.Do(func() error {
 		var err error
 		resp, err = o.conn.ClustersMgmt().V1().Clusters().Cluster(clusterID).Update().
 			Body(scaledCluster).
-			Send()
+			SendContext(ctx)
 
 		if err != nil {
 			err = fmt.Errorf("couldn't update cluster '%s': %v", clusterID, err)
@@ -247,7 +246,7 @@ func (o *OCMProvider) ScaleCluster(clusterID string, numComputeNodes int) error
 		return resp.Error()
 	}
 
-	finalCluster, err := o.GetCluster(clusterID)
+	finalCluster, err := o.GetCluster(ctx, clusterID)
 
 	if err != nil {
 		log.Printf("error attempting to retrieve cluster for verification: %v", err)
@@ -263,18 +262,18 @@ func (o *OCMProvider) ScaleCluster(clusterID string, numComputeNodes int) error
 }
 
 // ListClusters returns a list of clusters filtered on key/value pairs
-func (o *OCMProvider) ListClusters(query string) ([]*spi.Cluster, error) {
+func (o *OCMProvider) ListClusters(ctx context.Context, query string) ([]*spi.Cluster, error) {
 	var clusters []*spi.Cluster
 	clusterListRequest := o.conn.ClustersMgmt().V1().Clusters().List()
 
-	response, err := clusterListRequest.Search(query).Send()
+	response, err := clusterListRequest.Search(query).SendContext(ctx)
 
 	if err != nil {
 		return nil, err
 	}
 
 	for _, cluster := range response.Items().Slice() {
-		spiCluster, err := o.ocmToSPICluster(cluster)
+		spiCluster, err := o.ocmToSPICluster(ctx, cluster)
 		if err != nil {
 			return nil, err
 		}
@@ -285,13 +284,13 @@ func (o *OCMProvider) ListClusters(query string) ([]*spi.Cluster, error) {
 }
 
 // GetCluster returns a cluster from OCM.
-func (o *OCMProvider) GetCluster(clusterID string) (*spi.Cluster, error) {
-	ocmCluster, err := o.getOCMCluster(clusterID)
+func (o *OCMProvider) GetCluster(ctx context.Context, clusterID string) (*spi.Cluster, error) {
+	ocmCluster, err := o.getOCMCluster(ctx, clusterID)
 	if err != nil {
 		return nil, err
 	}
 
-	cluster, err := o.ocmToSPICluster(ocmCluster)
+	cluster, err := o.ocmToSPICluster(ctx, ocmCluster)
 	if err != nil {
 		return nil, err
 	}
@@ -299,14 +298,14 @@ func (o *OCMProvider) GetCluster(clusterID string) (*spi.Cluster, error) {
 	return cluster, nil
 }
 
-func (o *OCMProvider) getOCMCluster(clusterID string) (*v1.Cluster, error) {
+func (o *OCMProvider) getOCMCluster(ctx context.Context, clusterID string) (*v1.Cluster, error) {
 	var resp *v1.ClusterGetResponse
 
-	err := retryer().Do(func() error {
+	err := o.retryer.Do(func() error {
 		var err error
 		resp, err = o.conn.ClustersMgmt().V1().Clusters().Cluster(clusterID).
 			Get().
-			Send()
+			SendContext(ctx)
 
 		if err != nil {
 			err = fmt.Errorf("couldn't retrieve cluster '%s': %v", clusterID, err)
@@ -334,15 +333,15 @@ func (o *OCMProvider) getOCMCluster(clusterID string) (*v1.Cluster, error) {
 }
 
 // ClusterKubeconfig returns the kubeconfig for the given cluster ID.
-func (o *OCMProvider) ClusterKubeconfig(clusterID string) ([]byte, error) {
+func (o *OCMProvider) ClusterKubeconfig(ctx context.Context, clusterID string) ([]byte, error) {
 	var resp *v1.CredentialsGetResponse
 
-	err := retryer().Do(func() error {
+	err := o.retryer.Do(func() error {
 		var err error
 		resp, err = o.conn.ClustersMgmt().V1().Clusters().Cluster(clusterID).
 			Credentials().
 			Get().
-			Send()
+			SendContext(ctx)
 
 		if err != nil {
 			log.Printf("couldn't get credentials: %v", err)
@@ -365,12 +364,12 @@ func (o *OCMProvider) ClusterKubeconfig(clusterID string) ([]byte, error) {
 }
 
 // GetMetrics gathers metrics from OCM on a cluster
-func (o *OCMProvider) GetMetrics(clusterID string) (*v1.ClusterMetrics, error) {
+func (o *OCMProvider) GetMetrics(ctx context.Context, clusterID string) (*v1.ClusterMetrics, error) {
 	var err error
 
 	clusterClient := o.conn.ClustersMgmt().V1().Clusters().Cluster(clusterID)
 
-	cluster, err := clusterClient.Get().Send()
+	cluster, err := clusterClient.Get().SendContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -380,16 +379,16 @@ func (o *OCMProvider) GetMetrics(clusterID string) (*v1.ClusterMetrics, error) {
 
 // InstallAddons loops through the addons list in the config
 // and performs the CRUD operation to trigger addon installation
-func (o *OCMProvider) InstallAddons(clusterID string, addonIDs []string) (num int, err error) {
+func (o *OCMProvider) InstallAddons(ctx context.Context, clusterID string, addonIDs []string) (num int, err error) {
 	num = 0
 	addonsClient := o.conn.ClustersMgmt().V1().Addons()
 	clusterClient := o.conn.ClustersMgmt().V1().Clusters().Cluster(clusterID)
 	for _, addonID := range addonIDs {
 		var addonResp *v1.AddOnGetResponse
 
-		err = retryer().Do(func() error {
+		err = o.retryer.Do(func() error {
 			var err error
-			addonResp, err = addonsClient.Addon(addonID).Get().Send()
+			addonResp, err = addonsClient.Addon(addonID).Get().SendContext(ctx)
 
 			if err != nil {
 				return err
@@ -408,7 +407,7 @@ func (o *OCMProvider) InstallAddons(clusterID string, addonIDs []string) (num in
 		addon := addonResp.Body()
 
 		alreadyInstalled := false
-		cluster, err := o.GetCluster(clusterID)
+		cluster, err := o.GetCluster(ctx, clusterID)
 
 		if err != nil {
 			return 0, fmt.Errorf("error getting current cluster state when trying to install addon %s", addonID)
@@ -434,9 +433,9 @@ func (o *OCMProvider) InstallAddons(clusterID string, addonIDs []string) (num in
 
 			var aoar *v1.AddOnInstallationsAddResponse
 
-			err = retryer().Do(func() error {
+			err = o.retryer.Do(func() error {
 				var err error
-				aoar, err = clusterClient.Addons().Add().Body(addonInstallation).Send()
+				aoar, err = clusterClient.Addons().Add().Body(addonInstallation).SendContext(ctx)
 				if err != nil {
 					log.Printf("couldn't install addons: %v", err)
 					return err
@@ -464,7 +463,7 @@ func (o *OCMProvider) InstallAddons(clusterID string, addonIDs []string) (num in
 	return num, nil
 }
 
-func (o *OCMProvider) ocmToSPICluster(ocmCluster *v1.Cluster) (*spi.Cluster, error) {
+func (o *OCMProvider) ocmToSPICluster(ctx context.Context, ocmCluster *v1.Cluster) (*spi.Cluster, error) {
 	var err error
 	var resp *v1.ClusterGetResponse
 
@@ -494,11 +493,11 @@ func (o *OCMProvider) ocmToSPICluster(ocmCluster *v1.Cluster) (*spi.Cluster, err
 	}
 
 	var addonsResp *v1.AddOnInstallationsListResponse
-	err = retryer().Do(func() error {
+	err = o.retryer.Do(func() error {
 		var err error
 		addonsResp, err = o.conn.ClustersMgmt().V1().Clusters().Cluster(ocmCluster.ID()).Addons().
 			List().
-			Send()
+			SendContext(ctx)
 
 		if err != nil {
 			err = fmt.Errorf("couldn't retrieve addons for cluster '%s': %v", ocmCluster.ID(), err)
@@ -553,17 +552,17 @@ func ocmStateToInternalState(state v1.ClusterState) spi.ClusterState {
 }
 
 // ExtendExpiry extends the expiration time of an existing cluster
-func (o *OCMProvider) ExtendExpiry(clusterID string, hours uint64, minutes uint64, seconds uint64) error {
+func (o *OCMProvider) ExtendExpiry(ctx context.Context, clusterID string, hours uint64, minutes uint64, seconds uint64) error {
 	var resp *v1.ClusterUpdateResponse
 
 	// Get the current state of the cluster
-	ocmCluster, err := o.getOCMCluster(clusterID)
+	ocmCluster, err := o.getOCMCluster(ctx, clusterID)
 
 	if err != nil {
 		return err
 	}
 
-	cluster, err := o.ocmToSPICluster(ocmCluster)
+	cluster, err := o.ocmToSPICluster(ctx, ocmCluster)
 	if err != nil {
 		return err
 	}
@@ -586,11 +585,11 @@ func (o *OCMProvider) ExtendExpiry(clusterID string, hours uint64, minutes uint6
 		return fmt.Errorf("error while building updated expiration time cluster object: %v", err)
 	}
 
-	err = retryer().Do(func() error {
+	err = o.retryer.Do(func() error {
 		var err error
 		resp, err = o.conn.ClustersMgmt().V1().Clusters().Cluster(clusterID).Update().
 			Body(extendexpiryCluster).
-			Send()
+			SendContext(ctx)
 
 		if err != nil {
 			err = fmt.Errorf("couldn't update cluster '%s': %v", clusterID, err)
@@ -614,7 +613,7 @@ func (o *OCMProvider) ExtendExpiry(clusterID string, hours uint64, minutes uint6
 		return resp.Error()
 	}
 
-	finalCluster, err := o.GetCluster(clusterID)
+	finalCluster, err := o.GetCluster(ctx, clusterID)
 
 	if err != nil {
 		log.Printf("error attempting to retrieve cluster for verification: %v", err)
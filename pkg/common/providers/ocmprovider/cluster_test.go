@@ -0,0 +1,237 @@
+package ocmprovider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/openshift/osde2e/pkg/common/providers/ocmprovider/fakeocm"
+)
+
+// newFakeProvider returns an OCMProvider talking to a fake OCM server, which must be closed by the caller.
+// The provider claims to be using production so that it never tries to reach the real production
+// environment for versions.
+func newFakeProvider(t *testing.T) (*OCMProvider, *fakeocm.Server) {
+	server := fakeocm.NewServer()
+
+	conn, err := OCMConnection(server.Token(), server.URL, false)
+	if err != nil {
+		t.Fatalf("error connecting to fake OCM: %v", err)
+	}
+
+	cfg := config.NewRunConfig()
+	cfg.State.ClusterVersion = "openshift-v4.5.2"
+	cfg.CloudProvider.CloudProviderID = "aws"
+	cfg.CloudProvider.Region = "us-east-1"
+	cfg.Addons.IDsAtCreation = ""
+	cfg.OCM.UserOverride = "tester"
+
+	return &OCMProvider{
+		cfg:     cfg,
+		env:     prod,
+		conn:    conn,
+		retryer: newRetryer(cfg.OCM.NumRetries),
+	}, server
+}
+
+func TestLaunchCluster(t *testing.T) {
+	provider, server := newFakeProvider(t)
+	defer server.Close()
+	provider.cfg.Addons.IDsAtCreation = "addon-a,addon-b"
+
+	clusterID, err := provider.LaunchCluster(context.Background(), "test-cluster")
+	if err != nil {
+		t.Fatalf("error launching cluster: %v", err)
+	}
+
+	ocmCluster := server.Cluster(clusterID)
+	if ocmCluster == nil {
+		t.Fatalf("cluster %s wasn't created", clusterID)
+	}
+
+	if ocmCluster.Name() != "test-cluster" || ocmCluster.Region().ID() != "us-east-1" || ocmCluster.Version().ID() != "openshift-v4.5.2" {
+		t.Errorf("cluster created with unexpected name, region or version: %s, %s, %s", ocmCluster.Name(), ocmCluster.Region().ID(), ocmCluster.Version().ID())
+	}
+
+	if ocmCluster.Properties()[MadeByOSDe2e] != "true" || ocmCluster.Properties()[OwnedBy] != "tester" {
+		t.Errorf("cluster created with unexpected properties: %v", ocmCluster.Properties())
+	}
+
+	cluster, err := provider.GetCluster(context.Background(), clusterID)
+	if err != nil {
+		t.Fatalf("error getting cluster: %v", err)
+	}
+
+	if len(cluster.Addons()) != 2 {
+		t.Errorf("expected addons requested at creation to be installed, got %v", cluster.Addons())
+	}
+
+	server.SetClusterState(clusterID, v1.ClusterStateReady)
+	if cluster, err = provider.GetCluster(context.Background(), clusterID); err != nil || cluster.State() != "ready" {
+		t.Errorf("expected cluster to be ready, got: %v, %v", cluster, err)
+	}
+}
+
+func TestLaunchClusterRandomRegion(t *testing.T) {
+	provider, server := newFakeProvider(t)
+	defer server.Close()
+	provider.cfg.CloudProvider.Region = "random"
+
+	regions := map[string]bool{}
+	cloudRegions := []*v1.CloudRegion{}
+	for _, id := range []string{"us-east-1", "eu-west-1"} {
+		region, err := v1.NewCloudRegion().ID(id).Enabled(true).Build()
+		if err != nil {
+			t.Fatalf("error building region: %v", err)
+		}
+		cloudRegions = append(cloudRegions, region)
+		regions[id] = true
+	}
+	server.SetRegions("aws", cloudRegions...)
+
+	clusterID, err := provider.LaunchCluster(context.Background(), "test-cluster")
+	if err != nil {
+		t.Fatalf("error launching cluster: %v", err)
+	}
+
+	region := server.Cluster(clusterID).Region().ID()
+	if !regions[region] {
+		t.Errorf("cluster launched in unexpected region %s", region)
+	}
+
+	if provider.cfg.State.Region != region {
+		t.Errorf("expected chosen region %s to be saved in the run state, got %s", region, provider.cfg.State.Region)
+	}
+}
+
+func TestInstallAddons(t *testing.T) {
+	provider, server := newFakeProvider(t)
+	defer server.Close()
+
+	for _, id := range []string{"enabled-addon", "disabled-addon"} {
+		addon, err := v1.NewAddOn().ID(id).Enabled(id == "enabled-addon").Build()
+		if err != nil {
+			t.Fatalf("error building addon: %v", err)
+		}
+		server.AddAddon(addon)
+	}
+
+	clusterID, err := provider.LaunchCluster(context.Background(), "test-cluster")
+	if err != nil {
+		t.Fatalf("error launching cluster: %v", err)
+	}
+
+	// The first attempt to install fails and should be retried.
+	server.FailRequests(http.MethodPost, "/api/clusters_mgmt/v1/clusters/"+clusterID+"/addons", http.StatusServiceUnavailable, 1)
+
+	num, err := provider.InstallAddons(context.Background(), clusterID, []string{"enabled-addon", "disabled-addon"})
+	if err != nil || num != 1 {
+		t.Fatalf("expected one addon to be installed, got: %d, %v", num, err)
+	}
+
+	// Installing again should notice the addon is already there.
+	if num, err = provider.InstallAddons(context.Background(), clusterID, []string{"enabled-addon"}); err != nil || num != 0 {
+		t.Errorf("expected no addons to be installed a second time, got: %d, %v", num, err)
+	}
+
+	if _, err = provider.InstallAddons(context.Background(), clusterID, []string{"missing-addon"}); err == nil {
+		t.Error("expected installing a missing addon to fail")
+	}
+}
+
+func TestExtendExpiry(t *testing.T) {
+	provider, server := newFakeProvider(t)
+	defer server.Close()
+
+	expiration := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	cluster, err := v1.NewCluster().Name("test-cluster").ExpirationTimestamp(expiration).Build()
+	if err != nil {
+		t.Fatalf("error building cluster: %v", err)
+	}
+
+	clusterID, err := server.AddCluster(cluster)
+	if err != nil {
+		t.Fatalf("error adding cluster: %v", err)
+	}
+
+	if err = provider.ExtendExpiry(context.Background(), clusterID, 1, 30, 0); err != nil {
+		t.Fatalf("error extending expiry: %v", err)
+	}
+
+	expected := expiration.Add(90 * time.Minute)
+	if actual := server.Cluster(clusterID).ExpirationTimestamp(); !actual.Equal(expected) {
+		t.Errorf("expected expiration %v, got %v", expected, actual)
+	}
+}
+
+func TestScaleAndListClusters(t *testing.T) {
+	provider, server := newFakeProvider(t)
+	defer server.Close()
+
+	clusterID, err := provider.LaunchCluster(context.Background(), "test-cluster")
+	if err != nil {
+		t.Fatalf("error launching cluster: %v", err)
+	}
+
+	other, err := v1.NewCluster().Name("someone-elses-cluster").Build()
+	if err != nil {
+		t.Fatalf("error building cluster: %v", err)
+	}
+	if _, err = server.AddCluster(other); err != nil {
+		t.Fatalf("error adding cluster: %v", err)
+	}
+
+	clusters, err := provider.ListClusters(context.Background(), "properties.MadeByOSDe2e='true'")
+	if err != nil {
+		t.Fatalf("error listing clusters: %v", err)
+	}
+	if len(clusters) != 1 || clusters[0].ID() != clusterID {
+		t.Errorf("expected only cluster %s to be listed, got %v", clusterID, clusters)
+	}
+
+	if err = provider.ScaleCluster(context.Background(), clusterID, 6); err != nil {
+		t.Fatalf("error scaling cluster: %v", err)
+	}
+	if compute := server.Cluster(clusterID).Nodes().Compute(); compute != 6 {
+		t.Errorf("expected 6 compute nodes, got %d", compute)
+	}
+
+	if err = provider.DeleteCluster(context.Background(), clusterID); err != nil {
+		t.Fatalf("error deleting cluster: %v", err)
+	}
+	if server.Cluster(clusterID) != nil {
+		t.Error("expected cluster to be deleted")
+	}
+}
+
+func TestKubeconfigAndLogs(t *testing.T) {
+	provider, server := newFakeProvider(t)
+	defer server.Close()
+
+	clusterID, err := provider.LaunchCluster(context.Background(), "test-cluster")
+	if err != nil {
+		t.Fatalf("error launching cluster: %v", err)
+	}
+
+	server.SetKubeconfig(clusterID, "fake-kubeconfig")
+	server.SetLogs(clusterID, map[string]string{
+		"hive": "hive log",
+		"mgmt": "mgmt log",
+	})
+
+	kubeconfig, err := provider.ClusterKubeconfig(context.Background(), clusterID)
+	if err != nil || string(kubeconfig) != "fake-kubeconfig" {
+		t.Errorf("unexpected kubeconfig: %s, %v", kubeconfig, err)
+	}
+
+	logs, err := provider.Logs(context.Background(), clusterID)
+	if err != nil {
+		t.Fatalf("error getting logs: %v", err)
+	}
+	if len(logs) != 2 || string(logs["hive"]) != "hive log" {
+		t.Errorf("unexpected logs: %v", logs)
+	}
+}
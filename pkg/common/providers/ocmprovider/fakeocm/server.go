@@ -0,0 +1,640 @@
+// Package fakeocm provides an in-process fake of the OCM API for use in tests.
+package fakeocm
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	accounts "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+const (
+	clustersMgmtPrefix = "/api/clusters_mgmt/v1"
+	accountsMgmtPrefix = "/api/accounts_mgmt/v1"
+
+	// defaultComputeNodes is the number of compute nodes given to clusters created without any.
+	defaultComputeNodes = 4
+)
+
+// propertyClauseRegex matches a single "properties.key='value'" clause in a search query.
+var propertyClauseRegex = regexp.MustCompile(`^properties\.(\w+)\s*=\s*'([^']*)'$`)
+
+// Server is a fake OCM API. It keeps all of its state in memory and understands just enough of the
+// clusters_mgmt and accounts_mgmt APIs for the OCM provider to work against it.
+type Server struct {
+	*httptest.Server
+
+	// InitialState is the state newly created clusters are given. Defaults to installing.
+	InitialState v1.ClusterState
+
+	// OrganizationID is the organization of the current account.
+	OrganizationID string
+
+	clusters      map[string]map[string]interface{}
+	installations map[string][]map[string]interface{}
+	policies      map[string][]map[string]interface{}
+	credentials   map[string]string
+	logs          map[string]map[string]string
+	addons        map[string]*v1.AddOn
+	flavours      map[string]*v1.Flavour
+	regions       map[string][]*v1.CloudRegion
+	versions      []*v1.Version
+	quota         []*accounts.QuotaSummary
+	failures      map[string]*failure
+	requests      []string
+
+	mutex sync.Mutex
+}
+
+// failure is an error returned for a number of requests.
+type failure struct {
+	status int
+	times  int
+}
+
+// NewServer starts a new fake OCM server. It should be closed once no longer needed.
+func NewServer() *Server {
+	s := &Server{
+		InitialState:   v1.ClusterStateInstalling,
+		OrganizationID: "fake-org",
+		clusters:       map[string]map[string]interface{}{},
+		installations:  map[string][]map[string]interface{}{},
+		policies:       map[string][]map[string]interface{}{},
+		credentials:    map[string]string{},
+		logs:           map[string]map[string]string{},
+		addons:         map[string]*v1.AddOn{},
+		flavours:       map[string]*v1.Flavour{},
+		regions:        map[string][]*v1.CloudRegion{},
+		failures:       map[string]*failure{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Token returns an access token the OCM SDK will accept for this server. The token is valid for a day,
+// so the SDK never tries to refresh it.
+func (s *Server) Token() string {
+	encode := func(v string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(v))
+	}
+	claims := fmt.Sprintf(`{"typ":"Bearer","sub":"fake-user","exp":%d}`, time.Now().Add(24*time.Hour).Unix())
+	return encode(`{"alg":"HS256","typ":"JWT"}`) + "." + encode(claims) + "." + encode("fake")
+}
+
+// AddCluster adds an existing cluster. Its ID is generated if it doesn't have one.
+func (s *Server) AddCluster(cluster *v1.Cluster) (string, error) {
+	object, err := toMap(cluster, func(b *bytes.Buffer) error { return v1.MarshalCluster(cluster, b) })
+	if err != nil {
+		return "", err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.storeCluster(object), nil
+}
+
+// Cluster returns the current state of a cluster, or nil if it doesn't exist.
+func (s *Server) Cluster(clusterID string) *v1.Cluster {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	object, ok := s.clusters[clusterID]
+	if !ok {
+		return nil
+	}
+
+	cluster, err := fromMap(object, func(data []byte) (interface{}, error) { return v1.UnmarshalCluster(data) })
+	if err != nil {
+		return nil
+	}
+	return cluster.(*v1.Cluster)
+}
+
+// SetClusterState changes the state of a cluster.
+func (s *Server) SetClusterState(clusterID string, state v1.ClusterState) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if object, ok := s.clusters[clusterID]; ok {
+		object["state"] = string(state)
+	}
+}
+
+// SetKubeconfig sets the kubeconfig returned in a cluster's credentials.
+func (s *Server) SetKubeconfig(clusterID, kubeconfig string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.credentials[clusterID] = kubeconfig
+}
+
+// SetLogs sets the logs available for a cluster.
+func (s *Server) SetLogs(clusterID string, logs map[string]string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.logs[clusterID] = logs
+}
+
+// UpgradePolicies returns the upgrade policies created for a cluster.
+func (s *Server) UpgradePolicies(clusterID string) []map[string]interface{} {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	policies := make([]map[string]interface{}, len(s.policies[clusterID]))
+	copy(policies, s.policies[clusterID])
+	return policies
+}
+
+// AddAddon makes an addon available for installation.
+func (s *Server) AddAddon(addon *v1.AddOn) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.addons[addon.ID()] = addon
+}
+
+// AddFlavour makes a flavour available.
+func (s *Server) AddFlavour(flavour *v1.Flavour) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.flavours[flavour.ID()] = flavour
+}
+
+// SetRegions sets the regions offered by a cloud provider.
+func (s *Server) SetRegions(cloudProvider string, regions ...*v1.CloudRegion) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.regions[cloudProvider] = regions
+}
+
+// SetVersions sets the versions offered.
+func (s *Server) SetVersions(versions ...*v1.Version) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.versions = versions
+}
+
+// SetQuota sets the quota summary of the current account's organization.
+func (s *Server) SetQuota(quota ...*accounts.QuotaSummary) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.quota = quota
+}
+
+// FailRequests makes the next times requests with the given method and path fail with status.
+// The path is relative to the API root, for example "/api/clusters_mgmt/v1/clusters".
+func (s *Server) FailRequests(method, path string, status, times int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.failures[method+" "+path] = &failure{
+		status: status,
+		times:  times,
+	}
+}
+
+// Requests returns every request made so far as "METHOD path".
+func (s *Server) Requests() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	requests := make([]string, len(s.requests))
+	copy(requests, s.requests)
+	return requests
+}
+
+// storeCluster fills in server managed fields and stores the cluster. The mutex must be held.
+func (s *Server) storeCluster(object map[string]interface{}) string {
+	id, _ := object["id"].(string)
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	object["kind"] = v1.ClusterKind
+	object["id"] = id
+	object["href"] = clustersMgmtPrefix + "/clusters/" + id
+	if _, ok := object["state"]; !ok {
+		object["state"] = string(s.InitialState)
+	}
+
+	nodes, _ := object["nodes"].(map[string]interface{})
+	if nodes == nil {
+		nodes = map[string]interface{}{}
+		object["nodes"] = nodes
+	}
+	if _, ok := nodes["compute"]; !ok {
+		nodes["compute"] = defaultComputeNodes
+	}
+
+	// Addons requested at creation become installations, just like the real API.
+	if addons, ok := object["addons"].(map[string]interface{}); ok {
+		if items, ok := addons["items"].([]interface{}); ok {
+			for _, item := range items {
+				if installation, ok := item.(map[string]interface{}); ok {
+					s.addInstallation(id, installation)
+				}
+			}
+		}
+		delete(object, "addons")
+	}
+
+	s.clusters[id] = object
+	return id
+}
+
+// addInstallation records an addon installation for a cluster. The mutex must be held.
+func (s *Server) addInstallation(clusterID string, installation map[string]interface{}) {
+	addon, _ := installation["addon"].(map[string]interface{})
+	addonID, _ := addon["id"].(string)
+
+	installation["kind"] = v1.AddOnInstallationKind
+	installation["id"] = addonID
+	installation["href"] = clustersMgmtPrefix + "/clusters/" + clusterID + "/addons/" + addonID
+	installation["state"] = string(v1.AddOnInstallationStateReady)
+
+	s.installations[clusterID] = append(s.installations[clusterID], installation)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+
+	if f, ok := s.failures[r.Method+" "+r.URL.Path]; ok && f.times > 0 {
+		f.times--
+		writeError(w, f.status, "injected failure")
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("couldn't read body: %v", err))
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, clustersMgmtPrefix+"/"):
+		s.serveClustersMgmt(w, r, strings.Split(strings.TrimPrefix(r.URL.Path, clustersMgmtPrefix+"/"), "/"), body)
+	case strings.HasPrefix(r.URL.Path, accountsMgmtPrefix+"/"):
+		s.serveAccountsMgmt(w, r, strings.Split(strings.TrimPrefix(r.URL.Path, accountsMgmtPrefix+"/"), "/"))
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown path %s", r.URL.Path))
+	}
+}
+
+func (s *Server) serveClustersMgmt(w http.ResponseWriter, r *http.Request, path []string, body []byte) {
+	route := r.Method + " " + path[0]
+	switch {
+	case route == "GET clusters" && len(path) == 1:
+		s.listClusters(w, r)
+	case route == "POST clusters" && len(path) == 1:
+		s.createCluster(w, body)
+	case path[0] == "clusters" && len(path) >= 2:
+		s.serveCluster(w, r, path[1], path[2:], body)
+	case route == "GET versions" && len(path) == 1:
+		versions := s.versions
+		writeList(w, r, v1.VersionListKind, len(versions), func(b *bytes.Buffer, from, to int) error {
+			return v1.MarshalVersionList(versions[from:to], b)
+		})
+	case route == "GET flavours" && len(path) == 2:
+		flavour, ok := s.flavours[path[1]]
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("flavour '%s' not found", path[1]))
+			return
+		}
+		writeObject(w, http.StatusOK, func(b *bytes.Buffer) error { return v1.MarshalFlavour(flavour, b) })
+	case route == "GET cloud_providers" && len(path) == 3 && path[2] == "regions":
+		regions := s.regions[path[1]]
+		writeList(w, r, v1.CloudRegionListKind, len(regions), func(b *bytes.Buffer, from, to int) error {
+			return v1.MarshalCloudRegionList(regions[from:to], b)
+		})
+	case route == "GET addons" && len(path) == 2:
+		addon, ok := s.addons[path[1]]
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("addon '%s' not found", path[1]))
+			return
+		}
+		writeObject(w, http.StatusOK, func(b *bytes.Buffer) error { return v1.MarshalAddOn(addon, b) })
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown path %s", r.URL.Path))
+	}
+}
+
+func (s *Server) serveCluster(w http.ResponseWriter, r *http.Request, clusterID string, path []string, body []byte) {
+	object, ok := s.clusters[clusterID]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("cluster '%s' not found", clusterID))
+		return
+	}
+
+	route := r.Method + " " + strings.Join(path, "/")
+	switch {
+	case route == "GET ":
+		writeJSON(w, http.StatusOK, object)
+	case route == "PATCH ":
+		patch := map[string]interface{}{}
+		if err := json.Unmarshal(body, &patch); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid cluster: %v", err))
+			return
+		}
+		merge(object, patch)
+		writeJSON(w, http.StatusOK, object)
+	case route == "DELETE ":
+		delete(s.clusters, clusterID)
+		delete(s.installations, clusterID)
+		delete(s.policies, clusterID)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNoContent)
+	case route == "GET credentials":
+		credentials, err := v1.NewClusterCredentials().Kubeconfig(s.credentials[clusterID]).Build()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeObject(w, http.StatusOK, func(b *bytes.Buffer) error { return v1.MarshalClusterCredentials(credentials, b) })
+	case route == "GET addons":
+		installations := s.installations[clusterID]
+		writeList(w, r, v1.AddOnInstallationListKind, len(installations), func(b *bytes.Buffer, from, to int) error {
+			data, err := json.Marshal(installations[from:to])
+			b.Write(data)
+			return err
+		})
+	case route == "POST addons":
+		installation := map[string]interface{}{}
+		if err := json.Unmarshal(body, &installation); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid addon installation: %v", err))
+			return
+		}
+		addon, _ := installation["addon"].(map[string]interface{})
+		if addonID, _ := addon["id"].(string); s.addons[addonID] == nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("addon '%s' not found", addonID))
+			return
+		}
+		s.addInstallation(clusterID, installation)
+		writeJSON(w, http.StatusCreated, installation)
+	case route == "GET upgrade_policies":
+		policies := s.policies[clusterID]
+		writeList(w, r, "UpgradePolicyList", len(policies), func(b *bytes.Buffer, from, to int) error {
+			data, err := json.Marshal(policies[from:to])
+			b.Write(data)
+			return err
+		})
+	case route == "POST upgrade_policies":
+		policy := map[string]interface{}{}
+		if err := json.Unmarshal(body, &policy); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid upgrade policy: %v", err))
+			return
+		}
+		if version, _ := policy["version"].(string); version == "" {
+			writeError(w, http.StatusBadRequest, "upgrade policy version is required")
+			return
+		}
+		if nextRun, _ := policy["next_run"].(string); nextRun != "" {
+			if _, err := time.Parse(time.RFC3339, nextRun); err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid next run: %v", err))
+				return
+			}
+		}
+		if len(s.policies[clusterID]) > 0 {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("cluster '%s' already has an upgrade policy", clusterID))
+			return
+		}
+		policyID := uuid.New().String()
+		policy["kind"] = "UpgradePolicy"
+		policy["id"] = policyID
+		policy["href"] = clustersMgmtPrefix + "/clusters/" + clusterID + "/upgrade_policies/" + policyID
+		policy["cluster_id"] = clusterID
+		s.policies[clusterID] = append(s.policies[clusterID], policy)
+		writeJSON(w, http.StatusCreated, policy)
+	case route == "GET logs":
+		ids := []string{}
+		for id := range s.logs[clusterID] {
+			ids = append(ids, id)
+		}
+		writeList(w, r, v1.LogListKind, len(ids), func(b *bytes.Buffer, from, to int) error {
+			logs := []map[string]string{}
+			for _, id := range ids[from:to] {
+				logs = append(logs, map[string]string{"kind": v1.LogKind, "id": id})
+			}
+			data, err := json.Marshal(logs)
+			b.Write(data)
+			return err
+		})
+	case r.Method == "GET" && len(path) == 2 && path[0] == "logs":
+		content, ok := s.logs[clusterID][path[1]]
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("log '%s' not found", path[1]))
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"kind": v1.LogKind, "id": path[1], "content": content})
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown path %s", r.URL.Path))
+	}
+}
+
+func (s *Server) listClusters(w http.ResponseWriter, r *http.Request) {
+	wantedProperties := map[string]string{}
+	if search := r.URL.Query().Get("search"); search != "" {
+		for _, clause := range strings.Split(search, " and ") {
+			matches := propertyClauseRegex.FindStringSubmatch(strings.TrimSpace(clause))
+			if matches == nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported search clause: %s", clause))
+				return
+			}
+			wantedProperties[matches[1]] = matches[2]
+		}
+	}
+
+	clusters := []map[string]interface{}{}
+	for _, object := range s.clusters {
+		properties, _ := object["properties"].(map[string]interface{})
+
+		matches := true
+		for key, value := range wantedProperties {
+			if properties[key] != value {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			clusters = append(clusters, object)
+		}
+	}
+
+	writeList(w, r, v1.ClusterListKind, len(clusters), func(b *bytes.Buffer, from, to int) error {
+		data, err := json.Marshal(clusters[from:to])
+		b.Write(data)
+		return err
+	})
+}
+
+func (s *Server) createCluster(w http.ResponseWriter, body []byte) {
+	if _, err := v1.UnmarshalCluster(body); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid cluster: %v", err))
+		return
+	}
+
+	object := map[string]interface{}{}
+	if err := json.Unmarshal(body, &object); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid cluster: %v", err))
+		return
+	}
+
+	if name, _ := object["name"].(string); name == "" {
+		writeError(w, http.StatusBadRequest, "cluster name is required")
+		return
+	}
+
+	id := s.storeCluster(object)
+	writeJSON(w, http.StatusCreated, s.clusters[id])
+}
+
+func (s *Server) serveAccountsMgmt(w http.ResponseWriter, r *http.Request, path []string) {
+	route := r.Method + " " + strings.Join(path, "/")
+	switch route {
+	case "GET current_account":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"kind":     "Account",
+			"id":       "fake-account",
+			"username": "fake-user",
+			"organization": map[string]interface{}{
+				"kind": "Organization",
+				"id":   s.OrganizationID,
+			},
+		})
+	case "GET organizations/" + s.OrganizationID + "/quota_summary":
+		quota := s.quota
+		writeList(w, r, "QuotaSummaryList", len(quota), func(b *bytes.Buffer, from, to int) error {
+			return accounts.MarshalQuotaSummaryList(quota[from:to], b)
+		})
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown path %s", r.URL.Path))
+	}
+}
+
+// writeList writes a page of a list. marshal writes the items between from and to as a JSON array.
+func writeList(w http.ResponseWriter, r *http.Request, kind string, total int, marshal func(b *bytes.Buffer, from, to int) error) {
+	page, size := 1, 100
+	if value, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil && value > 0 {
+		page = value
+	}
+	if value, err := strconv.Atoi(r.URL.Query().Get("size")); err == nil && value > 0 {
+		size = value
+	}
+
+	from := (page - 1) * size
+	if from > total {
+		from = total
+	}
+	to := from + size
+	if to > total {
+		to = total
+	}
+
+	items := &bytes.Buffer{}
+	if err := marshal(items, from, to); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"kind":  kind,
+		"page":  page,
+		"size":  to - from,
+		"total": total,
+		"items": json.RawMessage(items.Bytes()),
+	})
+}
+
+// writeObject writes an object marshalled by the OCM SDK.
+func writeObject(w http.ResponseWriter, status int, marshal func(b *bytes.Buffer) error) {
+	b := &bytes.Buffer{}
+	if err := marshal(b); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, status, json.RawMessage(b.Bytes()))
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		status = http.StatusInternalServerError
+		data = []byte(fmt.Sprintf(`{"kind":"Error","reason":%q}`, err.Error()))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+func writeError(w http.ResponseWriter, status int, reason string) {
+	writeJSON(w, status, map[string]interface{}{
+		"kind":   "Error",
+		"id":     strconv.Itoa(status),
+		"href":   "/api/clusters_mgmt/v1/errors/" + strconv.Itoa(status),
+		"code":   fmt.Sprintf("CLUSTERS-MGMT-%d", status),
+		"reason": reason,
+	})
+}
+
+// merge applies a JSON merge patch to object.
+func merge(object, patch map[string]interface{}) {
+	for key, value := range patch {
+		if value == nil {
+			delete(object, key)
+			continue
+		}
+
+		patchMap, patchIsMap := value.(map[string]interface{})
+		objectMap, objectIsMap := object[key].(map[string]interface{})
+		if patchIsMap && objectIsMap {
+			merge(objectMap, patchMap)
+		} else {
+			object[key] = value
+		}
+	}
+}
+
+// toMap converts an SDK object to a generic JSON object.
+func toMap(object interface{}, marshal func(b *bytes.Buffer) error) (map[string]interface{}, error) {
+	b := &bytes.Buffer{}
+	if err := marshal(b); err != nil {
+		return nil, fmt.Errorf("couldn't marshal %T: %v", object, err)
+	}
+
+	result := map[string]interface{}{}
+	if err := json.Unmarshal(b.Bytes(), &result); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal %T: %v", object, err)
+	}
+	return result, nil
+}
+
+// fromMap converts a generic JSON object back to an SDK object.
+func fromMap(object map[string]interface{}, unmarshal func(data []byte) (interface{}, error)) (interface{}, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshal(data)
+}
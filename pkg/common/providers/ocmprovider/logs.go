@@ -1,6 +1,7 @@
 package ocmprovider
 
 import (
+	"context"
 	"fmt"
 	"math"
 
@@ -8,9 +9,9 @@ import (
 )
 
 // Logs provides all logs available for clusterID, ids can be optionally provided for only specific logs.
-func (o *OCMProvider) Logs(clusterID string) (logs map[string][]byte, err error) {
+func (o *OCMProvider) Logs(ctx context.Context, clusterID string) (logs map[string][]byte, err error) {
 	var ids []string
-	if ids, err = o.getLogList(clusterID); err != nil {
+	if ids, err = o.getLogList(ctx, clusterID); err != nil {
 		return logs, fmt.Errorf("couldn't get log list: %v", err)
 	}
 
@@ -18,13 +19,13 @@ func (o *OCMProvider) Logs(clusterID string) (logs map[string][]byte, err error)
 	for _, logID := range ids {
 		var resp *v1.LogGetResponse
 
-		retryer().Do(func() error {
+		o.retryer.Do(func() error {
 			var err error
 			resp, err = o.conn.ClustersMgmt().V1().Clusters().Cluster(clusterID).
 				Logs().
 				Log(logID).
 				Get().Parameter("tail", math.MaxInt32-1).
-				Send()
+				SendContext(ctx)
 
 			if err != nil {
 				return err
@@ -45,15 +46,15 @@ func (o *OCMProvider) Logs(clusterID string) (logs map[string][]byte, err error)
 	return
 }
 
-func (o *OCMProvider) getLogList(clusterID string) ([]string, error) {
+func (o *OCMProvider) getLogList(ctx context.Context, clusterID string) ([]string, error) {
 	var resp *v1.LogsListResponse
 
-	err := retryer().Do(func() error {
+	err := o.retryer.Do(func() error {
 		var err error
 		resp, err = o.conn.ClustersMgmt().V1().Clusters().Cluster(clusterID).
 			Logs().
 			List().
-			Send()
+			SendContext(ctx)
 
 		if err != nil {
 			return err
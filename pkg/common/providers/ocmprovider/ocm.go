@@ -2,11 +2,13 @@
 package ocmprovider
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
+	"github.com/adamliesko/retry"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
-	"github.com/spf13/viper"
 
 	ocm "github.com/openshift-online/ocm-sdk-go"
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
@@ -34,9 +36,11 @@ var connectionCache = map[ocmConnectionKey]*ocm.Connection{}
 
 // OCMProvider will provision clusters using the OCM API.
 type OCMProvider struct {
+	cfg          *config.RunConfig
 	env          string
 	conn         *ocm.Connection
 	prodProvider *OCMProvider
+	retryer      *retry.Retryer
 
 	// Since getting versions is a noisy operation, we'll just cache the version retrieval.
 	// This changes rarely and we only ever look at it once at the start of time, so it's not
@@ -46,7 +50,7 @@ type OCMProvider struct {
 }
 
 func init() {
-	spi.RegisterProvider("ocm", func() (spi.Provider, error) { return New() })
+	spi.RegisterProvider("ocm", func(cfg *config.RunConfig) (spi.Provider, error) { return New(cfg) })
 }
 
 // OCMConnection returns a raw OCM connection.
@@ -93,17 +97,14 @@ func OCMConnection(token, env string, debug bool) (*ocm.Connection, error) {
 	return connection, nil
 }
 
-// New returns a new OCMProvisioner.
-func New() (*OCMProvider, error) {
-	return NewWithEnv(viper.GetString(Env))
+// New returns a new OCMProvisioner for the run configured by cfg.
+func New(cfg *config.RunConfig) (*OCMProvider, error) {
+	return NewWithEnv(cfg, cfg.OCM.Env)
 }
 
 // NewWithEnv creates a new provider with a specific environment.
-func NewWithEnv(env string) (*OCMProvider, error) {
-	token := viper.GetString(Token)
-	debug := viper.GetBool(Debug)
-
-	conn, err := OCMConnection(token, env, debug)
+func NewWithEnv(cfg *config.RunConfig, env string) (*OCMProvider, error) {
+	conn, err := OCMConnection(cfg.OCM.Token, env, cfg.OCM.Debug)
 
 	if err != nil {
 		return nil, err
@@ -115,7 +116,7 @@ func NewWithEnv(env string) (*OCMProvider, error) {
 	// able to get the default version in production. This will allow us to make relative version
 	// upgrades by measuring against the current production default.
 	if env != prod {
-		prodProvider, err = NewWithEnv(prod)
+		prodProvider, err = NewWithEnv(cfg, prod)
 
 		if err != nil {
 			return nil, err
@@ -123,9 +124,11 @@ func NewWithEnv(env string) (*OCMProvider, error) {
 	}
 
 	return &OCMProvider{
+		cfg:              cfg,
 		env:              env,
 		conn:             conn,
 		prodProvider:     prodProvider,
+		retryer:          newRetryer(cfg.OCM.NumRetries),
 		versionCacheOnce: sync.Once{},
 	}, nil
 }
@@ -136,8 +139,8 @@ func (o *OCMProvider) Environment() string {
 }
 
 // Metrics returns the metrics of the cluster
-func (o *OCMProvider) Metrics(clusterID string) (*v1.ClusterMetrics, error) {
-	return o.GetMetrics(clusterID)
+func (o *OCMProvider) Metrics(ctx context.Context, clusterID string) (*v1.ClusterMetrics, error) {
+	return o.GetMetrics(ctx, clusterID)
 }
 
 // UpgradeSource indicates that for stage/production clusters, we should use Cincinnati.
@@ -1,14 +1,13 @@
 package ocmprovider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 
 	accounts "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
-	"github.com/openshift/osde2e/pkg/common/config"
-	"github.com/spf13/viper"
 )
 
 const (
@@ -17,12 +16,12 @@ const (
 )
 
 // CheckQuota determines if enough quota is available to launch with cfg.
-func (o *OCMProvider) CheckQuota() (bool, error) {
+func (o *OCMProvider) CheckQuota(ctx context.Context) (bool, error) {
 	// get flavour being deployed
 	var flavourResp *v1.FlavourGetResponse
-	err := retryer().Do(func() error {
+	err := o.retryer.Do(func() error {
 		var err error
-		flavourResp, err = o.conn.ClustersMgmt().V1().Flavours().Flavour(DefaultFlavour).Get().Send()
+		flavourResp, err = o.conn.ClustersMgmt().V1().Flavours().Flavour(DefaultFlavour).Get().SendContext(ctx)
 
 		if err != nil {
 			return err
@@ -48,7 +47,7 @@ func (o *OCMProvider) CheckQuota() (bool, error) {
 	flavour := flavourResp.Body()
 
 	// get quota
-	quotaList, err := o.currentAccountQuota()
+	quotaList, err := o.currentAccountQuota(ctx)
 	if err != nil {
 		return false, fmt.Errorf("could not get quota: %v", err)
 	}
@@ -58,11 +57,11 @@ func (o *OCMProvider) CheckQuota() (bool, error) {
 	machineType := ""
 
 	quotaFound := false
-	resourceClusterType := fmt.Sprintf(resourceClusterFmt, viper.GetString(config.CloudProvider.CloudProviderID))
+	resourceClusterType := fmt.Sprintf(resourceClusterFmt, o.cfg.CloudProvider.CloudProviderID)
 	for _, q := range quotaList.Slice() {
-		if quotaFound = HasQuotaFor(q, resourceClusterType, machineType); quotaFound {
+		if quotaFound = HasQuotaFor(q, resourceClusterType, machineType, o.cfg.Cluster.MultiAZ); quotaFound {
 			log.Printf("Quota for test config (%s/%s/multiAZ=%t) found: total=%d, remaining: %d",
-				resourceClusterType, machineType, viper.GetBool(config.Cluster.MultiAZ), q.Allowed(), q.Allowed()-q.Reserved())
+				resourceClusterType, machineType, o.cfg.Cluster.MultiAZ, q.Allowed(), q.Allowed()-q.Reserved())
 			break
 		}
 	}
@@ -71,8 +70,8 @@ func (o *OCMProvider) CheckQuota() (bool, error) {
 }
 
 // CurrentAccountQuota returns quota available for the current account's organization in the environment.
-func (o *OCMProvider) currentAccountQuota() (*accounts.QuotaSummaryList, error) {
-	resp, err := o.conn.AccountsMgmt().V1().CurrentAccount().Get().Send()
+func (o *OCMProvider) currentAccountQuota(ctx context.Context) (*accounts.QuotaSummaryList, error) {
+	resp, err := o.conn.AccountsMgmt().V1().CurrentAccount().Get().SendContext(ctx)
 	if err != nil || resp == nil {
 		return nil, fmt.Errorf("couldn't get current account: %v", err)
 	}
@@ -86,9 +85,9 @@ func (o *OCMProvider) currentAccountQuota() (*accounts.QuotaSummaryList, error)
 	orgID := acc.Organization().ID()
 
 	var quotaList *accounts.QuotaSummaryListResponse
-	err = retryer().Do(func() error {
+	err = o.retryer.Do(func() error {
 		var err error
-		quotaList, err = o.conn.AccountsMgmt().V1().Organizations().Organization(orgID).QuotaSummary().List().Send()
+		quotaList, err = o.conn.AccountsMgmt().V1().Organizations().Organization(orgID).QuotaSummary().List().SendContext(ctx)
 
 		if err != nil {
 			return err
@@ -110,9 +109,9 @@ func (o *OCMProvider) currentAccountQuota() (*accounts.QuotaSummaryList, error)
 }
 
 // HasQuotaFor the desired configuration. If machineT is empty a default will try to be selected.
-func HasQuotaFor(q *accounts.QuotaSummary, resourceType, machineType string) bool {
+func HasQuotaFor(q *accounts.QuotaSummary, resourceType, machineType string, multiAZ bool) bool {
 	azType := "single"
-	if viper.GetBool(config.Cluster.MultiAZ) {
+	if multiAZ {
 		azType = "multi"
 	}
 
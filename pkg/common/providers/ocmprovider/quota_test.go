@@ -0,0 +1,106 @@
+package ocmprovider
+
+import (
+	"context"
+	"testing"
+
+	accounts "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+func TestCheckQuota(t *testing.T) {
+	provider, server := newFakeProvider(t)
+	defer server.Close()
+	provider.cfg.Cluster.MultiAZ = false
+
+	if _, err := provider.CheckQuota(context.Background()); err == nil {
+		t.Error("expected an error when the flavour doesn't exist")
+	}
+
+	flavour, err := v1.NewFlavour().ID(DefaultFlavour).Build()
+	if err != nil {
+		t.Fatalf("error building flavour: %v", err)
+	}
+	server.AddFlavour(flavour)
+
+	tests := []struct {
+		Name     string
+		Reserved int
+		AZType   string
+		HasQuota bool
+	}{
+		{
+			Name:     "quota available",
+			Reserved: 1,
+			AZType:   "single",
+			HasQuota: true,
+		},
+		{
+			Name:     "quota used up",
+			Reserved: 2,
+			AZType:   "single",
+			HasQuota: false,
+		},
+		{
+			Name:     "quota only for multi AZ",
+			Reserved: 0,
+			AZType:   "multi",
+			HasQuota: false,
+		},
+	}
+
+	for _, test := range tests {
+		quota, err := accounts.NewQuotaSummary().
+			ResourceType("cluster.aws").
+			AvailabilityZoneType(test.AZType).
+			Allowed(2).
+			Reserved(test.Reserved).
+			Build()
+		if err != nil {
+			t.Fatalf("Test %s: error building quota: %v", test.Name, err)
+		}
+		server.SetQuota(quota)
+
+		hasQuota, err := provider.CheckQuota(context.Background())
+		if err != nil {
+			t.Errorf("Test %s: error checking quota: %v", test.Name, err)
+		}
+
+		if hasQuota != test.HasQuota {
+			t.Errorf("Test %s: expected quota to be %t, got %t", test.Name, test.HasQuota, hasQuota)
+		}
+	}
+}
+
+func TestVersions(t *testing.T) {
+	provider, server := newFakeProvider(t)
+	defer server.Close()
+
+	versions := []*v1.Version{}
+	for _, id := range []string{"openshift-v4.5.2", "openshift-v4.4.9", "openshift-v4.5.0", "openshift-v4.3.0"} {
+		version, err := v1.NewVersion().ID(id).Enabled(id != "openshift-v4.3.0").Default(id == "openshift-v4.5.0").Build()
+		if err != nil {
+			t.Fatalf("error building version: %v", err)
+		}
+		versions = append(versions, version)
+	}
+	server.SetVersions(versions...)
+
+	versionList, err := provider.Versions(context.Background())
+	if err != nil {
+		t.Fatalf("error getting versions: %v", err)
+	}
+
+	available := versionList.AvailableVersions()
+	if len(available) != 3 {
+		t.Fatalf("expected 3 enabled versions, got %d", len(available))
+	}
+
+	if available[0].Version().String() != "4.4.9" || available[2].Version().String() != "4.5.2" {
+		t.Errorf("expected versions to be sorted, got %v", available)
+	}
+
+	if versionList.Default().String() != "4.5.0" {
+		t.Errorf("expected default version 4.5.0, got %s", versionList.Default())
+	}
+}
@@ -46,8 +46,7 @@ func TestRetryer(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		retryer := retryer()
-		retryer.Tries = 3
+		retryer := newRetryer(3)
 		err := retryer.Do(test.Function)
 
 		if test.Attempts != retryer.Attempts() {
@@ -0,0 +1,57 @@
+package ocmprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	ocmerr "github.com/openshift-online/ocm-sdk-go/errors"
+)
+
+// upgradePolicy is an OCM upgrade policy. The vendored SDK predates upgrade policies, so they're sent as plain JSON.
+type upgradePolicy struct {
+	Kind         string `json:"kind"`
+	ScheduleType string `json:"schedule_type"`
+	UpgradeType  string `json:"upgrade_type"`
+	Version      string `json:"version"`
+	NextRun      string `json:"next_run"`
+}
+
+// UpgradeCluster creates a manual upgrade policy that upgrades the cluster to version at t.
+func (o *OCMProvider) UpgradeCluster(ctx context.Context, clusterID string, version string, t time.Time) error {
+	body, err := json.Marshal(upgradePolicy{
+		Kind:         "UpgradePolicy",
+		ScheduleType: "manual",
+		UpgradeType:  "OSD",
+		Version:      strings.TrimPrefix(version, "openshift-v"),
+		NextRun:      t.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return fmt.Errorf("error building upgrade policy: %v", err)
+	}
+
+	return o.retryer.Do(func() error {
+		resp, err := o.conn.Post().
+			Path(fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/upgrade_policies", clusterID)).
+			Bytes(body).
+			SendContext(ctx)
+		if err != nil {
+			err = fmt.Errorf("couldn't create upgrade policy for cluster '%s': %v", clusterID, err)
+			log.Printf("%v", err)
+			return err
+		}
+
+		if resp.Status() != http.StatusCreated {
+			if apiErr, err := ocmerr.UnmarshalError(resp.Bytes()); err == nil {
+				return errResp(apiErr)
+			}
+			return fmt.Errorf("couldn't create upgrade policy for cluster '%s': status %d", clusterID, resp.Status())
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,39 @@
+package ocmprovider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+func TestUpgradeCluster(t *testing.T) {
+	provider, server := newFakeProvider(t)
+	defer server.Close()
+
+	cluster, err := v1.NewCluster().Name("test-cluster").Build()
+	if err != nil {
+		t.Fatalf("error building cluster: %v", err)
+	}
+
+	clusterID, err := server.AddCluster(cluster)
+	if err != nil {
+		t.Fatalf("error adding cluster: %v", err)
+	}
+
+	nextRun := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err = provider.UpgradeCluster(context.Background(), clusterID, "openshift-v4.7.2", nextRun); err != nil {
+		t.Fatalf("error upgrading cluster: %v", err)
+	}
+
+	policies := server.UpgradePolicies(clusterID)
+	if len(policies) != 1 {
+		t.Fatalf("expected 1 upgrade policy, got %d", len(policies))
+	}
+
+	policy := policies[0]
+	if policy["version"] != "4.7.2" || policy["schedule_type"] != "manual" || policy["upgrade_type"] != "OSD" || policy["next_run"] != "2021-03-01T12:00:00Z" {
+		t.Errorf("unexpected upgrade policy: %v", policy)
+	}
+}
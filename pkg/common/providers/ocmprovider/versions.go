@@ -1,6 +1,7 @@
 package ocmprovider
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sort"
@@ -24,7 +25,7 @@ const (
 
 // Versions will return all of the available version and a default override of the production default version
 // if using a non-production environment.
-func (o *OCMProvider) Versions() (*spi.VersionList, error) {
+func (o *OCMProvider) Versions(ctx context.Context) (*spi.VersionList, error) {
 	var err error
 
 	o.versionCacheOnce.Do(func() {
@@ -34,10 +35,10 @@ func (o *OCMProvider) Versions() (*spi.VersionList, error) {
 		log.Printf("Querying cluster versions endpoint.")
 		for {
 			var resp *v1.VersionsListResponse
-			err = retryer().Do(func() error {
+			err = o.retryer.Do(func() error {
 				var err error
 
-				resp, err = o.conn.ClustersMgmt().V1().Versions().List().Page(page).Size(PageSize).Send()
+				resp, err = o.conn.ClustersMgmt().V1().Versions().List().Page(page).Size(PageSize).SendContext(ctx)
 
 				if err != nil {
 					return err
@@ -95,7 +96,7 @@ func (o *OCMProvider) Versions() (*spi.VersionList, error) {
 
 		if o.env != prod {
 			var versionList *spi.VersionList
-			versionList, err = o.prodProvider.Versions()
+			versionList, err = o.prodProvider.Versions(ctx)
 
 			if err != nil {
 				err = fmt.Errorf("error getting production default: %v", err)
@@ -4,6 +4,7 @@ package providers
 // This import list is necessary due to the statically linked nature of go
 import (
 	_ "github.com/openshift/osde2e/pkg/common/providers/crc"
+	_ "github.com/openshift/osde2e/pkg/common/providers/local"
 	_ "github.com/openshift/osde2e/pkg/common/providers/moaprovider"
 	_ "github.com/openshift/osde2e/pkg/common/providers/mock"
 	_ "github.com/openshift/osde2e/pkg/common/providers/ocmprovider"
@@ -0,0 +1,230 @@
+// Package releasecontroller queries the release controller, which builds and tests the nightly and CI releases of OpenShift.
+package releasecontroller
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+)
+
+const (
+	// PhaseAccepted tags passed the tests the release controller runs on them.
+	PhaseAccepted = "Accepted"
+
+	// PhaseRejected tags failed the tests the release controller runs on them.
+	PhaseRejected = "Rejected"
+
+	// PhaseReady tags are still being tested.
+	PhaseReady = "Ready"
+)
+
+// tagTimeRegex matches the time a nightly or CI release was built, which ends its name.
+var tagTimeRegex = regexp.MustCompile(`(\d{4}-\d{2}-\d{2}-\d{6})$`)
+
+// Tag is a release in a release stream.
+type Tag struct {
+	Name        string `json:"name"`
+	Phase       string `json:"phase"`
+	PullSpec    string `json:"pullSpec"`
+	DownloadURL string `json:"downloadURL"`
+}
+
+// Created returns when the release was built, if its name says.
+func (t Tag) Created() (time.Time, bool) {
+	match := tagTimeRegex.FindString(t.Name)
+	if match == "" {
+		return time.Time{}, false
+	}
+
+	created, err := time.Parse("2006-01-02-150405", match)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return created, true
+}
+
+// Client queries a release controller. Responses can be recorded as fixtures and replayed later, so tests and
+// offline runs don't depend on the release controller.
+type Client struct {
+	// URL is the base URL of the release controller.
+	URL string
+
+	// Fixtures is a directory of recorded responses. If set, responses are only ever read from it.
+	Fixtures string
+
+	// RecordFixtures is a directory responses are recorded in, in the layout read from Fixtures.
+	RecordFixtures string
+
+	// HTTPClient is used to query the release controller.
+	HTTPClient *http.Client
+
+	// now is the clock used to work out how old releases are.
+	now func() time.Time
+}
+
+// NewClient returns a client configured by cfg.
+func NewClient(cfg *config.RunConfig) *Client {
+	return &Client{
+		URL:            cfg.ReleaseController.URL,
+		Fixtures:       cfg.ReleaseController.Fixtures,
+		RecordFixtures: cfg.ReleaseController.RecordFixtures,
+		HTTPClient:     &http.Client{Timeout: 30 * time.Second},
+		now:            time.Now,
+	}
+}
+
+// Streams returns the name of every release stream.
+func (c *Client) Streams() ([]string, error) {
+	data, err := c.get("/api/v1/releasestreams/all", nil, "streams.json")
+	if err != nil {
+		return nil, err
+	}
+
+	streams := map[string]json.RawMessage{}
+	if err = json.Unmarshal(data, &streams); err != nil {
+		return nil, fmt.Errorf("error parsing release streams: %v", err)
+	}
+
+	names := []string{}
+	for name := range streams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Tags returns the releases of a stream in a phase, newest first. Every release is returned if phase is empty.
+func (c *Client) Tags(stream, phase string) ([]Tag, error) {
+	data, err := c.get(fmt.Sprintf("/api/v1/releasestream/%s/tags", url.PathEscape(stream)), nil, filepath.Join(stream, "tags.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var tags struct {
+		Tags []Tag `json:"tags"`
+	}
+	if err = json.Unmarshal(data, &tags); err != nil {
+		return nil, fmt.Errorf("error parsing tags of release stream %s: %v", stream, err)
+	}
+
+	filtered := []Tag{}
+	for _, tag := range tags.Tags {
+		if phase == "" || tag.Phase == phase {
+			filtered = append(filtered, tag)
+		}
+	}
+
+	// The release controller lists tags newest first, but names with times are sorted to be sure.
+	sort.SliceStable(filtered, func(i, j int) bool {
+		iCreated, iOK := filtered[i].Created()
+		jCreated, jOK := filtered[j].Created()
+		return iOK && jOK && iCreated.After(jCreated)
+	})
+	return filtered, nil
+}
+
+// Accepted returns the accepted releases of a stream, newest first.
+func (c *Client) Accepted(stream string) ([]Tag, error) {
+	return c.Tags(stream, PhaseAccepted)
+}
+
+// Rejected returns the rejected releases of a stream, newest first.
+func (c *Client) Rejected(stream string) ([]Tag, error) {
+	return c.Tags(stream, PhaseRejected)
+}
+
+// LatestOlderThan returns the newest release of a stream in a phase that was built at least age ago. It returns
+// nil if there isn't one. Releases whose names don't say when they were built are only returned if age is zero.
+func (c *Client) LatestOlderThan(stream, phase string, age time.Duration) (*Tag, error) {
+	tags, err := c.Tags(stream, phase)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now
+	if c.now != nil {
+		now = c.now
+	}
+
+	for i, tag := range tags {
+		if age == 0 {
+			return &tags[i], nil
+		}
+		if created, ok := tag.Created(); ok && !created.After(now().Add(-age)) {
+			return &tags[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// Changelog returns the changes between two releases as markdown.
+func (c *Client) Changelog(from, to string) (string, error) {
+	query := url.Values{}
+	query.Set("from", from)
+	query.Set("to", to)
+
+	data, err := c.get("/changelog", query, filepath.Join("changelog", fmt.Sprintf("%s..%s.md", from, to)))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// get reads a response from the fixtures or the release controller, recording it if asked to.
+func (c *Client) get(path string, query url.Values, fixture string) ([]byte, error) {
+	if c.Fixtures != "" {
+		data, err := ioutil.ReadFile(filepath.Join(c.Fixtures, fixture))
+		if err != nil {
+			return nil, fmt.Errorf("error reading release controller fixture %s: %v", fixture, err)
+		}
+		return data, nil
+	}
+
+	requestURL := strings.TrimSuffix(c.URL, "/") + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Get(requestURL)
+	if err != nil {
+		return nil, fmt.Errorf("request failed for URL '%s': %v", requestURL, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading body: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("release controller returned %s for URL '%s': %s", resp.Status, requestURL, data)
+	}
+
+	if c.RecordFixtures != "" {
+		recordPath := filepath.Join(c.RecordFixtures, fixture)
+		if err = os.MkdirAll(filepath.Dir(recordPath), os.ModePerm); err == nil {
+			err = ioutil.WriteFile(recordPath, data, 0644)
+		}
+		if err != nil {
+			log.Printf("Unable to record release controller fixture %s: %v", fixture, err)
+		}
+	}
+
+	return data, nil
+}
@@ -0,0 +1,108 @@
+package releasecontroller
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testTags = `{
+  "name": "4.7.0-0.nightly",
+  "tags": [
+    {"name": "4.7.0-0.nightly-2021-03-01-060000", "phase": "Rejected", "pullSpec": "registry.ci.openshift.org/ocp/release:4.7.0-0.nightly-2021-03-01-060000"},
+    {"name": "4.7.0-0.nightly-2021-03-01-120000", "phase": "Ready", "pullSpec": "registry.ci.openshift.org/ocp/release:4.7.0-0.nightly-2021-03-01-120000"},
+    {"name": "4.7.0-0.nightly-2021-02-28-120000", "phase": "Accepted", "pullSpec": "registry.ci.openshift.org/ocp/release:4.7.0-0.nightly-2021-02-28-120000"},
+    {"name": "4.7.0-0.nightly-2021-03-01-000000", "phase": "Accepted", "pullSpec": "registry.ci.openshift.org/ocp/release:4.7.0-0.nightly-2021-03-01-000000"}
+  ]
+}`
+
+func TestRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/releasestreams/all":
+			w.Write([]byte(`{"4.7.0-0.nightly": ["4.7.0-0.nightly-2021-03-01-000000"], "4.6.0-0.nightly": []}`))
+		case "/api/v1/releasestream/4.7.0-0.nightly/tags":
+			w.Write([]byte(testTags))
+		case "/changelog":
+			w.Write([]byte("## Changes from " + r.URL.Query().Get("from") + " to " + r.URL.Query().Get("to")))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	fixtures, err := ioutil.TempDir("", "release-controller-fixtures")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(fixtures)
+
+	now := time.Date(2021, 3, 1, 18, 0, 0, 0, time.UTC)
+	recording := &Client{URL: server.URL, RecordFixtures: fixtures, now: func() time.Time { return now }}
+	checkClient(t, "recording", recording)
+
+	if _, err = recording.Tags("4.8.0-0.nightly", ""); err == nil {
+		t.Errorf("expected an error getting the tags of a missing stream")
+	}
+
+	// Once recorded, the release controller is no longer needed.
+	server.Close()
+	replaying := &Client{URL: server.URL, Fixtures: fixtures, now: func() time.Time { return now }}
+	checkClient(t, "replaying", replaying)
+}
+
+func checkClient(t *testing.T, name string, client *Client) {
+	streams, err := client.Streams()
+	if err != nil || strings.Join(streams, ",") != "4.6.0-0.nightly,4.7.0-0.nightly" {
+		t.Errorf("%s: unexpected streams %v: %v", name, streams, err)
+	}
+
+	accepted, err := client.Accepted("4.7.0-0.nightly")
+	if err != nil || len(accepted) != 2 || accepted[0].Name != "4.7.0-0.nightly-2021-03-01-000000" {
+		t.Errorf("%s: expected accepted tags newest first, got %v: %v", name, accepted, err)
+	}
+
+	rejected, err := client.Rejected("4.7.0-0.nightly")
+	if err != nil || len(rejected) != 1 || rejected[0].Name != "4.7.0-0.nightly-2021-03-01-060000" {
+		t.Errorf("%s: unexpected rejected tags %v: %v", name, rejected, err)
+	}
+
+	tests := []struct {
+		age      time.Duration
+		expected string
+	}{
+		{0, "4.7.0-0.nightly-2021-03-01-000000"},
+		{12 * time.Hour, "4.7.0-0.nightly-2021-03-01-000000"},
+		{24 * time.Hour, "4.7.0-0.nightly-2021-02-28-120000"},
+		{48 * time.Hour, ""},
+	}
+	for _, test := range tests {
+		tag, err := client.LatestOlderThan("4.7.0-0.nightly", PhaseAccepted, test.age)
+		if err != nil {
+			t.Fatalf("%s: error getting latest tag: %v", name, err)
+		}
+		if (tag == nil && test.expected != "") || (tag != nil && tag.Name != test.expected) {
+			t.Errorf("%s: expected the latest accepted tag older than %v to be %q, got %+v", name, test.age, test.expected, tag)
+		}
+	}
+
+	changelog, err := client.Changelog("4.7.0-0.nightly-2021-02-28-120000", "4.7.0-0.nightly-2021-03-01-000000")
+	if err != nil || changelog != "## Changes from 4.7.0-0.nightly-2021-02-28-120000 to 4.7.0-0.nightly-2021-03-01-000000" {
+		t.Errorf("%s: unexpected changelog %q: %v", name, changelog, err)
+	}
+}
+
+func TestTagCreated(t *testing.T) {
+	created, ok := Tag{Name: "4.7.0-0.nightly-2021-03-01-123456"}.Created()
+	if !ok || !created.Equal(time.Date(2021, 3, 1, 12, 34, 56, 0, time.UTC)) {
+		t.Errorf("unexpected creation time %v", created)
+	}
+
+	if _, ok = (Tag{Name: "4.7.2"}).Created(); ok {
+		t.Errorf("expected a release without a time not to have a creation time")
+	}
+}
@@ -0,0 +1,481 @@
+// Package releaseimage reads the metadata of OpenShift release images straight from their registry, without
+// pulling them.
+package releaseimage
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// versionLabel is the label of a release image's config holding its version.
+	versionLabel = "io.openshift.release"
+
+	// imageReferencesFile lists the component images of a release.
+	imageReferencesFile = "release-manifests/image-references"
+
+	// releaseMetadataFile holds the version of a release and the versions it can be upgraded from.
+	releaseMetadataFile = "release-manifests/release-metadata"
+)
+
+// manifestTypes are the manifest media types understood, in order of preference.
+var manifestTypes = []string{
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+}
+
+// challengeParamRegex matches the parameters of a WWW-Authenticate challenge.
+var challengeParamRegex = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// Reference is a parsed image reference.
+type Reference struct {
+	// Registry is the host of the registry, with its port if it has one.
+	Registry string
+
+	// Repository is the path of the repository in the registry.
+	Repository string
+
+	// Tag is the tag of the image, if it's referenced by tag.
+	Tag string
+
+	// Digest is the digest of the image, if it's referenced by digest.
+	Digest string
+}
+
+// ParseReference parses an image reference. Release images are always pulled from a named registry, so the
+// reference must include one.
+func ParseReference(image string) (Reference, error) {
+	ref := Reference{}
+
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, ref.Digest = name[:i], name[i+1:]
+		if !strings.HasPrefix(ref.Digest, "sha256:") {
+			return ref, fmt.Errorf("image '%s' has an unsupported digest", image)
+		}
+	} else if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, ref.Tag = name[:i], name[i+1:]
+	} else {
+		ref.Tag = "latest"
+	}
+
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) != 2 || parts[1] == "" || (!strings.ContainsAny(parts[0], ".:") && parts[0] != "localhost") {
+		return ref, fmt.Errorf("image '%s' doesn't name a registry and repository", image)
+	}
+	ref.Registry, ref.Repository = parts[0], parts[1]
+	return ref, nil
+}
+
+// String returns the reference in its usual form.
+func (r Reference) String() string {
+	if r.Digest != "" {
+		return fmt.Sprintf("%s/%s@%s", r.Registry, r.Repository, r.Digest)
+	}
+	return fmt.Sprintf("%s/%s:%s", r.Registry, r.Repository, r.Tag)
+}
+
+// Release is the metadata of a release image.
+type Release struct {
+	// Image is the reference the release was inspected by.
+	Image Reference
+
+	// Digest is the digest of the image's manifest, which its signatures are made for.
+	Digest string
+
+	// Version is the version of the release.
+	Version string
+
+	// Previous are the versions the release can be upgraded from, if the image lists them.
+	Previous []string
+
+	// URL is the errata of the release, if the image has one.
+	URL string
+
+	// Components maps the name of each component of the release to its image.
+	Components map[string]string
+}
+
+// Client reads release images from registries.
+type Client struct {
+	// Arch is the architecture picked from images built for several architectures.
+	Arch string
+
+	// Auths maps registries to the base64 encoded "user:password" used to pull from them. Images are pulled
+	// anonymously from registries that aren't listed.
+	Auths map[string]string
+
+	// HTTPClient is used to talk to registries.
+	HTTPClient *http.Client
+}
+
+// NewClient returns a client using the credentials in authFile, a Docker config.json or pull secret. Images are
+// pulled anonymously if authFile is empty.
+func NewClient(arch, authFile string) (*Client, error) {
+	c := &Client{
+		Arch:       arch,
+		Auths:      map[string]string{},
+		HTTPClient: &http.Client{Timeout: 5 * time.Minute},
+	}
+
+	if authFile == "" {
+		return c, nil
+	}
+
+	data, err := ioutil.ReadFile(authFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading registry credentials: %v", err)
+	}
+
+	var config struct {
+		Auths map[string]struct {
+			Auth string `json:"auth"`
+		} `json:"auths"`
+	}
+	if err = json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("error parsing registry credentials: %v", err)
+	}
+	for registry, auth := range config.Auths {
+		c.Auths[registry] = auth.Auth
+	}
+	return c, nil
+}
+
+// manifest is an image manifest or manifest list.
+type manifest struct {
+	MediaType string `json:"mediaType"`
+	Config    struct {
+		Digest string `json:"digest"`
+	} `json:"config"`
+	Layers []struct {
+		MediaType string `json:"mediaType"`
+		Digest    string `json:"digest"`
+	} `json:"layers"`
+	Manifests []struct {
+		Digest   string `json:"digest"`
+		Platform struct {
+			Architecture string `json:"architecture"`
+			OS           string `json:"os"`
+		} `json:"platform"`
+	} `json:"manifests"`
+}
+
+// imageStream is the part of the image-references ImageStream needed to list components.
+type imageStream struct {
+	Spec struct {
+		Tags []struct {
+			Name string `json:"name"`
+			From struct {
+				Name string `json:"name"`
+			} `json:"from"`
+		} `json:"tags"`
+	} `json:"spec"`
+}
+
+// releaseMetadata is the release-metadata file of a release image.
+type releaseMetadata struct {
+	Version  string   `json:"version"`
+	Previous []string `json:"previous"`
+	Metadata struct {
+		URL string `json:"url"`
+	} `json:"metadata"`
+}
+
+// session talks to the repository of one image, reusing the token it's given.
+type session struct {
+	client *Client
+	ref    Reference
+	token  string
+}
+
+// Inspect reads the metadata of a release image.
+func (c *Client) Inspect(ctx context.Context, image string) (*Release, error) {
+	ref, err := ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &session{client: c, ref: ref}
+	reference := ref.Digest
+	if reference == "" {
+		reference = ref.Tag
+	}
+
+	m, digest, err := s.manifest(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+	release := &Release{Image: ref, Digest: digest, Components: map[string]string{}}
+
+	if len(m.Manifests) > 0 {
+		platformDigest := ""
+		for _, entry := range m.Manifests {
+			if entry.Platform.OS == "linux" && entry.Platform.Architecture == c.Arch {
+				platformDigest = entry.Digest
+			}
+		}
+		if platformDigest == "" {
+			return nil, fmt.Errorf("image '%s' wasn't built for linux/%s", image, c.Arch)
+		}
+		if m, _, err = s.manifest(ctx, platformDigest); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.readConfig(ctx, m.Config.Digest, release); err != nil {
+		return nil, err
+	}
+
+	// The release manifests are added last, so they're in one of the top layers.
+	var metadata *releaseMetadata
+	var stream *imageStream
+	for i := len(m.Layers) - 1; i >= 0 && (metadata == nil || stream == nil); i-- {
+		layer := m.Layers[i]
+		if err = s.readLayer(ctx, layer.Digest, layer.MediaType, func(name string, r io.Reader) error {
+			switch name {
+			case releaseMetadataFile:
+				metadata = &releaseMetadata{}
+				return json.NewDecoder(r).Decode(metadata)
+			case imageReferencesFile:
+				stream = &imageStream{}
+				return json.NewDecoder(r).Decode(stream)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if stream == nil {
+		return nil, fmt.Errorf("image '%s' has no %s, so it isn't a release image", image, imageReferencesFile)
+	}
+	for _, tag := range stream.Spec.Tags {
+		release.Components[tag.Name] = tag.From.Name
+	}
+
+	if metadata != nil {
+		if release.Version == "" {
+			release.Version = metadata.Version
+		}
+		release.Previous = metadata.Previous
+		release.URL = metadata.Metadata.URL
+	}
+
+	return release, nil
+}
+
+// HasSignature returns true if the signature store at storeURL has a signature for digest. Stores lay out
+// signatures as <store>/sha256=<hash>/signature-<n>.
+func (c *Client) HasSignature(ctx context.Context, storeURL, digest string) (bool, error) {
+	signatureURL := fmt.Sprintf("%s/%s/signature-1", strings.TrimSuffix(storeURL, "/"), strings.Replace(digest, ":", "=", 1))
+	req, err := http.NewRequest(http.MethodGet, signatureURL, nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return false, fmt.Errorf("error fetching signature: %v", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound, http.StatusForbidden:
+		return false, nil
+	default:
+		return false, fmt.Errorf("error fetching signature: %s returned %s", signatureURL, resp.Status)
+	}
+}
+
+// manifest returns the manifest with the given tag or digest, and the manifest's digest.
+func (s *session) manifest(ctx context.Context, reference string) (*manifest, string, error) {
+	resp, err := s.get(ctx, "manifests/"+reference, strings.Join(manifestTypes, ", "))
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("error reading manifest of '%s': %v", s.ref, err)
+	}
+
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		digest = fmt.Sprintf("sha256:%x", sha256.Sum256(data))
+	}
+
+	m := &manifest{}
+	if err = json.Unmarshal(data, m); err != nil {
+		return nil, "", fmt.Errorf("error parsing manifest of '%s': %v", s.ref, err)
+	}
+	return m, digest, nil
+}
+
+// readConfig reads the version of a release from its image config.
+func (s *session) readConfig(ctx context.Context, digest string, release *Release) error {
+	resp, err := s.get(ctx, "blobs/"+digest, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var config struct {
+		Config struct {
+			Labels map[string]string `json:"Labels"`
+		} `json:"config"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&config); err != nil {
+		return fmt.Errorf("error parsing config of '%s': %v", s.ref, err)
+	}
+
+	release.Version = config.Config.Labels[versionLabel]
+	return nil
+}
+
+// readLayer calls read with every file in a layer.
+func (s *session) readLayer(ctx context.Context, digest, mediaType string, read func(name string, r io.Reader) error) error {
+	resp, err := s.get(ctx, "blobs/"+digest, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var body io.Reader = resp.Body
+	if !strings.HasSuffix(mediaType, ".tar") {
+		gz, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return fmt.Errorf("error decompressing layer %s of '%s': %v", digest, s.ref, err)
+		}
+		defer gz.Close()
+		body = gz
+	}
+
+	tr := tar.NewReader(body)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("error reading layer %s of '%s': %v", digest, s.ref, err)
+		}
+
+		if err = read(path.Clean(strings.TrimPrefix(header.Name, "/")), tr); err != nil {
+			return fmt.Errorf("error reading %s from layer %s of '%s': %v", header.Name, digest, s.ref, err)
+		}
+	}
+}
+
+// get requests a path below the repository, authenticating when the registry asks for it.
+func (s *session) get(ctx context.Context, resource, accept string) (*http.Response, error) {
+	resourceURL := fmt.Sprintf("https://%s/v2/%s/%s", s.ref.Registry, s.ref.Repository, resource)
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest(http.MethodGet, resourceURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		if s.token != "" {
+			req.Header.Set("Authorization", "Bearer "+s.token)
+		}
+
+		resp, err := s.client.HTTPClient.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching %s of '%s': %v", resource, s.ref, err)
+		}
+
+		if resp.StatusCode == http.StatusUnauthorized && attempt == 0 {
+			challenge := resp.Header.Get("WWW-Authenticate")
+			resp.Body.Close()
+			if err = s.authenticate(ctx, challenge); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("error fetching %s of '%s': registry returned %s", resource, s.ref, resp.Status)
+		}
+		return resp, nil
+	}
+}
+
+// authenticate gets a token for the repository from the registry's token service.
+func (s *session) authenticate(ctx context.Context, challenge string) error {
+	if !strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+		return fmt.Errorf("registry %s asked for unsupported authentication '%s'", s.ref.Registry, challenge)
+	}
+
+	params := map[string]string{}
+	for _, match := range challengeParamRegex.FindAllStringSubmatch(challenge, -1) {
+		params[strings.ToLower(match[1])] = match[2]
+	}
+
+	tokenURL, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return fmt.Errorf("registry %s has an invalid token service '%s'", s.ref.Registry, params["realm"])
+	}
+
+	query := tokenURL.Query()
+	if params["service"] != "" {
+		query.Set("service", params["service"])
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", s.ref.Repository)
+	}
+	query.Set("scope", scope)
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	if auth, ok := s.client.Auths[s.ref.Registry]; ok {
+		req.Header.Set("Authorization", "Basic "+auth)
+	}
+
+	resp, err := s.client.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("error authenticating with registry %s: %v", s.ref.Registry, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error authenticating with registry %s: token service returned %s", s.ref.Registry, resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return fmt.Errorf("error parsing token from registry %s: %v", s.ref.Registry, err)
+	}
+
+	s.token = token.Token
+	if s.token == "" {
+		s.token = token.AccessToken
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package releaseimage
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		image    string
+		expected Reference
+		valid    bool
+	}{
+		{"quay.io/openshift-release-dev/ocp-release:4.7.2-x86_64", Reference{"quay.io", "openshift-release-dev/ocp-release", "4.7.2-x86_64", ""}, true},
+		{"quay.io/openshift-release-dev/ocp-release@sha256:abc", Reference{"quay.io", "openshift-release-dev/ocp-release", "", "sha256:abc"}, true},
+		{"localhost:5000/ocp/release", Reference{"localhost:5000", "ocp/release", "latest", ""}, true},
+		{"ocp/release:4.7.2", Reference{}, false},
+		{"quay.io/ocp/release@md5:abc", Reference{}, false},
+	}
+
+	for _, test := range tests {
+		ref, err := ParseReference(test.image)
+		if (err == nil) != test.valid {
+			t.Errorf("expected %s to have valid=%v: %v", test.image, test.valid, err)
+		} else if test.valid && ref != test.expected {
+			t.Errorf("expected %s to parse as %+v, got %+v", test.image, test.expected, ref)
+		}
+	}
+}
+
+// fakeRegistry serves one release image, requiring a token from its token service.
+type fakeRegistry struct {
+	*httptest.Server
+
+	blobs     map[string][]byte
+	manifests map[string][]byte
+	auth      string
+}
+
+func newFakeRegistry(t *testing.T, auth string) *fakeRegistry {
+	r := &fakeRegistry{blobs: map[string][]byte{}, manifests: map[string][]byte{}, auth: auth}
+	r.Server = httptest.NewTLSServer(http.HandlerFunc(r.serveHTTP))
+
+	config := r.addBlob(mustJSON(t, map[string]interface{}{
+		"config": map[string]interface{}{"Labels": map[string]string{"io.openshift.release": "4.7.2"}},
+	}))
+	base := r.addBlob(layer(t, map[string]string{"usr/bin/cluster-version-operator": "binary"}))
+	manifests := r.addBlob(layer(t, map[string]string{
+		"release-manifests/release-metadata": `{"kind": "cincinnati-metadata-v0", "version": "4.7.2", "previous": ["4.6.8", "4.7.1"], "metadata": {"url": "https://access.redhat.com/errata/RHBA-2021:0678"}}`,
+		"release-manifests/image-references": `{"kind": "ImageStream", "spec": {"tags": [{"name": "cli", "from": {"kind": "DockerImage", "name": "quay.io/ocp/cli@sha256:111"}}, {"name": "etcd", "from": {"kind": "DockerImage", "name": "quay.io/ocp/etcd@sha256:222"}}]}}`,
+	}))
+
+	image := mustJSON(t, map[string]interface{}{
+		"mediaType": "application/vnd.docker.distribution.manifest.v2+json",
+		"config":    map[string]string{"digest": config},
+		"layers": []map[string]string{
+			{"mediaType": "application/vnd.docker.image.rootfs.diff.tar.gzip", "digest": base},
+			{"mediaType": "application/vnd.docker.image.rootfs.diff.tar.gzip", "digest": manifests},
+		},
+	})
+	imageDigest := digestOf(image)
+	r.manifests[imageDigest] = image
+
+	list := mustJSON(t, map[string]interface{}{
+		"mediaType": "application/vnd.docker.distribution.manifest.list.v2+json",
+		"manifests": []map[string]interface{}{
+			{"digest": "sha256:arm", "platform": map[string]string{"os": "linux", "architecture": "arm64"}},
+			{"digest": imageDigest, "platform": map[string]string{"os": "linux", "architecture": "amd64"}},
+		},
+	})
+	r.manifests["4.7.2"] = list
+	r.manifests[digestOf(list)] = list
+
+	return r
+}
+
+func (r *fakeRegistry) addBlob(data []byte) string {
+	digest := digestOf(data)
+	r.blobs[digest] = data
+	return digest
+}
+
+func (r *fakeRegistry) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		if r.auth != "" && req.Header.Get("Authorization") != "Basic "+r.auth {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if req.URL.Query().Get("scope") != "repository:ocp/release:pull" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"token": "fake-token"}`))
+		return
+	}
+
+	if req.Header.Get("Authorization") != "Bearer fake-token" {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="fake",scope="repository:ocp/release:pull"`, r.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(req.URL.Path, "/v2/ocp/release/manifests/"):
+		data, ok := r.manifests[strings.TrimPrefix(req.URL.Path, "/v2/ocp/release/manifests/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Docker-Content-Digest", digestOf(data))
+		w.Write(data)
+	case strings.HasPrefix(req.URL.Path, "/v2/ocp/release/blobs/"):
+		data, ok := r.blobs[strings.TrimPrefix(req.URL.Path, "/v2/ocp/release/blobs/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(data)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	auth := base64.StdEncoding.EncodeToString([]byte("user:password"))
+	registry := newFakeRegistry(t, auth)
+	defer registry.Close()
+
+	host := strings.TrimPrefix(registry.URL, "https://")
+	client := &Client{Arch: "amd64", Auths: map[string]string{host: auth}, HTTPClient: registry.Client()}
+
+	release, err := client.Inspect(context.Background(), host+"/ocp/release:4.7.2")
+	if err != nil {
+		t.Fatalf("error inspecting release: %v", err)
+	}
+
+	if release.Version != "4.7.2" || release.Digest != digestOf(registry.manifests["4.7.2"]) {
+		t.Errorf("unexpected version or digest: %s, %s", release.Version, release.Digest)
+	}
+	if len(release.Previous) != 2 || release.Previous[0] != "4.6.8" || release.URL != "https://access.redhat.com/errata/RHBA-2021:0678" {
+		t.Errorf("unexpected release metadata: %+v", release)
+	}
+	if len(release.Components) != 2 || release.Components["etcd"] != "quay.io/ocp/etcd@sha256:222" {
+		t.Errorf("unexpected components: %v", release.Components)
+	}
+
+	if _, err = client.Inspect(context.Background(), host+"/ocp/release:4.7.3"); err == nil {
+		t.Errorf("expected inspecting a missing release to fail")
+	}
+
+	client.Auths = map[string]string{}
+	if _, err = client.Inspect(context.Background(), host+"/ocp/release:4.7.2"); err == nil {
+		t.Errorf("expected inspecting a release without credentials to fail")
+	}
+}
+
+func TestHasSignature(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/signatures/sha256=abc/signature-1" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{HTTPClient: server.Client()}
+	if signed, err := client.HasSignature(context.Background(), server.URL+"/signatures/", "sha256:abc"); err != nil || !signed {
+		t.Errorf("expected sha256:abc to be signed: %v", err)
+	}
+	if signed, err := client.HasSignature(context.Background(), server.URL+"/signatures", "sha256:def"); err != nil || signed {
+		t.Errorf("expected sha256:def not to be signed: %v", err)
+	}
+}
+
+func layer(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}); err != nil {
+			t.Fatalf("error writing layer: %v", err)
+		}
+		tw.Write([]byte(content))
+	}
+	tw.Close()
+	gz.Close()
+	return buf.Bytes()
+}
+
+func mustJSON(t *testing.T, v interface{}) []byte {
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("error marshalling JSON: %v", err)
+	}
+	return data
+}
+
+func digestOf(data []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(data))
+}
@@ -9,7 +9,6 @@ import (
 
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/metrics"
-	"github.com/spf13/viper"
 )
 
 type reportData struct {
@@ -23,13 +22,13 @@ type summaryReportData struct {
 	numTests        int64
 }
 
-// GenerateReport generates a weather report.
-func GenerateReport() (WeatherReport, error) {
+// GenerateReport generates a weather report with the weather config of cfg.
+func GenerateReport(cfg *config.RunConfig) (WeatherReport, error) {
 	// Range for the queries issued to Prometheus
 	end := time.Now()
-	start := end.Add(-time.Hour * (viper.GetDuration(config.Weather.StartOfTimeWindowInHours)))
+	start := end.Add(-time.Hour * time.Duration(cfg.Weather.StartOfTimeWindowInHours))
 
-	client, err := metrics.NewClient()
+	client, err := metrics.NewClient(cfg)
 
 	if err != nil {
 		return WeatherReport{}, fmt.Errorf("error while creating client: %v", err)
@@ -37,12 +36,12 @@ func GenerateReport() (WeatherReport, error) {
 
 	// Assemble the allowlist regexes. We'll only produce a report based on these regexes.
 	allowlistRegexes := []*regexp.Regexp{}
-	jobAllowlistString := viper.GetString(config.Weather.JobAllowlist)
+	jobAllowlistString := cfg.Weather.JobAllowlist
 	for _, allowlistRegex := range strings.Split(jobAllowlistString, ",") {
 		allowlistRegexes = append(allowlistRegexes, regexp.MustCompile(allowlistRegex))
 	}
 
-	provider := viper.GetString(config.Weather.Provider)
+	provider := cfg.Weather.Provider
 
 	results, err := client.ListAllJUnitResults(start, end)
 	if err != nil {
@@ -50,7 +49,7 @@ func GenerateReport() (WeatherReport, error) {
 	}
 
 	// Generate report from query results.
-	jobReportData, err := generateVersionsAndFailures(results)
+	jobReportData, err := generateVersionsAndFailures(results, cfg.Weather.NumberOfSamplesNecessary)
 
 	if err != nil {
 		return WeatherReport{}, err
@@ -61,6 +60,7 @@ func GenerateReport() (WeatherReport, error) {
 	weatherReport := WeatherReport{
 		ReportDate: time.Now().UTC(),
 		Provider:   provider,
+		metrics:    cfg.Metrics,
 	}
 	for job, reportData := range jobReportData {
 		allowed := false
@@ -163,7 +163,7 @@ func getPassRateColor(passRate float64) string {
 
 // generateVersionsAndFailures generates an intermediary data structure from the results that can be used to populate
 // the weather report.
-func generateVersionsAndFailures(results []metrics.JUnitResult) (map[string]*reportData, error) {
+func generateVersionsAndFailures(results []metrics.JUnitResult, numberOfSamplesNecessary int) (map[string]*reportData, error) {
 	jobReportData := map[string]*reportData{}
 	for _, result := range results {
 		job := result.JobName
@@ -192,7 +192,7 @@ func generateVersionsAndFailures(results []metrics.JUnitResult) (map[string]*rep
 
 	// Filter the failure results so that only results that cross the threshold are included.
 	for _, r := range jobReportData {
-		r.filterFailureResults()
+		r.filterFailureResults(numberOfSamplesNecessary)
 	}
 
 	return jobReportData, nil
@@ -211,10 +211,10 @@ func (r *reportData) addVersion(versionToAdd string) {
 
 // filterFailureResults eliminates results from the report that don't match the failure criteria.
 // At the moment, this is pretty simple: just if tests fail more than once over the timeframe.
-func (r *reportData) filterFailureResults() {
+func (r *reportData) filterFailureResults(numberOfSamplesNecessary int) {
 	filteredFailures := map[string]int{}
 	for testname, failureCount := range r.Failures {
-		if failureCount >= (viper.GetInt(config.Weather.NumberOfSamplesNecessary) - 1) {
+		if failureCount >= (numberOfSamplesNecessary - 1) {
 			filteredFailures[testname] = failureCount
 		}
 	}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/openshift/osde2e/pkg/common/aws"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/templates"
 )
 
@@ -42,6 +43,9 @@ type WeatherReport struct {
 
 	// We want the sort interface so that we can sort jobs and produce stable, comparable reports.
 	sort.Interface `json:"-"`
+
+	// metrics is used to write the report to S3.
+	metrics config.MetricsConfig
 }
 
 // JobReport is a report for an individual job.
@@ -101,7 +105,7 @@ func (w WeatherReport) WriteJSON(output string) error {
 	}
 
 	if strings.HasPrefix(output, "s3") {
-		aws.WriteToS3(output, jsonReport)
+		aws.WriteToS3(w.metrics, output, jsonReport)
 	} else {
 		writer, err := createWriter(output)
 		if err != nil {
@@ -165,7 +169,7 @@ func (w WeatherReport) WriteSDReport(output string) error {
 
 func (w WeatherReport) writeRawReport(output string, report []byte) error {
 	if strings.HasPrefix(output, "s3") {
-		aws.WriteToS3(output, report)
+		aws.WriteToS3(w.metrics, output, report)
 	} else {
 		writer, err := createWriter(output)
 		if err != nil {
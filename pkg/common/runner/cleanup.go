@@ -0,0 +1,73 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	kerror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Cleanup deletes the Pod or Job and the payload ConfigMap created by the last run. Results can't be retrieved once
+// they're deleted. It's safe to call if the runner hasn't run, or has already been cleaned up.
+func (r *Runner) Cleanup() error {
+	var errs *multierror.Error
+	propagation := metav1.DeletePropagationBackground
+	opts := metav1.DeleteOptions{PropagationPolicy: &propagation}
+
+	if r.jobName != "" {
+		err := r.Kube.BatchV1().Jobs(r.Namespace).Delete(context.TODO(), r.jobName, opts)
+		errs = multierror.Append(errs, ignoreNotFound(err, "Job", r.jobName))
+	}
+
+	if r.podName != "" {
+		err := r.Kube.CoreV1().Pods(r.Namespace).Delete(context.TODO(), r.podName, opts)
+		errs = multierror.Append(errs, ignoreNotFound(err, "Pod", r.podName))
+	}
+
+	// the payload is owned by the Pod or Job, but is deleted in case they weren't created
+	if r.configMapName != "" {
+		err := r.Kube.CoreV1().ConfigMaps(r.Namespace).Delete(context.TODO(), r.configMapName, opts)
+		errs = multierror.Append(errs, ignoreNotFound(err, "ConfigMap", r.configMapName))
+	}
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return err
+	}
+
+	if r.jobName != "" || r.podName != "" {
+		log.Printf("Deleted resources of %s runner", r.Name)
+	}
+	r.jobName, r.podName, r.configMapName = "", "", ""
+	r.pods = nil
+	return nil
+}
+
+// ownPayload makes the payload ConfigMap owned by the Pod or Job that runs it, so it's deleted along with them.
+func (r *Runner) ownPayload(owner metav1.OwnerReference) error {
+	if r.configMapName == "" {
+		return nil
+	}
+
+	configMaps := r.Kube.CoreV1().ConfigMaps(r.Namespace)
+	configMap, err := configMaps.Get(context.TODO(), r.configMapName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("couldn't get ConfigMap '%s': %v", r.configMapName, err)
+	}
+
+	configMap.OwnerReferences = append(configMap.OwnerReferences, owner)
+	if _, err = configMaps.Update(context.TODO(), configMap, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("couldn't make %s '%s' the owner of ConfigMap '%s': %v", owner.Kind, owner.Name, r.configMapName, err)
+	}
+	return nil
+}
+
+// ignoreNotFound explains an error deleting a resource, unless it had already been deleted.
+func ignoreNotFound(err error, kind, name string) error {
+	if err == nil || kerror.IsNotFound(err) {
+		return nil
+	}
+	return fmt.Errorf("couldn't delete %s '%s': %v", kind, name, err)
+}
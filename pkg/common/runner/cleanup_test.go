@@ -0,0 +1,96 @@
+package runner
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	kerror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCleanup(t *testing.T) {
+	def := *DefaultRunner
+	r := &def
+	r.Kube = fake.NewSimpleClientset()
+	r.Namespace = "default"
+	r.Cmd = "echo hello"
+
+	pod, err := r.createPod()
+	if err != nil {
+		t.Fatalf("failed to create pod: %v", err)
+	}
+
+	configMap, err := r.Kube.CoreV1().ConfigMaps(r.Namespace).Get(context.TODO(), r.configMapName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get payload: %v", err)
+	}
+	if owners := configMap.OwnerReferences; len(owners) != 1 || owners[0].Kind != "Pod" || owners[0].Name != pod.Name {
+		t.Errorf("expected the payload to be owned by Pod %s, got %+v", pod.Name, owners)
+	}
+
+	if err = r.Cleanup(); err != nil {
+		t.Fatalf("failed to clean up: %v", err)
+	}
+	if _, err = r.Kube.CoreV1().Pods(r.Namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{}); !kerror.IsNotFound(err) {
+		t.Errorf("expected the pod to be deleted, got %v", err)
+	}
+	if _, err = r.Kube.CoreV1().ConfigMaps(r.Namespace).Get(context.TODO(), configMap.Name, metav1.GetOptions{}); !kerror.IsNotFound(err) {
+		t.Errorf("expected the payload to be deleted, got %v", err)
+	}
+
+	if err = r.Cleanup(); err != nil {
+		t.Errorf("expected cleaning up again to do nothing, got %v", err)
+	}
+}
+
+func TestRunStopped(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(r *Runner) error
+	}{
+		{
+			name: "stop channel",
+			run: func(r *Runner) error {
+				stopCh := make(chan struct{})
+				time.AfterFunc(100*time.Millisecond, func() { close(stopCh) })
+				return r.Run(600, stopCh)
+			},
+		},
+		{
+			name: "context",
+			run: func(r *Runner) error {
+				ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+				defer cancel()
+				return r.RunContext(ctx, 600)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		def := *DefaultRunner
+		r := &def
+		r.Kube = fake.NewSimpleClientset()
+		r.Namespace = "default"
+		r.Cmd = "echo hello"
+		r.ImageName = "quay.io/run/tests"
+
+		// the fake Pod never starts running, so the runner waits until it's stopped
+		start := time.Now()
+		err := test.run(r)
+		if err == nil || !strings.Contains(err.Error(), "stopped") {
+			t.Errorf("test %s: expected the runner to be stopped, got %v", test.name, err)
+		}
+		if elapsed := time.Since(start); elapsed > fastPoll {
+			t.Errorf("test %s: expected the runner to stop promptly, took %v", test.name, elapsed)
+		}
+
+		pods, _ := r.Kube.CoreV1().Pods(r.Namespace).List(context.TODO(), metav1.ListOptions{})
+		configMaps, _ := r.Kube.CoreV1().ConfigMaps(r.Namespace).List(context.TODO(), metav1.ListOptions{})
+		if len(pods.Items) != 0 || len(configMaps.Items) != 0 {
+			t.Errorf("test %s: expected the runner's resources to be deleted, got %d pods and %d config maps", test.name, len(pods.Items), len(configMaps.Items))
+		}
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"text/template"
 )
 
-// testCmd configures default Service Account as a kubeconfig, runs openshift-tests, and serves results over HTTP
+// testCmd configures default Service Account as a kubeconfig, runs openshift-tests, and signals its results are ready
 const testCmd = `#!/bin/bash
 oc cluster-info
 
@@ -19,25 +19,46 @@ mkdir -p {{.OutputDir}}
 } > >(tee -a {{.OutputDir}}/{{.Name}}-out.txt) 2> >(tee -a {{.OutputDir}}/{{.Name}}-err.txt >&2)
 
 # create a Tarball of OutputDir if requested
-{{$outDir := .OutputDir}}
 {{if .Tarball}}
-	{{$outDir = "/tmp/out"}}
-        mkdir -p {{$outDir}}
-	tar cvfz {{$outDir}}/{{.Name}}.tgz {{.OutputDir}}
+        mkdir -p {{.TarballDir}}
+	tar cvfz {{.TarballDir}}/{{.Name}}.tgz {{.OutputDir}}
 {{end}}
 
-# make results available using HTTP
-cd {{$outDir}} && echo "Starting server" && python -m SimpleHTTPServer
+# signal results are ready
+mkdir -p {{.StatusDir}} && touch {{.DoneFile}} && echo "Results are ready"
+{{if .HoldResults}}
+# keep results available until they are retrieved
+{{.WaitCmd}}
+{{end}}
 `
 
 var (
 	cmdTemplate = template.Must(template.New("testCmd").Parse(testCmd))
 )
 
+// commandValues are the values testCmd is templated with.
+type commandValues struct {
+	*Runner
+
+	StatusDir   string
+	DoneFile    string
+	TarballDir  string
+	HoldResults bool
+	WaitCmd     string
+}
+
 // Command generates the templated command.
 func (r *Runner) Command() ([]byte, error) {
 	var cmd bytes.Buffer
-	if err := cmdTemplate.Execute(&cmd, r); err != nil {
+	values := commandValues{
+		Runner:      r,
+		StatusDir:   resultsStatusMountPath,
+		DoneFile:    resultsDoneFile,
+		TarballDir:  resultsTarballDir,
+		HoldResults: r.transport().HoldsResults(),
+		WaitCmd:     resultsWaitCmd,
+	}
+	if err := cmdTemplate.Execute(&cmd, values); err != nil {
 		return []byte{}, fmt.Errorf("failed templating command: %v", err)
 	}
 	return cmd.Bytes(), nil
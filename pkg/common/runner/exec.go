@@ -0,0 +1,150 @@
+package runner
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/websocket"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// execProtocol is the pods/exec streaming protocol used. Each message starts with the number of its stream.
+	execProtocol = "v4.channel.k8s.io"
+
+	execStdout = 1
+	execStderr = 2
+	execError  = 3
+
+	// maxExecStderr is how much of stderr is kept to explain a failed command.
+	maxExecStderr = 4096
+)
+
+// podExecutor runs a command in a container of a Pod, copying its stdout to stdout.
+type podExecutor func(pod *kubev1.Pod, container string, command []string, stdout io.Writer) error
+
+// exec runs a command in a container of the runner Pod, copying its stdout to stdout.
+func (r *Runner) exec(pod *kubev1.Pod, container string, command []string, stdout io.Writer) error {
+	if r.executor != nil {
+		return r.executor(pod, container, command, stdout)
+	}
+	if r.RestConfig == nil {
+		return errors.New("a REST config is needed to exec in the runner Pod")
+	}
+
+	execURL := r.Kube.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&kubev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec).
+		URL()
+
+	conn, err := dialWebSocket(execURL, r.RestConfig)
+	if err != nil {
+		return fmt.Errorf("couldn't exec in container %s of Pod '%s/%s': %v", container, pod.Namespace, pod.Name, err)
+	}
+	defer conn.Close()
+
+	var stderr bytes.Buffer
+	for {
+		_, msg, err := conn.ReadMessage()
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure) || err == io.EOF {
+			return fmt.Errorf("exec in container %s ended without a status: %s", container, stderr.String())
+		} else if err != nil {
+			return fmt.Errorf("error reading from exec in container %s: %v", container, err)
+		}
+
+		if len(msg) < 2 {
+			continue
+		}
+
+		switch msg[0] {
+		case execStdout:
+			if _, err = stdout.Write(msg[1:]); err != nil {
+				return err
+			}
+		case execStderr:
+			if stderr.Len() < maxExecStderr {
+				stderr.Write(msg[1:])
+			}
+		case execError:
+			status := metav1.Status{}
+			if err = json.Unmarshal(msg[1:], &status); err != nil {
+				return fmt.Errorf("error parsing exec status: %v", err)
+			}
+			if status.Status != metav1.StatusSuccess {
+				return fmt.Errorf("command %v failed in container %s: %s: %s", command, container, status.Message, stderr.String())
+			}
+			return nil
+		}
+	}
+}
+
+// dialWebSocket opens a WebSocket to the API server. The handshake is sent through the HTTP wrappers of the REST
+// config, so it is authenticated the same way as any other request.
+func dialWebSocket(u *url.URL, config *rest.Config) (*websocket.Conn, error) {
+	tlsConfig, err := rest.TLSConfigFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't configure TLS: %v", err)
+	}
+
+	wsURL := *u
+	if wsURL.Scheme == "https" {
+		wsURL.Scheme = "wss"
+	} else {
+		wsURL.Scheme = "ws"
+	}
+
+	dialer := &webSocketDialer{
+		Dialer: websocket.Dialer{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+			Subprotocols:    []string{execProtocol},
+		},
+	}
+	rt, err := rest.HTTPWrappersForConfig(config, dialer)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, wsURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = rt.RoundTrip(req); err != nil {
+		return nil, err
+	}
+	return dialer.conn, nil
+}
+
+// webSocketDialer is a RoundTripper that opens a WebSocket with the request it is given, keeping the connection.
+type webSocketDialer struct {
+	websocket.Dialer
+	conn *websocket.Conn
+}
+
+func (d *webSocketDialer) RoundTrip(req *http.Request) (*http.Response, error) {
+	conn, resp, err := d.DialContext(req.Context(), req.URL.String(), req.Header)
+	if err == websocket.ErrBadHandshake && resp != nil {
+		return nil, fmt.Errorf("%v: %s", err, resp.Status)
+	} else if err != nil {
+		return nil, err
+	}
+
+	d.conn = conn
+	return resp, nil
+}
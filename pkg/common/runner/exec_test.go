@@ -0,0 +1,109 @@
+package runner
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestExec(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      string
+		expected    string
+		expectedErr string
+	}{
+		{"success", `{"status":"Success"}`, "first second", ""},
+		{"failure", `{"status":"Failure","message":"command terminated with non-zero exit code"}`, "first second", "non-zero exit code: no such file"},
+	}
+
+	for _, test := range tests {
+		upgrader := websocket.Upgrader{Subprotocols: []string{execProtocol}}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			query := req.URL.Query()
+			if req.URL.Path != "/api/v1/namespaces/default/pods/runner-pod/exec" || query.Get("container") != "results" ||
+				!reflect.DeepEqual(query["command"], []string{"cat", "results.tar"}) || query.Get("stdout") != "true" {
+				http.NotFound(w, req)
+				return
+			}
+			if auth := req.Header.Get("Authorization"); auth != "Bearer token" {
+				http.Error(w, "unauthorized: "+auth, http.StatusUnauthorized)
+				return
+			}
+
+			conn, err := upgrader.Upgrade(w, req, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+
+			for _, msg := range [][]byte{
+				append([]byte{execStdout}, "first "...),
+				append([]byte{execStderr}, "no such file"...),
+				append([]byte{execStdout}, "second"...),
+				append([]byte{execError}, test.status...),
+			} {
+				if err = conn.WriteMessage(websocket.BinaryMessage, msg); err != nil {
+					return
+				}
+			}
+		}))
+
+		config := &rest.Config{Host: server.URL, BearerToken: "token"}
+		client, err := kube.NewForConfig(config)
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+
+		def := *DefaultRunner
+		r := &def
+		r.Kube = client
+		r.RestConfig = config
+
+		var stdout bytes.Buffer
+		pod := &kubev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "runner-pod", Namespace: "default"}}
+		err = r.exec(pod, "results", []string{"cat", "results.tar"}, &stdout)
+		server.Close()
+
+		if test.expectedErr == "" && err != nil {
+			t.Errorf("test %s: unexpected error: %v", test.name, err)
+		} else if test.expectedErr != "" && (err == nil || !strings.Contains(err.Error(), test.expectedErr)) {
+			t.Errorf("test %s: expected an error containing %q, got %v", test.name, test.expectedErr, err)
+		}
+		if stdout.String() != test.expected {
+			t.Errorf("test %s: expected stdout %q, got %q", test.name, test.expected, stdout.String())
+		}
+	}
+}
+
+func TestExecUnauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	config := &rest.Config{Host: server.URL}
+	client, err := kube.NewForConfig(config)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	def := *DefaultRunner
+	r := &def
+	r.Kube = client
+	r.RestConfig = config
+
+	pod := &kubev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "runner-pod", Namespace: "default"}}
+	if err = r.exec(pod, "results", []string{"true"}, &bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("expected the failed handshake to be reported, got %v", err)
+	}
+}
@@ -0,0 +1,215 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	// jobNameLabel is added to the Pods of a Job by the Job controller.
+	jobNameLabel = "job-name"
+
+	// podReasonEvicted is the reason of a Pod evicted by its node.
+	podReasonEvicted = "Evicted"
+)
+
+// JobOptions configure the Job a runner's workload is run as. Every Pod of the Job runs Cmd, so workloads sharded
+// across Pods must split the work between them.
+type JobOptions struct {
+	// BackoffLimit is how many times Pods can fail, including being evicted, before the Job fails.
+	BackoffLimit int32
+
+	// ActiveDeadlineSeconds limits how long the Job can run. The timeout given to Run is used if it is zero.
+	ActiveDeadlineSeconds int64
+
+	// Parallelism is how many Pods can run at once. One is used if it is zero.
+	Parallelism int32
+
+	// Completions is how many Pods must succeed for the Job to complete. One is used if it is zero.
+	Completions int32
+}
+
+// runJob runs the workload as a Job, waits for it to finish, and gathers its Pods so their results can be retrieved.
+func (r *Runner) runJob(timeoutInSeconds int) (err error) {
+	if !r.transport().Completes() {
+		return fmt.Errorf("the %T result transport can't retrieve results from a Job", r.transport())
+	}
+
+	log.Printf("Creating %s runner Job...", r.Name)
+	var job *batchv1.Job
+	if job, err = r.createJob(timeoutInSeconds); err != nil {
+		return
+	}
+	r.status = StatusRunning
+
+	log.Printf("Waiting for %s runner Job to finish with a timeout of %d seconds...", r.Name, timeoutInSeconds)
+	completionErr := r.waitForJob(job.Name, timeoutInSeconds)
+	r.logs.stop()
+
+	pods, err := r.jobPods(job.Name)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Collecting logs from containers on %d %s runner Pods...", len(pods), r.Name)
+	for _, pod := range pods {
+		if pod.Status.Reason == podReasonEvicted {
+			continue
+		}
+		if err = r.getAllLogsFromPod(pod.Name); err != nil {
+			return
+		}
+	}
+
+	r.pods = resultPods(pods)
+	if completionErr != nil {
+		return completionErr
+	}
+
+	log.Printf("%s runner is done", r.Name)
+	r.status = StatusDone
+	return nil
+}
+
+// createJob creates a Job whose Pods run the workload.
+func (r *Runner) createJob(timeoutInSeconds int) (*batchv1.Job, error) {
+	pod, err := r.buildPod()
+	if err != nil {
+		return nil, err
+	}
+
+	// failed Pods are replaced by the Job rather than restarted, so their logs and results are kept
+	pod.Spec.RestartPolicy = kubev1.RestartPolicyNever
+
+	deadline := r.Job.ActiveDeadlineSeconds
+	if deadline == 0 {
+		deadline = int64(timeoutInSeconds)
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: pod.ObjectMeta,
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          pointer.Int32Ptr(r.Job.BackoffLimit),
+			ActiveDeadlineSeconds: pointer.Int64Ptr(deadline),
+			Template: kubev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: pod.Labels},
+				Spec:       pod.Spec,
+			},
+		},
+	}
+	if r.Job.Parallelism > 0 {
+		job.Spec.Parallelism = pointer.Int32Ptr(r.Job.Parallelism)
+	}
+	if r.Job.Completions > 0 {
+		job.Spec.Completions = pointer.Int32Ptr(r.Job.Completions)
+	}
+
+	// retry until Job can be created or timeout occurs
+	var createdJob *batchv1.Job
+	err = r.poll(fastPoll, podCreateTimeout, func() (done bool, err error) {
+		if createdJob, err = r.Kube.BatchV1().Jobs(r.Namespace).Create(context.TODO(), job, metav1.CreateOptions{}); err != nil {
+			log.Printf("Error creating %s runner Job: %v", r.Name, err)
+		}
+		return err == nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.jobName = createdJob.Name
+
+	// the payload is deleted with the Job
+	return createdJob, r.ownPayload(metav1.OwnerReference{
+		APIVersion: "batch/v1",
+		Kind:       "Job",
+		Name:       createdJob.Name,
+		UID:        createdJob.UID,
+	})
+}
+
+// waitForJob waits for a Job to complete or fail. Evicted and deleted Pods are reported, but are left to the Job to
+// replace.
+func (r *Runner) waitForJob(jobName string, timeoutInSeconds int) error {
+	reported := map[string]bool{}
+	return r.poll(slowPoll, time.Duration(timeoutInSeconds)*time.Second, func() (done bool, err error) {
+		job, err := r.Kube.BatchV1().Jobs(r.Namespace).Get(context.TODO(), jobName, metav1.GetOptions{})
+		if err != nil {
+			r.Printf("Encountered error getting Job: %v", err)
+			return false, err
+		}
+
+		for _, condition := range job.Status.Conditions {
+			if condition.Status != kubev1.ConditionTrue {
+				continue
+			}
+			switch condition.Type {
+			case batchv1.JobComplete:
+				return true, nil
+			case batchv1.JobFailed:
+				return false, fmt.Errorf("job '%s' failed: %s: %s", jobName, condition.Reason, condition.Message)
+			}
+		}
+
+		if pods, err := r.jobPods(jobName); err != nil {
+			r.Printf("Encountered error listing Pods of Job '%s': %v", jobName, err)
+		} else {
+			for _, pod := range pods {
+				r.logs.follow(pod)
+				if reported[pod.Name] {
+					continue
+				}
+				if pod.Status.Reason == podReasonEvicted {
+					r.Printf("Pod '%s' of Job '%s' was evicted, it will be replaced if the Job's backoff limit allows: %s", pod.Name, jobName, pod.Status.Message)
+					reported[pod.Name] = true
+				} else if pod.DeletionTimestamp != nil && pod.Status.Phase != kubev1.PodSucceeded {
+					r.Printf("Pod '%s' of Job '%s' is being deleted before finishing, possibly preempted; the Job will replace it", pod.Name, jobName)
+					reported[pod.Name] = true
+				}
+			}
+		}
+
+		r.Printf("Waiting for Job '%s/%s': %d active, %d succeeded, %d failed...", r.Namespace, jobName, job.Status.Active, job.Status.Succeeded, job.Status.Failed)
+		return false, nil
+	})
+}
+
+// jobPods lists the Pods of a Job.
+func (r *Runner) jobPods(jobName string) ([]*kubev1.Pod, error) {
+	list, err := r.Kube.CoreV1().Pods(r.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", jobNameLabel, jobName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't list Pods of Job '%s': %v", jobName, err)
+	}
+
+	pods := []*kubev1.Pod{}
+	for i := range list.Items {
+		pods = append(pods, &list.Items[i])
+	}
+	return pods, nil
+}
+
+// resultPods returns the Pods of a Job to retrieve results from: the Pods that succeeded or, if none did, the Pods
+// that failed without being evicted, which may have partial results.
+func resultPods(pods []*kubev1.Pod) []*kubev1.Pod {
+	succeeded, failed := []*kubev1.Pod{}, []*kubev1.Pod{}
+	for _, pod := range pods {
+		switch {
+		case pod.Status.Phase == kubev1.PodSucceeded:
+			succeeded = append(succeeded, pod)
+		case pod.Status.Phase == kubev1.PodFailed && pod.Status.Reason != podReasonEvicted:
+			failed = append(failed, pod)
+		}
+	}
+
+	if len(succeeded) > 0 {
+		return succeeded
+	}
+	return failed
+}
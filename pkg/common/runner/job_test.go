@@ -0,0 +1,113 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCreateJob(t *testing.T) {
+	def := *DefaultRunner
+	r := &def
+	r.Kube = fake.NewSimpleClientset()
+	r.Cmd = "echo hello"
+	r.Transport = LogTransport{}
+	r.Job = &JobOptions{BackoffLimit: 2, Parallelism: 3, Completions: 3}
+
+	job, err := r.createJob(600)
+	if err != nil {
+		t.Fatalf("failed to create job: %v", err)
+	}
+
+	spec := job.Spec
+	if *spec.BackoffLimit != 2 || *spec.ActiveDeadlineSeconds != 600 || *spec.Parallelism != 3 || *spec.Completions != 3 {
+		t.Errorf("job spec doesn't match options: %+v", spec)
+	}
+	if spec.Template.Spec.RestartPolicy != kubev1.RestartPolicyNever {
+		t.Errorf("expected Pods of the job to never restart, got %s", spec.Template.Spec.RestartPolicy)
+	}
+	if len(spec.Template.Spec.Containers) != 2 || spec.Template.Spec.Containers[1].Name != resultsSidecarName {
+		t.Errorf("expected the runner container and results sidecar, got %+v", spec.Template.Spec.Containers)
+	}
+}
+
+func TestRunJobRequiresCompletingTransport(t *testing.T) {
+	def := *DefaultRunner
+	r := &def
+	r.Kube = fake.NewSimpleClientset()
+	r.Job = &JobOptions{}
+
+	if err := r.runJob(1); err == nil {
+		t.Errorf("expected running a job with the %T transport to fail", r.transport())
+	}
+}
+
+func TestWaitForJob(t *testing.T) {
+	tests := []struct {
+		name        string
+		condition   batchv1.JobConditionType
+		expectedErr bool
+	}{
+		{"complete", batchv1.JobComplete, false},
+		{"failed", batchv1.JobFailed, true},
+	}
+
+	for _, test := range tests {
+		def := *DefaultRunner
+		r := &def
+		r.Kube = fake.NewSimpleClientset(&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: r.Name, Namespace: r.Namespace},
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{Type: test.condition, Status: kubev1.ConditionTrue, Reason: "BackoffLimitExceeded"}},
+			},
+		})
+
+		if err := r.waitForJob(r.Name, 1); (err != nil) != test.expectedErr {
+			t.Errorf("test %s: expected error=%v, got %v", test.name, test.expectedErr, err)
+		}
+	}
+}
+
+func TestJobPods(t *testing.T) {
+	def := *DefaultRunner
+	r := &def
+
+	pod := func(name, job string, phase kubev1.PodPhase, reason string) *kubev1.Pod {
+		return &kubev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: r.Namespace, Labels: map[string]string{jobNameLabel: job}},
+			Status:     kubev1.PodStatus{Phase: phase, Reason: reason},
+		}
+	}
+	r.Kube = fake.NewSimpleClientset(
+		pod("evicted", r.Name, kubev1.PodFailed, podReasonEvicted),
+		pod("failed", r.Name, kubev1.PodFailed, ""),
+		pod("succeeded", r.Name, kubev1.PodSucceeded, ""),
+		pod("other", "other-job", kubev1.PodSucceeded, ""),
+	)
+
+	pods, err := r.jobPods(r.Name)
+	if err != nil {
+		t.Fatalf("failed to list pods: %v", err)
+	}
+	if len(pods) != 3 {
+		t.Errorf("expected 3 pods of the job, got %d", len(pods))
+	}
+
+	if results := resultPods(pods); len(results) != 1 || results[0].Name != "succeeded" {
+		t.Errorf("expected results from the succeeded pod, got %v", results)
+	}
+
+	if err = r.Kube.CoreV1().Pods(r.Namespace).Delete(context.TODO(), "succeeded", metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("failed to delete pod: %v", err)
+	}
+	if pods, err = r.jobPods(r.Name); err != nil {
+		t.Fatalf("failed to list pods: %v", err)
+	}
+	if results := resultPods(pods); len(results) != 1 || results[0].Name != "failed" {
+		t.Errorf("expected partial results from the failed pod that wasn't evicted, got %v", results)
+	}
+}
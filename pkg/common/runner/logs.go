@@ -0,0 +1,225 @@
+package runner
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	containerLogs = "containerLogs"
+
+	// logDrainTimeout is how long the logs being followed are read for once the runner has finished.
+	logDrainTimeout = 5 * time.Second
+)
+
+// getAllLogsFromPod writes the complete logs of every container of a Pod, including init containers, to the
+// containerLogs directory, replacing any followed while it ran.
+func (r *Runner) getAllLogsFromPod(podName string) error {
+	pod, err := r.Kube.CoreV1().Pods(r.Namespace).Get(context.TODO(), podName, metav1.GetOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	var allErrors *multierror.Error
+	for _, containerStatus := range containerStatuses(pod) {
+		// the log of a sidecar that writes the results to it is only the results
+		if r.transport().Completes() && containerStatus.Name == r.resultsContainer {
+			continue
+		}
+
+		func() {
+			r.Printf("Trying to get logs for %s:%s", podName, containerStatus.Name)
+			request := r.Kube.CoreV1().Pods(r.Namespace).GetLogs(podName, &kubev1.PodLogOptions{Container: containerStatus.Name})
+
+			logStream, err := request.Stream(context.TODO())
+
+			if err != nil {
+				allErrors = multierror.Append(allErrors, err)
+				return
+			}
+
+			defer logStream.Close()
+
+			logBytes, err := ioutil.ReadAll(logStream)
+
+			if err != nil {
+				allErrors = multierror.Append(allErrors, err)
+				return
+			}
+
+			logOutput, err := r.containerLogFile(podName, containerStatus.Name)
+			if err != nil {
+				allErrors = multierror.Append(allErrors, err)
+				return
+			}
+
+			allErrors = multierror.Append(allErrors, ioutil.WriteFile(logOutput, logBytes, os.FileMode(0644)))
+		}()
+	}
+
+	return allErrors.ErrorOrNil()
+}
+
+// containerLogFile returns the file the log of a container is written to, creating the containerLogs directory.
+func (r *Runner) containerLogFile(podName, containerName string) (string, error) {
+	configMapDirectory := filepath.Join(r.LogDir, containerLogs)
+
+	if err := os.MkdirAll(configMapDirectory, os.FileMode(0755)); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configMapDirectory, fmt.Sprintf("%s-%s.log", podName, containerName)), nil
+}
+
+// logFollower streams the logs of the containers of a runner's Pods while they run.
+type logFollower struct {
+	r *Runner
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu       sync.Mutex
+	followed map[string]bool
+}
+
+// followLogs starts following the logs of the runner's Pods. Containers are followed once they've started, when a Pod
+// is passed to follow.
+func (r *Runner) followLogs() *logFollower {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &logFollower{
+		r:        r,
+		ctx:      ctx,
+		cancel:   cancel,
+		followed: map[string]bool{},
+	}
+}
+
+// follow starts following the log of every container of a Pod that has started and isn't already being followed.
+func (f *logFollower) follow(pod *kubev1.Pod) {
+	if f == nil || pod == nil {
+		return
+	}
+
+	for _, containerStatus := range containerStatuses(pod) {
+		if containerStatus.State.Running == nil && containerStatus.State.Terminated == nil {
+			continue
+		}
+
+		// the log of a sidecar that writes the results to it is only the results
+		if f.r.transport().Completes() && containerStatus.Name == f.r.resultsContainer {
+			continue
+		}
+
+		key := pod.Name + "/" + containerStatus.Name
+		f.mu.Lock()
+		started := f.followed[key]
+		f.followed[key] = true
+		f.mu.Unlock()
+		if started {
+			continue
+		}
+
+		f.wg.Add(1)
+		go func(podName, containerName string) {
+			defer f.wg.Done()
+			if err := f.stream(podName, containerName); err != nil && f.ctx.Err() == nil {
+				f.r.Printf("Stopped following log of %s:%s: %v", podName, containerName, err)
+			}
+		}(pod.Name, containerStatus.Name)
+	}
+}
+
+// stop stops following logs. Logs of containers that have terminated are given logDrainTimeout to be read to the end.
+func (f *logFollower) stop() {
+	if f == nil {
+		return
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		f.wg.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+	case <-time.After(logDrainTimeout):
+	}
+	f.cancel()
+	<-drained
+}
+
+// stream follows the log of a container, writing it to the containerLogs directory as it's read, and echoing up to
+// LogLines lines of it prefixed with the runner and container names.
+func (f *logFollower) stream(podName, containerName string) error {
+	request := f.r.Kube.CoreV1().Pods(f.r.Namespace).GetLogs(podName, &kubev1.PodLogOptions{Container: containerName, Follow: true})
+	logStream, err := request.Stream(f.ctx)
+	if err != nil {
+		return err
+	}
+	defer logStream.Close()
+
+	out := ioutil.Discard
+	if f.r.LogDir != "" {
+		logOutput, err := f.r.containerLogFile(podName, containerName)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Create(logOutput)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	// the Pods of a Job are told apart by name, which starts with the runner's
+	prefix := f.r.Name + "/" + containerName
+	if f.r.Job != nil {
+		prefix = podName + "/" + containerName
+	}
+
+	reader := bufio.NewReader(logStream)
+	for lines := 0; ; lines++ {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			if _, writeErr := io.WriteString(out, line); writeErr != nil {
+				return writeErr
+			}
+
+			if f.r.LogLines < 0 || lines < f.r.LogLines {
+				f.r.Printf("[%s] %s", prefix, strings.TrimRight(line, "\n"))
+			} else if lines == f.r.LogLines && f.r.LogLines > 0 {
+				f.r.Printf("[%s] echoed %d lines, the rest of the log is only written to %s", prefix, f.r.LogLines, containerLogs)
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// containerStatuses returns the statuses of the init containers and then the containers of a Pod.
+func containerStatuses(pod *kubev1.Pod) []kubev1.ContainerStatus {
+	statuses := make([]kubev1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	return append(statuses, pod.Status.ContainerStatuses...)
+}
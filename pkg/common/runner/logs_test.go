@@ -0,0 +1,98 @@
+package runner
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestFollowLogs(t *testing.T) {
+	logDir, err := ioutil.TempDir("", "runner-logs")
+	if err != nil {
+		t.Fatalf("failed to create log dir: %v", err)
+	}
+	defer os.RemoveAll(logDir)
+
+	// the fake clientset can't stream logs, so they're served over HTTP
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/default/pods/runner-pod/log" || req.URL.Query().Get("follow") != "true" {
+			http.NotFound(w, req)
+			return
+		}
+		fmt.Fprintf(w, "first line of %s\nlast line of %s", req.URL.Query().Get("container"), req.URL.Query().Get("container"))
+	}))
+	defer server.Close()
+
+	client, err := kube.NewForConfig(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		logLines int
+		echoed   int
+	}{
+		{"echo none", 0, 0},
+		{"echo some", 1, 1},
+		{"echo all", -1, 2},
+	}
+
+	for _, test := range tests {
+		def := *DefaultRunner
+		r := &def
+		r.Kube = client
+		r.Namespace = "default"
+		r.LogDir = logDir
+		r.LogLines = test.logLines
+
+		output := &bytes.Buffer{}
+		r.Logger = log.New(output, "", 0)
+
+		running := kubev1.ContainerState{Running: &kubev1.ContainerStateRunning{}}
+		pod := &kubev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "runner-pod"},
+			Status: kubev1.PodStatus{
+				InitContainerStatuses: []kubev1.ContainerStatus{{Name: "clone", State: kubev1.ContainerState{Terminated: &kubev1.ContainerStateTerminated{}}}},
+				ContainerStatuses: []kubev1.ContainerStatus{
+					{Name: r.Name, State: running},
+					{Name: "waiting"},
+				},
+			},
+		}
+
+		r.logs = r.followLogs()
+		r.logs.follow(pod)
+		r.logs.follow(pod)
+		r.logs.stop()
+
+		for _, container := range []string{"clone", r.Name} {
+			data, err := ioutil.ReadFile(filepath.Join(logDir, containerLogs, "runner-pod-"+container+".log"))
+			expected := fmt.Sprintf("first line of %s\nlast line of %s", container, container)
+			if err != nil || string(data) != expected {
+				t.Errorf("test %s: expected the log of container %s to be written, got %q: %v", test.name, container, data, err)
+			}
+
+			echoed := strings.Count(output.String(), "["+r.Name+"/"+container+"] first") + strings.Count(output.String(), "["+r.Name+"/"+container+"] last")
+			if echoed != test.echoed {
+				t.Errorf("test %s: expected %d lines of container %s to be echoed, got %q", test.name, test.echoed, container, output.String())
+			}
+		}
+
+		if _, err := os.Stat(filepath.Join(logDir, containerLogs, "runner-pod-waiting.log")); !os.IsNotExist(err) {
+			t.Errorf("test %s: expected containers that haven't started not to be followed", test.name)
+		}
+	}
+}
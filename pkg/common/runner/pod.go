@@ -8,12 +8,11 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/openshift/osde2e/pkg/common/util"
 	kubev1 "k8s.io/api/core/v1"
 	kerror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/intstr"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/pointer"
 )
 
@@ -24,9 +23,6 @@ const (
 	fastPoll               = 5 * time.Second
 	slowPoll               = 15 * time.Second
 
-	resultsPort     = 8000
-	resultsPortName = "results"
-
 	osde2ePayload          = "osde2e-payload"
 	osde2ePayloadMountPath = "/osde2e-payload"
 	osde2ePayloadScript    = "payload.sh"
@@ -40,23 +36,7 @@ func init() {
 
 // DefaultContainer is used by the DefaultRunner to run workloads
 var DefaultContainer = kubev1.Container{
-	Ports: []kubev1.ContainerPort{
-		{
-			Name:          resultsPortName,
-			ContainerPort: resultsPort,
-			Protocol:      kubev1.ProtocolTCP,
-		},
-	},
 	ImagePullPolicy: kubev1.PullAlways,
-	ReadinessProbe: &kubev1.Probe{
-		Handler: kubev1.Handler{
-			HTTPGet: &kubev1.HTTPGetAction{
-				Path: "/",
-				Port: intstr.FromInt(resultsPort),
-			},
-		},
-		PeriodSeconds: 7,
-	},
 	SecurityContext: &kubev1.SecurityContext{
 		RunAsUser: pointer.Int64Ptr(0),
 	},
@@ -90,12 +70,41 @@ func volumes(name string) []kubev1.Volume {
 }
 
 // createPod for creates a runner-based pod and typically collects generated artifacts from it.
-func (r *Runner) createPod() (pod *kubev1.Pod, err error) {
+func (r *Runner) createPod() (*kubev1.Pod, error) {
+	pod, err := r.buildPod()
+	if err != nil {
+		return nil, err
+	}
+
+	// retry until Pod can be created or timeout occurs
+	var createdPod *kubev1.Pod
+	err = r.poll(fastPoll, podCreateTimeout, func() (done bool, err error) {
+		if createdPod, err = r.Kube.CoreV1().Pods(r.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil {
+			log.Printf("Error creating %s runner Pod: %v", r.Name, err)
+		}
+		return err == nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.podName = createdPod.Name
+
+	// the payload is deleted with the Pod
+	return createdPod, r.ownPayload(metav1.OwnerReference{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Name:       createdPod.Name,
+		UID:        createdPod.UID,
+	})
+}
+
+// buildPod creates the payload ConfigMap and returns the Pod that runs the workload, without creating it.
+func (r *Runner) buildPod() (pod *kubev1.Pod, err error) {
 	// configure pod to run workload
 	cmName := fmt.Sprintf("%s-%s", osde2ePayload, util.RandomStr(5))
 	pod = &kubev1.Pod{
 		ObjectMeta: r.meta(),
-		Spec:       r.PodSpec,
+		Spec:       *r.PodSpec.DeepCopy(),
 	}
 
 	if len(r.Cmd) != 0 {
@@ -116,9 +125,10 @@ func (r *Runner) createPod() (pod *kubev1.Pod, err error) {
 		if err != nil {
 			return nil, fmt.Errorf("error creating ConfigMap: %v", err)
 		}
+		r.configMapName = configMap.Name
 
 		// Verify the configMap has been created before proceeding
-		err = wait.PollImmediate(fastPoll, configMapCreateTimeout, func() (done bool, err error) {
+		err = r.poll(fastPoll, configMapCreateTimeout, func() (done bool, err error) {
 			if configMap, err = r.Kube.CoreV1().ConfigMaps(r.Namespace).Get(context.TODO(), configMap.Name, metav1.GetOptions{}); err != nil {
 				log.Printf("Error creating %s config map: %v", configMap.Name, err)
 			}
@@ -142,33 +152,31 @@ func (r *Runner) createPod() (pod *kubev1.Pod, err error) {
 					fullPayloadScriptPath,
 				}
 				pod.Spec.Containers[i].VolumeMounts = volumeMounts(cmName)
+				pod.Spec.Volumes = volumes(cmName)
 			}
-			pod.Spec.Volumes = volumes(cmName)
 		}
 	}
 
 	// setup git repos to be cloned in init containers
 	r.Repos.ConfigurePod(&pod.Spec)
 
-	// retry until Pod can be created or timeout occurs
-	var createdPod *kubev1.Pod
-	err = wait.PollImmediate(fastPoll, podCreateTimeout, func() (done bool, err error) {
-		if createdPod, err = r.Kube.CoreV1().Pods(r.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil {
-			log.Printf("Error creating %s runner Pod: %v", r.Name, err)
-		}
-		return err == nil, nil
-	})
-	return createdPod, err
+	// make results available once Cmd has finished
+	r.resultsContainer = r.configureResults(&pod.Spec)
+	return pod, nil
 }
 
 // waitForRunningPod, given a v1.Pod, will wait for 3 minutes for a pod to enter the running phase or return an error.
 func (r *Runner) waitForPodRunning(pod *kubev1.Pod) error {
 	var pendingCount int = 0
-	return wait.PollImmediate(fastPoll, 3*time.Minute, func() (done bool, err error) {
+	return r.poll(fastPoll, 3*time.Minute, func() (done bool, err error) {
 		pod, err = r.Kube.CoreV1().Pods(pod.Namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{})
 		if err != nil && !kerror.IsNotFound(err) {
 			return
-		} else if pod == nil {
+		} else if err == nil {
+			r.logs.follow(pod)
+		}
+
+		if pod == nil {
 			err = errors.New("pod can't be nil")
 		} else if pod.Status.Phase == kubev1.PodFailed || pod.Status.Phase == kubev1.PodUnknown {
 			err = fmt.Errorf("failed waiting for Pod: the Pod has a phase of %s", pod.Status.Phase)
@@ -184,3 +192,50 @@ func (r *Runner) waitForPodRunning(pod *kubev1.Pod) error {
 		return
 	})
 }
+
+// waitForCompletion will wait for the results of a runner's pod to be ready
+func (r *Runner) waitForCompletion(podName string, timeoutInSeconds int) error {
+	var pendingCount int = 0
+	return r.poll(slowPoll, time.Duration(timeoutInSeconds)*time.Second, func() (done bool, err error) {
+		pod, err := r.Kube.CoreV1().Pods(r.Namespace).Get(context.TODO(), podName, metav1.GetOptions{})
+		if err != nil {
+			r.Printf("Encountered error getting pod: %v", err)
+			return false, err
+		}
+		r.logs.follow(pod)
+
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.Name == r.resultsContainer && containerStatus.Ready {
+				return true, nil
+			}
+
+			// results the runner container wrote before failing may still be retrievable from a sidecar
+			if !r.transport().Completes() && containerStatus.Name == r.Name && containerStatus.State.Terminated != nil && containerStatus.State.Terminated.ExitCode != 0 {
+				return false, fmt.Errorf("container %s failed with exit code %d before its results were ready", containerStatus.Name, containerStatus.State.Terminated.ExitCode)
+			}
+		}
+
+		if pod.Status.Phase == kubev1.PodFailed || pod.Status.Phase == kubev1.PodUnknown {
+			r.Printf("Pod entered error state while waiting for results: %+v", pod.Status)
+			return false, fmt.Errorf("pod failed while waiting for results")
+		} else if pod.Status.Phase == kubev1.PodSucceeded {
+			var err *multierror.Error
+			for _, containerStatus := range pod.Status.ContainerStatuses {
+				if containerStatus.State.Terminated != nil {
+					if containerStatus.State.Terminated.ExitCode != 0 {
+						err = multierror.Append(err, fmt.Errorf("container %s failed, please refer to artifacts for results", containerStatus.Name))
+					}
+				}
+			}
+			return err == nil, err.ErrorOrNil()
+		} else if pod.Status.Phase == kubev1.PodPending {
+			pendingCount++
+			if pendingCount > podPendingTimeout {
+				return false, fmt.Errorf("timed out waiting for pod to start")
+			}
+		}
+
+		r.Printf("Waiting for results of Pod '%s/%s'...", pod.Namespace, pod.Name)
+		return false, nil
+	})
+}
@@ -1,115 +1,175 @@
 package runner
 
 import (
-	"context"
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"path"
-	"strconv"
+	"path/filepath"
+	"sort"
 	"strings"
-	"time"
 
-	"golang.org/x/net/html"
-	"k8s.io/apimachinery/pkg/util/wait"
-	restclient "k8s.io/client-go/rest"
+	"github.com/hashicorp/go-multierror"
+	kubev1 "k8s.io/api/core/v1"
 )
 
-var (
-	resultsPortStr = strconv.Itoa(resultsPort)
+// checksumsFile lists the SHA-256 checksums of the results. It's written beside resultsDoneFile so it can't clash
+// with a result.
+const checksumsFile = "checksums.sha256"
 
-	errNotRun = errors.New("suite has not run yet")
-)
+var errNotRun = errors.New("suite has not run yet")
 
-// RetrieveResults gathers the results from the test Pod. Should only be called after tests are finished.
+// RetrieveResults gathers the results from the test Pod. Should only be called after tests are finished. If only some
+// results can be retrieved, for example because the Pod failed, they are returned with an error. The results of a Job
+// with more than one Pod are put in a directory for each Pod.
 func (r *Runner) RetrieveResults() (map[string][]byte, error) {
-	return r.retrieveResultsForDirectory("")
-}
-
-func (r *Runner) retrieveResultsForDirectory(directory string) (map[string][]byte, error) {
-	var rdr io.ReadCloser
-	var resp restclient.ResponseWrapper
-	var err error
-	if r.svc == nil {
+	if len(r.pods) == 0 {
 		return nil, errNotRun
 	}
 
-	// request result list
-	// sometimes it is possible for the service/endpoint to not be ready before the results are finished.
-	// we loop through here five times with a sleep statement to check.
-	wait.PollImmediate(5*time.Second, 1*time.Minute, func() (bool, error) {
-		resp = r.Kube.CoreV1().Services(r.Namespace).ProxyGet("http", r.svc.Name, resultsPortStr, directory, nil)
-		rdr, err = resp.Stream(context.TODO())
-		if err != nil {
-			return false, nil
+	results := map[string][]byte{}
+	var errs *multierror.Error
+	for _, pod := range r.pods {
+		podResults, err := r.transport().Retrieve(r, pod, r.resultsContainer)
+		errs = multierror.Append(errs, err)
+
+		for name, data := range podResults {
+			if len(r.pods) > 1 || (r.Job != nil && r.Job.Completions > 1) {
+				name = path.Join(pod.Name, name)
+			}
+			results[name] = data
 		}
-		return true, nil
-	})
 
-	if err != nil {
-		return nil, fmt.Errorf("could not retrieve result file listing: %v", err)
+		// let the Pod exit now its results have been retrieved
+		if !r.transport().Completes() {
+			if err = r.exec(pod, r.resultsContainer, []string{"touch", resultsRetrievedFile}, ioutil.Discard); err != nil {
+				log.Printf("Unable to mark results of %s runner as retrieved: %v", r.Name, err)
+			}
+		}
 	}
+	return results, errs.ErrorOrNil()
+}
 
-	// parse list
-	n, err := html.Parse(rdr)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse result file listing: %v", err)
-	} else if err = rdr.Close(); err != nil {
-		return nil, err
+// resultsDirCmd changes to the directory in the Pod results are retrieved from. If the Tarball wasn't made, for
+// example because the runner container failed, the OutputDir is retrieved instead.
+func (r *Runner) resultsDirCmd() string {
+	if r.Tarball {
+		return fmt.Sprintf("{ cd %s 2>/dev/null || cd %s; }", resultsTarballDir, r.OutputDir)
 	}
+	return fmt.Sprintf("cd %s", r.OutputDir)
+}
 
-	// download each file
-	results := map[string][]byte{}
-	if err = r.downloadLinks(n, results, directory); err != nil {
-		return results, fmt.Errorf("encountered error downloading results: %v", err)
+// resultsTarCmd writes the results as a tar to stdout, starting with their checksums.
+func (r *Runner) resultsTarCmd() string {
+	return fmt.Sprintf("%s && { find . -type f -exec sha256sum {} + > %s || true; } && tar cf - -C %s %s -C \"$PWD\" .",
+		r.resultsDirCmd(), filepath.Join(resultsStatusMountPath, checksumsFile), resultsStatusMountPath, checksumsFile)
+}
+
+// retrieveTar streams the results as a tar from a container of a Pod, along with their checksums.
+func (r *Runner) retrieveTar(pod *kubev1.Pod, container string, maxBytes int64) (map[string][]byte, error) {
+	script := r.resultsTarCmd()
+
+	pr, pw := io.Pipe()
+	execErr := make(chan error, 1)
+	go func() {
+		err := r.exec(pod, container, []string{"/bin/sh", "-c", script}, pw)
+		pw.CloseWithError(err)
+		execErr <- err
+	}()
+
+	results, err := readResults(pr, maxBytes)
+	pr.CloseWithError(io.ErrClosedPipe)
+	if streamErr := <-execErr; streamErr != nil && err == nil {
+		err = streamErr
+	}
+
+	if err != nil {
+		log.Printf("Retrieved %d results from %s runner before encountering an error: %v", len(results), r.Name, err)
+		return results, fmt.Errorf("encountered error retrieving results: %v", err)
 	}
+	log.Printf("Retrieved %d results from %s runner", len(results), r.Name)
 	return results, nil
 }
 
-// downloadLinks, given an html page, will download all present links.
-// This is useful when a pod is publishing an html list of artifacts.
-func (r *Runner) downloadLinks(n *html.Node, results map[string][]byte, directory string) error {
-	if n.Type == html.ElementNode && n.Data == "a" {
-		for _, a := range n.Attr {
-			if a.Key == "href" {
-				if strings.HasSuffix(a.Val, "/") {
-					var newDirectory string
-					if directory != "" {
-						newDirectory = a.Val
-					} else {
-						newDirectory = path.Join(directory, a.Val)
-					}
-
-					log.Println("Downloading directory " + newDirectory)
-					directoryResults, err := r.retrieveResultsForDirectory(newDirectory)
-
-					if err != nil {
-						return fmt.Errorf("error while getting results for directory %s: %v", newDirectory, err)
-					}
-
-					for k, v := range directoryResults {
-						results[k] = v
-					}
-				} else {
-					resp := r.Kube.CoreV1().Services(r.Namespace).ProxyGet("http", r.svc.Name, resultsPortStr, path.Join(directory, a.Val), nil)
-					data, err := resp.DoRaw(context.TODO())
-					if err != nil {
-						return err
-					}
-
-					filename := a.Val
-					log.Println("Downloading " + filename)
-					results[path.Join(directory, filename)] = data
-				}
+// readResults reads results from a tar stream, verifying their checksums if they come first. Results are skipped once
+// maxBytes have been read, unless it is zero. The results read before an error are returned with it.
+func readResults(stream io.Reader, maxBytes int64) (map[string][]byte, error) {
+	results := map[string][]byte{}
+	var sums map[string]string
+	var total int64
+	var errs *multierror.Error
+	skipped := []string{}
+
+	tr := tar.NewReader(stream)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("results stream broke: %v", err))
+			break
+		}
+
+		if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA {
+			continue
+		}
+
+		name := strings.TrimPrefix(path.Clean(hdr.Name), "./")
+		if hdr.Name == checksumsFile && sums == nil {
+			data, err := ioutil.ReadAll(tr)
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("results stream broke reading checksums: %v", err))
+				break
 			}
+			sums = parseChecksums(data)
+			continue
 		}
+
+		if maxBytes > 0 && total+hdr.Size > maxBytes {
+			skipped = append(skipped, name)
+			continue
+		}
+
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("results stream broke reading '%s': %v", name, err))
+			break
+		}
+		total += int64(len(data))
+
+		if sum, ok := sums[name]; ok && sum != fmt.Sprintf("%x", sha256.Sum256(data)) {
+			errs = multierror.Append(errs, fmt.Errorf("checksum of '%s' doesn't match", name))
+			continue
+		}
+		results[name] = data
+	}
+
+	if len(sums) == 0 && len(results) > 0 {
+		log.Printf("No checksums were sent with the results, so they couldn't be verified.")
+	}
+
+	if len(skipped) > 0 {
+		sort.Strings(skipped)
+		errs = multierror.Append(errs, fmt.Errorf("results exceed the limit of %d bytes, skipped: %s", maxBytes, strings.Join(skipped, ", ")))
 	}
+	return results, errs.ErrorOrNil()
+}
 
-	for c := n.FirstChild; c != nil; c = c.NextSibling {
-		if err := r.downloadLinks(c, results, directory); err != nil {
-			return err
+// parseChecksums parses the output of sha256sum, keyed by file.
+func parseChecksums(data []byte) map[string]string {
+	sums := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), "  ", 2)
+		if len(fields) == 2 {
+			sums[strings.TrimPrefix(path.Clean(fields[1]), "./")] = fields[0]
 		}
 	}
-	return nil
+	return sums
 }
@@ -1,109 +1,122 @@
 package runner
 
 import (
+	"archive/tar"
 	"bytes"
-	"context"
+	"crypto/sha256"
 	"errors"
+	"fmt"
 	"io"
-	"io/ioutil"
-	"net/http"
 	"strings"
 	"testing"
 
 	kubev1 "k8s.io/api/core/v1"
-	"k8s.io/client-go/kubernetes/fake"
-	"k8s.io/client-go/rest"
-	kubetest "k8s.io/client-go/testing"
 )
 
-var (
-	expectedResults = map[string][]byte{
-		"a": []byte("testdata"),
-		"b": []byte("moretestdata"),
-		"c": []byte("evenmoretestdata"),
-	}
-
-	resultPage = response(`
-<!DOCTYPE html PUBLIC "-//W3C//DTD HTML 3.2 Final//EN"><html>
-<title>Directory listing for /</title>
-<body>
-<h2>Directory listing for /</h2>
-<hr>
-<ul>
-<li><a href="a">a</a>
-<li><a href="b">b</a>
-<li><a href="c">c</a>
-</ul>
-<hr>
-</body>
-</html>
-`)
-)
-
-func TestRetrieveResults(t *testing.T) {
-	// setup mock client
-	client := fake.NewSimpleClientset()
-	client.AddProxyReactor("services", resultsServerReactor)
+var expectedResults = map[string][]byte{
+	"a":     []byte("testdata"),
+	"b":     []byte("moretestdata"),
+	"dir/c": []byte("evenmoretestdata"),
+}
 
-	// setup runner
-	def := *DefaultRunner
-	r := &def
-	r.Kube = client
+// resultsTar builds a results stream the way the runner Pod does, with the checksums of sums first.
+func resultsTar(t *testing.T, files map[string][]byte, sums map[string][]byte) []byte {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
 
-	// create results service
-	svc, err := r.createService(new(kubev1.Pod))
-	if err != nil {
-		t.Fatalf("Failed to create example service: %v", err)
+	write := func(name string, data []byte) {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatalf("error writing tar: %v", err)
+		}
+		tw.Write(data)
 	}
-	r.svc = svc
 
-	// get results
-	results, err := r.RetrieveResults()
-	if err != nil {
-		t.Fatalf("Failed to get results: %v", err)
+	checksums := &bytes.Buffer{}
+	for _, name := range []string{"a", "b", "dir/c"} {
+		if data, ok := sums[name]; ok {
+			fmt.Fprintf(checksums, "%x  ./%s\n", sha256.Sum256(data), name)
+		}
 	}
+	write(checksumsFile, checksums.Bytes())
 
-	// compare to expected
-	for k, v := range expectedResults {
-		if actualV, ok := results[k]; !ok {
-			t.Fatalf("missing file '%s' in results", k)
-		} else if !bytes.Equal(actualV, v) {
-			t.Fatalf("file '%s' has been corrupted: want '%s', got '%s'", k, v, actualV)
+	tw.WriteHeader(&tar.Header{Name: "./dir/", Mode: 0755, Typeflag: tar.TypeDir})
+	for _, name := range []string{"a", "b", "dir/c"} {
+		if data, ok := files[name]; ok {
+			write("./"+name, data)
 		}
 	}
+	tw.Close()
+	return buf.Bytes()
 }
 
-func resultsServerReactor(action kubetest.Action) (handled bool, ret rest.ResponseWrapper, err error) {
-	proxyAction := action.(kubetest.ProxyGetActionImpl)
+func TestReadResults(t *testing.T) {
+	corrupted := map[string][]byte{"a": []byte("testdata"), "b": []byte("corrupted"), "dir/c": []byte("evenmoretestdata")}
 
-	// only respond to service proxy requests
-	if handled = proxyAction.Matches(http.MethodGet, "services"); !handled {
-		return
+	tests := []struct {
+		name        string
+		stream      []byte
+		maxBytes    int64
+		expected    []string
+		expectedErr string
+	}{
+		{"all results", resultsTar(t, expectedResults, expectedResults), 0, []string{"a", "b", "dir/c"}, ""},
+		{"without checksums", resultsTar(t, expectedResults, nil), 0, []string{"a", "b", "dir/c"}, ""},
+		{"corrupted", resultsTar(t, corrupted, expectedResults), 0, []string{"a", "dir/c"}, "checksum of 'b' doesn't match"},
+		{"size limit", resultsTar(t, expectedResults, expectedResults), 25, []string{"a", "b"}, "skipped: dir/c"},
+		{"broken stream", resultsTar(t, expectedResults, expectedResults)[:2600], 0, []string{"a"}, "results stream broke"},
 	}
 
-	path := strings.TrimPrefix(proxyAction.Path, "/")
-	if path == "" {
-		ret = resultPage
-	} else if data, ok := expectedResults[path]; ok {
-		ret = response(data)
-	} else {
-		ret = response{}
-	}
+	for _, test := range tests {
+		results, err := readResults(bytes.NewReader(test.stream), test.maxBytes)
+		if test.expectedErr == "" && err != nil {
+			t.Errorf("test %s: unexpected error: %v", test.name, err)
+		} else if test.expectedErr != "" && (err == nil || !strings.Contains(err.Error(), test.expectedErr)) {
+			t.Errorf("test %s: expected error containing '%s', got %v", test.name, test.expectedErr, err)
+		}
 
-	return
+		if len(results) != len(test.expected) {
+			t.Errorf("test %s: expected results %v, got %d results", test.name, test.expected, len(results))
+		}
+		for _, name := range test.expected {
+			if !bytes.Equal(results[name], expectedResults[name]) {
+				t.Errorf("test %s: file '%s' has been corrupted: want '%s', got '%s'", test.name, name, expectedResults[name], results[name])
+			}
+		}
+	}
 }
 
-type response []byte
+func TestRetrieveResults(t *testing.T) {
+	def := *DefaultRunner
+	r := &def
 
-func (r response) DoRaw(context.Context) ([]byte, error) {
-	if len(r) == 0 {
-		return nil, errors.New("file does not exist")
+	if _, err := r.RetrieveResults(); err != errNotRun {
+		t.Fatalf("expected retrieving results before running to fail with %v, got %v", errNotRun, err)
 	}
-	return r, nil
-}
-func (r response) Stream(context.Context) (io.ReadCloser, error) {
-	if len(r) == 0 {
-		return nil, errors.New("file does not exist")
+
+	stream := resultsTar(t, expectedResults, expectedResults)
+	commands := []string{}
+	r.pods = []*kubev1.Pod{{}}
+	r.resultsContainer = r.Name
+	r.executor = func(pod *kubev1.Pod, container string, command []string, stdout io.Writer) error {
+		commands = append(commands, strings.Join(command, " "))
+		if command[0] == "touch" {
+			return nil
+		}
+
+		// fail part way through the stream
+		stdout.Write(stream[:2600])
+		return errors.New("container exited")
+	}
+
+	results, err := r.RetrieveResults()
+	if err == nil || !strings.Contains(err.Error(), "container exited") {
+		t.Errorf("expected an error from the broken stream, got %v", err)
+	}
+	if len(results) != 1 || !bytes.Equal(results["a"], expectedResults["a"]) {
+		t.Errorf("expected the results before the stream broke, got %v", results)
+	}
+
+	if len(commands) != 2 || !strings.Contains(commands[0], "tar cf - ") || commands[1] != "touch "+resultsRetrievedFile {
+		t.Errorf("unexpected commands run in the Pod: %v", commands)
 	}
-	return ioutil.NopCloser(bytes.NewReader(r)), nil
 }
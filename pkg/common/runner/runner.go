@@ -2,15 +2,19 @@
 package runner
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	image "github.com/openshift/client-go/image/clientset/versioned"
 	"github.com/openshift/osde2e/pkg/common/util"
 	kubev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	kube "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 )
 
 const (
@@ -30,6 +34,7 @@ var DefaultRunner = &Runner{
 		RestartPolicy: kubev1.RestartPolicyNever,
 	},
 	OutputDir: "/test-run-results",
+	Transport: ExecTransport{},
 	Logger:    log.New(os.Stderr, "", log.LstdFlags),
 }
 
@@ -41,6 +46,9 @@ type Runner struct {
 	// Image client used to gather ImageStream information.
 	Image image.Interface
 
+	// RestConfig is used to exec in the test Pod to retrieve results.
+	RestConfig *rest.Config
+
 	// Name of the operation being performed.
 	Name string
 
@@ -68,6 +76,19 @@ type Runner struct {
 	// Tarball will create a single .tgz file for the entire OutputDir.
 	Tarball bool
 
+	// Transport gets the results out of the Pod.
+	Transport ResultTransport
+
+	// Job runs the workload as a Job instead of a bare Pod, if set.
+	Job *JobOptions
+
+	// LogDir is the local directory container logs are written to.
+	LogDir string
+
+	// LogLines is how many lines of each container's log are echoed as the runner follows it. All of them are echoed
+	// if it is negative.
+	LogLines int
+
 	// Repos are cloned and mounted into the test Pod.
 	Repos
 
@@ -75,16 +96,53 @@ type Runner struct {
 	*log.Logger
 
 	// internal
-	stopCh <-chan struct{}
-	svc    *kubev1.Service
-	status Status
+	ctx              context.Context
+	configMapName    string
+	podName          string
+	jobName          string
+	pods             []*kubev1.Pod
+	resultsContainer string
+	executor         podExecutor
+	logs             *logFollower
+	status           Status
 }
 
-// Run deploys the suite into a cluster, waits for it to finish, and gathers the results.
-func (r *Runner) Run(timeoutInSeconds int, stopCh <-chan struct{}) (err error) {
-	r.stopCh = stopCh
+// Run deploys the suite into a cluster, waits for it to finish, and gathers the results. Closing stopCh stops the
+// runner, deleting its resources.
+func (r *Runner) Run(timeoutInSeconds int, stopCh <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return r.RunContext(ctx, timeoutInSeconds)
+}
+
+// RunContext is Run, stopped when ctx is done instead of by a channel. Resources left by a previous run are deleted
+// first.
+func (r *Runner) RunContext(ctx context.Context, timeoutInSeconds int) (err error) {
+	if err = r.Cleanup(); err != nil {
+		return err
+	}
+
+	r.ctx = ctx
 	r.status = StatusSetup
 
+	defer func() {
+		if err != nil && ctx.Err() != nil {
+			log.Printf("%s runner was stopped, deleting its resources...", r.Name)
+			if cleanupErr := r.Cleanup(); cleanupErr != nil {
+				log.Printf("Unable to delete resources of %s runner: %v", r.Name, cleanupErr)
+			}
+			err = fmt.Errorf("%s runner was stopped: %v", r.Name, ctx.Err())
+		}
+	}()
+
 	// set image if imagestream is set
 	if r.ImageName == "" {
 		if r.ImageName, err = r.GetLatestImageStreamTag(); err != nil {
@@ -93,6 +151,13 @@ func (r *Runner) Run(timeoutInSeconds int, stopCh <-chan struct{}) (err error) {
 	}
 	log.Printf("Using '%s' as image for runner", r.ImageName)
 
+	r.logs = r.followLogs()
+	defer r.logs.stop()
+
+	if r.Job != nil {
+		return r.runJob(timeoutInSeconds)
+	}
+
 	log.Printf("Creating %s runner Pod...", r.Name)
 	var pod *kubev1.Pod
 	if pod, err = r.createPod(); err != nil {
@@ -104,15 +169,12 @@ func (r *Runner) Run(timeoutInSeconds int, stopCh <-chan struct{}) (err error) {
 		return
 	}
 	r.status = StatusRunning
+	r.pods = []*kubev1.Pod{pod}
 
-	log.Printf("Creating service for %s runner Pod...", r.Name)
-	if r.svc, err = r.createService(pod); err != nil {
-		return
-	}
-
-	log.Printf("Waiting for endpoints of %s runner Pod with a timeout of %d seconds...", r.Name, timeoutInSeconds)
+	log.Printf("Waiting for results of %s runner Pod with a timeout of %d seconds...", r.Name, timeoutInSeconds)
 	var completionErr error
 	completionErr = r.waitForCompletion(pod.Name, timeoutInSeconds)
+	r.logs.stop()
 
 	log.Printf("Collecting logs from containers on %s runner Pod...", r.Name)
 	if err = r.getAllLogsFromPod(pod.Name); err != nil {
@@ -128,6 +190,18 @@ func (r *Runner) Run(timeoutInSeconds int, stopCh <-chan struct{}) (err error) {
 	return nil
 }
 
+// poll runs condition every interval until it's done or fails, timeout has passed, or the runner is stopped.
+func (r *Runner) poll(interval, timeout time.Duration, condition wait.ConditionFunc) error {
+	ctx := r.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return wait.PollImmediateUntil(interval, condition, ctx.Done())
+}
+
 // Status returns the current state of the runner.
 func (r *Runner) Status() Status {
 	return r.status
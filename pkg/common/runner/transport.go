@@ -0,0 +1,248 @@
+package runner
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"path/filepath"
+
+	kubev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// resultsStatusVolume is shared by the containers of the runner Pod to signal when results are ready.
+	resultsStatusVolume    = "osde2e-results"
+	resultsStatusMountPath = "/osde2e-results"
+
+	// resultsOutputVolume shares the OutputDir with the sidecar.
+	resultsOutputVolume = "osde2e-output"
+
+	// resultsSidecarName is the name of the sidecar results are retrieved from.
+	resultsSidecarName = "results"
+
+	// resultsRetentionSeconds is how long results are kept available before the runner Pod exits.
+	resultsRetentionSeconds = 3600
+)
+
+var (
+	// resultsDoneFile is created once Cmd has finished and results are ready.
+	resultsDoneFile = filepath.Join(resultsStatusMountPath, "done")
+
+	// resultsRetrievedFile is created once results have been retrieved, letting the runner Pod exit.
+	resultsRetrievedFile = filepath.Join(resultsStatusMountPath, "retrieved")
+
+	// resultsTarballDir holds the tarball of the OutputDir when Tarball is set.
+	resultsTarballDir = filepath.Join(resultsStatusMountPath, "tarball")
+
+	// resultsWaitCmd waits until results have been retrieved, or until they've been kept long enough.
+	resultsWaitCmd = fmt.Sprintf("for i in $(seq %d); do [ -f %s ] && break; sleep 5; done", resultsRetentionSeconds/5, resultsRetrievedFile)
+)
+
+// ResultTransport gets the results a runner writes to its OutputDir out of its Pod.
+type ResultTransport interface {
+	// ConfigurePod sets up a Pod so its results can be retrieved, returning the container they're retrieved from.
+	ConfigurePod(r *Runner, spec *kubev1.PodSpec) (container string)
+
+	// HoldsResults is true if the runner container must stay running after Cmd until its results are retrieved.
+	HoldsResults() bool
+
+	// Completes is true if the Pod exits once its results are ready, rather than waiting for them to be retrieved.
+	// Only transports that complete can retrieve results from Jobs.
+	Completes() bool
+
+	// Retrieve gets the results from a Pod whose results are ready. If only some results can be retrieved, they are
+	// returned with an error.
+	Retrieve(r *Runner, pod *kubev1.Pod, container string) (map[string][]byte, error)
+}
+
+// ExecTransport streams the results as a tar over pods/exec from the runner container, which waits after Cmd until
+// they are retrieved. Results can't be retrieved if the runner container fails.
+type ExecTransport struct {
+	// MaxBytes limits the total size of the results retrieved. There is no limit if it is zero.
+	MaxBytes int64
+}
+
+// ConfigurePod makes the runner container ready once Cmd has finished.
+func (t ExecTransport) ConfigurePod(r *Runner, spec *kubev1.PodSpec) string {
+	for i := range spec.Containers {
+		if spec.Containers[i].Name == r.Name {
+			spec.Containers[i].ReadinessProbe = resultsReadinessProbe()
+		}
+	}
+	return r.Name
+}
+
+// HoldsResults is true as results are retrieved from the runner container.
+func (t ExecTransport) HoldsResults() bool {
+	return true
+}
+
+// Completes is false as the runner container waits for its results to be retrieved.
+func (t ExecTransport) Completes() bool {
+	return false
+}
+
+// Retrieve streams the results from the runner container.
+func (t ExecTransport) Retrieve(r *Runner, pod *kubev1.Pod, container string) (map[string][]byte, error) {
+	return r.retrieveTar(pod, container, t.MaxBytes)
+}
+
+// SidecarTransport shares the OutputDir with a sidecar, and streams the results as a tar over pods/exec from the
+// sidecar. Results written before the runner container fails can still be retrieved.
+type SidecarTransport struct {
+	// Image of the sidecar, which needs sh and tar. The runner's image is used if it is empty.
+	Image string
+
+	// MaxBytes limits the total size of the results retrieved. There is no limit if it is zero.
+	MaxBytes int64
+}
+
+// ConfigurePod adds the sidecar, which is ready once Cmd has finished, and shares the OutputDir with it.
+func (t SidecarTransport) ConfigurePod(r *Runner, spec *kubev1.PodSpec) string {
+	image := t.Image
+	if image == "" {
+		image = r.ImageName
+	}
+
+	spec.Containers = append(spec.Containers, kubev1.Container{
+		Name:           resultsSidecarName,
+		Image:          image,
+		Command:        []string{"/bin/sh", "-c", resultsWaitCmd},
+		ReadinessProbe: resultsReadinessProbe(),
+		VolumeMounts:   r.shareOutputDir(spec),
+	})
+	return resultsSidecarName
+}
+
+// HoldsResults is false as the sidecar holds the results.
+func (t SidecarTransport) HoldsResults() bool {
+	return false
+}
+
+// Completes is false as the sidecar waits for the results to be retrieved.
+func (t SidecarTransport) Completes() bool {
+	return false
+}
+
+// Retrieve streams the results from the sidecar.
+func (t SidecarTransport) Retrieve(r *Runner, pod *kubev1.Pod, container string) (map[string][]byte, error) {
+	return r.retrieveTar(pod, container, t.MaxBytes)
+}
+
+// LogTransport shares the OutputDir with a sidecar, which writes the results to its log as a base64 encoded tar and
+// exits once the runner container has finished. Results can be retrieved after the Pod has exited, so this is the
+// only transport that can be used by Jobs. The size of the results is limited by how much log the node keeps.
+type LogTransport struct {
+	// Image of the sidecar, which needs sh, tar and base64. The sidecar finds out when the runner container fails if
+	// the image has oc. The runner's image is used if it is empty.
+	Image string
+
+	// MaxBytes limits the total size of the results retrieved. There is no limit if it is zero.
+	MaxBytes int64
+}
+
+// ConfigurePod adds the sidecar and shares the OutputDir with it.
+func (t LogTransport) ConfigurePod(r *Runner, spec *kubev1.PodSpec) string {
+	image := t.Image
+	if image == "" {
+		image = r.ImageName
+	}
+
+	// wait for Cmd to finish, or the runner container to terminate without finishing it
+	wait := fmt.Sprintf(`until [ -f %s ]; do
+  if command -v oc >/dev/null && oc get pod "$HOSTNAME" -o jsonpath='{.status.containerStatuses[?(@.name=="%s")].state.terminated}' 2>/dev/null | grep -q .; then break; fi
+  sleep 5
+done`, resultsDoneFile, r.Name)
+
+	spec.Containers = append(spec.Containers, kubev1.Container{
+		Name:         resultsSidecarName,
+		Image:        image,
+		Command:      []string{"/bin/sh", "-c", wait + "\n" + r.resultsTarCmd() + " | base64"},
+		VolumeMounts: r.shareOutputDir(spec),
+	})
+	return resultsSidecarName
+}
+
+// HoldsResults is false as the sidecar writes the results to its log.
+func (t LogTransport) HoldsResults() bool {
+	return false
+}
+
+// Completes is true as the sidecar exits once it has written the results to its log.
+func (t LogTransport) Completes() bool {
+	return true
+}
+
+// Retrieve reads the results from the log of the sidecar.
+func (t LogTransport) Retrieve(r *Runner, pod *kubev1.Pod, container string) (map[string][]byte, error) {
+	stream, err := r.Kube.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &kubev1.PodLogOptions{Container: container}).Stream(context.TODO())
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read results from the log of container %s of Pod '%s': %v", container, pod.Name, err)
+	}
+	defer stream.Close()
+
+	results, err := readResults(base64.NewDecoder(base64.StdEncoding, stream), t.MaxBytes)
+	if err != nil {
+		return results, fmt.Errorf("encountered error retrieving results from Pod '%s': %v", pod.Name, err)
+	}
+	return results, nil
+}
+
+// shareOutputDir mounts an emptyDir at the OutputDir of the runner container, returning the mounts a sidecar needs to
+// read the results.
+func (r *Runner) shareOutputDir(spec *kubev1.PodSpec) []kubev1.VolumeMount {
+	output := kubev1.VolumeMount{Name: resultsOutputVolume, MountPath: r.OutputDir}
+	for i := range spec.Containers {
+		if spec.Containers[i].Name == r.Name {
+			spec.Containers[i].VolumeMounts = append(spec.Containers[i].VolumeMounts, output)
+		}
+	}
+
+	spec.Volumes = append(spec.Volumes, kubev1.Volume{
+		Name:         resultsOutputVolume,
+		VolumeSource: kubev1.VolumeSource{EmptyDir: &kubev1.EmptyDirVolumeSource{}},
+	})
+	return []kubev1.VolumeMount{
+		output,
+		{Name: resultsStatusVolume, MountPath: resultsStatusMountPath},
+	}
+}
+
+// resultsReadinessProbe is ready once the results are.
+func resultsReadinessProbe() *kubev1.Probe {
+	return &kubev1.Probe{
+		Handler: kubev1.Handler{
+			Exec: &kubev1.ExecAction{
+				Command: []string{"test", "-f", resultsDoneFile},
+			},
+		},
+		PeriodSeconds: 7,
+	}
+}
+
+// configureResults shares the results status volume with the runner container and lets the transport configure the
+// Pod, returning the container results are retrieved from.
+func (r *Runner) configureResults(spec *kubev1.PodSpec) string {
+	for i := range spec.Containers {
+		if spec.Containers[i].Name == r.Name {
+			spec.Containers[i].VolumeMounts = append(spec.Containers[i].VolumeMounts, kubev1.VolumeMount{
+				Name:      resultsStatusVolume,
+				MountPath: resultsStatusMountPath,
+			})
+		}
+	}
+	spec.Volumes = append(spec.Volumes, kubev1.Volume{
+		Name:         resultsStatusVolume,
+		VolumeSource: kubev1.VolumeSource{EmptyDir: &kubev1.EmptyDirVolumeSource{}},
+	})
+
+	return r.transport().ConfigurePod(r, spec)
+}
+
+// transport returns the runner's result transport.
+func (r *Runner) transport() ResultTransport {
+	if r.Transport == nil {
+		return ExecTransport{}
+	}
+	return r.Transport
+}
@@ -0,0 +1,121 @@
+package runner
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestTransportsConfigurePod(t *testing.T) {
+	tests := []struct {
+		name              string
+		transport         ResultTransport
+		expectedContainer string
+		containers        int
+		holdsResults      bool
+		probed            bool
+	}{
+		{"exec", ExecTransport{}, defaultName, 1, true, true},
+		{"sidecar", SidecarTransport{}, resultsSidecarName, 2, false, true},
+		{"log", LogTransport{}, resultsSidecarName, 2, false, false},
+	}
+
+	for _, test := range tests {
+		def := *DefaultRunner
+		r := &def
+		r.Kube = fake.NewSimpleClientset()
+		r.Cmd = "echo hello"
+		r.ImageName = "quay.io/run/tests"
+		r.Transport = test.transport
+
+		pod, err := r.createPod()
+		if err != nil {
+			t.Fatalf("test %s: failed to create pod: %v", test.name, err)
+		}
+
+		if r.resultsContainer != test.expectedContainer || len(pod.Spec.Containers) != test.containers {
+			t.Errorf("test %s: expected results in container %s of %d, got %s of %d", test.name, test.expectedContainer, test.containers, r.resultsContainer, len(pod.Spec.Containers))
+		}
+
+		for _, container := range pod.Spec.Containers {
+			ready := container.ReadinessProbe != nil && container.ReadinessProbe.Exec != nil
+			if ready != (test.probed && container.Name == test.expectedContainer) {
+				t.Errorf("test %s: container %s should only have a readiness probe if results are retrieved from it", test.name, container.Name)
+			}
+			if container.Image != r.ImageName {
+				t.Errorf("test %s: container %s should use the runner image, not %s", test.name, container.Name, container.Image)
+			}
+		}
+
+		cmd, err := r.Command()
+		if err != nil {
+			t.Fatalf("test %s: couldn't template command: %v", test.name, err)
+		}
+		if strings.Contains(string(cmd), resultsRetrievedFile) != test.holdsResults {
+			t.Errorf("test %s: command should wait for results to be retrieved: %v", test.name, test.holdsResults)
+		}
+
+		// the runner's PodSpec mustn't be changed, so it can be run again
+		if len(DefaultRunner.PodSpec.Containers[0].VolumeMounts) != 0 || DefaultRunner.PodSpec.Containers[0].ReadinessProbe != nil {
+			t.Errorf("test %s: the runner's PodSpec was modified", test.name)
+		}
+	}
+}
+
+func TestWaitForCompletion(t *testing.T) {
+	tests := []struct {
+		name        string
+		transport   ResultTransport
+		statuses    []kubev1.ContainerStatus
+		expectedErr bool
+	}{
+		{
+			name:      "runner ready",
+			transport: ExecTransport{},
+			statuses:  []kubev1.ContainerStatus{{Name: defaultName, Ready: true}},
+		},
+		{
+			name:      "sidecar ready",
+			transport: SidecarTransport{},
+			statuses: []kubev1.ContainerStatus{
+				{Name: defaultName, State: kubev1.ContainerState{Terminated: &kubev1.ContainerStateTerminated{}}},
+				{Name: resultsSidecarName, Ready: true},
+			},
+		},
+		{
+			name:      "runner failed",
+			transport: SidecarTransport{},
+			statuses: []kubev1.ContainerStatus{
+				{Name: defaultName, State: kubev1.ContainerState{Terminated: &kubev1.ContainerStateTerminated{ExitCode: 137}}},
+				{Name: resultsSidecarName},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		def := *DefaultRunner
+		r := &def
+		r.Kube = fake.NewSimpleClientset()
+		r.Transport = test.transport
+
+		pod, err := r.createPod()
+		if err != nil {
+			t.Fatalf("test %s: failed to create pod: %v", test.name, err)
+		}
+
+		pod.Status.Phase = kubev1.PodRunning
+		pod.Status.ContainerStatuses = test.statuses
+		if _, err = r.Kube.CoreV1().Pods(r.Namespace).UpdateStatus(context.TODO(), pod, metav1.UpdateOptions{}); err != nil {
+			t.Fatalf("test %s: failed to update pod: %v", test.name, err)
+		}
+
+		if err = r.waitForCompletion(pod.Name, 1); (err != nil) != test.expectedErr {
+			t.Errorf("test %s: expected error=%v, got %v", test.name, test.expectedErr, err)
+		}
+	}
+}
@@ -2,10 +2,17 @@
 package spi
 
 import (
+	"context"
+	"time"
+
 	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 )
 
 // Provider is the interface that must be implemented in order to provision clusters in osde2e.
+//
+// Every call that may block on an external system accepts a context. Providers are expected to
+// abandon the call and return the context's error as soon as the context is cancelled or its
+// deadline passes, which allows osde2e to shut down cleanly when a job is terminated.
 type Provider interface {
 	// LaunchCluster creates a new cluster and returns the cluster ID.
 	//
@@ -13,13 +20,13 @@ type Provider interface {
 	// report back an identifier without waiting. Subsequent calls within OSDe2e will
 	// use the status reported by GetCluster to determine the provision state of
 	// the cluster and wait for it to start before running tests.
-	LaunchCluster(clusterName string) (string, error)
+	LaunchCluster(ctx context.Context, clusterName string) (string, error)
 
 	// DeleteCluster deletes a cluster.
 	//
 	// Calling this will start a cluster deletion and return as soon as the process
 	// has begun. OSDe2e will not wait for the cluster to delete.
-	DeleteCluster(clusterID string) error
+	DeleteCluster(ctx context.Context, clusterID string) error
 
 	// ScaleCluster scales a cluster.
 	//
@@ -28,36 +35,36 @@ type Provider interface {
 	// process and return without waiting. Subsequent calls within OSDe2e will use the status
 	// reported by GetCluster and status calls from the cluster itself to determine if the
 	// scaling has finished.
-	ScaleCluster(clusterID string, numComputeNodes int) error
+	ScaleCluster(ctx context.Context, clusterID string, numComputeNodes int) error
 
 	// ListCluster lists clusters from a provider based on a SQL-like query.
-	ListClusters(query string) ([]*Cluster, error)
+	ListClusters(ctx context.Context, query string) ([]*Cluster, error)
 
 	// GetCluster gets a cluster.
 	//
 	// This is what OSDe2e will use to gather cluster information, including whether
 	// the cluser has finished provisioning.
-	GetCluster(clusterID string) (*Cluster, error)
+	GetCluster(ctx context.Context, clusterID string) (*Cluster, error)
 
 	// ClusterKubeconfig should return the raw kubeconfig for the cluster.
 	//
 	// OSDe2e needs administrative cluster level access for a cluster, so this should
 	// return a raw kubeconfig that will allow OSDe2e to connect with administrative
 	// access.
-	ClusterKubeconfig(clusterID string) ([]byte, error)
+	ClusterKubeconfig(ctx context.Context, clusterID string) ([]byte, error)
 
 	// CheckQuota will return true if there is enough quota to provision a cluster.
 	//
 	// To prevent a provisioning attempt, OSDe2e will first check the quota first. This quota
 	// is currently expected to be configured by the global config object.
-	CheckQuota() (bool, error)
+	CheckQuota(ctx context.Context) (bool, error)
 
 	// InstallAddons will install addons onto the cluster.
 	//
 	// OpenShift dedicated has the notion of addon installation, which users can request from
 	// the OCM API. If you wish to emulate this support, the provider will need to support a similar
 	// mechanism.
-	InstallAddons(clusterID string, addonIDs []string) (int, error)
+	InstallAddons(ctx context.Context, clusterID string, addonIDs []string) (int, error)
 
 	// Versions returns a sorted list of supported OpenShift versions.
 	//
@@ -65,15 +72,15 @@ type Provider interface {
 	// versions is expected to be labeled as "default." The provider can also set a default version
 	// override, which is useful if you want to select relative versions to test against, e.g.
 	// 4.3.12 + nightly of next release == 4.4.0-0.nightly.
-	Versions() (*VersionList, error)
+	Versions(ctx context.Context) (*VersionList, error)
 
 	// Logs will get logs relevant to the cluster from the provider.
 	//
 	// Any provider level logs that are relevant to the cluster.
-	Logs(clusterID string) (map[string][]byte, error)
+	Logs(ctx context.Context, clusterID string) (map[string][]byte, error)
 
 	// Metrics will get metrics relevant to the cluster from the provider.
-	Metrics(clusterID string) (*clustersmgmtv1.ClusterMetrics, error)
+	Metrics(ctx context.Context, clusterID string) (*clustersmgmtv1.ClusterMetrics, error)
 
 	// Environment retrives the environment from the provider.
 	//
@@ -99,5 +106,12 @@ type Provider interface {
 	Type() string
 
 	//ExtendExpiry extends the expiration time of an existing cluster
-	ExtendExpiry(clusterID string, hours uint64, minutes uint64, seconds uint64) error
+	ExtendExpiry(ctx context.Context, clusterID string, hours uint64, minutes uint64, seconds uint64) error
+
+	// UpgradeCluster schedules an upgrade of a cluster to a version.
+	//
+	// This is how customers upgrade managed clusters. The upgrade should start at the given time,
+	// and this is expected to return as soon as it is scheduled. OSDe2e will watch the cluster
+	// itself to determine when the upgrade has finished.
+	UpgradeCluster(ctx context.Context, clusterID string, version string, t time.Time) error
 }
@@ -2,10 +2,12 @@ package spi
 
 import (
 	"fmt"
+
+	"github.com/openshift/osde2e/pkg/common/config"
 )
 
-// ProviderCreateFunction is a function that creates providers.
-type ProviderCreateFunction func() (Provider, error)
+// ProviderCreateFunction is a function that creates providers for a run.
+type ProviderCreateFunction func(cfg *config.RunConfig) (Provider, error)
 
 type providerRegistry struct {
 	providerCreation map[string]ProviderCreateFunction
@@ -16,7 +18,7 @@ var registry = providerRegistry{
 }
 
 // RegisterProvider will register a provider with the given name that will be created by the given provider factory.
-func RegisterProvider(name string, providerCreate func() (Provider, error)) {
+func RegisterProvider(name string, providerCreate ProviderCreateFunction) {
 	if _, ok := registry.providerCreation[name]; ok {
 		panic(fmt.Sprintf("Duplicate provider name %s!", name))
 	}
@@ -24,10 +26,10 @@ func RegisterProvider(name string, providerCreate func() (Provider, error)) {
 	registry.providerCreation[name] = providerCreate
 }
 
-// GetProvider will retrieve a provider with the given name.
-func GetProvider(name string) (Provider, error) {
+// GetProvider will create a provider with the given name for the run configured by cfg.
+func GetProvider(name string, cfg *config.RunConfig) (Provider, error) {
 	if providerCreate, ok := registry.providerCreation[name]; ok {
-		return providerCreate()
+		return providerCreate(cfg)
 	}
 
 	return nil, fmt.Errorf("unable to find provider %s", name)
@@ -0,0 +1,61 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/openshift/osde2e/pkg/common/helper"
+	"github.com/openshift/osde2e/pkg/common/providers"
+)
+
+// managedStartTimeout is how long a managed upgrade can take to start after it was scheduled to.
+const managedStartTimeout = 30 * time.Minute
+
+// TriggerManagedUpgrade schedules an upgrade with the cluster's provider, the way customers upgrade managed
+// clusters, and waits for the cluster to start working towards it.
+func TriggerManagedUpgrade(ctx context.Context, h *helper.H) (*configv1.Update, error) {
+	cfg := h.Config()
+	if cfg.State.UpgradeImage != "" {
+		return nil, fmt.Errorf("managed upgrades can't upgrade to an image, only to a release offered by the provider")
+	}
+
+	provider, err := providers.ClusterProvider(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cluster provider: %v", err)
+	}
+
+	delay := time.Duration(cfg.Upgrade.ManagedDelayInMinutes) * time.Minute
+	version := strings.Replace(cfg.State.UpgradeReleaseName, "openshift-v", "", -1)
+	if err = provider.UpgradeCluster(ctx, cfg.State.ClusterID, cfg.State.UpgradeReleaseName, time.Now().Add(delay)); err != nil {
+		return nil, fmt.Errorf("couldn't schedule upgrade to %s: %v", version, err)
+	}
+	log.Printf("Upgrade to %s scheduled in %v", version, delay)
+
+	// The upgrade has started once whatever manages upgrades on the cluster updates the ClusterVersion.
+	startCtx, cancel := context.WithTimeout(ctx, delay+managedStartTimeout)
+	defer cancel()
+	if err = wait.PollImmediateUntil(30*time.Second, func() (bool, error) {
+		cVersion, err := h.Cfg().ConfigV1().ClusterVersions().Get(startCtx, ClusterVersionName, metav1.GetOptions{})
+		if err != nil {
+			log.Printf("error getting ClusterVersion '%s': %v", ClusterVersionName, err)
+			return false, nil
+		}
+		return managedUpgradeStarted(cVersion, version), nil
+	}, startCtx.Done()); err != nil {
+		return nil, fmt.Errorf("cluster did not start upgrade to %s in a timely manner: %v", version, err)
+	}
+
+	return &configv1.Update{Version: version}, nil
+}
+
+// managedUpgradeStarted returns true once the cluster is working towards version.
+func managedUpgradeStarted(cVersion *configv1.ClusterVersion, version string) bool {
+	return cVersion.Spec.DesiredUpdate != nil && cVersion.Spec.DesiredUpdate.Version == version
+}
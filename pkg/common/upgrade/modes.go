@@ -0,0 +1,397 @@
+package upgrade
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/openshift/osde2e/pkg/common/cluster"
+	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/openshift/osde2e/pkg/common/events"
+	"github.com/openshift/osde2e/pkg/common/helper"
+)
+
+// Mode is a way of upgrading a cluster.
+type Mode string
+
+const (
+	// ModeStandard upgrades the cluster to the selected release and waits for it to complete.
+	ModeStandard Mode = ""
+
+	// ModeRollback starts an upgrade to the selected release, then changes the desired update back to the
+	// original release part way through and waits for the cluster to return to it.
+	ModeRollback Mode = "rollback"
+
+	// ModeUnavailableImage requests an upgrade to an image that can't be pulled and checks the upgrade never
+	// starts and the cluster stays healthy.
+	ModeUnavailableImage Mode = "unavailable-image"
+
+	// ModePauseMachineConfigPools pauses machine config pools, upgrades the cluster and checks the pools were held
+	// back, then unpauses them and waits for them to roll out.
+	ModePauseMachineConfigPools Mode = "pause-machine-config-pools"
+
+	// HistoryFile is the name of the file in a phase's report directory recording the ClusterVersion history.
+	HistoryFile = "cluster-version-history.json"
+)
+
+var (
+	// RollbackAfter is the fraction of cluster operators that must report the new version before an upgrade is rolled back.
+	RollbackAfter = 0.25
+
+	// UnavailableImageDuration is how long the cluster is watched after requesting an unavailable image.
+	UnavailableImageDuration = 10 * time.Minute
+)
+
+// modeEvents are the events recorded when each mode passes or fails.
+var modeEvents = map[Mode][2]events.EventType{
+	ModeRollback:                {events.UpgradeRollbackSuccessful, events.UpgradeRollbackFailed},
+	ModeUnavailableImage:        {events.UpgradeUnavailableImageSuccessful, events.UpgradeUnavailableImageFailed},
+	ModePauseMachineConfigPools: {events.UpgradePausedMachineConfigPoolsSuccessful, events.UpgradePausedMachineConfigPoolsFailed},
+}
+
+// ParseMode returns the upgrade mode with the given name.
+func ParseMode(name string) (Mode, error) {
+	mode := Mode(name)
+	if _, ok := modeEvents[mode]; ok || mode == ModeStandard {
+		return mode, nil
+	}
+	return ModeStandard, fmt.Errorf("unknown upgrade mode '%s'", name)
+}
+
+// RunMode upgrades the cluster using the mode set in cfg, recording whether the mode passed as an event. The
+// ClusterVersion history is written to the current phase's report directory afterwards.
+func RunMode(ctx context.Context, cfg *config.RunConfig) (err error) {
+	mode, err := ParseMode(cfg.Upgrade.Mode)
+	if err != nil {
+		return err
+	}
+	if mode == ModeStandard {
+		return RunUpgrade(ctx, cfg)
+	}
+
+	h := helper.NewOutsideGinkgo(cfg)
+	if h == nil {
+		return fmt.Errorf("Unable to generate helper outside ginkgo")
+	}
+
+	log.Printf("Running %s upgrade", mode)
+	defer func() {
+		writeHistory(ctx, cfg, h)
+
+		if err != nil {
+			events.RecordEvent(modeEvents[mode][1])
+			err = fmt.Errorf("%s upgrade failed: %v", mode, err)
+		} else {
+			events.RecordEvent(modeEvents[mode][0])
+		}
+	}()
+
+	switch mode {
+	case ModeRollback:
+		return runRollback(ctx, cfg, h)
+	case ModeUnavailableImage:
+		return runUnavailableImage(ctx, cfg, h)
+	default:
+		return runPausedMachineConfigPools(ctx, cfg, h)
+	}
+}
+
+// runRollback starts an upgrade, then sets the desired update back to the original release once enough cluster
+// operators have upgraded, and waits for the cluster to finish returning to it.
+func runRollback(ctx context.Context, cfg *config.RunConfig, h *helper.H) error {
+	cVersion, err := h.Cfg().ConfigV1().ClusterVersions().Get(ctx, ClusterVersionName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("couldn't get current ClusterVersion '%s': %v", ClusterVersionName, err)
+	}
+	original := cVersion.Status.Desired
+
+	upgradeStarted := time.Now()
+	desired, err := StartUpgrade(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Waiting for %.0f%% of cluster operators to upgrade to %s before rolling back", RollbackAfter*100, desired.Version)
+	upgradeCtx, cancel := context.WithTimeout(ctx, MaxDuration)
+	defer cancel()
+	if err = wait.PollImmediateUntil(10*time.Second, func() (bool, error) {
+		if done, _, _ := IsUpgradeDone(upgradeCtx, h, desired); done {
+			return false, fmt.Errorf("upgrade to %s completed before it could be rolled back", desired.Version)
+		}
+
+		operators, err := h.Cfg().ConfigV1().ClusterOperators().List(upgradeCtx, metav1.ListOptions{})
+		if err != nil {
+			log.Printf("error listing cluster operators: %v", err)
+			return false, nil
+		}
+
+		upgraded, total := upgradedOperators(operators.Items, desired.Version)
+		log.Printf("%d of %d cluster operators upgraded", upgraded, total)
+		return total > 0 && float64(upgraded)/float64(total) >= RollbackAfter, nil
+	}, upgradeCtx.Done()); err != nil {
+		return fmt.Errorf("failed waiting to roll back: %v", err)
+	}
+
+	log.Printf("Rolling back to %s", original.Version)
+	rollback := &configv1.Update{Version: original.Version, Image: original.Image, Force: true}
+	if err = setDesiredUpdate(ctx, h, rollback); err != nil {
+		return err
+	}
+
+	return WaitForUpgrade(ctx, cfg, rollback, upgradeStarted)
+}
+
+// runUnavailableImage requests an upgrade to an image that can't be pulled, checks the cluster never starts
+// upgrading and stays healthy, then withdraws the request.
+func runUnavailableImage(ctx context.Context, cfg *config.RunConfig, h *helper.H) error {
+	image := cfg.Upgrade.UnavailableImage
+	version := strings.Replace(cfg.State.UpgradeReleaseName, "openshift-v", "", -1)
+
+	log.Printf("Requesting an upgrade to unavailable image '%s'", image)
+	if err := setDesiredUpdate(ctx, h, &configv1.Update{Version: version, Image: image, Force: true}); err != nil {
+		return err
+	}
+
+	var checkErr error
+	waitCtx, cancel := context.WithTimeout(ctx, UnavailableImageDuration)
+	defer cancel()
+	wait.PollImmediateUntil(30*time.Second, func() (bool, error) {
+		cVersion, err := h.Cfg().ConfigV1().ClusterVersions().Get(waitCtx, ClusterVersionName, metav1.GetOptions{})
+		if err != nil {
+			log.Printf("error getting ClusterVersion '%s': %v", ClusterVersionName, err)
+			return false, nil
+		}
+
+		operators, err := h.Cfg().ConfigV1().ClusterOperators().List(waitCtx, metav1.ListOptions{})
+		if err != nil {
+			log.Printf("error listing cluster operators: %v", err)
+			return false, nil
+		}
+
+		// Stop watching as soon as the cluster misbehaves.
+		checkErr = checkUnavailableImage(cVersion, operators.Items, image)
+		return checkErr != nil, nil
+	}, waitCtx.Done())
+
+	if ctx.Err() != nil {
+		return fmt.Errorf("stopped watching unavailable image upgrade: %v", ctx.Err())
+	}
+
+	// Clearing the desired update leaves the cluster at its current release.
+	log.Println("Withdrawing upgrade to unavailable image")
+	if err := setDesiredUpdate(ctx, h, nil); err != nil {
+		return err
+	}
+
+	if checkErr != nil {
+		return checkErr
+	}
+
+	if err := cluster.WaitForClusterReady(ctx, cfg, cfg.State.ClusterID, nil); err != nil {
+		return fmt.Errorf("failed waiting for cluster ready: %v", err)
+	}
+	return nil
+}
+
+// runPausedMachineConfigPools pauses machine config pools while the cluster upgrades, checks they weren't rolled
+// out, then unpauses them and waits for them to roll out. Pools are always unpaused before returning.
+func runPausedMachineConfigPools(ctx context.Context, cfg *config.RunConfig, h *helper.H) (err error) {
+	pools := []string{}
+	for _, pool := range strings.Split(cfg.Upgrade.PausedMachineConfigPools, ",") {
+		if pool = strings.TrimSpace(pool); pool != "" {
+			pools = append(pools, pool)
+		}
+	}
+	if len(pools) == 0 {
+		return fmt.Errorf("no machine config pools to pause")
+	}
+
+	configurations := map[string]string{}
+	for _, pool := range pools {
+		obj, err := h.Dynamic().Resource(machineConfigPools).Get(ctx, pool, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error getting machine config pool %s: %v", pool, err)
+		}
+		_, configurations[pool] = poolConfigurations(*obj)
+	}
+
+	defer func() {
+		// Unpausing gets its own context so that the pools aren't left paused when the run has been cancelled.
+		unpauseCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		for _, pool := range pools {
+			if unpauseErr := pausePool(unpauseCtx, h, pool, false); unpauseErr != nil && err == nil {
+				err = unpauseErr
+			}
+		}
+	}()
+
+	for _, pool := range pools {
+		log.Printf("Pausing machine config pool %s", pool)
+		if err = pausePool(ctx, h, pool, true); err != nil {
+			return err
+		}
+	}
+
+	if err = RunUpgrade(ctx, cfg); err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		obj, err := h.Dynamic().Resource(machineConfigPools).Get(ctx, pool, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error getting machine config pool %s: %v", pool, err)
+		}
+		if _, current := poolConfigurations(*obj); current != configurations[pool] {
+			return fmt.Errorf("paused machine config pool %s rolled out %s during the upgrade", pool, current)
+		}
+	}
+
+	for _, pool := range pools {
+		log.Printf("Unpausing machine config pool %s", pool)
+		if err = pausePool(ctx, h, pool, false); err != nil {
+			return err
+		}
+	}
+
+	rolloutCtx, cancel := context.WithTimeout(ctx, MaxDuration)
+	defer cancel()
+	return wait.PollImmediateUntil(30*time.Second, func() (bool, error) {
+		for _, pool := range pools {
+			obj, err := h.Dynamic().Resource(machineConfigPools).Get(rolloutCtx, pool, metav1.GetOptions{})
+			if err != nil {
+				log.Printf("error getting machine config pool %s: %v", pool, err)
+				return false, nil
+			}
+			if !poolRolledOut(*obj) {
+				status := parsePoolStatus(*obj)
+				log.Printf("Machine config pool %s rolling out: %d/%d machines updated", pool, status.updatedMachineCount, status.machineCount)
+				return false, nil
+			}
+		}
+		return true, nil
+	}, rolloutCtx.Done())
+}
+
+// setDesiredUpdate sets the update the cluster should work towards. A nil update leaves the cluster at its current release.
+func setDesiredUpdate(ctx context.Context, h *helper.H, update *configv1.Update) error {
+	cVersion, err := h.Cfg().ConfigV1().ClusterVersions().Get(ctx, ClusterVersionName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("couldn't get current ClusterVersion '%s': %v", ClusterVersionName, err)
+	}
+
+	cVersion.Spec.DesiredUpdate = update
+	if _, err = h.Cfg().ConfigV1().ClusterVersions().Update(ctx, cVersion, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("couldn't update desired ClusterVersion: %v", err)
+	}
+	return nil
+}
+
+func pausePool(ctx context.Context, h *helper.H, pool string, paused bool) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"paused":%t}}`, paused))
+	if _, err := h.Dynamic().Resource(machineConfigPools).Patch(ctx, pool, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("error setting paused=%t on machine config pool %s: %v", paused, pool, err)
+	}
+	return nil
+}
+
+// upgradedOperators returns how many cluster operators report the given version, out of how many there are.
+func upgradedOperators(operators []configv1.ClusterOperator, version string) (upgraded, total int) {
+	for _, operator := range operators {
+		total++
+		for _, v := range operator.Status.Versions {
+			if v.Name == "operator" && v.Version == version {
+				upgraded++
+			}
+		}
+	}
+	return upgraded, total
+}
+
+// checkUnavailableImage returns an error if the cluster has started upgrading to the image, or any cluster
+// operator is unavailable or degraded.
+func checkUnavailableImage(cVersion *configv1.ClusterVersion, operators []configv1.ClusterOperator, image string) error {
+	if cVersion.Status.Desired.Image == image {
+		return fmt.Errorf("cluster started upgrading to unavailable image '%s'", image)
+	}
+	for _, entry := range cVersion.Status.History {
+		if entry.Image == image {
+			return fmt.Errorf("unavailable image '%s' is in the ClusterVersion history as %s", image, entry.State)
+		}
+	}
+
+	var unhealthy []string
+	for _, operator := range operators {
+		for _, c := range operator.Status.Conditions {
+			if (c.Type == configv1.OperatorAvailable && c.Status != configv1.ConditionTrue) ||
+				(c.Type == configv1.OperatorDegraded && c.Status == configv1.ConditionTrue) {
+				unhealthy = append(unhealthy, fmt.Sprintf("%s %s=%s", operator.Name, c.Type, c.Status))
+			}
+		}
+	}
+	if len(unhealthy) > 0 {
+		return fmt.Errorf("cluster became unhealthy after requesting unavailable image: %s", strings.Join(unhealthy, ", "))
+	}
+	return nil
+}
+
+// poolConfigurations returns the rendered config a machine config pool should be running, and the one its
+// machines are running.
+func poolConfigurations(pool unstructured.Unstructured) (desired, current string) {
+	desired, _, _ = unstructured.NestedString(pool.Object, "spec", "configuration", "name")
+	current, _, _ = unstructured.NestedString(pool.Object, "status", "configuration", "name")
+	return desired, current
+}
+
+// poolRolledOut returns true when every machine in a pool runs the config the pool should be running.
+func poolRolledOut(pool unstructured.Unstructured) bool {
+	desired, current := poolConfigurations(pool)
+	status := parsePoolStatus(pool)
+	return desired != "" && desired == current && !status.updating && status.updatedMachineCount == status.machineCount
+}
+
+// writeHistory writes the ClusterVersion history to the current phase's report directory.
+func writeHistory(ctx context.Context, cfg *config.RunConfig, h *helper.H) {
+	if cfg.State.ReportDir == "" {
+		return
+	}
+
+	// The history is still wanted when the run has been cancelled.
+	if ctx.Err() != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+	}
+
+	cVersion, err := h.Cfg().ConfigV1().ClusterVersions().Get(ctx, ClusterVersionName, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("Unable to get ClusterVersion history: %v", err)
+		return
+	}
+
+	data, err := json.MarshalIndent(cVersion.Status.History, "", "  ")
+	if err != nil {
+		log.Printf("Unable to marshal ClusterVersion history: %v", err)
+		return
+	}
+
+	dir := filepath.Join(cfg.State.ReportDir, cfg.State.Phase)
+	if err = os.MkdirAll(dir, os.ModePerm); err == nil {
+		err = ioutil.WriteFile(filepath.Join(dir, HistoryFile), data, 0644)
+	}
+	if err != nil {
+		log.Printf("Unable to write ClusterVersion history: %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package upgrade
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseMode(t *testing.T) {
+	for _, name := range []string{"", "rollback", "unavailable-image", "pause-machine-config-pools"} {
+		if mode, err := ParseMode(name); err != nil || string(mode) != name {
+			t.Errorf("expected mode %q to parse: %v", name, err)
+		}
+	}
+
+	if _, err := ParseMode("sideways"); err == nil {
+		t.Errorf("expected an unknown mode to fail to parse")
+	}
+}
+
+func TestUpgradedOperators(t *testing.T) {
+	operators := []configv1.ClusterOperator{
+		testOperator("etcd", "4.7.2", false, false),
+		testOperator("dns", "4.7.1", true, false),
+		testOperator("network", "4.7.1", false, false),
+		testOperator("console", "4.7.1", false, false),
+	}
+
+	if upgraded, total := upgradedOperators(operators, "4.7.2"); upgraded != 1 || total != 4 {
+		t.Errorf("expected 1 of 4 operators upgraded, got %d of %d", upgraded, total)
+	}
+}
+
+func TestCheckUnavailableImage(t *testing.T) {
+	const image = "quay.io/openshift-release-dev/ocp-release@sha256:0000"
+
+	cVersion := &configv1.ClusterVersion{
+		Status: configv1.ClusterVersionStatus{
+			Desired: configv1.Update{Version: "4.7.1", Image: "quay.io/openshift-release-dev/ocp-release@sha256:1111"},
+			History: []configv1.UpdateHistory{{State: configv1.CompletedUpdate, Version: "4.7.1"}},
+		},
+	}
+	operators := []configv1.ClusterOperator{testOperator("etcd", "4.7.1", false, false)}
+
+	if err := checkUnavailableImage(cVersion, operators, image); err != nil {
+		t.Errorf("expected a healthy cluster to pass: %v", err)
+	}
+
+	degraded := []configv1.ClusterOperator{testOperator("etcd", "4.7.1", false, true)}
+	if err := checkUnavailableImage(cVersion, degraded, image); err == nil {
+		t.Errorf("expected a degraded operator to fail")
+	}
+
+	cVersion.Status.History = append([]configv1.UpdateHistory{{State: configv1.PartialUpdate, Image: image}}, cVersion.Status.History...)
+	if err := checkUnavailableImage(cVersion, operators, image); err == nil {
+		t.Errorf("expected the unavailable image in the history to fail")
+	}
+}
+
+func TestPoolRolledOut(t *testing.T) {
+	pool := func(desired, current string, updated int64) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "worker"},
+			"spec": map[string]interface{}{
+				"configuration": map[string]interface{}{"name": desired},
+			},
+			"status": map[string]interface{}{
+				"configuration":       map[string]interface{}{"name": current},
+				"machineCount":        int64(3),
+				"updatedMachineCount": updated,
+			},
+		}}
+	}
+
+	if desired, current := poolConfigurations(pool("rendered-worker-b", "rendered-worker-a", 0)); desired != "rendered-worker-b" || current != "rendered-worker-a" {
+		t.Errorf("unexpected configurations: desired %s, current %s", desired, current)
+	}
+
+	if poolRolledOut(pool("rendered-worker-b", "rendered-worker-a", 3)) {
+		t.Errorf("expected a pool running an old configuration not to be rolled out")
+	}
+	if poolRolledOut(pool("rendered-worker-b", "rendered-worker-b", 2)) {
+		t.Errorf("expected a pool with machines still updating not to be rolled out")
+	}
+	if !poolRolledOut(pool("rendered-worker-b", "rendered-worker-b", 3)) {
+		t.Errorf("expected a fully updated pool to be rolled out")
+	}
+}
@@ -0,0 +1,428 @@
+package upgrade
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/openshift/osde2e/pkg/common/helper"
+	"github.com/openshift/osde2e/pkg/common/metadata"
+)
+
+// TimelineFile is the name of the file in a phase's report directory recording the progress of its upgrade.
+const TimelineFile = "upgrade-timeline.json"
+
+// machineConfigPools is the resource of MachineConfigPools, which are read with the dynamic client.
+var machineConfigPools = schema.GroupVersionResource{Group: "machineconfiguration.openshift.io", Version: "v1", Resource: "machineconfigpools"}
+
+// Monitor records the progress of each ClusterOperator, MachineConfigPool and node while the cluster upgrades,
+// so it's clear which component took the time.
+type Monitor struct {
+	h       *helper.H
+	version string
+	started time.Time
+	now     func() time.Time
+
+	mutex     sync.Mutex
+	lastPoll  time.Time
+	operators map[string]*OperatorProgress
+	pools     map[string]*PoolProgress
+	nodes     map[string]*NodeProgress
+	events    []Event
+}
+
+// Timeline is the progress of an upgrade.
+type Timeline struct {
+	// Version is the version being upgraded to.
+	Version string    `json:"version"`
+	Started time.Time `json:"started"`
+	Ended   time.Time `json:"ended"`
+
+	Operators          []OperatorProgress `json:"operators"`
+	MachineConfigPools []PoolProgress     `json:"machineConfigPools"`
+	Nodes              []NodeProgress     `json:"nodes"`
+
+	// Events are every transition seen, in order.
+	Events []Event `json:"events"`
+}
+
+// OperatorProgress is the progress of a ClusterOperator through the upgrade.
+type OperatorProgress struct {
+	Name        string `json:"name"`
+	FromVersion string `json:"fromVersion,omitempty"`
+	ToVersion   string `json:"toVersion,omitempty"`
+
+	// Upgraded is when the operator first reported the version being upgraded to.
+	Upgraded *time.Time `json:"upgraded,omitempty"`
+
+	// UpgradeSeconds is how long after the upgrade started the operator was upgraded.
+	UpgradeSeconds float64 `json:"upgradeSeconds,omitempty"`
+
+	// ProgressingSeconds and DegradedSeconds are how long the operator reported those conditions for.
+	ProgressingSeconds float64 `json:"progressingSeconds"`
+	DegradedSeconds    float64 `json:"degradedSeconds"`
+
+	progressingSince *time.Time
+	degradedSince    *time.Time
+}
+
+// PoolProgress is the progress of a MachineConfigPool rolling out the upgrade to its machines.
+type PoolProgress struct {
+	Name                string `json:"name"`
+	MachineCount        int64  `json:"machineCount"`
+	UpdatedMachineCount int64  `json:"updatedMachineCount"`
+
+	// Started is when the pool was first seen updating.
+	Started *time.Time `json:"started,omitempty"`
+
+	// Updated is when every machine in the pool had been updated.
+	Updated *time.Time `json:"updated,omitempty"`
+
+	// UpdateSeconds is how long the pool took to update.
+	UpdateSeconds float64 `json:"updateSeconds,omitempty"`
+}
+
+// NodeProgress is the reboots of a node during the upgrade.
+type NodeProgress struct {
+	Name    string       `json:"name"`
+	Reboots []NodeReboot `json:"reboots"`
+
+	bootID       string
+	drainedSince *time.Time
+}
+
+// NodeReboot is a single reboot of a node.
+type NodeReboot struct {
+	// Drained is when the node was cordoned before rebooting, if that was seen.
+	Drained *time.Time `json:"drained,omitempty"`
+
+	// Rebooted is when the node was first seen with a new boot ID.
+	Rebooted time.Time `json:"rebooted"`
+
+	// Ready is when the node was ready and schedulable again.
+	Ready *time.Time `json:"ready,omitempty"`
+
+	// Seconds is how long the node was out of service for.
+	Seconds float64 `json:"seconds,omitempty"`
+}
+
+// Event is a transition of a component during the upgrade.
+type Event struct {
+	Time    time.Time `json:"time"`
+	Kind    string    `json:"kind"`
+	Name    string    `json:"name"`
+	Message string    `json:"message"`
+}
+
+// poolStatus is the part of a MachineConfigPool's status the monitor uses.
+type poolStatus struct {
+	name                string
+	machineCount        int64
+	updatedMachineCount int64
+	updating            bool
+}
+
+// NewMonitor creates a monitor for an upgrade to version that was started at started.
+func NewMonitor(h *helper.H, version string, started time.Time) *Monitor {
+	return &Monitor{
+		h:         h,
+		version:   version,
+		started:   started,
+		now:       time.Now,
+		operators: map[string]*OperatorProgress{},
+		pools:     map[string]*PoolProgress{},
+		nodes:     map[string]*NodeProgress{},
+	}
+}
+
+// Run polls the cluster every interval until ctx is done.
+func (m *Monitor) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Poll(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Error monitoring upgrade: %v", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Poll records the current state of the cluster's operators, pools and nodes. If the pools can't be listed, the
+// operators and nodes are still recorded before the error is returned.
+func (m *Monitor) Poll(ctx context.Context) error {
+	operators, err := m.h.Cfg().ConfigV1().ClusterOperators().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing cluster operators: %v", err)
+	}
+
+	// Clusters without the machine config operator have no pools, which shouldn't stop operators and nodes being
+	// recorded.
+	var pools []poolStatus
+	list, poolErr := m.h.Dynamic().Resource(machineConfigPools).List(ctx, metav1.ListOptions{})
+	if poolErr != nil {
+		poolErr = fmt.Errorf("error listing machine config pools: %v", poolErr)
+		log.Printf("Recording upgrade progress without machine config pools: %v", poolErr)
+	} else {
+		for _, item := range list.Items {
+			pools = append(pools, parsePoolStatus(item))
+		}
+	}
+
+	nodes, err := m.h.Kube().CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing nodes: %v", err)
+	}
+
+	m.record(m.now(), operators.Items, pools, nodes.Items)
+	return poolErr
+}
+
+// record updates the progress of every component from a single poll.
+func (m *Monitor) record(now time.Time, operators []configv1.ClusterOperator, pools []poolStatus, nodes []kubev1.Node) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.lastPoll = now
+	for _, operator := range operators {
+		m.recordOperator(now, operator)
+	}
+	for _, pool := range pools {
+		m.recordPool(now, pool)
+	}
+	for _, node := range nodes {
+		m.recordNode(now, node)
+	}
+}
+
+func (m *Monitor) recordOperator(now time.Time, operator configv1.ClusterOperator) {
+	progress, ok := m.operators[operator.Name]
+	if !ok {
+		progress = &OperatorProgress{Name: operator.Name}
+		m.operators[operator.Name] = progress
+	}
+
+	version := ""
+	for _, v := range operator.Status.Versions {
+		if v.Name == "operator" {
+			version = v.Version
+		}
+	}
+
+	if !ok {
+		progress.FromVersion = version
+	}
+	if version != progress.ToVersion {
+		if ok {
+			m.addEvent(now, "ClusterOperator", operator.Name, fmt.Sprintf("version %s -> %s", progress.ToVersion, version))
+		}
+		progress.ToVersion = version
+	}
+	if version == m.version && progress.Upgraded == nil {
+		upgraded := now
+		progress.Upgraded = &upgraded
+		progress.UpgradeSeconds = now.Sub(m.started).Seconds()
+	}
+
+	for _, condition := range operator.Status.Conditions {
+		switch condition.Type {
+		case configv1.OperatorProgressing:
+			m.recordCondition(now, operator.Name, condition, &progress.progressingSince, &progress.ProgressingSeconds)
+		case configv1.OperatorDegraded:
+			m.recordCondition(now, operator.Name, condition, &progress.degradedSince, &progress.DegradedSeconds)
+		}
+	}
+}
+
+// recordCondition tracks how long a condition has been true for, recording an event whenever it flips.
+func (m *Monitor) recordCondition(now time.Time, name string, condition configv1.ClusterOperatorStatusCondition, since **time.Time, seconds *float64) {
+	active := condition.Status == configv1.ConditionTrue
+	switch {
+	case active && *since == nil:
+		start := now
+		*since = &start
+		m.addEvent(now, "ClusterOperator", name, fmt.Sprintf("%s=True: %s", condition.Type, condition.Message))
+	case !active && *since != nil:
+		*seconds += now.Sub(**since).Seconds()
+		*since = nil
+		m.addEvent(now, "ClusterOperator", name, fmt.Sprintf("%s=%s", condition.Type, condition.Status))
+	}
+}
+
+func (m *Monitor) recordPool(now time.Time, pool poolStatus) {
+	progress, ok := m.pools[pool.name]
+	if !ok {
+		progress = &PoolProgress{Name: pool.name}
+		m.pools[pool.name] = progress
+	}
+
+	if pool.updatedMachineCount != progress.UpdatedMachineCount || pool.machineCount != progress.MachineCount {
+		m.addEvent(now, "MachineConfigPool", pool.name, fmt.Sprintf("%d/%d machines updated", pool.updatedMachineCount, pool.machineCount))
+	}
+	progress.MachineCount = pool.machineCount
+	progress.UpdatedMachineCount = pool.updatedMachineCount
+
+	rollingOut := pool.updating || pool.updatedMachineCount < pool.machineCount
+	switch {
+	case progress.Started == nil && rollingOut:
+		started := now
+		progress.Started = &started
+	case progress.Started != nil && progress.Updated == nil && !rollingOut:
+		updated := now
+		progress.Updated = &updated
+		progress.UpdateSeconds = now.Sub(*progress.Started).Seconds()
+		m.addEvent(now, "MachineConfigPool", pool.name, "updated")
+	}
+}
+
+func (m *Monitor) recordNode(now time.Time, node kubev1.Node) {
+	progress, ok := m.nodes[node.Name]
+	if !ok {
+		progress = &NodeProgress{Name: node.Name, Reboots: []NodeReboot{}, bootID: node.Status.NodeInfo.BootID}
+		m.nodes[node.Name] = progress
+	}
+
+	if node.Spec.Unschedulable && progress.drainedSince == nil {
+		drained := now
+		progress.drainedSince = &drained
+		m.addEvent(now, "Node", node.Name, "cordoned")
+	}
+
+	if bootID := node.Status.NodeInfo.BootID; bootID != progress.bootID {
+		progress.bootID = bootID
+		progress.Reboots = append(progress.Reboots, NodeReboot{Drained: progress.drainedSince, Rebooted: now})
+		m.addEvent(now, "Node", node.Name, "rebooted")
+	}
+
+	ready := !node.Spec.Unschedulable
+	if ready {
+		ready = false
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == kubev1.NodeReady {
+				ready = condition.Status == kubev1.ConditionTrue
+			}
+		}
+	}
+
+	if ready {
+		progress.drainedSince = nil
+		if n := len(progress.Reboots); n > 0 && progress.Reboots[n-1].Ready == nil {
+			reboot := &progress.Reboots[n-1]
+			readyAt := now
+			reboot.Ready = &readyAt
+			outOfService := reboot.Rebooted
+			if reboot.Drained != nil {
+				outOfService = *reboot.Drained
+			}
+			reboot.Seconds = now.Sub(outOfService).Seconds()
+			m.addEvent(now, "Node", node.Name, "ready")
+		}
+	}
+}
+
+func (m *Monitor) addEvent(now time.Time, kind, name, message string) {
+	m.events = append(m.events, Event{Time: now, Kind: kind, Name: name, Message: message})
+}
+
+// Timeline returns the progress of the upgrade so far. Conditions still true are counted up to the last poll.
+func (m *Monitor) Timeline() Timeline {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	timeline := Timeline{
+		Version:            m.version,
+		Started:            m.started,
+		Ended:              m.lastPoll,
+		Operators:          []OperatorProgress{},
+		MachineConfigPools: []PoolProgress{},
+		Nodes:              []NodeProgress{},
+		Events:             append([]Event{}, m.events...),
+	}
+
+	for _, progress := range m.operators {
+		operator := *progress
+		if operator.progressingSince != nil {
+			operator.ProgressingSeconds += m.lastPoll.Sub(*operator.progressingSince).Seconds()
+		}
+		if operator.degradedSince != nil {
+			operator.DegradedSeconds += m.lastPoll.Sub(*operator.degradedSince).Seconds()
+		}
+		timeline.Operators = append(timeline.Operators, operator)
+	}
+	sort.Slice(timeline.Operators, func(i, j int) bool { return timeline.Operators[i].Name < timeline.Operators[j].Name })
+
+	for _, progress := range m.pools {
+		timeline.MachineConfigPools = append(timeline.MachineConfigPools, *progress)
+	}
+	sort.Slice(timeline.MachineConfigPools, func(i, j int) bool {
+		return timeline.MachineConfigPools[i].Name < timeline.MachineConfigPools[j].Name
+	})
+
+	for _, progress := range m.nodes {
+		node := *progress
+		node.Reboots = append([]NodeReboot{}, progress.Reboots...)
+		timeline.Nodes = append(timeline.Nodes, node)
+	}
+	sort.Slice(timeline.Nodes, func(i, j int) bool { return timeline.Nodes[i].Name < timeline.Nodes[j].Name })
+
+	return timeline
+}
+
+// WriteTimeline writes the timeline as a JSON file.
+func (m *Monitor) WriteTimeline(filename string) error {
+	data, err := json.MarshalIndent(m.Timeline(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling upgrade timeline: %v", err)
+	}
+
+	if err = ioutil.WriteFile(filename, data, 0644); err != nil {
+		return fmt.Errorf("error writing upgrade timeline: %v", err)
+	}
+	return nil
+}
+
+// StoreMetadata records how long each operator and pool took to upgrade in the metadata.
+func (m *Monitor) StoreMetadata() {
+	timeline := m.Timeline()
+	for _, operator := range timeline.Operators {
+		if operator.Upgraded != nil {
+			metadata.Instance.SetUpgradeOperatorTime(operator.Name, operator.UpgradeSeconds)
+		}
+	}
+	for _, pool := range timeline.MachineConfigPools {
+		if pool.Updated != nil {
+			metadata.Instance.SetUpgradeMachineConfigPoolTime(pool.Name, pool.UpdateSeconds)
+		}
+	}
+}
+
+func parsePoolStatus(pool unstructured.Unstructured) poolStatus {
+	status := poolStatus{name: pool.GetName()}
+	status.machineCount, _, _ = unstructured.NestedInt64(pool.Object, "status", "machineCount")
+	status.updatedMachineCount, _, _ = unstructured.NestedInt64(pool.Object, "status", "updatedMachineCount")
+
+	conditions, _, _ := unstructured.NestedSlice(pool.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if ok && condition["type"] == "Updating" && condition["status"] == "True" {
+			status.updating = true
+		}
+	}
+	return status
+}
@@ -0,0 +1,152 @@
+package upgrade
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func testOperator(name, version string, progressing, degraded bool) configv1.ClusterOperator {
+	status := func(active bool) configv1.ConditionStatus {
+		if active {
+			return configv1.ConditionTrue
+		}
+		return configv1.ConditionFalse
+	}
+
+	return configv1.ClusterOperator{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: configv1.ClusterOperatorStatus{
+			Versions: []configv1.OperandVersion{{Name: "operator", Version: version}},
+			Conditions: []configv1.ClusterOperatorStatusCondition{
+				{Type: configv1.OperatorProgressing, Status: status(progressing)},
+				{Type: configv1.OperatorDegraded, Status: status(degraded)},
+			},
+		},
+	}
+}
+
+func testNode(name, bootID string, unschedulable, ready bool) kubev1.Node {
+	status := kubev1.ConditionFalse
+	if ready {
+		status = kubev1.ConditionTrue
+	}
+
+	return kubev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       kubev1.NodeSpec{Unschedulable: unschedulable},
+		Status: kubev1.NodeStatus{
+			NodeInfo:   kubev1.NodeSystemInfo{BootID: bootID},
+			Conditions: []kubev1.NodeCondition{{Type: kubev1.NodeReady, Status: status}},
+		},
+	}
+}
+
+func TestMonitorTimeline(t *testing.T) {
+	started := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return started.Add(time.Duration(minutes) * time.Minute) }
+	m := NewMonitor(nil, "4.7.2", started)
+
+	m.record(at(0),
+		[]configv1.ClusterOperator{testOperator("etcd", "4.6.8", true, false), testOperator("dns", "4.6.8", false, false)},
+		[]poolStatus{{name: "worker", machineCount: 2, updatedMachineCount: 2}},
+		[]kubev1.Node{testNode("worker-a", "boot-1", false, true)})
+
+	m.record(at(5),
+		[]configv1.ClusterOperator{testOperator("etcd", "4.7.2", false, true), testOperator("dns", "4.6.8", true, false)},
+		[]poolStatus{{name: "worker", machineCount: 2, updatedMachineCount: 0, updating: true}},
+		[]kubev1.Node{testNode("worker-a", "boot-1", true, true)})
+
+	m.record(at(8),
+		[]configv1.ClusterOperator{testOperator("etcd", "4.7.2", false, false), testOperator("dns", "4.6.8", true, false)},
+		[]poolStatus{{name: "worker", machineCount: 2, updatedMachineCount: 1, updating: true}},
+		[]kubev1.Node{testNode("worker-a", "boot-2", true, false)})
+
+	m.record(at(12),
+		[]configv1.ClusterOperator{testOperator("etcd", "4.7.2", false, false), testOperator("dns", "4.7.2", true, false)},
+		[]poolStatus{{name: "worker", machineCount: 2, updatedMachineCount: 2}},
+		[]kubev1.Node{testNode("worker-a", "boot-2", false, true)})
+
+	timeline := m.Timeline()
+	if !timeline.Ended.Equal(at(12)) || len(timeline.Operators) != 2 {
+		t.Fatalf("unexpected timeline: %+v", timeline)
+	}
+
+	dns, etcd := timeline.Operators[0], timeline.Operators[1]
+	if etcd.FromVersion != "4.6.8" || etcd.ToVersion != "4.7.2" || etcd.UpgradeSeconds != 300 {
+		t.Errorf("expected etcd to be upgraded after 5 minutes: %+v", etcd)
+	}
+	if etcd.ProgressingSeconds != 300 || etcd.DegradedSeconds != 180 {
+		t.Errorf("expected etcd to be progressing for 5 minutes and degraded for 3: %+v", etcd)
+	}
+
+	// dns is still progressing, which is counted up to the last poll.
+	if dns.UpgradeSeconds != 720 || dns.ProgressingSeconds != 420 {
+		t.Errorf("expected dns to be upgraded after 12 minutes, having progressed for 7: %+v", dns)
+	}
+
+	pool := timeline.MachineConfigPools[0]
+	if pool.Started == nil || !pool.Started.Equal(at(5)) || pool.UpdateSeconds != 420 {
+		t.Errorf("expected the worker pool to take 7 minutes to update: %+v", pool)
+	}
+
+	node := timeline.Nodes[0]
+	if len(node.Reboots) != 1 {
+		t.Fatalf("expected a single reboot: %+v", node)
+	}
+	if reboot := node.Reboots[0]; !reboot.Drained.Equal(at(5)) || !reboot.Rebooted.Equal(at(8)) || reboot.Seconds != 420 {
+		t.Errorf("expected worker-a to be out of service for 7 minutes: %+v", reboot)
+	}
+
+	if len(timeline.Events) == 0 || timeline.Events[0].Kind != "ClusterOperator" {
+		t.Errorf("expected events to be recorded in order: %+v", timeline.Events)
+	}
+
+	dir, err := ioutil.TempDir("", "upgrade-timeline")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, TimelineFile)
+	if err = m.WriteTimeline(filename); err != nil {
+		t.Fatalf("error writing timeline: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("error reading timeline: %v", err)
+	}
+
+	var written Timeline
+	if err = json.Unmarshal(data, &written); err != nil || len(written.Nodes) != 1 || written.Version != "4.7.2" {
+		t.Errorf("unexpected timeline written: %s: %v", data, err)
+	}
+}
+
+func TestParsePoolStatus(t *testing.T) {
+	pool := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "master"},
+		"status": map[string]interface{}{
+			"machineCount":        int64(3),
+			"updatedMachineCount": int64(1),
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Updated", "status": "False"},
+				map[string]interface{}{"type": "Updating", "status": "True"},
+			},
+		},
+	}}
+
+	status := parsePoolStatus(pool)
+	if status.name != "master" || status.machineCount != 3 || status.updatedMachineCount != 1 || !status.updating {
+		t.Errorf("unexpected pool status: %+v", status)
+	}
+}
@@ -0,0 +1,59 @@
+package upgrade
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/openshift/osde2e/pkg/common/util"
+)
+
+// ParsePath parses a comma separated list of releases to upgrade through, such as "4.6.8,4.7.2". A release
+// can be followed by "=" and the image to upgrade to, otherwise OpenShift will use Cincinnati to find it.
+func ParsePath(path string) ([]config.UpgradeTarget, error) {
+	targets := []config.UpgradeTarget{}
+	for _, hop := range strings.Split(path, ",") {
+		hop = strings.TrimSpace(hop)
+		if hop == "" {
+			continue
+		}
+
+		target := config.UpgradeTarget{}
+		if i := strings.Index(hop, "="); i >= 0 {
+			hop, target.Image = strings.TrimSpace(hop[:i]), strings.TrimSpace(hop[i+1:])
+			if target.Image == "" {
+				return nil, fmt.Errorf("upgrade path release %s has an empty image", hop)
+			}
+		}
+
+		version, err := util.OpenshiftVersionToSemver(hop)
+		if err != nil {
+			return nil, fmt.Errorf("upgrade path release %s is invalid: %v", hop, err)
+		}
+		target.ReleaseName = util.SemverToOpenshiftVersion(version)
+
+		targets = append(targets, target)
+	}
+
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("upgrade path %q has no releases", path)
+	}
+	return targets, nil
+}
+
+// Path returns the releases the cluster will be upgraded through, which is a single release unless an upgrade
+// path was chosen. It is empty if no upgrade was chosen.
+func Path(cfg *config.RunConfig) []config.UpgradeTarget {
+	if len(cfg.State.UpgradePath) > 0 {
+		return cfg.State.UpgradePath
+	}
+
+	if cfg.State.UpgradeReleaseName == "" && cfg.State.UpgradeImage == "" {
+		return nil
+	}
+
+	return []config.UpgradeTarget{{
+		ReleaseName: cfg.State.UpgradeReleaseName,
+		Image:       cfg.State.UpgradeImage,
+	}}
+}
@@ -0,0 +1,66 @@
+package upgrade
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+)
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected []config.UpgradeTarget
+		err      bool
+	}{
+		{
+			name: "releases",
+			path: "4.5.16, openshift-v4.6.8,4.7.2",
+			expected: []config.UpgradeTarget{
+				{ReleaseName: "openshift-v4.5.16"},
+				{ReleaseName: "openshift-v4.6.8"},
+				{ReleaseName: "openshift-v4.7.2"},
+			},
+		},
+		{
+			name: "release with image",
+			path: "4.6.8,4.7.0-0.nightly-2020-12-01-000000=quay.io/openshift-release-dev/ocp-release@sha256:abc",
+			expected: []config.UpgradeTarget{
+				{ReleaseName: "openshift-v4.6.8"},
+				{ReleaseName: "openshift-v4.7.0-0.nightly-2020-12-01-000000", Image: "quay.io/openshift-release-dev/ocp-release@sha256:abc"},
+			},
+		},
+		{
+			name: "invalid release",
+			path: "4.6.8,latest",
+			err:  true,
+		},
+		{
+			name: "empty image",
+			path: "4.6.8=",
+			err:  true,
+		},
+		{
+			name: "empty",
+			path: " , ",
+			err:  true,
+		},
+	}
+
+	for _, test := range tests {
+		targets, err := ParsePath(test.path)
+		if test.err {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %v", test.name, targets)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		} else if !reflect.DeepEqual(targets, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, targets)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Masterminds/semver"
+
+	"github.com/openshift/osde2e/pkg/common/cincinnati"
+	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/openshift/osde2e/pkg/common/releaseimage"
+	"github.com/openshift/osde2e/pkg/common/util"
+)
+
+// PreflightStatus is the outcome of a pre-flight check.
+type PreflightStatus string
+
+const (
+	// PreflightPassed checks found nothing wrong.
+	PreflightPassed PreflightStatus = "passed"
+
+	// PreflightWarning checks found something that doesn't stop the upgrade.
+	PreflightWarning PreflightStatus = "warning"
+
+	// PreflightFailed checks found a problem that would stop the upgrade.
+	PreflightFailed PreflightStatus = "failed"
+
+	// PreflightSkipped checks couldn't be made.
+	PreflightSkipped PreflightStatus = "skipped"
+)
+
+// PreflightCheck is the result of checking one property of a release image.
+type PreflightCheck struct {
+	Name    string
+	Status  PreflightStatus
+	Message string
+}
+
+// Preflight checks the release image of target can be upgraded to from the release named from, before the cluster
+// is touched. It returns an error describing every failed check.
+func Preflight(ctx context.Context, cfg *config.RunConfig, from string, target config.UpgradeTarget) error {
+	log.Printf("Running pre-flight checks on release image '%s'", target.Image)
+
+	client, err := releaseimage.NewClient(cfg.Cincinnati.Arch, cfg.Upgrade.RegistryAuthFile)
+	if err != nil {
+		return fmt.Errorf("pre-flight checks couldn't start: %v", err)
+	}
+
+	release, err := client.Inspect(ctx, target.Image)
+	if err != nil {
+		check := imageCheck(err, cfg.Upgrade.PreflightRequireImage)
+		log.Printf("Pre-flight check %s %s: %s", check.Name, check.Status, check.Message)
+		if check.Status == PreflightFailed {
+			return fmt.Errorf("pre-flight checks of release image '%s' failed: %s: %s", target.Image, check.Name, check.Message)
+		}
+		return nil
+	}
+	log.Printf("Release image '%s' is %s (%s) with %d components", target.Image, release.Version, release.Digest, len(release.Components))
+
+	checks := preflightChecks(release, from, target.ReleaseName, cincinnati.NewClient(cfg), cfg.Upgrade.PreflightRequireEdge)
+	checks = append(checks, signatureCheck(ctx, client, release, cfg.Upgrade.SignatureStoreURL, cfg.Upgrade.PreflightRequireSignature))
+
+	failures := []string{}
+	for _, check := range checks {
+		log.Printf("Pre-flight check %s %s: %s", check.Name, check.Status, check.Message)
+		if check.Status == PreflightFailed {
+			failures = append(failures, fmt.Sprintf("%s: %s", check.Name, check.Message))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("pre-flight checks of release image '%s' failed: %s", target.Image, strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// preflightChecks checks a release is the version expected, has components, and can be upgraded to from the
+// release named from according to the release itself or Cincinnati. A missing upgrade edge is only a failure if
+// edges are required.
+func preflightChecks(release *releaseimage.Release, from, to string, graphs *cincinnati.Client, requireEdge bool) []PreflightCheck {
+	checks := []PreflightCheck{}
+
+	expected := strings.Replace(to, "openshift-v", "", -1)
+	version := PreflightCheck{Name: "version", Status: PreflightPassed, Message: release.Version}
+	if release.Version == "" {
+		version.Status, version.Message = PreflightFailed, "release image has no version"
+	} else if expected != "" && release.Version != expected {
+		version.Status, version.Message = PreflightFailed, fmt.Sprintf("release image is %s, not %s", release.Version, expected)
+	}
+	checks = append(checks, version)
+
+	components := PreflightCheck{Name: "components", Status: PreflightPassed, Message: fmt.Sprintf("%d component images", len(release.Components))}
+	if len(release.Components) == 0 {
+		components.Status, components.Message = PreflightFailed, "release image has no component images"
+	}
+	checks = append(checks, components)
+
+	fromVersion, fromErr := util.OpenshiftVersionToSemver(from)
+	toVersion, toErr := semver.NewVersion(release.Version)
+	if fromErr != nil || toErr != nil {
+		return append(checks,
+			PreflightCheck{Name: "channels", Status: PreflightSkipped, Message: "release version isn't a semantic version"},
+			PreflightCheck{Name: "edge", Status: PreflightSkipped, Message: fmt.Sprintf("can't compare %s to %s", from, release.Version)})
+	}
+
+	channels, err := graphs.Channels(toVersion)
+	channel := PreflightCheck{Name: "channels", Status: PreflightPassed, Message: strings.Join(channels, ", ")}
+	if err != nil {
+		channel.Status, channel.Message = PreflightSkipped, err.Error()
+	} else if len(channels) == 0 {
+		channel.Status, channel.Message = PreflightSkipped, fmt.Sprintf("%s isn't in any channel", toVersion)
+	}
+	checks = append(checks, channel)
+
+	return append(checks, edgeCheck(release, fromVersion, toVersion, channels, graphs, requireEdge))
+}
+
+// edgeCheck checks there's an upgrade from one version to another. The versions a release lists as previous are
+// used if it has them, otherwise the graphs of the channels the release is in. A missing edge is only a failure if
+// required, since osde2e forces upgrades to images and nightlies rarely list the installed version.
+func edgeCheck(release *releaseimage.Release, from, to *semver.Version, channels []string, graphs *cincinnati.Client, required bool) PreflightCheck {
+	check := PreflightCheck{Name: "edge", Status: PreflightPassed}
+
+	problem := PreflightWarning
+	if required {
+		problem = PreflightFailed
+	}
+
+	if len(release.Previous) > 0 {
+		for _, previous := range release.Previous {
+			if v, err := semver.NewVersion(previous); err == nil && v.Equal(from) {
+				check.Message = fmt.Sprintf("release image lists %s as a previous version", from)
+				return check
+			}
+		}
+		check.Status, check.Message = problem, fmt.Sprintf("release image doesn't list %s as a previous version", from)
+		return check
+	}
+
+	if len(channels) == 0 {
+		check.Status, check.Message = PreflightSkipped, "release image lists no previous versions and isn't in any channel"
+		return check
+	}
+
+	for _, channel := range channels {
+		if g, err := graphs.Graph(channel); err == nil && g.HasEdge(from, to) {
+			check.Message = fmt.Sprintf("%s has an edge from %s to %s", channel, from, to)
+			return check
+		}
+	}
+	check.Status, check.Message = problem, fmt.Sprintf("no channel has an edge from %s to %s", from, to)
+	return check
+}
+
+// imageCheck reports a release image that couldn't be read. Every other check needs the image, so they're all
+// skipped. It's only a failure if the image is required, since registry credentials or network access are often
+// missing where osde2e runs.
+func imageCheck(err error, required bool) PreflightCheck {
+	check := PreflightCheck{Name: "image", Status: PreflightSkipped}
+	if required {
+		check.Status = PreflightFailed
+	}
+	check.Message = fmt.Sprintf("release image can't be read: %v", err)
+	return check
+}
+
+// signatureCheck checks a release image is signed. Unsigned images are only a failure if signatures are required,
+// since osde2e forces upgrades to images.
+func signatureCheck(ctx context.Context, client *releaseimage.Client, release *releaseimage.Release, storeURL string, required bool) PreflightCheck {
+	check := PreflightCheck{Name: "signature", Status: PreflightPassed}
+
+	problem := PreflightWarning
+	if required {
+		problem = PreflightFailed
+	}
+
+	if release.Image.Digest == "" {
+		check.Status, check.Message = problem, "release image is referenced by tag, so the cluster can't verify its signature"
+		return check
+	}
+
+	signed, err := client.HasSignature(ctx, storeURL, release.Digest)
+	switch {
+	case err != nil:
+		check.Status, check.Message = problem, err.Error()
+	case !signed:
+		check.Status, check.Message = problem, fmt.Sprintf("no signature for %s in %s", release.Digest, storeURL)
+	default:
+		check.Message = fmt.Sprintf("signed in %s", storeURL)
+	}
+	return check
+}
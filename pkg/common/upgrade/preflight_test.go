@@ -0,0 +1,135 @@
+package upgrade
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openshift/osde2e/pkg/common/cincinnati"
+	"github.com/openshift/osde2e/pkg/common/releaseimage"
+)
+
+const preflightGraph = `{
+  "nodes": [
+    {"version": "4.6.8", "payload": "quay.io/openshift-release-dev/ocp-release@sha256:468"},
+    {"version": "4.7.1", "payload": "quay.io/openshift-release-dev/ocp-release@sha256:471"},
+    {"version": "4.7.2", "payload": "quay.io/openshift-release-dev/ocp-release@sha256:472"}
+  ],
+  "edges": [[0, 2], [1, 2]]
+}`
+
+func TestPreflightChecks(t *testing.T) {
+	fixtures, err := ioutil.TempDir("", "preflight-fixtures")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(fixtures)
+
+	if err = ioutil.WriteFile(filepath.Join(fixtures, "stable-4.7.json"), []byte(preflightGraph), 0644); err != nil {
+		t.Fatalf("error writing fixture: %v", err)
+	}
+	graphs := &cincinnati.Client{URL: "http://127.0.0.1:0/graph", Arch: "amd64", Fixtures: fixtures}
+
+	release := func(version string, previous ...string) *releaseimage.Release {
+		return &releaseimage.Release{Version: version, Previous: previous, Components: map[string]string{"cli": "quay.io/ocp/cli@sha256:111"}}
+	}
+
+	tests := []struct {
+		name        string
+		release     *releaseimage.Release
+		from, to    string
+		requireEdge bool
+		expected    map[string]PreflightStatus
+	}{
+		{
+			name:     "edge in the graph",
+			release:  release("4.7.2"),
+			from:     "openshift-v4.6.8",
+			to:       "openshift-v4.7.2",
+			expected: map[string]PreflightStatus{"version": PreflightPassed, "components": PreflightPassed, "channels": PreflightPassed, "edge": PreflightPassed},
+		},
+		{
+			name:     "no edge in the graph",
+			release:  release("4.7.2"),
+			from:     "openshift-v4.6.9",
+			to:       "openshift-v4.7.2",
+			expected: map[string]PreflightStatus{"edge": PreflightWarning},
+		},
+		{
+			name:        "no edge in the graph when required",
+			release:     release("4.7.2"),
+			from:        "openshift-v4.6.9",
+			to:          "openshift-v4.7.2",
+			requireEdge: true,
+			expected:    map[string]PreflightStatus{"edge": PreflightFailed},
+		},
+		{
+			name:     "install version not listed as previous",
+			release:  release("4.7.2", "4.6.8"),
+			from:     "openshift-v4.6.9",
+			to:       "openshift-v4.7.2",
+			expected: map[string]PreflightStatus{"edge": PreflightWarning},
+		},
+		{
+			name:     "previous versions from the release",
+			release:  release("4.7.2", "4.6.9"),
+			from:     "openshift-v4.6.9",
+			to:       "openshift-v4.7.2",
+			expected: map[string]PreflightStatus{"edge": PreflightPassed},
+		},
+		{
+			name:     "wrong version",
+			release:  release("4.7.1"),
+			from:     "openshift-v4.6.8",
+			to:       "openshift-v4.7.2",
+			expected: map[string]PreflightStatus{"version": PreflightFailed},
+		},
+		{
+			name:     "nightly outside of any channel",
+			release:  release("4.8.0-0.nightly-2021-03-01-000000"),
+			from:     "openshift-v4.7.2",
+			to:       "",
+			expected: map[string]PreflightStatus{"version": PreflightPassed, "channels": PreflightSkipped, "edge": PreflightSkipped},
+		},
+		{
+			name:     "no components",
+			release:  &releaseimage.Release{Version: "4.7.2", Components: map[string]string{}},
+			from:     "openshift-v4.6.8",
+			to:       "openshift-v4.7.2",
+			expected: map[string]PreflightStatus{"components": PreflightFailed},
+		},
+	}
+
+	for _, test := range tests {
+		statuses := map[string]PreflightStatus{}
+		for _, check := range preflightChecks(test.release, test.from, test.to, graphs, test.requireEdge) {
+			statuses[check.Name] = check.Status
+		}
+
+		for name, expected := range test.expected {
+			if statuses[name] != expected {
+				t.Errorf("%s: expected check %s to be %s, got %s", test.name, name, expected, statuses[name])
+			}
+		}
+	}
+}
+
+func TestImageCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		required bool
+		want     PreflightStatus
+	}{
+		{name: "optional", required: false, want: PreflightSkipped},
+		{name: "required", required: true, want: PreflightFailed},
+	}
+
+	for _, test := range tests {
+		check := imageCheck(errors.New("unauthorized"), test.required)
+		if check.Status != test.want {
+			t.Errorf("%s: expected status %s, got %s (%s)", test.name, test.want, check.Status, check.Message)
+		}
+	}
+}
@@ -5,11 +5,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/Masterminds/semver"
-	"github.com/spf13/viper"
 
 	configv1 "github.com/openshift/api/config/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,42 +42,92 @@ var (
 )
 
 // RunUpgrade uses the OpenShift extended suite to upgrade a cluster to the image provided in cfg.
-func RunUpgrade() error {
-	var done bool
-	var msg string
-	var err error
-	var upgradeStarted time.Time
+// The upgrade is abandoned if ctx is done before it completes.
+func RunUpgrade(ctx context.Context, cfg *config.RunConfig) error {
+	upgradeStarted := time.Now()
+
+	desired, err := StartUpgrade(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	return WaitForUpgrade(ctx, cfg, desired, upgradeStarted)
+}
 
+// StartUpgrade requests an upgrade of the cluster to the image or release provided in cfg and returns the
+// update the cluster is working towards.
+func StartUpgrade(ctx context.Context, cfg *config.RunConfig) (*configv1.Update, error) {
 	// setup helper
-	h := helper.NewOutsideGinkgo()
+	h := helper.NewOutsideGinkgo(cfg)
 	if h == nil {
-		return fmt.Errorf("Unable to generate helper outside ginkgo")
+		return nil, fmt.Errorf("Unable to generate helper outside ginkgo")
 	}
 
-	image := viper.GetString(config.Upgrade.Image)
+	image := cfg.State.UpgradeImage
 	if image != "" {
 		log.Printf("Upgrading cluster to UPGRADE_IMAGE '%s'", image)
 	} else {
-		log.Printf("Upgrading cluster to cluster image set with version %s", viper.GetString(config.Upgrade.ReleaseName))
+		log.Printf("Upgrading cluster to cluster image set with version %s", cfg.State.UpgradeReleaseName)
 	}
 
-	upgradeStarted = time.Now()
+	if cfg.Upgrade.Managed {
+		desired, err := TriggerManagedUpgrade(ctx, h)
+		if err != nil {
+			return nil, fmt.Errorf("failed triggering managed upgrade: %v", err)
+		}
+		log.Println("Cluster started managed upgrade.")
+		return desired, nil
+	}
 
-	desired, err := TriggerUpgrade(h)
+	desired, err := TriggerUpgrade(ctx, h)
 	if err != nil {
-		return fmt.Errorf("failed triggering upgrade: %v", err)
+		return nil, fmt.Errorf("failed triggering upgrade: %v", err)
 	}
 	log.Println("Cluster acknowledged update request.")
 
+	return desired.Spec.DesiredUpdate, nil
+}
+
+// WaitForUpgrade waits for the cluster to finish an upgrade to desired that was started at upgradeStarted.
+// Waiting is abandoned if ctx is done before the upgrade completes.
+func WaitForUpgrade(ctx context.Context, cfg *config.RunConfig, desired *configv1.Update, upgradeStarted time.Time) error {
+	var done bool
+	var msg string
+	var err error
+
+	// setup helper
+	h := helper.NewOutsideGinkgo(cfg)
+	if h == nil {
+		return fmt.Errorf("Unable to generate helper outside ginkgo")
+	}
+
+	// record the progress of each component until the cluster is ready again
+	monitor := NewMonitor(h, desired.Version, upgradeStarted)
+	monitorCtx, stopMonitor := context.WithCancel(ctx)
+	monitorDone := make(chan struct{})
+	go func() {
+		monitor.Run(monitorCtx, 10*time.Second)
+		close(monitorDone)
+	}()
+	defer func() {
+		stopMonitor()
+		<-monitorDone
+		writeUpgradeTimeline(cfg, monitor)
+	}()
+
 	log.Println("Upgrading...")
-	done = false
-	if err = wait.PollImmediate(10*time.Second, MaxDuration, func() (bool, error) {
-		done, msg, err = IsUpgradeDone(h, desired.Spec.DesiredUpdate)
+	upgradeCtx, cancel := context.WithTimeout(ctx, MaxDuration)
+	defer cancel()
+	if err = wait.PollImmediateUntil(10*time.Second, func() (bool, error) {
+		done, msg, err = IsUpgradeDone(upgradeCtx, h, desired)
 		if !done {
 			log.Printf("Upgrade in progress: %s", msg)
 		}
 		return done, err
-	}); err != nil {
+	}, upgradeCtx.Done()); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("stopped waiting for upgrade: %v", ctx.Err())
+		}
 		return fmt.Errorf("failed to upgrade cluster: %v", err)
 	}
 
@@ -86,7 +137,7 @@ func RunUpgrade() error {
 
 	metadata.Instance.SetTimeToUpgradedCluster(time.Since(upgradeStarted).Seconds())
 
-	if err = cluster.WaitForClusterReady(viper.GetString(config.Cluster.ID), nil); err != nil {
+	if err = cluster.WaitForClusterReady(ctx, cfg, cfg.State.ClusterID, nil); err != nil {
 		return fmt.Errorf("failed waiting for cluster ready: %v", err)
 	}
 
@@ -94,8 +145,28 @@ func RunUpgrade() error {
 	return nil
 }
 
+// writeUpgradeTimeline stores the monitor's results in the metadata and writes its timeline to the report
+// directory of the current phase.
+func writeUpgradeTimeline(cfg *config.RunConfig, monitor *Monitor) {
+	monitor.StoreMetadata()
+
+	if cfg.State.ReportDir == "" {
+		return
+	}
+
+	dir := filepath.Join(cfg.State.ReportDir, cfg.State.Phase)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		log.Printf("Unable to create directory for upgrade timeline: %v", err)
+		return
+	}
+
+	if err := monitor.WriteTimeline(filepath.Join(dir, TimelineFile)); err != nil {
+		log.Printf("Unable to write upgrade timeline: %v", err)
+	}
+}
+
 // TriggerUpgrade uses a helper to perform an upgrade.
-func TriggerUpgrade(h *helper.H) (*configv1.ClusterVersion, error) {
+func TriggerUpgrade(ctx context.Context, h *helper.H) (*configv1.ClusterVersion, error) {
 	var cVersion *configv1.ClusterVersion
 	var err error
 	// setup Config client
@@ -103,13 +174,14 @@ func TriggerUpgrade(h *helper.H) (*configv1.ClusterVersion, error) {
 
 	// get current Version
 	getOpts := metav1.GetOptions{}
-	cVersion, err = cfgClient.ConfigV1().ClusterVersions().Get(context.TODO(), ClusterVersionName, getOpts)
+	cVersion, err = cfgClient.ConfigV1().ClusterVersions().Get(ctx, ClusterVersionName, getOpts)
 	if err != nil {
 		return cVersion, fmt.Errorf("couldn't get current ClusterVersion '%s': %v", ClusterVersionName, err)
 	}
 
-	image := viper.GetString(config.Upgrade.Image)
-	releaseName := viper.GetString(config.Upgrade.ReleaseName)
+	cfg := h.Config()
+	image := cfg.State.UpgradeImage
+	releaseName := cfg.State.UpgradeReleaseName
 
 	// set requested upgrade targets
 	if image != "" {
@@ -120,26 +192,30 @@ func TriggerUpgrade(h *helper.H) (*configv1.ClusterVersion, error) {
 		}
 	} else {
 		upgradeVersion := strings.Replace(releaseName, "openshift-v", "", -1)
-		installVersion := strings.Replace(viper.GetString(config.Cluster.Version), "openshift-v", "", -1)
+		installVersion := currentVersion(cVersion, cfg.State.ClusterVersion)
 
 		upgradeVersionParsed := semver.MustParse(upgradeVersion)
 		installVersionParsed := semver.MustParse(installVersion)
 
 		if upgradeVersionParsed.GreaterThan(installVersionParsed) {
-			cVersion.Spec.Channel, err = VersionToChannel(upgradeVersionParsed)
+			cVersion.Spec.Channel, err = VersionToChannel(cfg, upgradeVersionParsed)
 			if err != nil {
 				return cVersion, fmt.Errorf("unable to channel from version: %v", err)
 			}
 
-			cVersion, err = cfgClient.ConfigV1().ClusterVersions().Update(context.TODO(), cVersion, metav1.UpdateOptions{})
+			cVersion, err = cfgClient.ConfigV1().ClusterVersions().Update(ctx, cVersion, metav1.UpdateOptions{})
 			if err != nil {
 				return cVersion, fmt.Errorf("couldn't update desired release channel: %v", err)
 			}
 
 			// https://github.com/openshift/managed-cluster-config/blob/master/scripts/cluster-upgrade.sh#L258
-			time.Sleep(15 * time.Second)
+			select {
+			case <-time.After(15 * time.Second):
+			case <-ctx.Done():
+				return cVersion, ctx.Err()
+			}
 
-			cVersion, err = cfgClient.ConfigV1().ClusterVersions().Get(context.TODO(), ClusterVersionName, getOpts)
+			cVersion, err = cfgClient.ConfigV1().ClusterVersions().Get(ctx, ClusterVersionName, getOpts)
 			if err != nil {
 				return cVersion, fmt.Errorf("couldn't get current ClusterVersion '%s' after updating release channel: %v", ClusterVersionName, err)
 			}
@@ -151,32 +227,45 @@ func TriggerUpgrade(h *helper.H) (*configv1.ClusterVersion, error) {
 		}
 	}
 
-	updatedCV, err := cfgClient.ConfigV1().ClusterVersions().Update(context.TODO(), cVersion, metav1.UpdateOptions{})
+	updatedCV, err := cfgClient.ConfigV1().ClusterVersions().Update(ctx, cVersion, metav1.UpdateOptions{})
 	if err != nil {
 		return updatedCV, fmt.Errorf("couldn't update desired ClusterVersion: %v", err)
 	}
 
 	// wait for update acknowledgement
 	updateGeneration := updatedCV.Generation
-	if err = wait.PollImmediate(15*time.Second, 5*time.Minute, func() (bool, error) {
-		if cVersion, err = cfgClient.ConfigV1().ClusterVersions().Get(context.TODO(), ClusterVersionName, getOpts); err != nil {
+	ackCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	if err = wait.PollImmediateUntil(15*time.Second, func() (bool, error) {
+		if cVersion, err = cfgClient.ConfigV1().ClusterVersions().Get(ctx, ClusterVersionName, getOpts); err != nil {
 			return false, err
 		}
 		return cVersion.Status.ObservedGeneration >= updateGeneration, nil
-	}); err != nil {
+	}, ackCtx.Done()); err != nil {
 		return updatedCV, fmt.Errorf("cluster did not acknowledge update in a timely manner: %v", err)
 	}
 
 	return updatedCV, nil
 }
 
+// currentVersion returns the version a cluster is at or moving to, which is the target of the last hop of an upgrade
+// path rather than the version the cluster was installed with. The installed version is used if the ClusterVersion
+// doesn't have a desired version yet.
+func currentVersion(cVersion *configv1.ClusterVersion, installVersion string) string {
+	if cVersion.Status.Desired.Version != "" {
+		return cVersion.Status.Desired.Version
+	}
+	return strings.Replace(installVersion, "openshift-v", "", -1)
+}
+
 // IsUpgradeDone returns with done true when an upgrade is complete at desired and any available msg.
-func IsUpgradeDone(h *helper.H, desired *configv1.Update) (done bool, msg string, err error) {
+func IsUpgradeDone(ctx context.Context, h *helper.H, desired *configv1.Update) (done bool, msg string, err error) {
 	// retrieve current ClusterVersion
 	cfgClient, getOpts := h.Cfg(), metav1.GetOptions{}
-	cVersion, err := cfgClient.ConfigV1().ClusterVersions().Get(context.TODO(), ClusterVersionName, getOpts)
+	cVersion, err := cfgClient.ConfigV1().ClusterVersions().Get(ctx, ClusterVersionName, getOpts)
 	if err != nil {
 		log.Printf("error getting ClusterVersion '%s': %v", ClusterVersionName, err)
+		return false, "unable to retrieve ClusterVersion", nil
 	}
 
 	// ensure working towards correct desired
@@ -209,15 +298,15 @@ func IsUpgradeDone(h *helper.H, desired *configv1.Update) (done bool, msg string
 }
 
 // VersionToChannel creates a Cincinnati channel version out of an OpenShift version.
-// If the config.Instance.Upgrade.OnlyUpgradeToZReleases flag is set, this will use the install version
-// in the global state object to determine the channel.
+// If the cfg.Upgrade.OnlyUpgradeToZReleases flag is set, this will use the install version
+// in the run state to determine the channel.
 // The provider will be queried for the appropriate Cincinnati channel  to use unless a prelease version
 // is being used, in which case the candidate channel will be used.
-func VersionToChannel(version *semver.Version) (string, error) {
+func VersionToChannel(cfg *config.RunConfig, version *semver.Version) (string, error) {
 	useVersion := version
-	if viper.GetBool(config.Upgrade.OnlyUpgradeToZReleases) {
+	if cfg.Upgrade.OnlyUpgradeToZReleases {
 		var err error
-		useVersion, err = util.OpenshiftVersionToSemver(viper.GetString(config.Cluster.Version))
+		useVersion, err = util.OpenshiftVersionToSemver(cfg.State.ClusterVersion)
 
 		if err != nil {
 			panic("cluster version stored in state object is invalid")
@@ -228,7 +317,7 @@ func VersionToChannel(version *semver.Version) (string, error) {
 		return fmt.Sprintf("candidate-%d.%d", useVersion.Major(), useVersion.Minor()), nil
 	}
 
-	provider, err := providers.ClusterProvider()
+	provider, err := providers.ClusterProvider(cfg)
 
 	if err != nil {
 		return "", fmt.Errorf("unable to get provider: %s", err)
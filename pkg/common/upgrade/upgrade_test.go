@@ -0,0 +1,27 @@
+package upgrade
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+func TestCurrentVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		desired  string
+		expected string
+	}{
+		{name: "after a hop", desired: "4.6.8", expected: "4.6.8"},
+		{name: "no desired version", desired: "", expected: "4.5.16"},
+	}
+
+	for _, test := range tests {
+		cVersion := &configv1.ClusterVersion{}
+		cVersion.Status.Desired.Version = test.desired
+
+		if version := currentVersion(cVersion, "openshift-v4.5.16"); version != test.expected {
+			t.Errorf("%s: expected version %s, got %s", test.name, test.expected, version)
+		}
+	}
+}
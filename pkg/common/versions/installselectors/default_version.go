@@ -2,6 +2,7 @@ package installselectors
 
 import (
 	"github.com/Masterminds/semver"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
 )
 
@@ -12,7 +13,7 @@ func init() {
 // DefaultVersion is the fallback selector.
 type defaultVersion struct{}
 
-func (d defaultVersion) ShouldUse() bool {
+func (d defaultVersion) ShouldUse(cfg *config.RunConfig) bool {
 	return true
 }
 
@@ -20,6 +21,6 @@ func (d defaultVersion) Priority() int {
 	return 0
 }
 
-func (d defaultVersion) SelectVersion(versionList *spi.VersionList) (*semver.Version, string, error) {
+func (d defaultVersion) SelectVersion(cfg *config.RunConfig, versionList *spi.VersionList) (*semver.Version, string, error) {
 	return versionList.Default(), "current default", nil
 }
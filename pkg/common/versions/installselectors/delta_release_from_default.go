@@ -7,7 +7,6 @@ import (
 	"github.com/Masterminds/semver"
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
-	"github.com/spf13/viper"
 )
 
 func init() {
@@ -18,30 +17,30 @@ func init() {
 // delta can be negative or positive.
 type deltaReleaseFromDefault struct{}
 
-func (d deltaReleaseFromDefault) ShouldUse() bool {
-	return viper.GetInt(config.Cluster.DeltaReleaseFromDefault) != 0
+func (d deltaReleaseFromDefault) ShouldUse(cfg *config.RunConfig) bool {
+	return cfg.Cluster.DeltaReleaseFromDefault != 0
 }
 
 func (d deltaReleaseFromDefault) Priority() int {
 	return 50
 }
 
-func (d deltaReleaseFromDefault) SelectVersion(versionList *spi.VersionList) (*semver.Version, string, error) {
+func (d deltaReleaseFromDefault) SelectVersion(cfg *config.RunConfig, versionList *spi.VersionList) (*semver.Version, string, error) {
 	availableVersions := versionList.AvailableVersions()
 	defaultIndex := findDefaultVersionIndex(availableVersions)
-	deltaReleasesFromDefault := viper.GetInt(config.Cluster.DeltaReleaseFromDefault)
+	deltaReleasesFromDefault := cfg.Cluster.DeltaReleaseFromDefault
 	versionType := fmt.Sprintf("version %d releases from the default", deltaReleasesFromDefault)
 
 	if defaultIndex < 0 {
 		log.Printf("unable to find default version in avaialable version list")
-		viper.Set(config.Cluster.PreviousVersionFromDefaultFound, false)
+		cfg.State.PreviousVersionFromDefaultFound = false
 	}
 
 	targetIndex := defaultIndex + deltaReleasesFromDefault
 
 	if targetIndex < 0 || targetIndex >= len(availableVersions) {
 		log.Printf("not enough enabled versions to go back %d releases", deltaReleasesFromDefault)
-		viper.Set(config.Cluster.PreviousVersionFromDefaultFound, false)
+		cfg.State.PreviousVersionFromDefaultFound = false
 		return nil, versionType, nil
 	}
 
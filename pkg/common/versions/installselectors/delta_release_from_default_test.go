@@ -7,7 +7,6 @@ import (
 	"github.com/Masterminds/semver"
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
-	"github.com/spf13/viper"
 )
 
 func TestDeltaReleaseFromDefaultVersionSelectVersion(t *testing.T) {
@@ -79,12 +78,11 @@ func TestDeltaReleaseFromDefaultVersionSelectVersion(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		viper.Reset()
-
-		viper.Set(config.Cluster.DeltaReleaseFromDefault, test.delta)
+		cfg := config.NewRunConfig()
+		cfg.Cluster.DeltaReleaseFromDefault = test.delta
 
 		selector := deltaReleaseFromDefault{}
-		selectedVersion, descriptor, err := selector.SelectVersion(test.versions)
+		selectedVersion, descriptor, err := selector.SelectVersion(cfg, test.versions)
 
 		if err != nil && !test.expectedErr {
 			t.Errorf("test %s: error while selecting version: %v", test.name, err)
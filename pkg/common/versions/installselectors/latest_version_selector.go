@@ -6,7 +6,6 @@ import (
 	"github.com/Masterminds/semver"
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
-	"github.com/spf13/viper"
 )
 
 func init() {
@@ -16,15 +15,15 @@ func init() {
 // LatestVersion will always select the latest version of openshift.
 type latestVersion struct{}
 
-func (l latestVersion) ShouldUse() bool {
-	return viper.GetBool(config.Cluster.UseLatestVersionForInstall)
+func (l latestVersion) ShouldUse(cfg *config.RunConfig) bool {
+	return cfg.Cluster.UseLatestVersionForInstall
 }
 
 func (l latestVersion) Priority() int {
 	return 70
 }
 
-func (l latestVersion) SelectVersion(versionList *spi.VersionList) (*semver.Version, string, error) {
+func (l latestVersion) SelectVersion(cfg *config.RunConfig, versionList *spi.VersionList) (*semver.Version, string, error) {
 	availableVersions := versionList.AvailableVersions()
 	numVersions := len(availableVersions)
 	versionType := "latest version"
@@ -4,7 +4,6 @@ import (
 	"github.com/Masterminds/semver"
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
-	"github.com/spf13/viper"
 )
 
 func init() {
@@ -14,15 +13,15 @@ func init() {
 // MiddleClusterImageSet will use the image in the middle of the available versions.
 type middleClusterImageSet struct{}
 
-func (m middleClusterImageSet) ShouldUse() bool {
-	return viper.GetBool(config.Cluster.UseMiddleClusterImageSetForInstall)
+func (m middleClusterImageSet) ShouldUse(cfg *config.RunConfig) bool {
+	return cfg.Cluster.UseMiddleClusterImageSetForInstall
 }
 
 func (m middleClusterImageSet) Priority() int {
 	return 60
 }
 
-func (m middleClusterImageSet) SelectVersion(versionList *spi.VersionList) (*semver.Version, string, error) {
+func (m middleClusterImageSet) SelectVersion(cfg *config.RunConfig, versionList *spi.VersionList) (*semver.Version, string, error) {
 	versionsWithoutDefault := removeDefaultVersion(versionList.AvailableVersions())
 	numVersions := len(versionsWithoutDefault)
 	versionType := "middle version"
@@ -30,7 +29,7 @@ func (m middleClusterImageSet) SelectVersion(versionList *spi.VersionList) (*sem
 	// We don't want to fail entirely if there aren't enough versions. It's valid and perhaps even expected
 	// that we d on't have enough versions for a middle cluster image set.
 	if numVersions < 2 {
-		viper.Set(config.Cluster.EnoughVersionsForOldestOrMiddleTest, false)
+		cfg.State.EnoughVersionsForOldestOrMiddleTest = false
 		return nil, versionType, nil
 	}
 
@@ -7,7 +7,6 @@ import (
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
 	"github.com/openshift/osde2e/pkg/common/versions/common"
-	"github.com/spf13/viper"
 )
 
 func init() {
@@ -17,16 +16,16 @@ func init() {
 // nextVersionAfterProdDefault will select a version that is N releases from the current production default.
 type nextVersionAfterProdDefault struct{}
 
-func (n nextVersionAfterProdDefault) ShouldUse() bool {
-	return viper.GetInt(config.Cluster.NextReleaseAfterProdDefault) > -1
+func (n nextVersionAfterProdDefault) ShouldUse(cfg *config.RunConfig) bool {
+	return cfg.Cluster.NextReleaseAfterProdDefault > -1
 }
 
 func (n nextVersionAfterProdDefault) Priority() int {
 	return 40
 }
 
-func (n nextVersionAfterProdDefault) SelectVersion(versionList *spi.VersionList) (*semver.Version, string, error) {
-	numReleasesAfterProdDefault := viper.GetInt(config.Cluster.NextReleaseAfterProdDefault)
+func (n nextVersionAfterProdDefault) SelectVersion(cfg *config.RunConfig, versionList *spi.VersionList) (*semver.Version, string, error) {
+	numReleasesAfterProdDefault := cfg.Cluster.NextReleaseAfterProdDefault
 	defaultVersion := versionList.Default()
 	selectedVersion, err := common.NextReleaseAfterGivenVersionFromVersionList(defaultVersion, versionList.AvailableVersions(), numReleasesAfterProdDefault)
 	return selectedVersion, fmt.Sprintf("%d release(s) from the default version in prod", numReleasesAfterProdDefault), err
@@ -4,7 +4,6 @@ import (
 	"github.com/Masterminds/semver"
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
-	"github.com/spf13/viper"
 )
 
 func init() {
@@ -14,15 +13,15 @@ func init() {
 // oldestClusterImageSet will use the oldest image from the list of available versions.
 type oldestClusterImageSet struct{}
 
-func (o oldestClusterImageSet) ShouldUse() bool {
-	return viper.GetBool(config.Cluster.UseOldestClusterImageSetForInstall)
+func (o oldestClusterImageSet) ShouldUse(cfg *config.RunConfig) bool {
+	return cfg.Cluster.UseOldestClusterImageSetForInstall
 }
 
 func (o oldestClusterImageSet) Priority() int {
 	return 60
 }
 
-func (o oldestClusterImageSet) SelectVersion(versionList *spi.VersionList) (*semver.Version, string, error) {
+func (o oldestClusterImageSet) SelectVersion(cfg *config.RunConfig, versionList *spi.VersionList) (*semver.Version, string, error) {
 	versionsWithoutDefault := removeDefaultVersion(versionList.AvailableVersions())
 	numVersions := len(versionsWithoutDefault)
 	versionType := "oldest version"
@@ -30,7 +29,7 @@ func (o oldestClusterImageSet) SelectVersion(versionList *spi.VersionList) (*sem
 	// We don't want to fail entirely if there aren't enough versions. It's valid and perhaps even expected
 	// that we d on't have enough versions for a middle cluster image set.
 	if numVersions < 2 {
-		viper.Set(config.Cluster.EnoughVersionsForOldestOrMiddleTest, false)
+		cfg.State.EnoughVersionsForOldestOrMiddleTest = false
 		return nil, versionType, nil
 	}
 
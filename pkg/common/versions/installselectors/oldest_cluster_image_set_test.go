@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/Masterminds/semver"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
 )
 
@@ -52,7 +53,7 @@ func TestOldestClusterImageSetSelectVersion(t *testing.T) {
 
 	for _, test := range tests {
 		selector := oldestClusterImageSet{}
-		selectedVersion, descriptor, err := selector.SelectVersion(test.versions)
+		selectedVersion, descriptor, err := selector.SelectVersion(config.NewRunConfig(), test.versions)
 
 		if err != nil && !test.expectedErr {
 			t.Errorf("test %s: error while selecting version: %v", test.name, err)
@@ -0,0 +1,61 @@
+package installselectors
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/semver"
+	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/openshift/osde2e/pkg/common/releasecontroller"
+	"github.com/openshift/osde2e/pkg/common/spi"
+)
+
+func init() {
+	registerSelector(releaseControllerVersion{})
+}
+
+// releaseControllerVersion selects the newest nightly of a release stream that is in the configured phase, is old
+// enough and is offered by the provider.
+type releaseControllerVersion struct{}
+
+func (r releaseControllerVersion) ShouldUse(cfg *config.RunConfig) bool {
+	return cfg.ReleaseController.InstallStream != ""
+}
+
+func (r releaseControllerVersion) Priority() int {
+	return 80
+}
+
+func (r releaseControllerVersion) SelectVersion(cfg *config.RunConfig, versionList *spi.VersionList) (*semver.Version, string, error) {
+	return selectFromReleaseController(releasecontroller.NewClient(cfg), cfg, versionList)
+}
+
+func selectFromReleaseController(client *releasecontroller.Client, cfg *config.RunConfig, versionList *spi.VersionList) (*semver.Version, string, error) {
+	stream := cfg.ReleaseController.InstallStream
+	phase := cfg.ReleaseController.Phase
+	minAge := time.Duration(cfg.ReleaseController.MinAgeInHours) * time.Hour
+	versionType := fmt.Sprintf("%s release from %s", phase, stream)
+
+	tags, err := client.Tags(stream, phase)
+	if err != nil {
+		return nil, versionType, fmt.Errorf("error getting releases of %s: %v", stream, err)
+	}
+
+	available := map[string]*semver.Version{}
+	for _, version := range versionList.AvailableVersions() {
+		available[version.Version().String()] = version.Version()
+	}
+
+	for _, tag := range tags {
+		if minAge > 0 {
+			if created, ok := tag.Created(); !ok || time.Since(created) < minAge {
+				continue
+			}
+		}
+		if version, ok := available[tag.Name]; ok {
+			return version, versionType, nil
+		}
+	}
+
+	return nil, versionType, fmt.Errorf("no %s release of %s at least %v old is offered by the provider", phase, stream, minAge)
+}
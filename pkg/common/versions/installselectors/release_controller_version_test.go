@@ -0,0 +1,69 @@
+package installselectors
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Masterminds/semver"
+	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/openshift/osde2e/pkg/common/releasecontroller"
+	"github.com/openshift/osde2e/pkg/common/spi"
+)
+
+func TestReleaseControllerSelectVersion(t *testing.T) {
+	fixtures, err := ioutil.TempDir("", "release-controller-fixtures")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(fixtures)
+
+	if err = os.MkdirAll(filepath.Join(fixtures, "4.7.0-0.nightly"), os.ModePerm); err != nil {
+		t.Fatalf("error creating fixture dir: %v", err)
+	}
+	tags := `{"tags": [
+  {"name": "4.7.0-0.nightly-2021-03-02-000000", "phase": "Accepted"},
+  {"name": "4.7.0-0.nightly-2021-03-01-000000", "phase": "Rejected"},
+  {"name": "4.7.0-0.nightly-2021-02-28-000000", "phase": "Accepted"},
+  {"name": "4.7.0-0.nightly-2021-02-27-000000", "phase": "Accepted"}
+]}`
+	if err = ioutil.WriteFile(filepath.Join(fixtures, "4.7.0-0.nightly", "tags.json"), []byte(tags), 0644); err != nil {
+		t.Fatalf("error writing fixture: %v", err)
+	}
+
+	// The newest accepted nightly isn't offered by the provider yet.
+	versions := spi.NewVersionListBuilder().
+		AvailableVersions([]*spi.Version{
+			spi.NewVersionBuilder().Default(true).Version(semver.MustParse("4.6.8")).Build(),
+			spi.NewVersionBuilder().Version(semver.MustParse("4.7.0-0.nightly-2021-03-01-000000")).Build(),
+			spi.NewVersionBuilder().Version(semver.MustParse("4.7.0-0.nightly-2021-02-28-000000")).Build(),
+			spi.NewVersionBuilder().Version(semver.MustParse("4.7.0-0.nightly-2021-02-27-000000")).Build(),
+		}).
+		Build()
+
+	tests := []struct {
+		name            string
+		phase           string
+		expectedVersion *semver.Version
+		expectedErr     bool
+	}{
+		{"accepted", "Accepted", semver.MustParse("4.7.0-0.nightly-2021-02-28-000000"), false},
+		{"rejected", "Rejected", semver.MustParse("4.7.0-0.nightly-2021-03-01-000000"), false},
+		{"ready", "Ready", nil, true},
+	}
+
+	for _, test := range tests {
+		cfg := config.NewRunConfig()
+		cfg.ReleaseController.InstallStream = "4.7.0-0.nightly"
+		cfg.ReleaseController.Phase = test.phase
+
+		client := &releasecontroller.Client{Fixtures: fixtures}
+		selectedVersion, _, err := selectFromReleaseController(client, cfg, versions)
+		if (err != nil) != test.expectedErr {
+			t.Errorf("test %s: expected error=%v, got %v", test.name, test.expectedErr, err)
+		}
+
+		failIfVersionsNotEqual(t, test.name, selectedVersion, test.expectedVersion)
+	}
+}
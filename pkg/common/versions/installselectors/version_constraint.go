@@ -0,0 +1,190 @@
+package installselectors
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/openshift/osde2e/pkg/common/spi"
+)
+
+const (
+	pickNewest          = "newest"
+	pickOldest          = "oldest"
+	pickRandom          = "random"
+	pickDefaultRelative = "default-relative:"
+)
+
+// operatorOnlyRegex matches a comparison operator separated from its version by a space, as in ">= 4.6".
+var operatorOnlyRegex = regexp.MustCompile(`^(=|!=|>|<|>=|=>|<=|=<|~|~>|\^)$`)
+
+func init() {
+	registerSelector(versionConstraintSelector{})
+}
+
+// versionConstraintSelector selects a version matching a constraint expression, picked by a policy.
+type versionConstraintSelector struct{}
+
+func (v versionConstraintSelector) ShouldUse(cfg *config.RunConfig) bool {
+	return cfg.Cluster.InstallVersionConstraint != ""
+}
+
+func (v versionConstraintSelector) Priority() int {
+	return 75
+}
+
+func (v versionConstraintSelector) SelectVersion(cfg *config.RunConfig, versionList *spi.VersionList) (*semver.Version, string, error) {
+	expression, pick := cfg.Cluster.InstallVersionConstraint, cfg.Cluster.InstallVersionPick
+	versionType := fmt.Sprintf("%s version matching '%s'", pick, expression)
+
+	constraint, err := parseVersionConstraint(expression)
+	if err != nil {
+		return nil, versionType, err
+	}
+
+	matching := []*spi.Version{}
+	for _, version := range versionList.AvailableVersions() {
+		if constraint.matches(version.Version()) {
+			matching = append(matching, version)
+		}
+	}
+	sortVersions(matching)
+
+	selected, err := pickVersion(matching, pick, versionList.Default())
+	if err != nil {
+		return nil, versionType, fmt.Errorf("unable to pick a version matching '%s': %v", expression, err)
+	}
+	return selected, versionType, nil
+}
+
+// versionConstraint is a semver constraint on the release a version is, plus whether it must or mustn't be a
+// prerelease such as a nightly.
+type versionConstraint struct {
+	constraints *semver.Constraints
+
+	// prerelease is nil if prereleases and releases both match.
+	prerelease *bool
+}
+
+// parseVersionConstraint parses a constraint expression. Terms are ANDed when separated by commas or spaces and ORed
+// when separated by "||". The terms "prerelease" and "!prerelease" only match, or never match, prereleases.
+func parseVersionConstraint(expression string) (*versionConstraint, error) {
+	constraint := &versionConstraint{}
+
+	ors := []string{}
+	for _, or := range strings.Split(expression, "||") {
+		ands := []string{}
+		fields := strings.Fields(strings.Replace(or, ",", " ", -1))
+		for i := 0; i < len(fields); i++ {
+			field := fields[i]
+			switch {
+			case field == "prerelease" || field == "!prerelease":
+				prerelease := field == "prerelease"
+				if constraint.prerelease != nil && *constraint.prerelease != prerelease {
+					return nil, fmt.Errorf("version constraint '%s' both requires and excludes prereleases", expression)
+				}
+				constraint.prerelease = &prerelease
+				continue
+			case operatorOnlyRegex.MatchString(field) && i+1 < len(fields):
+				i++
+				field += fields[i]
+			}
+			ands = append(ands, field)
+		}
+
+		if len(ands) > 0 {
+			ors = append(ors, strings.Join(ands, ","))
+		} else if strings.Contains(expression, "||") {
+			return nil, fmt.Errorf("version constraint '%s' has an empty alternative", expression)
+		}
+	}
+
+	if len(ors) > 0 {
+		constraints, err := semver.NewConstraint(strings.Join(ors, "||"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint '%s': %v", expression, err)
+		}
+		constraint.constraints = constraints
+	}
+
+	return constraint, nil
+}
+
+// matches returns true if a version matches the constraint. Versions are compared by the release they are or lead
+// up to, so 4.8.0-0.nightly matches ">=4.8" but not "<4.8".
+func (c *versionConstraint) matches(version *semver.Version) bool {
+	if version == nil {
+		return false
+	}
+
+	if c.prerelease != nil && *c.prerelease != (version.Prerelease() != "") {
+		return false
+	}
+
+	if c.constraints == nil {
+		return true
+	}
+
+	release, err := semver.NewVersion(fmt.Sprintf("%d.%d.%d", version.Major(), version.Minor(), version.Patch()))
+	return err == nil && c.constraints.Check(release)
+}
+
+// pickVersion picks a version from versions, which are sorted oldest first, using a pick policy.
+func pickVersion(versions []*spi.Version, pick string, defaultVersion *semver.Version) (*semver.Version, error) {
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("no versions match")
+	}
+
+	switch {
+	case pick == pickNewest || pick == "":
+		return versions[len(versions)-1].Version(), nil
+	case pick == pickOldest:
+		return versions[0].Version(), nil
+	case pick == pickRandom:
+		return versions[rand.Intn(len(versions))].Version(), nil
+	case strings.HasPrefix(pick, pickDefaultRelative):
+		delta, err := strconv.Atoi(strings.TrimPrefix(pick, pickDefaultRelative))
+		if err != nil {
+			return nil, fmt.Errorf("invalid pick '%s': %v", pick, err)
+		}
+		return pickRelativeToDefault(versions, delta, defaultVersion)
+	default:
+		return nil, fmt.Errorf("unknown pick '%s', expected %s, %s, %s or %sN", pick, pickNewest, pickOldest, pickRandom, pickDefaultRelative)
+	}
+}
+
+// pickRelativeToDefault picks the version delta places from the default in versions, which are sorted oldest first.
+// If the default isn't one of versions, -1 is the newest version older than it and 1 the oldest version newer than it.
+func pickRelativeToDefault(versions []*spi.Version, delta int, defaultVersion *semver.Version) (*semver.Version, error) {
+	if defaultVersion == nil {
+		return nil, fmt.Errorf("there is no default version")
+	}
+
+	// index is where the default is, or would be, in versions.
+	index, isMatch := len(versions), false
+	for i, version := range versions {
+		if !version.Version().LessThan(defaultVersion) {
+			index, isMatch = i, version.Version().Equal(defaultVersion)
+			break
+		}
+	}
+
+	target := index + delta
+	if !isMatch {
+		if delta == 0 {
+			return nil, fmt.Errorf("the default version %s doesn't match", defaultVersion)
+		}
+		if delta > 0 {
+			target--
+		}
+	}
+
+	if target < 0 || target >= len(versions) {
+		return nil, fmt.Errorf("not enough matching versions to pick %d releases from the default version %s", delta, defaultVersion)
+	}
+	return versions[target].Version(), nil
+}
@@ -0,0 +1,74 @@
+package installselectors
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver"
+	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/openshift/osde2e/pkg/common/spi"
+)
+
+func TestVersionConstraintSelectVersion(t *testing.T) {
+	versions := spi.NewVersionListBuilder().
+		AvailableVersions([]*spi.Version{
+			spi.NewVersionBuilder().Version(semver.MustParse("4.8.0-0.nightly-2021-03-01-000000")).Build(),
+			spi.NewVersionBuilder().Version(semver.MustParse("4.5.16")).Build(),
+			spi.NewVersionBuilder().Version(semver.MustParse("4.6.8")).Build(),
+			spi.NewVersionBuilder().Default(true).Version(semver.MustParse("4.6.12")).Build(),
+			spi.NewVersionBuilder().Version(semver.MustParse("4.7.0-rc.1")).Build(),
+			spi.NewVersionBuilder().Version(semver.MustParse("4.7.2")).Build(),
+		}).
+		Build()
+
+	tests := []struct {
+		name            string
+		constraint      string
+		pick            string
+		expectedVersion *semver.Version
+		expectedErr     bool
+	}{
+		{"newest release", ">=4.6 <4.8, !prerelease", "newest", semver.MustParse("4.7.2"), false},
+		{"oldest release", ">=4.6 <4.8, !prerelease", "oldest", semver.MustParse("4.6.8"), false},
+		{"nightlies compare by release", "< 4.8", "newest", semver.MustParse("4.7.2"), false},
+		{"prereleases only", "prerelease", "oldest", semver.MustParse("4.7.0-rc.1"), false},
+		{"alternatives", "4.5.x || >=4.8", "oldest", semver.MustParse("4.5.16"), false},
+		{"previous to default", ">=4.5", "default-relative:-1", semver.MustParse("4.6.8"), false},
+		{"after default", "!prerelease", "default-relative:1", semver.MustParse("4.7.2"), false},
+		{"default", "~4.6", "default-relative:0", semver.MustParse("4.6.12"), false},
+		{"previous to unmatched default", "4.5.x || 4.7.x", "default-relative:-1", semver.MustParse("4.5.16"), false},
+		{"after unmatched default", "4.5.x || 4.7.x", "default-relative:1", semver.MustParse("4.7.0-rc.1"), false},
+		{"unmatched default", ">=4.7", "default-relative:0", nil, true},
+		{"too far from default", ">=4.6", "default-relative:-2", nil, true},
+		{"nothing matches", ">=4.9", "newest", nil, true},
+		{"unknown pick", ">=4.6", "middle", nil, true},
+		{"invalid constraint", ">=four", "newest", nil, true},
+		{"contradicting prerelease terms", "prerelease !prerelease", "newest", nil, true},
+	}
+
+	for _, test := range tests {
+		cfg := config.NewRunConfig()
+		cfg.Cluster.InstallVersionConstraint = test.constraint
+		cfg.Cluster.InstallVersionPick = test.pick
+
+		selectedVersion, _, err := versionConstraintSelector{}.SelectVersion(cfg, versions)
+		if (err != nil) != test.expectedErr {
+			t.Errorf("test %s: expected error=%v, got %v", test.name, test.expectedErr, err)
+		}
+
+		failIfVersionsNotEqual(t, test.name, selectedVersion, test.expectedVersion)
+	}
+}
+
+func TestVersionConstraintPickRandom(t *testing.T) {
+	versions := []*spi.Version{
+		spi.NewVersionBuilder().Version(semver.MustParse("4.6.8")).Build(),
+		spi.NewVersionBuilder().Version(semver.MustParse("4.7.2")).Build(),
+	}
+
+	for i := 0; i < 10; i++ {
+		selected, err := pickVersion(versions, "random", nil)
+		if err != nil || (!selected.Equal(versions[0].Version()) && !selected.Equal(versions[1].Version())) {
+			t.Errorf("expected a random pick of a matching version, got %v: %v", selected, err)
+		}
+	}
+}
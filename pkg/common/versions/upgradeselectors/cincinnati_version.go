@@ -1,25 +1,16 @@
 package upgradeselectors
 
 import (
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"net/http"
-	"sync"
 
 	"github.com/Masterminds/semver"
+	"github.com/openshift/osde2e/pkg/common/cincinnati"
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/metadata"
 	"github.com/openshift/osde2e/pkg/common/spi"
 	"github.com/openshift/osde2e/pkg/common/upgrade"
 	"github.com/openshift/osde2e/pkg/common/util"
-	"github.com/spf13/viper"
-)
-
-const (
-	// format string for Cincinnati releases
-	cincinnatiURLFmt = "https://api.openshift.com/api/upgrades_info/v1/graph?channel=%s&arch=amd64"
 )
 
 func init() {
@@ -29,25 +20,25 @@ func init() {
 // cincinnatiUpgrade will select an upgrade target based on Cincinnaati.
 type cincinnatiUpgrade struct{}
 
-func (c cincinnatiUpgrade) ShouldUse(upgradeSource spi.UpgradeSource) bool {
-	return upgradeSource == spi.CincinnatiSource && viper.GetBool(config.Upgrade.UpgradeToCISIfPossible)
+func (c cincinnatiUpgrade) ShouldUse(cfg *config.RunConfig, upgradeSource spi.UpgradeSource) bool {
+	return upgradeSource == spi.CincinnatiSource && cfg.Upgrade.UpgradeToCISIfPossible
 }
 
 func (c cincinnatiUpgrade) Priority() int {
 	return 40
 }
 
-func (c cincinnatiUpgrade) SelectVersion(installVersion *semver.Version, versionList *spi.VersionList) (string, string, error) {
+func (c cincinnatiUpgrade) SelectVersion(cfg *config.RunConfig, installVersion *semver.Version, versionList *spi.VersionList) (string, string, error) {
 	var filteredVersionList = []*semver.Version{}
 	for _, version := range versionList.AvailableVersions() {
-		if filterOnCincinnati(installVersion, version.Version()) {
+		if filterOnCincinnati(cfg, installVersion, version.Version()) {
 			filteredVersionList = append(filteredVersionList, version.Version())
 		}
 	}
 
 	numResults := len(filteredVersionList)
 	if numResults == 0 {
-		viper.Set(config.Upgrade.ReleaseName, util.NoVersionFound)
+		cfg.State.UpgradeReleaseName = util.NoVersionFound
 		metadata.Instance.SetUpgradeVersionSource("none")
 		return "", "", nil
 	}
@@ -60,14 +51,14 @@ func (c cincinnatiUpgrade) SelectVersion(installVersion *semver.Version, version
 		log.Printf("Using cluster image set.")
 		releaseName = util.SemverToOpenshiftVersion(cisUpgradeVersion)
 		metadata.Instance.SetUpgradeVersionSource("cluster image set")
-		viper.Set(config.Upgrade.UpgradeVersionEqualToInstallVersion, cisUpgradeVersion.Equal(installVersion))
+		cfg.State.UpgradeVersionEqualToInstallVersion = cisUpgradeVersion.Equal(installVersion)
 	}
 
 	return releaseName, "", nil
 }
 
-func filterOnCincinnati(installVersion *semver.Version, upgradeVersion *semver.Version) bool {
-	versionInCincinnati, err := doesEdgeExistInCincinnati(installVersion, upgradeVersion)
+func filterOnCincinnati(cfg *config.RunConfig, installVersion *semver.Version, upgradeVersion *semver.Version) bool {
+	versionInCincinnati, err := doesEdgeExistInCincinnati(cfg, installVersion, upgradeVersion)
 
 	if err != nil {
 		log.Printf("error while trying to filter on version in Cincinnati: %v", err)
@@ -77,146 +68,46 @@ func filterOnCincinnati(installVersion *semver.Version, upgradeVersion *semver.V
 	return versionInCincinnati
 }
 
-type smallCincinnatiCache struct {
-	Cache map[string]smallCincinnatiCacheObject
-
-	mutex sync.Mutex
-}
-
-type smallCincinnatiCacheObject struct {
-	Versions []*semver.Version
-	Edges    [][]int
-}
-
-// Get returns a specific channel from Cincinnati via our cache
-func (s *smallCincinnatiCache) Get(channel string) (smallCincinnatiCacheObject, error) {
-	s.mutex.Lock()
-	defer s.mutex.Unlock()
-
-	// Load Cincinnati data when we're trying to get information from a channel
-	if _, ok := s.Cache[channel]; !ok {
-		err := s.loadCincinnatiData(channel)
-
-		if err != nil {
-			return smallCincinnatiCacheObject{}, err
-		}
-	}
-
-	return s.Cache[channel], nil
-}
-
-// loadCincinnatiData populates our cache with a given channel's data
-func (s *smallCincinnatiCache) loadCincinnatiData(channel string) error {
-	cincinnatiFormattedURL := fmt.Sprintf(cincinnatiURLFmt, channel)
-
-	var req *http.Request
-
-	// Cincinnati requires an Accept header, so we add it in here
-	req, err := http.NewRequest("GET", cincinnatiFormattedURL, nil)
-	req.Header.Set("Accept", "application/json")
-
+// upgradeGraph returns the Cincinnati graph of the channel used to upgrade to a version.
+func upgradeGraph(cfg *config.RunConfig, upgradeVersion *semver.Version) (*cincinnati.Graph, error) {
+	channel, err := upgrade.VersionToChannel(cfg, upgradeVersion)
 	if err != nil {
-		return fmt.Errorf("failed to create Cincinnati request for URL '%s': %v", cincinnatiFormattedURL, err)
+		return nil, fmt.Errorf("error getting channel from provided version: %v", err)
 	}
 
-	resp, err := (&http.Client{}).Do(req)
-
+	graph, err := cincinnati.NewClient(cfg).Graph(channel)
 	if err != nil {
-		return fmt.Errorf("Request failed for URL '%s': %v", cincinnatiFormattedURL, err)
+		return nil, fmt.Errorf("error loading Cincinnati data: %v", err)
 	}
-
-	data, err := ioutil.ReadAll(resp.Body)
-
-	if err != nil {
-		err = fmt.Errorf("Failed reading body: %v", err)
-		return err
-	}
-
-	var cincinnatiReleases cincinnatiReleaseNodes
-
-	if err = json.Unmarshal(data, &cincinnatiReleases); err != nil {
-		return fmt.Errorf("error decoding body of '%s': %v", data, err)
-	}
-
-	versions := []*semver.Version{}
-
-	for _, release := range cincinnatiReleases.Nodes {
-		currentVersion, err := semver.NewVersion(release.Version)
-
-		if err != nil {
-			log.Printf("Unable to parse version for %s, skipping", release.Version)
-			continue
-		}
-
-		versions = append(versions, currentVersion)
-	}
-
-	s.Cache[channel] = smallCincinnatiCacheObject{
-		Versions: versions,
-		Edges:    cincinnatiReleases.Edges,
-	}
-
-	return nil
-}
-
-var cache *smallCincinnatiCache = &smallCincinnatiCache{
-	Cache: map[string]smallCincinnatiCacheObject{},
-	mutex: sync.Mutex{},
+	return graph, nil
 }
 
 // doesEdgeExistInCincinnati returns true if the version can be found in Cincinnati and the edge from the install version to the upgrade version exists.
-func doesEdgeExistInCincinnati(installVersion, upgradeVersion *semver.Version) (bool, error) {
-	channel, err := upgrade.VersionToChannel(upgradeVersion)
+// If the upgrade will follow a path through Cincinnati, any path to the upgrade version will do.
+func doesEdgeExistInCincinnati(cfg *config.RunConfig, installVersion, upgradeVersion *semver.Version) (bool, error) {
+	graph, err := upgradeGraph(cfg, upgradeVersion)
 	if err != nil {
-		return false, fmt.Errorf("error getting channel from provided version: %v", err)
+		return false, err
 	}
 
-	cincinnatiVersions, err := cache.Get(channel)
-
-	if err != nil {
-		return false, fmt.Errorf("error loading Cincinnati data: %v", err)
+	if cfg.Upgrade.PathFromCincinnati {
+		return graph.ShortestPath(installVersion, upgradeVersion, false) != nil, nil
 	}
+	return graph.HasEdge(installVersion, upgradeVersion), nil
+}
 
-	installIndex := -1
-	upgradeIndex := -1
-	for i, cincinnatiVersion := range cincinnatiVersions.Versions {
-		if installVersion.Equal(cincinnatiVersion) {
-			installIndex = i
-		}
-		if upgradeVersion.Equal(cincinnatiVersion) {
-			upgradeIndex = i
-		}
+// CincinnatiPath returns the versions to upgrade through to get from the install version to the upgrade version,
+// ending with the upgrade version. It takes as few hops as the Cincinnati graph allows, which is how customers
+// who skip z-streams upgrade.
+func CincinnatiPath(cfg *config.RunConfig, installVersion, upgradeVersion *semver.Version) ([]*semver.Version, error) {
+	graph, err := upgradeGraph(cfg, upgradeVersion)
+	if err != nil {
+		return nil, err
 	}
 
-	targetEdge := []int{installIndex, upgradeIndex}
-
-	for _, edge := range cincinnatiVersions.Edges {
-		if len(edge) != len(targetEdge) {
-			continue
-		}
-
-		match := true
-		for i := range edge {
-			if edge[i] != targetEdge[i] {
-				match = false
-				break
-			}
-		}
-
-		if match {
-			return true, nil
-		}
+	path := graph.ShortestPath(installVersion, upgradeVersion, false)
+	if path == nil {
+		return nil, fmt.Errorf("no upgrade path from %s to %s in channel %s", installVersion, upgradeVersion, graph.Channel)
 	}
-
-	return false, nil
-}
-
-type cincinnatiReleaseNodes struct {
-	Nodes []cincinnatiRelease `json:"nodes"`
-	Edges [][]int             `json:"edges"`
-}
-
-type cincinnatiRelease struct {
-	Version string `json:"version"`
-	Payload string `json:"payload"`
+	return path, nil
 }
@@ -1,25 +1,18 @@
 package upgradeselectors
 
 import (
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"net/http"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver"
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/metadata"
+	"github.com/openshift/osde2e/pkg/common/releasecontroller"
 	"github.com/openshift/osde2e/pkg/common/spi"
 	"github.com/openshift/osde2e/pkg/common/util"
 	"github.com/openshift/osde2e/pkg/common/versions/common"
-	"github.com/spf13/viper"
-)
-
-const (
-	// format string for release stream latest from release controller
-	latestReleaseControllerURLFmt = "https://openshift-release.svc.ci.openshift.org/api/v1/releasestream/%s/latest"
 )
 
 func init() {
@@ -29,18 +22,18 @@ func init() {
 // releaseControllerUpgrade will select an upgrade target based on the ReleaseController.
 type releaseControllerUpgrade struct{}
 
-func (r releaseControllerUpgrade) ShouldUse(upgradeSource spi.UpgradeSource) bool {
-	return upgradeSource == spi.ReleaseControllerSource && viper.GetInt(config.Upgrade.NextReleaseAfterProdDefaultForUpgrade) > -1
+func (r releaseControllerUpgrade) ShouldUse(cfg *config.RunConfig, upgradeSource spi.UpgradeSource) bool {
+	return upgradeSource == spi.ReleaseControllerSource && cfg.Upgrade.NextReleaseAfterProdDefaultForUpgrade > -1
 }
 
 func (r releaseControllerUpgrade) Priority() int {
 	return 40
 }
 
-func (r releaseControllerUpgrade) SelectVersion(installVersion *semver.Version, versionList *spi.VersionList) (string, string, error) {
+func (r releaseControllerUpgrade) SelectVersion(cfg *config.RunConfig, installVersion *semver.Version, versionList *spi.VersionList) (string, string, error) {
 	// If we're using the release controller, we're trying to do relative version selection.
 	// We'll confirm this in case things change in the future and just proceed with that assumption.
-	nextVersion, err := common.NextReleaseAfterGivenVersionFromVersionList(versionList.Default(), versionList.AvailableVersions(), viper.GetInt(config.Upgrade.NextReleaseAfterProdDefaultForUpgrade))
+	nextVersion, err := common.NextReleaseAfterGivenVersionFromVersionList(versionList.Default(), versionList.AvailableVersions(), cfg.Upgrade.NextReleaseAfterProdDefaultForUpgrade)
 
 	if err != nil {
 		return "", "", fmt.Errorf("error determining next version to upgrade to: %v", err)
@@ -48,52 +41,25 @@ func (r releaseControllerUpgrade) SelectVersion(installVersion *semver.Version,
 
 	releaseStream := fmt.Sprintf("%d.%d.0-0.nightly", nextVersion.Major(), nextVersion.Minor())
 
-	return latestReleaseFromReleaseController(releaseStream)
-}
-
-// latestAccepted information from release controller.
-type latestAccepted struct {
-	Name        string `json:"name"`
-	PullSpec    string `json:"pullSpec"`
-	DownloadURL string `json:"downloadURL"`
+	return latestReleaseFromReleaseController(cfg, releaseStream)
 }
 
-// latestReleaseFromReleaseController retrieves latest release information for given releaseStream on the release controller.
-func latestReleaseFromReleaseController(releaseStream string) (name, pullSpec string, err error) {
-	var resp *http.Response
-	var data []byte
-
-	latestURL := fmt.Sprintf(latestReleaseControllerURLFmt, releaseStream)
-	resp, err = http.Get(latestURL)
-	if err != nil {
-		err = fmt.Errorf("failed to get latest for stream '%s': %v", releaseStream, err)
-		return
-	}
-
-	data, err = ioutil.ReadAll(resp.Body)
+// latestReleaseFromReleaseController retrieves the newest release of the given releaseStream on the release controller
+// that is in the configured phase and old enough.
+func latestReleaseFromReleaseController(cfg *config.RunConfig, releaseStream string) (name, pullSpec string, err error) {
+	minAge := time.Duration(cfg.ReleaseController.MinAgeInHours) * time.Hour
+	latest, err := releasecontroller.NewClient(cfg).LatestOlderThan(releaseStream, cfg.ReleaseController.Phase, minAge)
 	if err != nil {
-		err = fmt.Errorf("failed reading body: %v", err)
-		return
-	}
-
-	latest := latestAccepted{}
-	if err = json.Unmarshal(data, &latest); err != nil {
-		return "", "", fmt.Errorf("error decoding body of '%s': %v", data, err)
+		return "", "", fmt.Errorf("failed to get latest for stream '%s': %v", releaseStream, err)
 	}
 
 	metadata.Instance.SetUpgradeVersionSource("release controller")
 
-	if latest.Name == "" {
-		return util.NoVersionFound, "", nil
-	}
-
-	releaseName := ensureReleasePrefix(latest.Name)
-
-	if releaseStream == "" {
+	if latest == nil {
 		return util.NoVersionFound, "", nil
 	}
 
-	return releaseName, latest.PullSpec, err
+	return ensureReleasePrefix(latest.Name), latest.PullSpec, nil
 }
 
 func ensureReleasePrefix(release string) string {
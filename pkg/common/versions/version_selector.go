@@ -5,6 +5,7 @@ import (
 	"math"
 
 	"github.com/Masterminds/semver"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/spi"
 	"github.com/openshift/osde2e/pkg/common/util"
 	"github.com/openshift/osde2e/pkg/common/versions/installselectors"
@@ -12,7 +13,7 @@ import (
 )
 
 // GetVersionForInstall will get a version based upon available configuration options.
-func GetVersionForInstall(versionList *spi.VersionList) (*semver.Version, string, error) {
+func GetVersionForInstall(cfg *config.RunConfig, versionList *spi.VersionList) (*semver.Version, string, error) {
 	var selectedVersionSelector installselectors.Interface = nil
 
 	curPriority := math.MinInt32
@@ -20,7 +21,7 @@ func GetVersionForInstall(versionList *spi.VersionList) (*semver.Version, string
 	versionSelectors := installselectors.GetVersionSelectors()
 
 	for _, versionSelector := range versionSelectors {
-		if versionSelector.ShouldUse() && versionSelector.Priority() > curPriority {
+		if versionSelector.ShouldUse(cfg) && versionSelector.Priority() > curPriority {
 			selectedVersionSelector = versionSelector
 			curPriority = versionSelector.Priority()
 		}
@@ -30,11 +31,11 @@ func GetVersionForInstall(versionList *spi.VersionList) (*semver.Version, string
 		return nil, "", fmt.Errorf("unable to find an install version selector")
 	}
 
-	return selectedVersionSelector.SelectVersion(versionList)
+	return selectedVersionSelector.SelectVersion(cfg, versionList)
 }
 
 // GetVersionForUpgrade will get a version based upon available configuration options.
-func GetVersionForUpgrade(installVersion *semver.Version, versionList *spi.VersionList, upgradeSource spi.UpgradeSource) (string, string, error) {
+func GetVersionForUpgrade(cfg *config.RunConfig, installVersion *semver.Version, versionList *spi.VersionList, upgradeSource spi.UpgradeSource) (string, string, error) {
 	var selectedVersionSelector upgradeselectors.Interface = nil
 
 	curPriority := math.MinInt32
@@ -42,7 +43,7 @@ func GetVersionForUpgrade(installVersion *semver.Version, versionList *spi.Versi
 	versionSelectors := upgradeselectors.GetVersionSelectors()
 
 	for _, versionSelector := range versionSelectors {
-		if versionSelector.ShouldUse(upgradeSource) && versionSelector.Priority() > curPriority {
+		if versionSelector.ShouldUse(cfg, upgradeSource) && versionSelector.Priority() > curPriority {
 			selectedVersionSelector = versionSelector
 			curPriority = versionSelector.Priority()
 		}
@@ -53,7 +54,7 @@ func GetVersionForUpgrade(installVersion *semver.Version, versionList *spi.Versi
 		return "", "", nil
 	}
 
-	releaseName, image, err := selectedVersionSelector.SelectVersion(installVersion, versionList)
+	releaseName, image, err := selectedVersionSelector.SelectVersion(cfg, installVersion, versionList)
 
 	if releaseName == "" && err == nil {
 		return util.NoVersionFound, "", nil
@@ -1,23 +1,26 @@
 package versions
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/Masterminds/semver"
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/metadata"
 	"github.com/openshift/osde2e/pkg/common/providers"
 	"github.com/openshift/osde2e/pkg/common/spi"
+	"github.com/openshift/osde2e/pkg/common/upgrade"
 	"github.com/openshift/osde2e/pkg/common/util"
-	"github.com/spf13/viper"
+	"github.com/openshift/osde2e/pkg/common/versions/upgradeselectors"
 )
 
 // ChooseVersions sets versions in cfg if not set based on defaults and upgrade options.
 // If a release stream is set for an upgrade the previous available version is used and it's image is used for upgrade.
-func ChooseVersions() (err error) {
-	provider, err := providers.ClusterProvider()
+func ChooseVersions(ctx context.Context, cfg *config.RunConfig) (err error) {
+	provider, err := providers.ClusterProvider(cfg)
 	if err != nil {
 		return fmt.Errorf("error getting cluster provider: %v", err)
 	}
@@ -25,19 +28,19 @@ func ChooseVersions() (err error) {
 	if provider == nil {
 		err = errors.New("osd must be setup when upgrading with release stream")
 	} else {
-		versionList, err := provider.Versions()
+		versionList, err := provider.Versions(ctx)
 
 		if err != nil {
 			return fmt.Errorf("error getting versions: %v", err)
 		}
 
-		clusterVersion, err := setupVersion(versionList)
+		clusterVersion, err := setupVersion(cfg, versionList)
 
 		if err != nil {
 			return fmt.Errorf("error while selecting install version: %v", err)
 		}
 
-		err = setupUpgradeVersion(clusterVersion, versionList)
+		err = setupUpgradeVersion(cfg, provider, clusterVersion, versionList)
 
 		if err != nil {
 			return fmt.Errorf("error while selecting upgrade version: %v", err)
@@ -45,26 +48,26 @@ func ChooseVersions() (err error) {
 	}
 
 	// Set the versions in metadata. If upgrade hasn't been chosen, it should still be omitted from the end result.
-	metadata.Instance.SetClusterVersion(viper.GetString(config.Cluster.Version))
-	metadata.Instance.SetUpgradeVersion(viper.GetString(config.Upgrade.ReleaseName))
+	metadata.Instance.SetClusterVersion(cfg.State.ClusterVersion)
+	metadata.Instance.SetUpgradeVersion(cfg.State.UpgradeReleaseName)
 
 	return err
 }
 
 // chooses between default version and nightly based on target versions.
-func setupVersion(versionList *spi.VersionList) (*semver.Version, error) {
+func setupVersion(cfg *config.RunConfig, versionList *spi.VersionList) (*semver.Version, error) {
 	var selectedVersion *semver.Version
 
 	versionType := "user supplied version"
 
-	clusterVersion := viper.GetString(config.Cluster.Version)
+	clusterVersion := cfg.State.ClusterVersion
 	if len(clusterVersion) == 0 {
 		var err error
 
-		selectedVersion, versionType, err = GetVersionForInstall(versionList)
+		selectedVersion, versionType, err = GetVersionForInstall(cfg, versionList)
 		if err == nil {
-			if viper.GetBool(config.Cluster.EnoughVersionsForOldestOrMiddleTest) && viper.GetBool(config.Cluster.PreviousVersionFromDefaultFound) {
-				viper.Set(config.Cluster.Version, util.SemverToOpenshiftVersion(selectedVersion))
+			if cfg.State.EnoughVersionsForOldestOrMiddleTest && cfg.State.PreviousVersionFromDefaultFound {
+				cfg.State.ClusterVersion = util.SemverToOpenshiftVersion(selectedVersion)
 				clusterVersion = util.SemverToOpenshiftVersion(selectedVersion)
 			} else {
 				log.Printf("Unable to get the %s.", versionType)
@@ -92,14 +95,27 @@ func setupVersion(versionList *spi.VersionList) (*semver.Version, error) {
 }
 
 // chooses version based on optimal upgrade path
-func setupUpgradeVersion(clusterVersion *semver.Version, versionList *spi.VersionList) error {
-	var err error
-	provider, err := providers.ClusterProvider()
-	if err != nil {
-		return fmt.Errorf("error getting cluster provider: %v", err)
+func setupUpgradeVersion(cfg *config.RunConfig, provider spi.Provider, clusterVersion *semver.Version, versionList *spi.VersionList) error {
+	if cfg.Upgrade.Path != "" {
+		path, err := upgrade.ParsePath(cfg.Upgrade.Path)
+		if err != nil {
+			return err
+		}
+
+		last := path[len(path)-1]
+		cfg.State.UpgradePath = path
+		cfg.State.UpgradeReleaseName = last.ReleaseName
+		cfg.State.UpgradeImage = last.Image
+
+		log.Printf("Using user supplied upgrade path with %d hops ending at '%s'.", len(path), last.ReleaseName)
+		return nil
 	}
-	if viper.GetString(config.Upgrade.ReleaseName) != "" || viper.GetString(config.Upgrade.Image) != "" {
+
+	if cfg.State.UpgradeReleaseName != "" || cfg.State.UpgradeImage != "" {
 		log.Printf("Using user supplied upgrade state.")
+		if cfg.Upgrade.PathFromCincinnati && clusterVersion != nil && len(cfg.State.UpgradePath) == 0 {
+			return setupCincinnatiUpgradePath(cfg, clusterVersion)
+		}
 		return nil
 	}
 
@@ -109,7 +125,7 @@ func setupUpgradeVersion(clusterVersion *semver.Version, versionList *spi.Versio
 	}
 
 	upgradeSource := provider.UpgradeSource()
-	releaseName, image, err := GetVersionForUpgrade(clusterVersion, versionList, upgradeSource)
+	releaseName, image, err := GetVersionForUpgrade(cfg, clusterVersion, versionList, upgradeSource)
 
 	if err != nil {
 		return fmt.Errorf("error selecting an upgrade version: %v", err)
@@ -120,11 +136,44 @@ func setupUpgradeVersion(clusterVersion *semver.Version, versionList *spi.Versio
 		return nil
 	}
 
-	viper.Set(config.Upgrade.ReleaseName, releaseName)
-	viper.Set(config.Upgrade.Image, image)
+	cfg.State.UpgradeReleaseName = releaseName
+	cfg.State.UpgradeImage = image
 
 	// set upgrade image
 	log.Printf("Selecting version '%s' to be able to upgrade to '%s' using upgrade source '%s'",
-		viper.GetString(config.Cluster.Version), releaseName, upgradeSource)
+		cfg.State.ClusterVersion, releaseName, upgradeSource)
+
+	if cfg.Upgrade.PathFromCincinnati && releaseName != util.NoVersionFound && !cfg.State.UpgradeVersionEqualToInstallVersion {
+		return setupCincinnatiUpgradePath(cfg, clusterVersion)
+	}
+	return nil
+}
+
+// setupCincinnatiUpgradePath upgrades to the chosen release along the shortest path through the Cincinnati graph.
+func setupCincinnatiUpgradePath(cfg *config.RunConfig, clusterVersion *semver.Version) error {
+	if cfg.State.UpgradeImage != "" {
+		log.Printf("Upgrading straight to image '%s' as it may not be in Cincinnati.", cfg.State.UpgradeImage)
+		return nil
+	}
+
+	upgradeVersion, err := util.OpenshiftVersionToSemver(cfg.State.UpgradeReleaseName)
+	if err != nil {
+		return fmt.Errorf("upgrade version %s is invalid: %v", cfg.State.UpgradeReleaseName, err)
+	}
+
+	versions, err := upgradeselectors.CincinnatiPath(cfg, clusterVersion, upgradeVersion)
+	if err != nil {
+		return fmt.Errorf("error finding an upgrade path in Cincinnati: %v", err)
+	}
+
+	path := make([]config.UpgradeTarget, len(versions))
+	releaseNames := make([]string, len(versions))
+	for i, version := range versions {
+		releaseNames[i] = util.SemverToOpenshiftVersion(version)
+		path[i] = config.UpgradeTarget{ReleaseName: releaseNames[i]}
+	}
+	cfg.State.UpgradePath = path
+
+	log.Printf("Upgrading through Cincinnati path %s", strings.Join(releaseNames, " -> "))
 	return nil
 }
@@ -14,17 +14,16 @@ import (
 	"github.com/google/go-github/v31/github"
 	"github.com/kylelemons/godebug/diff"
 	"github.com/openshift/osde2e/pkg/common/config"
-	"github.com/spf13/viper"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // GenerateDiff attempts to pull a dependency list from a previous job (job, jobID) and generate a diff against a provided string
-func GenerateDiff(phase, dependencies string) error {
-	baseJobURL := viper.GetString(config.BaseJobURL)
-	baseProwURL := viper.GetString(config.BaseProwURL)
-	jobName := viper.GetString(config.JobName)
+func GenerateDiff(cfg *config.RunConfig, phase, dependencies string) error {
+	baseJobURL := cfg.BaseJobURL
+	baseProwURL := cfg.BaseProwURL
+	jobName := cfg.JobName
 
 	jobID, err := getLastJobID(baseProwURL, jobName)
 	if err != nil {
@@ -2,19 +2,18 @@ package addons
 
 import (
 	"github.com/onsi/ginkgo"
-	"github.com/spf13/viper"
 
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 )
 
-var _ = ginkgo.Describe("[Suite: addons] Addon Test Harness", func() {
+var _ = helper.Describe("[Suite: addons] Addon Test Harness", func(cfg *config.RunConfig) {
 	defer ginkgo.GinkgoRecover()
-	h := helper.New()
+	h := helper.New(cfg)
 
 	addonTimeoutInSeconds := 3600
 	ginkgo.It("should run until completion", func() {
-		h.SetServiceAccount(viper.GetString(config.Addons.TestUser))
+		h.SetServiceAccount(h.Config().Addons.TestUser)
 		h.RunAddonTests("addon-tests", []string{})
 	}, float64(addonTimeoutInSeconds+30))
 })
@@ -0,0 +1,192 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+)
+
+// checkpointFile is the name of the file in the report directory recording how far a run has got.
+const checkpointFile = "checkpoint.json"
+
+// stage is a point in a run that can be resumed from.
+type stage string
+
+const (
+	// stageClusterProvisioned is reached once the cluster is ready.
+	stageClusterProvisioned stage = "cluster-provisioned"
+
+	// stageAddonsInstalled is reached once any addons have been installed on the cluster.
+	stageAddonsInstalled stage = "addons-installed"
+
+	// stageInstallTests is reached once the install phase tests have run.
+	stageInstallTests stage = "install-tests"
+
+	// stageUpgradeTriggered is reached once the cluster has acknowledged an upgrade request. It is reached once
+	// for every hop of an upgrade path.
+	stageUpgradeTriggered stage = "upgrade-triggered"
+
+	// stageUpgradeTests is reached once the upgrade phase tests have run. It is reached once for every hop of an
+	// upgrade path.
+	stageUpgradeTests stage = "upgrade-tests"
+)
+
+// stages are all stages in the order they're reached. The upgrade stages are repeated for each hop.
+var stages = []stage{
+	stageClusterProvisioned,
+	stageAddonsInstalled,
+	stageInstallTests,
+	stageUpgradeTriggered,
+	stageUpgradeTests,
+}
+
+// checkpoint is the progress of a run, which is written to the report directory after each stage. A run
+// resumed from a checkpoint reuses its cluster, project and suffix and skips every stage already completed.
+//
+// The kubeconfig isn't included as the report directory is often published. It is fetched again using the
+// cluster ID when resuming.
+type checkpoint struct {
+	// Stage is the last stage completed.
+	Stage stage `json:"stage"`
+
+	// Hop is the hop of the upgrade path the last stage completed was for, counting from 1.
+	Hop int `json:"hop,omitempty"`
+
+	// Updated is when the checkpoint was written.
+	Updated time.Time `json:"updated"`
+
+	ClusterID          string `json:"clusterID"`
+	ClusterName        string `json:"clusterName"`
+	ClusterVersion     string `json:"clusterVersion"`
+	CloudProviderID    string `json:"cloudProviderID"`
+	Region             string `json:"region"`
+	Project            string `json:"project"`
+	Suffix             string `json:"suffix"`
+	UpgradeReleaseName string `json:"upgradeReleaseName"`
+	UpgradeImage       string `json:"upgradeImage"`
+
+	// UpgradePath is every release the cluster is being upgraded through.
+	UpgradePath []config.UpgradeTarget `json:"upgradePath,omitempty"`
+
+	// InstallTestsPassed is whether the install phase tests passed.
+	InstallTestsPassed bool `json:"installTestsPassed"`
+
+	// UpgradeTestsPassed is whether the upgrade phase tests of every hop so far passed.
+	UpgradeTestsPassed bool `json:"upgradeTestsPassed"`
+
+	// Upgrade is the update the cluster was last asked to upgrade to.
+	Upgrade *configv1.Update `json:"upgrade,omitempty"`
+
+	// UpgradeStarted is when the upgrade was requested.
+	UpgradeStarted time.Time `json:"upgradeStarted"`
+}
+
+// readCheckpoint reads the checkpoint in a report directory.
+func readCheckpoint(reportDir string) (*checkpoint, error) {
+	data, err := ioutil.ReadFile(filepath.Join(reportDir, checkpointFile))
+	if err != nil {
+		return nil, fmt.Errorf("error reading checkpoint: %v", err)
+	}
+
+	c := &checkpoint{}
+	if err = json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("error parsing checkpoint: %v", err)
+	}
+
+	if c.index(c.Stage) < 0 {
+		return nil, fmt.Errorf("checkpoint has unknown stage %q", c.Stage)
+	}
+	return c, nil
+}
+
+// completed returns true if s has already been completed. hop is the hop of the upgrade path upgrade stages are
+// for, and is ignored for other stages.
+func (c *checkpoint) completed(s stage, hop int) bool {
+	return c.Stage != "" && c.position(s, hop) <= c.position(c.Stage, c.Hop)
+}
+
+// position is where s is reached in a run, counting the upgrade stages once for every hop.
+func (c *checkpoint) position(s stage, hop int) int {
+	i := c.index(s)
+	if firstUpgradeStage := c.index(stageInstallTests) + 1; i >= firstUpgradeStage && hop > 1 {
+		i += (hop - 1) * (len(stages) - firstUpgradeStage)
+	}
+	return i
+}
+
+func (c *checkpoint) index(s stage) int {
+	for i, known := range stages {
+		if known == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// save records that s has been completed for the given hop with the given state and writes the checkpoint to
+// the report directory. The file is replaced atomically so that a run dying mid-write leaves the previous checkpoint.
+func (c *checkpoint) save(s stage, hop int, state *config.RunState) error {
+	c.Stage = s
+	c.Hop = hop
+	c.Updated = time.Now()
+	c.ClusterID = state.ClusterID
+	c.ClusterName = state.ClusterName
+	c.ClusterVersion = state.ClusterVersion
+	c.CloudProviderID = state.CloudProviderID
+	c.Region = state.Region
+	c.Project = state.Project
+	c.Suffix = state.Suffix
+	c.UpgradeReleaseName = state.UpgradeReleaseName
+	c.UpgradeImage = state.UpgradeImage
+	c.UpgradePath = state.UpgradePath
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling checkpoint: %v", err)
+	}
+
+	path := filepath.Join(state.ReportDir, checkpointFile)
+	if err = ioutil.WriteFile(path+".tmp", data, 0644); err != nil {
+		return fmt.Errorf("error writing checkpoint: %v", err)
+	}
+
+	if err = os.Rename(path+".tmp", path); err != nil {
+		return fmt.Errorf("error replacing checkpoint: %v", err)
+	}
+
+	if hop > 1 {
+		log.Printf("Checkpoint: %s (hop %d)", s, hop)
+	} else {
+		log.Printf("Checkpoint: %s", s)
+	}
+	return nil
+}
+
+// restore sets the state of a run to that of the checkpoint. The upgrade is restored to the end of the upgrade
+// path, rather than the hop in progress, as that is what the run is upgrading to.
+func (c *checkpoint) restore(state *config.RunState) {
+	state.ClusterID = c.ClusterID
+	state.ClusterName = c.ClusterName
+	state.ClusterVersion = c.ClusterVersion
+	state.CloudProviderID = c.CloudProviderID
+	state.Region = c.Region
+	state.Project = c.Project
+	state.Suffix = c.Suffix
+	state.UpgradeReleaseName = c.UpgradeReleaseName
+	state.UpgradeImage = c.UpgradeImage
+	state.UpgradePath = c.UpgradePath
+
+	if len(c.UpgradePath) > 0 {
+		last := c.UpgradePath[len(c.UpgradePath)-1]
+		state.UpgradeReleaseName = last.ReleaseName
+		state.UpgradeImage = last.Image
+	}
+}
@@ -0,0 +1,116 @@
+package e2e
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+)
+
+func TestCheckpoint(t *testing.T) {
+	reportDir, err := ioutil.TempDir("", "checkpoint")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(reportDir)
+
+	if _, err = readCheckpoint(reportDir); err == nil {
+		t.Errorf("expected an error reading a missing checkpoint")
+	}
+
+	state := &config.RunState{
+		ReportDir:      reportDir,
+		ClusterID:      "abc123",
+		ClusterVersion: "openshift-v4.5.2",
+		Project:        "osde2e-xyz",
+		Suffix:         "q1w",
+	}
+
+	c := &checkpoint{}
+	if c.completed(stageClusterProvisioned, 0) {
+		t.Errorf("expected a new checkpoint to have no completed stages")
+	}
+
+	c.InstallTestsPassed = true
+	c.Upgrade = &configv1.Update{Version: "4.5.3"}
+	if err = c.save(stageUpgradeTriggered, 1, state); err != nil {
+		t.Fatalf("error saving checkpoint: %v", err)
+	}
+
+	read, err := readCheckpoint(reportDir)
+	if err != nil {
+		t.Fatalf("error reading checkpoint: %v", err)
+	}
+
+	for _, s := range []stage{stageClusterProvisioned, stageAddonsInstalled, stageInstallTests, stageUpgradeTriggered} {
+		if !read.completed(s, 1) {
+			t.Errorf("expected stage %s to be completed", s)
+		}
+	}
+	if read.completed(stageUpgradeTests, 1) {
+		t.Errorf("expected stage %s not to be completed", stageUpgradeTests)
+	}
+
+	if !read.InstallTestsPassed || read.Upgrade == nil || read.Upgrade.Version != "4.5.3" {
+		t.Errorf("expected the test results and upgrade to be saved, got %+v", read)
+	}
+
+	resumed := &config.RunState{}
+	read.restore(resumed)
+	if resumed.ClusterID != state.ClusterID || resumed.ClusterVersion != state.ClusterVersion ||
+		resumed.Project != state.Project || resumed.Suffix != state.Suffix {
+		t.Errorf("expected the state to be restored, got %+v", resumed)
+	}
+}
+
+func TestCheckpointUpgradeHops(t *testing.T) {
+	reportDir, err := ioutil.TempDir("", "checkpoint")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(reportDir)
+
+	path := []config.UpgradeTarget{
+		{ReleaseName: "openshift-v4.6.8"},
+		{ReleaseName: "openshift-v4.7.2", Image: "quay.io/openshift-release-dev/ocp-release:4.7.2"},
+	}
+	state := &config.RunState{
+		ReportDir:          reportDir,
+		UpgradeReleaseName: path[0].ReleaseName,
+		UpgradePath:        path,
+	}
+
+	c := &checkpoint{}
+	if err = c.save(stageUpgradeTests, 1, state); err != nil {
+		t.Fatalf("error saving checkpoint: %v", err)
+	}
+
+	if !c.completed(stageInstallTests, 0) || !c.completed(stageUpgradeTests, 1) {
+		t.Errorf("expected the install tests and first hop to be completed")
+	}
+	if c.completed(stageUpgradeTriggered, 2) || c.completed(stageUpgradeTests, 2) {
+		t.Errorf("expected the second hop not to be completed")
+	}
+
+	if err = c.save(stageUpgradeTriggered, 2, state); err != nil {
+		t.Fatalf("error saving checkpoint: %v", err)
+	}
+
+	read, err := readCheckpoint(reportDir)
+	if err != nil {
+		t.Fatalf("error reading checkpoint: %v", err)
+	}
+
+	if !read.completed(stageUpgradeTests, 1) || !read.completed(stageUpgradeTriggered, 2) || read.completed(stageUpgradeTests, 2) {
+		t.Errorf("expected the second hop to have been triggered, got stage %s of hop %d", read.Stage, read.Hop)
+	}
+
+	resumed := &config.RunState{}
+	read.restore(resumed)
+	if len(resumed.UpgradePath) != 2 || resumed.UpgradeReleaseName != path[1].ReleaseName || resumed.UpgradeImage != path[1].Image {
+		t.Errorf("expected the upgrade to be restored to the end of the path, got %+v", resumed)
+	}
+}
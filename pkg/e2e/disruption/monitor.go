@@ -0,0 +1,295 @@
+package disruption
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/onsi/ginkgo/reporters"
+
+	"github.com/openshift/osde2e/pkg/common/metadata"
+)
+
+const (
+	// probeInterval is how often each workload is probed.
+	probeInterval = time.Second
+
+	// probeTimeout is how long a probe can take before the workload is considered unavailable.
+	probeTimeout = 3 * time.Second
+
+	// JUnitFile is the name of the JUnit file in a phase's report directory recording whether budgets were exceeded.
+	JUnitFile = "junit_disruption.xml"
+
+	// ReportFile is the name of the file in a phase's report directory recording when workloads were disrupted.
+	ReportFile = "disruption.json"
+)
+
+// Monitor continuously probes workloads, recording the windows in which each was unavailable.
+type Monitor struct {
+	now     func() time.Time
+	started time.Time
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+
+	mutex   sync.Mutex
+	results map[string]*Result
+	order   []string
+}
+
+// Report is the disruption of every workload during an upgrade.
+type Report struct {
+	Started   time.Time `json:"started"`
+	Ended     time.Time `json:"ended"`
+	Workloads []Result  `json:"workloads"`
+}
+
+// Result is the disruption of a single workload.
+type Result struct {
+	Name string `json:"name"`
+
+	// DeployError is why the workload couldn't be deployed, if it wasn't.
+	DeployError string `json:"deployError,omitempty"`
+
+	// BudgetSeconds is how long the workload could be disrupted for.
+	BudgetSeconds float64 `json:"budgetSeconds"`
+
+	// DisruptionSeconds is how long the workload was unavailable for.
+	DisruptionSeconds float64 `json:"disruptionSeconds"`
+
+	Probes   int      `json:"probes"`
+	Failures int      `json:"failures"`
+	Windows  []Window `json:"windows"`
+}
+
+// Window is a period a workload was unavailable, from the first failed probe to the next successful one.
+type Window struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+
+	// Error is the error of the first failed probe.
+	Error string `json:"error"`
+
+	open bool
+}
+
+// Start begins probing the workloads until the monitor is stopped.
+func Start(ctx context.Context, workloads []*Workload) *Monitor {
+	m := newMonitor(workloads, time.Now)
+
+	probeCtx, cancel := context.WithCancel(ctx)
+	m.cancel = cancel
+	for _, workload := range workloads {
+		if workload.DeployError != nil {
+			continue
+		}
+
+		m.wg.Add(1)
+		go func(workload *Workload) {
+			defer m.wg.Done()
+			m.probe(probeCtx, workload)
+		}(workload)
+	}
+	return m
+}
+
+func newMonitor(workloads []*Workload, now func() time.Time) *Monitor {
+	m := &Monitor{
+		now:     now,
+		started: now(),
+		results: map[string]*Result{},
+	}
+	for _, workload := range workloads {
+		m.results[workload.Name] = &Result{
+			Name:          workload.Name,
+			BudgetSeconds: workload.Budget.Seconds(),
+			Windows:       []Window{},
+		}
+		if workload.DeployError != nil {
+			m.results[workload.Name].DeployError = workload.DeployError.Error()
+		}
+		m.order = append(m.order, workload.Name)
+	}
+	return m
+}
+
+func (m *Monitor) probe(ctx context.Context, workload *Workload) {
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+
+	for {
+		probeCtx, cancel := context.WithTimeout(ctx, probeTimeout)
+		err := workload.Probe(probeCtx)
+		cancel()
+
+		// A probe interrupted by stopping the monitor says nothing about the workload.
+		if ctx.Err() != nil {
+			return
+		}
+		m.record(workload.Name, m.now(), err)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// record adds the outcome of a probe, opening a window when a workload becomes unavailable and closing it
+// once the workload is available again.
+func (m *Monitor) record(name string, at time.Time, err error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	result := m.results[name]
+	result.Probes++
+
+	var current *Window
+	if n := len(result.Windows); n > 0 && result.Windows[n-1].open {
+		current = &result.Windows[n-1]
+	}
+
+	switch {
+	case err != nil && current == nil:
+		result.Failures++
+		result.Windows = append(result.Windows, Window{Start: at, End: at, Error: err.Error(), open: true})
+	case err != nil:
+		result.Failures++
+		current.End = at
+	case current != nil:
+		current.End = at
+		current.open = false
+		result.DisruptionSeconds += current.End.Sub(current.Start).Seconds()
+	}
+}
+
+// Stop stops probing and returns the disruption of each workload. Workloads still unavailable are counted as
+// disrupted until now.
+func (m *Monitor) Stop() *Report {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.wg.Wait()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	report := &Report{Started: m.started, Ended: m.now(), Workloads: []Result{}}
+	for _, name := range m.order {
+		result := *m.results[name]
+		result.Windows = append([]Window{}, result.Windows...)
+		if n := len(result.Windows); n > 0 && result.Windows[n-1].open {
+			window := &result.Windows[n-1]
+			window.End = report.Ended
+			window.open = false
+			result.DisruptionSeconds += window.End.Sub(window.Start).Seconds()
+		}
+		report.Workloads = append(report.Workloads, result)
+	}
+	return report
+}
+
+// Passed returns true if every workload was deployed and none was disrupted for longer than its budget.
+func (r *Report) Passed() bool {
+	for _, result := range r.Workloads {
+		if result.Failed() {
+			return false
+		}
+	}
+	return true
+}
+
+// Exceeded returns true if the workload was disrupted for longer than its budget.
+func (r Result) Exceeded() bool {
+	return r.DisruptionSeconds > r.BudgetSeconds
+}
+
+// Failed returns true if the workload couldn't be deployed or was disrupted for longer than its budget.
+func (r Result) Failed() bool {
+	return r.DeployError != "" || r.Exceeded()
+}
+
+// StoreMetadata records how long each deployed workload was disrupted for in the metadata.
+func (r *Report) StoreMetadata() {
+	for _, result := range r.Workloads {
+		if result.DeployError != "" {
+			continue
+		}
+		metadata.Instance.SetWorkloadDisruption(result.Name, result.DisruptionSeconds)
+	}
+}
+
+// JUnit returns the report as a JUnit test suite. A workload fails if it couldn't be deployed or was disrupted for
+// longer than its budget.
+func (r *Report) JUnit() reporters.JUnitTestSuite {
+	suite := reporters.JUnitTestSuite{
+		Name: "Workload Disruption",
+	}
+
+	for _, result := range r.Workloads {
+		testCase := reporters.JUnitTestCase{
+			ClassName: "Workload Disruption",
+			Name:      fmt.Sprintf("[Disruption] %s", result.Name),
+			Time:      r.Ended.Sub(r.Started).Seconds(),
+		}
+
+		message := fmt.Sprintf("Disrupted for %.0fs of a %.0fs budget in %d windows, failed %d of %d probes",
+			result.DisruptionSeconds, result.BudgetSeconds, len(result.Windows), result.Failures, result.Probes)
+		if result.DeployError != "" {
+			testCase.FailureMessage = &reporters.JUnitFailureMessage{
+				Type:    "Disruption",
+				Message: fmt.Sprintf("Workload couldn't be deployed: %s", result.DeployError),
+			}
+			suite.Failures++
+		} else if result.Exceeded() {
+			if n := len(result.Windows); n > 0 {
+				message = fmt.Sprintf("%s, last error: %s", message, result.Windows[n-1].Error)
+			}
+			testCase.FailureMessage = &reporters.JUnitFailureMessage{
+				Type:    "Disruption",
+				Message: message,
+			}
+			suite.Failures++
+		} else {
+			testCase.PassedMessage = &reporters.JUnitPassedMessage{Message: message}
+		}
+
+		suite.Tests++
+		suite.Time += testCase.Time
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	return suite
+}
+
+// WriteJUnit writes the report as a JUnit XML file.
+func (r *Report) WriteJUnit(filename string) error {
+	suite := r.JUnit()
+
+	data, err := xml.Marshal(&suite)
+	if err != nil {
+		return fmt.Errorf("error marshalling disruption report: %v", err)
+	}
+
+	if err = ioutil.WriteFile(filename, data, 0644); err != nil {
+		return fmt.Errorf("error writing disruption report: %v", err)
+	}
+	return nil
+}
+
+// WriteJSON writes the report as a JSON file.
+func (r *Report) WriteJSON(filename string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling disruption windows: %v", err)
+	}
+
+	if err = ioutil.WriteFile(filename, data, 0644); err != nil {
+		return fmt.Errorf("error writing disruption windows: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package disruption
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestMonitorWindows(t *testing.T) {
+	started := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := started
+	at := func(seconds int) time.Time { return started.Add(time.Duration(seconds) * time.Second) }
+
+	m := newMonitor([]*Workload{
+		{Name: "tcp", Budget: 10 * time.Second},
+		{Name: "http", Budget: 30 * time.Second},
+	}, func() time.Time { return now })
+
+	unavailable := fmt.Errorf("connection refused")
+	probes := []struct {
+		seconds int
+		tcp     error
+		http    error
+	}{
+		{0, nil, nil},
+		{5, unavailable, nil},
+		{6, unavailable, unavailable},
+		{12, nil, unavailable},
+		{20, unavailable, nil},
+		{25, nil, unavailable},
+	}
+	for _, probe := range probes {
+		m.record("tcp", at(probe.seconds), probe.tcp)
+		m.record("http", at(probe.seconds), probe.http)
+	}
+
+	// http is still unavailable when the monitor stops.
+	now = at(40)
+	report := m.Stop()
+
+	tcp, http := report.Workloads[0], report.Workloads[1]
+	if tcp.Name != "tcp" || tcp.DisruptionSeconds != 12 || len(tcp.Windows) != 2 || tcp.Failures != 3 || tcp.Probes != 6 {
+		t.Errorf("expected tcp to be disrupted for 12s in 2 windows: %+v", tcp)
+	}
+	if window := tcp.Windows[0]; !window.Start.Equal(at(5)) || !window.End.Equal(at(12)) || window.Error != "connection refused" {
+		t.Errorf("unexpected first tcp window: %+v", window)
+	}
+	if http.DisruptionSeconds != 29 || !http.Windows[1].End.Equal(at(40)) {
+		t.Errorf("expected http to be disrupted for 29s, including until the monitor stopped: %+v", http)
+	}
+
+	if !tcp.Exceeded() || http.Exceeded() || report.Passed() {
+		t.Errorf("expected only tcp to exceed its budget")
+	}
+
+	suite := report.JUnit()
+	if suite.Tests != 2 || suite.Failures != 1 || suite.TestCases[0].FailureMessage == nil || suite.TestCases[1].PassedMessage == nil {
+		t.Errorf("expected tcp to fail and http to pass: %+v", suite)
+	}
+	if suite.TestCases[0].Name != "[Disruption] tcp" || suite.TestCases[0].Time != 40 {
+		t.Errorf("unexpected test case: %+v", suite.TestCases[0])
+	}
+}
+
+func TestMonitorUndeployedWorkloads(t *testing.T) {
+	workloads := []*Workload{
+		{Name: "tcp", Budget: 10 * time.Second},
+		{Name: "http", DeployError: fmt.Errorf("no load balancer")},
+	}
+	m := newMonitor(workloads, time.Now)
+	m.record("tcp", time.Now(), nil)
+	report := m.Stop()
+
+	if report.Passed() || !report.Workloads[1].Failed() || report.Workloads[0].Failed() {
+		t.Errorf("expected only the undeployed workload to fail: %+v", report.Workloads)
+	}
+
+	suite := report.JUnit()
+	if suite.Tests != 2 || suite.Failures != 1 || suite.TestCases[1].FailureMessage == nil ||
+		suite.TestCases[1].FailureMessage.Message != "Workload couldn't be deployed: no load balancer" {
+		t.Errorf("expected the undeployed workload to be a failed test case: %+v", suite)
+	}
+}
@@ -0,0 +1,421 @@
+// Package disruption deploys probe workloads and measures how long upgrades disrupt them.
+package disruption
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	kubev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/openshift/osde2e/pkg/common/helper"
+)
+
+const (
+	// tcpPort is the port the TCP workload listens on.
+	tcpPort = 9000
+
+	// httpPort is the port the HTTP and stateful workloads listen on.
+	httpPort = 8080
+
+	// deployTimeout is how long each workload has to become available.
+	deployTimeout = 10 * time.Minute
+
+	// tcpServer accepts connections and replies to each with "ok".
+	tcpServer = `import socket
+s = socket.socket()
+s.setsockopt(socket.SOL_SOCKET, socket.SO_REUSEADDR, 1)
+s.bind(("", 9000))
+s.listen(16)
+while True:
+    c, _ = s.accept()
+    c.sendall(b"ok\n")
+    c.close()
+`
+
+	// statefulServer writes a marker to its volume the first time it starts and serves the volume.
+	statefulServer = `[ -f /data/marker ] || date +%s%N > /data/marker; exec python3 -m http.server 8080 --directory /data`
+)
+
+// Workload is a probe workload whose availability is measured during upgrades.
+type Workload struct {
+	// Name identifies the workload in reports and metadata.
+	Name string
+
+	// Budget is how long an upgrade can disrupt the workload for.
+	Budget time.Duration
+
+	// Probe returns an error if the workload is unavailable.
+	Probe func(ctx context.Context) error
+
+	// DeployError is why the workload couldn't be deployed. Undeployed workloads aren't probed and fail the report.
+	DeployError error
+}
+
+// probeClient makes a new connection for every probe, so each one measures whether the workload can be reached.
+var probeClient = &http.Client{
+	Timeout: probeTimeout,
+	Transport: &http.Transport{
+		TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+		DisableKeepAlives: true,
+	},
+}
+
+// Deploy deploys the probe workloads and waits for them to become available. A workload that can't be deployed,
+// for instance because the cluster can't provision load balancers, is returned with its DeployError set.
+func Deploy(ctx context.Context, cfg *config.RunConfig) ([]*Workload, error) {
+	h := helper.NewOutsideGinkgo(cfg)
+	if h == nil {
+		return nil, fmt.Errorf("Unable to generate helper outside ginkgo")
+	}
+
+	namespace := &kubev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: cfg.Disruption.Namespace}}
+	if _, err := h.Kube().CoreV1().Namespaces().Create(ctx, namespace, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("error creating namespace %s: %v", cfg.Disruption.Namespace, err)
+	}
+
+	deployers := []struct {
+		name   string
+		deploy func(context.Context, *helper.H, *config.RunConfig) (*Workload, error)
+	}{
+		{"tcp", deployTCP},
+		{"http", deployHTTP},
+		{"stateful", deployStateful},
+	}
+
+	var workloads []*Workload
+	for _, deployer := range deployers {
+		deployCtx, cancel := context.WithTimeout(ctx, deployTimeout)
+		workload, err := deployer.deploy(deployCtx, h, cfg)
+		cancel()
+		if err != nil {
+			log.Printf("Unable to deploy disruption workload %s: %v", deployer.name, err)
+			workloads = append(workloads, &Workload{Name: deployer.name, DeployError: err})
+			continue
+		}
+
+		log.Printf("Deployed disruption workload %s", workload.Name)
+		workloads = append(workloads, workload)
+	}
+	return workloads, nil
+}
+
+// Delete removes the probe workloads.
+func Delete(ctx context.Context, cfg *config.RunConfig) error {
+	h := helper.NewOutsideGinkgo(cfg)
+	if h == nil {
+		return fmt.Errorf("Unable to generate helper outside ginkgo")
+	}
+
+	if err := h.Kube().CoreV1().Namespaces().Delete(ctx, cfg.Disruption.Namespace, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting namespace %s: %v", cfg.Disruption.Namespace, err)
+	}
+	return nil
+}
+
+// deployTCP deploys a replicated TCP server behind a load balancer.
+func deployTCP(ctx context.Context, h *helper.H, cfg *config.RunConfig) (*Workload, error) {
+	name, namespace := "tcp", cfg.Disruption.Namespace
+
+	container := probeContainer(cfg, []string{"python3", "-c", tcpServer}, tcpPort)
+	container.ReadinessProbe.Handler = kubev1.Handler{TCPSocket: &kubev1.TCPSocketAction{Port: intstr.FromInt(tcpPort)}}
+	if err := createReplicated(ctx, h, namespace, name, container); err != nil {
+		return nil, err
+	}
+
+	service := &kubev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: kubev1.ServiceSpec{
+			Type:     kubev1.ServiceTypeLoadBalancer,
+			Selector: labels(name),
+			Ports:    []kubev1.ServicePort{{Port: tcpPort, TargetPort: intstr.FromInt(tcpPort)}},
+		},
+	}
+	if err := create(h.Kube().CoreV1().Services(namespace).Create(ctx, service, metav1.CreateOptions{})); err != nil {
+		return nil, fmt.Errorf("error creating %s service: %v", name, err)
+	}
+
+	var address string
+	err := wait.PollImmediateUntil(10*time.Second, func() (bool, error) {
+		service, err := h.Kube().CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil || len(service.Status.LoadBalancer.Ingress) == 0 {
+			return false, nil
+		}
+
+		ingress := service.Status.LoadBalancer.Ingress[0]
+		host := ingress.Hostname
+		if host == "" {
+			host = ingress.IP
+		}
+		address = net.JoinHostPort(host, fmt.Sprint(tcpPort))
+		return true, nil
+	}, ctx.Done())
+	if err != nil {
+		return nil, fmt.Errorf("load balancer for %s wasn't provisioned: %v", name, err)
+	}
+
+	workload := &Workload{
+		Name:   name,
+		Budget: time.Duration(cfg.Disruption.TCPBudgetInSeconds) * time.Second,
+		Probe: func(ctx context.Context) error {
+			conn, err := (&net.Dialer{Timeout: probeTimeout}).DialContext(ctx, "tcp", address)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			conn.SetDeadline(time.Now().Add(probeTimeout))
+			reply, err := bufio.NewReader(conn).ReadString('\n')
+			if err != nil {
+				return err
+			}
+			if reply != "ok\n" {
+				return fmt.Errorf("unexpected reply %q", reply)
+			}
+			return nil
+		},
+	}
+	return workload, waitForProbe(ctx, workload)
+}
+
+// deployHTTP deploys a replicated HTTP server behind a route.
+func deployHTTP(ctx context.Context, h *helper.H, cfg *config.RunConfig) (*Workload, error) {
+	name, namespace := "http", cfg.Disruption.Namespace
+
+	container := probeContainer(cfg, []string{"python3", "-m", "http.server", fmt.Sprint(httpPort)}, httpPort)
+	if err := createReplicated(ctx, h, namespace, name, container); err != nil {
+		return nil, err
+	}
+
+	url, err := createRoute(ctx, h, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	workload := &Workload{
+		Name:   name,
+		Budget: time.Duration(cfg.Disruption.HTTPBudgetInSeconds) * time.Second,
+		Probe: func(ctx context.Context) error {
+			_, err := get(ctx, url)
+			return err
+		},
+	}
+	return workload, waitForProbe(ctx, workload)
+}
+
+// deployStateful deploys a single HTTP server serving a marker from a persistent volume. The marker is written
+// the first time the server starts, so losing the volume's data is a disruption too.
+func deployStateful(ctx context.Context, h *helper.H, cfg *config.RunConfig) (*Workload, error) {
+	name, namespace := "stateful", cfg.Disruption.Namespace
+
+	container := probeContainer(cfg, []string{"sh", "-c", statefulServer}, httpPort)
+	container.VolumeMounts = []kubev1.VolumeMount{{Name: "data", MountPath: "/data"}}
+
+	replicas := int32(1)
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    &replicas,
+			ServiceName: name,
+			Selector:    &metav1.LabelSelector{MatchLabels: labels(name)},
+			Template: kubev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels(name)},
+				Spec:       kubev1.PodSpec{Containers: []kubev1.Container{container}},
+			},
+			VolumeClaimTemplates: []kubev1.PersistentVolumeClaim{{
+				ObjectMeta: metav1.ObjectMeta{Name: "data"},
+				Spec: kubev1.PersistentVolumeClaimSpec{
+					AccessModes: []kubev1.PersistentVolumeAccessMode{kubev1.ReadWriteOnce},
+					Resources: kubev1.ResourceRequirements{
+						Requests: kubev1.ResourceList{kubev1.ResourceStorage: resource.MustParse("1Gi")},
+					},
+				},
+			}},
+		},
+	}
+	if err := create(h.Kube().AppsV1().StatefulSets(namespace).Create(ctx, statefulSet, metav1.CreateOptions{})); err != nil {
+		return nil, fmt.Errorf("error creating %s statefulset: %v", name, err)
+	}
+
+	url, err := createRoute(ctx, h, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	url += "/marker"
+
+	var marker string
+	workload := &Workload{
+		Name:   name,
+		Budget: time.Duration(cfg.Disruption.StatefulBudgetInSeconds) * time.Second,
+		Probe: func(ctx context.Context) error {
+			body, err := get(ctx, url)
+			if err != nil {
+				return err
+			}
+
+			// The first marker seen is the one that should survive the upgrade.
+			if marker == "" {
+				marker = body
+			} else if body != marker {
+				return fmt.Errorf("marker changed from %q to %q, data was lost", marker, body)
+			}
+			return nil
+		},
+	}
+	return workload, waitForProbe(ctx, workload)
+}
+
+// probeContainer returns a container running the probe image which is ready once port accepts HTTP requests.
+func probeContainer(cfg *config.RunConfig, command []string, port int) kubev1.Container {
+	return kubev1.Container{
+		Name:    "probe",
+		Image:   cfg.Disruption.Image,
+		Command: command,
+		Ports:   []kubev1.ContainerPort{{ContainerPort: int32(port)}},
+		ReadinessProbe: &kubev1.Probe{
+			Handler:       kubev1.Handler{HTTPGet: &kubev1.HTTPGetAction{Path: "/", Port: intstr.FromInt(port)}},
+			PeriodSeconds: 2,
+		},
+	}
+}
+
+// createReplicated creates a deployment that is spread across nodes and protected from losing every replica at once,
+// as customers are advised to run their workloads.
+func createReplicated(ctx context.Context, h *helper.H, namespace, name string, container kubev1.Container) error {
+	replicas := int32(2)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels(name)},
+			Template: kubev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels(name)},
+				Spec: kubev1.PodSpec{
+					Containers: []kubev1.Container{container},
+					Affinity: &kubev1.Affinity{
+						PodAntiAffinity: &kubev1.PodAntiAffinity{
+							PreferredDuringSchedulingIgnoredDuringExecution: []kubev1.WeightedPodAffinityTerm{{
+								Weight: 100,
+								PodAffinityTerm: kubev1.PodAffinityTerm{
+									LabelSelector: &metav1.LabelSelector{MatchLabels: labels(name)},
+									TopologyKey:   "kubernetes.io/hostname",
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	}
+	if err := create(h.Kube().AppsV1().Deployments(namespace).Create(ctx, deployment, metav1.CreateOptions{})); err != nil {
+		return fmt.Errorf("error creating %s deployment: %v", name, err)
+	}
+
+	minAvailable := intstr.FromInt(1)
+	budget := &policyv1beta1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: policyv1beta1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector:     &metav1.LabelSelector{MatchLabels: labels(name)},
+		},
+	}
+	if err := create(h.Kube().PolicyV1beta1().PodDisruptionBudgets(namespace).Create(ctx, budget, metav1.CreateOptions{})); err != nil {
+		return fmt.Errorf("error creating %s pod disruption budget: %v", name, err)
+	}
+	return nil
+}
+
+// createRoute creates a service and an edge terminated route for a workload and returns the route's URL.
+func createRoute(ctx context.Context, h *helper.H, namespace, name string) (string, error) {
+	service := &kubev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: kubev1.ServiceSpec{
+			Selector: labels(name),
+			Ports:    []kubev1.ServicePort{{Port: httpPort, TargetPort: intstr.FromInt(httpPort)}},
+		},
+	}
+	if err := create(h.Kube().CoreV1().Services(namespace).Create(ctx, service, metav1.CreateOptions{})); err != nil {
+		return "", fmt.Errorf("error creating %s service: %v", name, err)
+	}
+
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: routev1.RouteSpec{
+			To:  routev1.RouteTargetReference{Name: name},
+			TLS: &routev1.TLSConfig{Termination: routev1.TLSTerminationEdge},
+		},
+	}
+	if err := create(h.Route().RouteV1().Routes(namespace).Create(ctx, route, metav1.CreateOptions{})); err != nil {
+		return "", fmt.Errorf("error creating %s route: %v", name, err)
+	}
+
+	route, err := h.Route().RouteV1().Routes(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("error getting %s route: %v", name, err)
+	}
+	return fmt.Sprintf("https://%s", route.Spec.Host), nil
+}
+
+// waitForProbe waits for a newly deployed workload to become available.
+func waitForProbe(ctx context.Context, workload *Workload) error {
+	var probeErr error
+	err := wait.PollImmediateUntil(10*time.Second, func() (bool, error) {
+		probeErr = workload.Probe(ctx)
+		return probeErr == nil, nil
+	}, ctx.Done())
+	if err != nil {
+		return fmt.Errorf("%s didn't become available: %v", workload.Name, probeErr)
+	}
+	return nil
+}
+
+// get returns the body of a successful HTTP GET.
+func get(ctx context.Context, url string) (string, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := probeClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+	return strings.TrimSpace(string(body)), nil
+}
+
+// create ignores objects that already exist, so a resumed run can deploy the workloads again.
+func create(_ interface{}, err error) error {
+	if apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+func labels(name string) map[string]string {
+	return map[string]string{"app": "osde2e-disruption-" + name}
+}
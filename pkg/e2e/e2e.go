@@ -4,9 +4,11 @@ package e2e
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"math"
@@ -17,14 +19,12 @@ import (
 	"time"
 
 	"github.com/hpcloud/tail"
-	vegeta "github.com/tsenart/vegeta/lib"
 
 	"github.com/onsi/ginkgo"
 	ginkgoConfig "github.com/onsi/ginkgo/config"
 	"github.com/onsi/ginkgo/reporters"
 	"github.com/onsi/gomega"
 	. "github.com/onsi/gomega"
-	"github.com/spf13/viper"
 
 	"github.com/openshift/osde2e/pkg/common/aws"
 	"github.com/openshift/osde2e/pkg/common/cluster"
@@ -39,6 +39,7 @@ import (
 	"github.com/openshift/osde2e/pkg/common/upgrade"
 	"github.com/openshift/osde2e/pkg/common/util"
 	"github.com/openshift/osde2e/pkg/debug"
+	"github.com/openshift/osde2e/pkg/e2e/disruption"
 	"github.com/openshift/osde2e/pkg/e2e/routemonitors"
 )
 
@@ -48,11 +49,28 @@ const (
 
 	// buildLog is the name of the build log file.
 	buildLog string = "test_output.log"
+
+	// cleanupTimeout is how long post-test cleanup is allowed to take, even if the run has been cancelled.
+	cleanupTimeout = 1 * time.Hour
 )
 
 // provisioner is used to deploy and manage clusters.
 var provider spi.Provider
 
+// runContext is the context of the current run. Ginkgo setup nodes can't accept arguments, so it is
+// stored here by runGinkgoTests. It is cancelled when the run should stop, e.g. on SIGTERM.
+var runContext = context.Background()
+
+// runConfig is the config of the current run. Like runContext, it is stored here by runGinkgoTests.
+var runConfig *config.RunConfig
+
+// runCheckpoint is the progress of the current run. Like runContext, it is stored here by runGinkgoTests.
+var runCheckpoint = &checkpoint{}
+
+// installRouteMonitors monitor routes during the install phase. They need the cluster's kubeconfig, so they're
+// started by SynchronizedBeforeSuite once the cluster is set up, and stopped by runGinkgoTests.
+var installRouteMonitors *routemonitors.RouteMonitors
+
 // --- BEGIN Ginkgo setup
 // Check if the test should run
 var _ = ginkgo.BeforeEach(func() {
@@ -60,8 +78,7 @@ var _ = ginkgo.BeforeEach(func() {
 	testContext := strings.TrimSpace(strings.TrimSuffix(ginkgo.CurrentGinkgoTestDescription().FullTestText, testText))
 
 	shouldRun := false
-	testsToRun := viper.GetStringSlice(config.Tests.TestsToRun)
-	for _, testToRun := range testsToRun {
+	for _, testToRun := range runConfig.Tests.TestsToRun {
 		if strings.HasPrefix(testContext, testToRun) {
 			shouldRun = true
 			break
@@ -77,50 +94,58 @@ var _ = ginkgo.BeforeEach(func() {
 var _ = ginkgo.SynchronizedBeforeSuite(func() []byte {
 	defer ginkgo.GinkgoRecover()
 
+	cfg := runConfig
+
 	// Skip provisioning if we already have a kubeconfig
-	if viper.GetString(config.Kubeconfig.Contents) == "" {
-		cluster, err := cluster.SetupCluster(nil)
+	if cfg.State.Kubeconfig == "" {
+		cluster, err := cluster.SetupCluster(runContext, cfg, nil)
 		events.HandleErrorWithEvents(err, events.InstallSuccessful, events.InstallFailed).ShouldNot(HaveOccurred(), "failed to setup cluster for testing")
 		if err != nil {
 			return []byte{}
 		}
 
-		viper.Set(config.Cluster.ID, cluster.ID())
-		log.Printf("CLUSTER_ID set to %s from OCM.", viper.GetString(config.Cluster.ID))
+		cfg.State.ClusterID = cluster.ID()
+		log.Printf("CLUSTER_ID set to %s from OCM.", cfg.State.ClusterID)
 
-		viper.Set(config.Cluster.Name, cluster.Name())
-		log.Printf("CLUSTER_NAME set to %s from OCM.", viper.GetString(config.Cluster.Name))
+		cfg.State.ClusterName = cluster.Name()
+		log.Printf("CLUSTER_NAME set to %s from OCM.", cfg.State.ClusterName)
 
-		viper.Set(config.Cluster.Version, cluster.Version())
-		log.Printf("CLUSTER_VERSION set to %s from OCM.", viper.GetString(config.Cluster.Version))
+		cfg.State.ClusterVersion = cluster.Version()
+		log.Printf("CLUSTER_VERSION set to %s from OCM.", cfg.State.ClusterVersion)
 
-		viper.Set(config.CloudProvider.CloudProviderID, cluster.CloudProvider())
-		log.Printf("CLOUD_PROVIDER_ID set to %s from OCM.", viper.GetString(config.CloudProvider.CloudProviderID))
+		cfg.State.CloudProviderID = cluster.CloudProvider()
+		log.Printf("CLOUD_PROVIDER_ID set to %s from OCM.", cfg.State.CloudProviderID)
 
-		viper.Set(config.CloudProvider.Region, cluster.Region())
-		log.Printf("CLOUD_PROVIDER_REGION set to %s from OCM.", viper.GetString(config.CloudProvider.Region))
+		cfg.State.Region = cluster.Region()
+		log.Printf("CLOUD_PROVIDER_REGION set to %s from OCM.", cfg.State.Region)
 
 		log.Printf("Found addons: %s", strings.Join(cluster.Addons(), ","))
 
 		metadata.Instance.SetClusterName(cluster.Name())
 		metadata.Instance.SetClusterID(cluster.ID())
 
-		if len(viper.GetString(config.Addons.IDs)) > 0 {
-			err = installAddons()
+		saveCheckpoint(cfg, stageClusterProvisioned, 0)
+
+		if runCheckpoint.completed(stageAddonsInstalled, 0) {
+			log.Printf("Addons were installed before the run was resumed, skipping them.")
+		} else if len(cfg.Addons.IDs) > 0 {
+			err = installAddons(runContext, cfg)
 			events.HandleErrorWithEvents(err, events.InstallAddonsSuccessful, events.InstallAddonsFailed).ShouldNot(HaveOccurred(), "failed while installing addons")
 			if err != nil {
 				return []byte{}
 			}
 		}
 
+		saveCheckpoint(cfg, stageAddonsInstalled, 0)
+
 		var kubeconfigBytes []byte
-		if kubeconfigBytes, err = provider.ClusterKubeconfig(cluster.ID()); err != nil {
+		if kubeconfigBytes, err = provider.ClusterKubeconfig(runContext, cluster.ID()); err != nil {
 			events.HandleErrorWithEvents(err, events.InstallKubeconfigRetrievalSuccess, events.InstallKubeconfigRetrievalFailure).ShouldNot(HaveOccurred(), "failed while retrieve kubeconfig")
 			return []byte{}
 		}
-		viper.Set(config.Kubeconfig.Contents, string(kubeconfigBytes))
+		cfg.State.Kubeconfig = string(kubeconfigBytes)
 
-		if len(viper.GetString(config.Kubeconfig.Contents)) == 0 {
+		if len(cfg.State.Kubeconfig) == 0 {
 			// Give the cluster some breathing room.
 			log.Println("OSD cluster installed. Sleeping for 600s.")
 			time.Sleep(600 * time.Second)
@@ -129,6 +154,11 @@ var _ = ginkgo.SynchronizedBeforeSuite(func() []byte {
 		}
 	}
 
+	if cfg.State.Phase == phase.InstallPhase && cfg.RouteMonitors.DuringInstall && !cfg.DryRun && installRouteMonitors == nil {
+		// monitors are only started during install when asked for, so not being able to create them fails the phase
+		installRouteMonitors = startRouteMonitors(cfg, true)
+	}
+
 	return []byte{}
 }, func(data []byte) {
 	// only needs to run once
@@ -142,13 +172,13 @@ var _ = ginkgo.JustAfterEach(getLogs)
 func getLogs() {
 	defer ginkgo.GinkgoRecover()
 
-	clusterID := viper.GetString(config.Cluster.ID)
+	clusterID := runConfig.State.ClusterID
 	if provider == nil {
 		log.Println("OSD was not configured. Skipping log collection...")
 	} else if clusterID == "" {
 		log.Println("CLUSTER_ID is not set, likely due to a setup failure. Skipping log collection...")
 	} else {
-		logs, err := provider.Logs(clusterID)
+		logs, err := provider.Logs(runContext, clusterID)
 		Expect(err).NotTo(HaveOccurred(), "failed to collect cluster logs")
 		writeLogs(logs)
 	}
@@ -157,7 +187,7 @@ func getLogs() {
 func writeLogs(m map[string][]byte) {
 	for k, v := range m {
 		name := k + "-log.txt"
-		filePath := filepath.Join(viper.GetString(config.ReportDir), name)
+		filePath := filepath.Join(runConfig.State.ReportDir, name)
 		err := ioutil.WriteFile(filePath, v, os.ModePerm)
 		Expect(err).NotTo(HaveOccurred(), "failed to write log '%s'", filePath)
 	}
@@ -165,14 +195,14 @@ func writeLogs(m map[string][]byte) {
 */
 
 // installAddons installs addons onto the cluster
-func installAddons() (err error) {
-	clusterID := viper.GetString(config.Cluster.ID)
-	num, err := provider.InstallAddons(clusterID, strings.Split(viper.GetString(config.Addons.IDs), ","))
+func installAddons(ctx context.Context, cfg *config.RunConfig) (err error) {
+	clusterID := cfg.State.ClusterID
+	num, err := provider.InstallAddons(ctx, clusterID, strings.Split(cfg.Addons.IDs, ","))
 	if err != nil {
 		return fmt.Errorf("could not install addons: %s", err.Error())
 	}
 	if num > 0 {
-		if err = cluster.WaitForClusterReady(clusterID, nil); err != nil {
+		if err = cluster.WaitForClusterReady(ctx, cfg, clusterID, nil); err != nil {
 			return fmt.Errorf("failed waiting for cluster ready: %v", err)
 		}
 	}
@@ -180,13 +210,200 @@ func installAddons() (err error) {
 	return nil
 }
 
+// saveCheckpoint records that the run has completed s for the given upgrade hop, unless a resumed run already
+// had. Failing to write a checkpoint only stops the run from being resumed, so it isn't fatal.
+func saveCheckpoint(cfg *config.RunConfig, s stage, hop int) {
+	if runCheckpoint.completed(s, hop) {
+		return
+	}
+
+	if err := runCheckpoint.save(s, hop, cfg.State); err != nil {
+		log.Printf("Unable to save checkpoint: %v", err)
+	}
+}
+
+// runUpgrade performs the given hop of the upgrade. If the run being resumed had already triggered the hop, it
+// waits for that upgrade rather than requesting another.
+func runUpgrade(ctx context.Context, cfg *config.RunConfig, hop int) error {
+	// Upgrade modes change the cluster in ways that can't be resumed part way through.
+	if cfg.Upgrade.Mode != "" {
+		return upgrade.RunMode(ctx, cfg)
+	}
+
+	if runCheckpoint.completed(stageUpgradeTriggered, hop) && runCheckpoint.Upgrade != nil {
+		log.Printf("Resuming upgrade to %s started at %v", runCheckpoint.Upgrade.Version, runCheckpoint.UpgradeStarted)
+	} else {
+		upgradeStarted := time.Now()
+		desired, err := upgrade.StartUpgrade(ctx, cfg)
+		if err != nil {
+			return err
+		}
+
+		runCheckpoint.Upgrade = desired
+		runCheckpoint.UpgradeStarted = upgradeStarted
+		saveCheckpoint(cfg, stageUpgradeTriggered, hop)
+	}
+
+	return upgrade.WaitForUpgrade(ctx, cfg, runCheckpoint.Upgrade, runCheckpoint.UpgradeStarted)
+}
+
+// runUpgradePath upgrades the cluster through each release of the upgrade path, running the upgrade phase tests
+// after every hop. It returns whether the tests of every hop passed.
+func runUpgradePath(ctx context.Context, cfg *config.RunConfig, path []config.UpgradeTarget) (bool, error) {
+	if _, err := upgrade.ParseMode(cfg.Upgrade.Mode); err != nil {
+		return false, err
+	} else if cfg.Upgrade.Mode != "" && len(path) > 1 {
+		return false, fmt.Errorf("upgrade mode %s can't be used with an upgrade path of %d releases", cfg.Upgrade.Mode, len(path))
+	}
+
+	// Images are only checked before the cluster has been touched.
+	if cfg.Upgrade.Preflight && !runCheckpoint.completed(stageUpgradeTriggered, 1) {
+		if err := preflightUpgradePath(ctx, cfg, path); err != nil {
+			events.RecordEvent(events.UpgradePreflightFailed)
+			return false, err
+		}
+	}
+
+	// Whichever hop the run gets to, it is reported as an upgrade to the end of the path.
+	last := path[len(path)-1]
+	defer func() {
+		cfg.State.UpgradeReleaseName = last.ReleaseName
+		cfg.State.UpgradeImage = last.Image
+	}()
+
+	testsPassed := true
+	if runCheckpoint.completed(stageUpgradeTests, 1) {
+		testsPassed = runCheckpoint.UpgradeTestsPassed
+	}
+
+	var workloads []*disruption.Workload
+	if cfg.Disruption.Enabled && !runCheckpoint.completed(stageUpgradeTests, len(path)) {
+		var err error
+		if workloads, err = disruption.Deploy(ctx, cfg); err != nil {
+			log.Printf("Unable to measure workload disruption: %v", err)
+			testsPassed = false
+		}
+		defer func() {
+			// Cleanup gets its own context so that it still happens when the run has been cancelled.
+			cleanupCtx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+			defer cancel()
+			if err := disruption.Delete(cleanupCtx, cfg); err != nil {
+				log.Printf("Error deleting disruption workloads: %v", err)
+			}
+		}()
+	}
+
+	for i, target := range path {
+		hop := i + 1
+		hopPhase := phase.UpgradeHopPhase(hop, len(path))
+		description := "OSD e2e suite post-upgrade"
+
+		cfg.State.UpgradeReleaseName = target.ReleaseName
+		cfg.State.UpgradeImage = target.Image
+		if len(path) > 1 {
+			log.Printf("Upgrade hop %d of %d to %s", hop, len(path), target.ReleaseName)
+			metadata.Instance.SetUpgradeHop(hopPhase, target.ReleaseName)
+			description = fmt.Sprintf("%s %d of %d", description, hop, len(path))
+		}
+
+		if runCheckpoint.completed(stageUpgradeTests, hop) {
+			log.Printf("Tests for %s were run before the run was resumed, skipping them.", hopPhase)
+			continue
+		}
+
+		if ctx.Err() != nil {
+			log.Printf("Run was cancelled, skipping upgrade: %v", ctx.Err())
+			return false, nil
+		}
+
+		// Anything reported while upgrading, like the upgrade timeline, belongs to this hop's phase.
+		cfg.State.Phase = hopPhase
+
+		var disruptionMonitor *disruption.Monitor
+		if len(workloads) > 0 {
+			disruptionMonitor = disruption.Start(ctx, workloads)
+		}
+
+		err := runUpgrade(ctx, cfg, hop)
+		if disruptionMonitor != nil {
+			testsPassed = writeDisruptionReport(cfg, disruptionMonitor.Stop()) && testsPassed
+		}
+
+		if err != nil {
+			events.RecordEvent(events.UpgradeFailed)
+			// A cancelled run should still gather results and clean up after itself.
+			if ctx.Err() == nil {
+				return false, fmt.Errorf("error performing upgrade to %s: %v", target.ReleaseName, err)
+			}
+			log.Printf("Upgrade interrupted: %v", err)
+			return false, nil
+		}
+		events.RecordEvent(events.UpgradeSuccessful)
+
+		log.Println("Running e2e tests POST-UPGRADE...")
+		testsPassed = runTestsInPhase(ctx, cfg, hopPhase, description) && testsPassed
+		if ctx.Err() == nil {
+			runCheckpoint.UpgradeTestsPassed = testsPassed
+			saveCheckpoint(cfg, stageUpgradeTests, hop)
+		}
+	}
+
+	return testsPassed, nil
+}
+
+// preflightUpgradePath checks the release image of every hop of the upgrade path that has one can be upgraded
+// to from the release before it.
+func preflightUpgradePath(ctx context.Context, cfg *config.RunConfig, path []config.UpgradeTarget) error {
+	from := cfg.State.ClusterVersion
+	for _, target := range path {
+		if target.Image != "" {
+			if err := upgrade.Preflight(ctx, cfg, from, target); err != nil {
+				return err
+			}
+		}
+		from = target.ReleaseName
+	}
+	return nil
+}
+
+// writeDisruptionReport stores how long each workload was disrupted by an upgrade and writes the report to the
+// current phase's report directory. It returns whether every workload stayed within its budget.
+func writeDisruptionReport(cfg *config.RunConfig, report *disruption.Report) bool {
+	for _, result := range report.Workloads {
+		if result.DeployError != "" {
+			log.Printf("Workload %s wasn't deployed: %s", result.Name, result.DeployError)
+			continue
+		}
+		log.Printf("Workload %s was disrupted for %.0fs in %d windows (budget %.0fs)",
+			result.Name, result.DisruptionSeconds, len(result.Windows), result.BudgetSeconds)
+	}
+	report.StoreMetadata()
+
+	if cfg.State.ReportDir != "" {
+		phaseDirectory := filepath.Join(cfg.State.ReportDir, cfg.State.Phase)
+		if err := os.MkdirAll(phaseDirectory, os.ModePerm); err != nil {
+			log.Printf("Error creating phase directory %s: %v", phaseDirectory, err)
+		} else {
+			if err = report.WriteJUnit(filepath.Join(phaseDirectory, disruption.JUnitFile)); err != nil {
+				log.Printf("Error writing disruption report: %v", err)
+			}
+			if err = report.WriteJSON(filepath.Join(phaseDirectory, disruption.ReportFile)); err != nil {
+				log.Printf("Error writing disruption windows: %v", err)
+			}
+		}
+	}
+
+	return report.Passed()
+}
+
 // -- END Ginkgo setup
 
 // RunTests initializes Ginkgo and runs the osde2e test suite.
-func RunTests() bool {
+// Cancelling ctx stops any cluster provisioning or upgrade in progress. Cleanup and must-gather still run.
+func RunTests(ctx context.Context, cfg *config.RunConfig) bool {
 	testing.Init()
 
-	if err := runGinkgoTests(); err != nil {
+	if err := runGinkgoTests(ctx, cfg); err != nil {
 		log.Printf("Tests failed: %v", err)
 		return false
 	}
@@ -196,19 +413,33 @@ func RunTests() bool {
 
 // runGinkgoTests runs the osde2e test suite using Ginkgo.
 // nolint:gocyclo
-func runGinkgoTests() error {
+func runGinkgoTests(ctx context.Context, cfg *config.RunConfig) error {
 	var err error
 	gomega.RegisterFailHandler(ginkgo.Fail)
 
-	dryRun := viper.GetBool(config.DryRun)
+	runContext = ctx
+	runConfig = cfg
+	runCheckpoint = &checkpoint{}
+	helper.BuildTests(cfg)
 
-	ginkgoConfig.DefaultReporterConfig.NoisySkippings = !viper.GetBool(config.Tests.SuppressSkipNotifications)
-	ginkgoConfig.GinkgoConfig.SkipString = viper.GetString(config.Tests.GinkgoSkip)
-	ginkgoConfig.GinkgoConfig.FocusString = viper.GetString(config.Tests.GinkgoFocus)
+	// A resumed run carries on in the report directory of the run it's resuming.
+	if cfg.Resume != "" {
+		if runCheckpoint, err = readCheckpoint(cfg.Resume); err != nil {
+			return fmt.Errorf("unable to resume run: %v", err)
+		}
+		runCheckpoint.restore(cfg.State)
+		cfg.State.ReportDir = cfg.Resume
+	}
+
+	dryRun := cfg.DryRun
+
+	ginkgoConfig.DefaultReporterConfig.NoisySkippings = !cfg.Tests.SuppressSkipNotifications
+	ginkgoConfig.GinkgoConfig.SkipString = cfg.Tests.GinkgoSkip
+	ginkgoConfig.GinkgoConfig.FocusString = cfg.Tests.GinkgoFocus
 	ginkgoConfig.GinkgoConfig.DryRun = dryRun
 
 	// setup reporter
-	reportDir := viper.GetString(config.ReportDir)
+	reportDir := cfg.State.ReportDir
 	if reportDir == "" {
 		reportDir, err = ioutil.TempDir("", "")
 
@@ -217,14 +448,21 @@ func runGinkgoTests() error {
 		}
 
 		log.Printf("Writing files to temporary directory %s", reportDir)
-		viper.Set(config.ReportDir, reportDir)
-	} else if err = os.Mkdir(reportDir, os.ModePerm); err != nil {
+		cfg.State.ReportDir = reportDir
+	} else if err = os.MkdirAll(reportDir, os.ModePerm); err != nil {
 		log.Printf("Could not create reporter directory: %v", err)
 	}
 
 	// Redirect stdout to where we want it to go
 	buildLogPath := filepath.Join(reportDir, buildLog)
-	buildLogWriter, err := os.Create(buildLogPath)
+	buildLogFlags := os.O_RDWR | os.O_CREATE | os.O_TRUNC
+	tailLocation := &tail.SeekInfo{Whence: io.SeekStart}
+	if cfg.Resume != "" {
+		// Keep the log of the run being resumed, without echoing it again.
+		buildLogFlags = os.O_RDWR | os.O_CREATE | os.O_APPEND
+		tailLocation.Whence = io.SeekEnd
+	}
+	buildLogWriter, err := os.OpenFile(buildLogPath, buildLogFlags, 0666)
 
 	if err != nil {
 		return fmt.Errorf("unable to create build log in report directory: %v", err)
@@ -246,8 +484,9 @@ func runGinkgoTests() error {
 
 	// Tail the build log.
 	tail, err := tail.TailFile(buildLogPath, tail.Config{
-		Follow: true,
-		Logger: tail.DiscardingLogger,
+		Follow:   true,
+		Location: tailLocation,
+		Logger:   tail.DiscardingLogger,
 	})
 
 	if err != nil {
@@ -264,34 +503,40 @@ func runGinkgoTests() error {
 
 	log.Printf("Outputting log to build log at %s", buildLogPath)
 
+	if cfg.Resume != "" {
+		log.Printf("Resuming run for cluster %s after stage %s.", cfg.State.ClusterID, runCheckpoint.Stage)
+		metadata.Instance.SetClusterName(cfg.State.ClusterName)
+		metadata.Instance.SetClusterID(cfg.State.ClusterID)
+	}
+
 	// Get the cluster ID now to test against later
-	clusterID := viper.GetString(config.Cluster.ID)
+	clusterID := cfg.State.ClusterID
 	// setup OSD unless Kubeconfig is present
-	if len(viper.GetString(config.Kubeconfig.Path)) > 0 {
+	if len(cfg.Kubeconfig.Path) > 0 {
 		log.Print("Found an existing Kubeconfig!")
 	} else {
-		if provider, err = providers.ClusterProvider(); err != nil {
+		if provider, err = providers.ClusterProvider(cfg); err != nil {
 			return fmt.Errorf("could not setup cluster provider: %v", err)
 		}
 
 		metadata.Instance.SetEnvironment(provider.Environment())
 
 		// configure cluster and upgrade versions
-		if err = ChooseVersions(); err != nil {
+		if err = ChooseVersions(ctx, cfg); err != nil {
 			return fmt.Errorf("failed to configure versions: %v", err)
 		}
 
 		switch {
-		case !viper.GetBool(config.Cluster.EnoughVersionsForOldestOrMiddleTest):
+		case !cfg.State.EnoughVersionsForOldestOrMiddleTest:
 			log.Printf("There were not enough available cluster image sets to choose and oldest or middle cluster image set to test against. Skipping tests.")
 			return nil
-		case !viper.GetBool(config.Cluster.PreviousVersionFromDefaultFound):
+		case !cfg.State.PreviousVersionFromDefaultFound:
 			log.Printf("No previous version from default found with the given arguments.")
 			return nil
-		case viper.GetBool(config.Upgrade.UpgradeVersionEqualToInstallVersion):
+		case cfg.State.UpgradeVersionEqualToInstallVersion:
 			log.Printf("Install version and upgrade version are the same. Skipping tests.")
 			return nil
-		case viper.GetString(config.Upgrade.ReleaseName) == util.NoVersionFound:
+		case cfg.State.UpgradeReleaseName == util.NoVersionFound:
 			log.Printf("No valid upgrade versions were found. Skipping tests.")
 			return nil
 		}
@@ -300,12 +545,21 @@ func runGinkgoTests() error {
 		if len(clusterID) == 0 {
 			if dryRun {
 				log.Printf("This is a dry run. Skipping quota check.")
-			} else if enoughQuota, err := provider.CheckQuota(); err != nil {
+			} else if enoughQuota, err := provider.CheckQuota(ctx); err != nil {
 				log.Printf("Failed to check if enough quota is available: %v", err)
 			} else if !enoughQuota {
 				return fmt.Errorf("currently not enough quota exists to run this test")
 			}
 		}
+
+		// The cluster is only set up again before tests are run, which a resumed run may not do before upgrading.
+		if runCheckpoint.completed(stageInstallTests, 0) {
+			kubeconfigBytes, err := provider.ClusterKubeconfig(ctx, clusterID)
+			if err != nil {
+				return fmt.Errorf("unable to get kubeconfig to resume run: %v", err)
+			}
+			cfg.State.Kubeconfig = string(kubeconfigBytes)
+		}
 	}
 
 	// Update the metadata object to use the report directory.
@@ -313,29 +567,46 @@ func runGinkgoTests() error {
 
 	log.Println("Running e2e tests...")
 
-	if viper.GetString(config.Suffix) == "" {
-		viper.Set(config.Suffix, util.RandomStr(3))
+	if cfg.State.Suffix == "" {
+		cfg.State.Suffix = util.RandomStr(3)
 	}
 
-	testsPassed := runTestsInPhase(phase.InstallPhase, "OSD e2e suite")
-	upgradeTestsPassed := true
-
-	var routeMonitorChan chan struct{}
-	if viper.GetBool(config.Upgrade.MonitorRoutesDuringUpgrade) && !dryRun {
-		routeMonitorChan = setupRouteMonitors()
+	var testsPassed bool
+	if runCheckpoint.completed(stageInstallTests, 0) {
+		log.Println("Install phase tests were run before the run was resumed, skipping them.")
+		testsPassed = runCheckpoint.InstallTestsPassed
+	} else {
+		installRouteMonitors = nil
+		testsPassed = runTestsInPhase(ctx, cfg, phase.InstallPhase, "OSD e2e suite")
+		if installRouteMonitors != nil {
+			testsPassed = stopRouteMonitors(cfg, installRouteMonitors, false) && testsPassed
+			installRouteMonitors = nil
+		}
+		if ctx.Err() == nil {
+			runCheckpoint.InstallTestsPassed = testsPassed
+			saveCheckpoint(cfg, stageInstallTests, 0)
+		}
 	}
+	upgradeTestsPassed := true
 
 	// upgrade cluster if requested
-	if viper.GetString(config.Upgrade.Image) != "" || viper.GetString(config.Upgrade.ReleaseName) != "" {
-		if len(viper.GetString(config.Kubeconfig.Contents)) > 0 {
-			if err = upgrade.RunUpgrade(); err != nil {
-				events.RecordEvent(events.UpgradeFailed)
-				return fmt.Errorf("error performing upgrade: %v", err)
+	if path := upgrade.Path(cfg); len(path) > 0 {
+		if ctx.Err() != nil {
+			log.Printf("Run was cancelled, skipping upgrade: %v", ctx.Err())
+			upgradeTestsPassed = false
+		} else if len(cfg.State.Kubeconfig) > 0 {
+			var routeMonitors *routemonitors.RouteMonitors
+			if cfg.Upgrade.MonitorRoutesDuringUpgrade && !dryRun {
+				routeMonitors = startRouteMonitors(cfg, false)
 			}
-			events.RecordEvent(events.UpgradeSuccessful)
 
-			log.Println("Running e2e tests POST-UPGRADE...")
-			upgradeTestsPassed = runTestsInPhase(phase.UpgradePhase, "OSD e2e suite post-upgrade")
+			upgradeTestsPassed, err = runUpgradePath(ctx, cfg, path)
+			if routeMonitors != nil {
+				upgradeTestsPassed = stopRouteMonitors(cfg, routeMonitors, true) && upgradeTestsPassed
+			}
+			if err != nil {
+				return err
+			}
 		} else {
 			log.Println("No Kubeconfig found from initial cluster setup. Unable to run upgrade.")
 		}
@@ -347,9 +618,9 @@ func runGinkgoTests() error {
 		}
 
 		// TODO: SDA-2594 Hotfix
-		//checkBeforeMetricsGeneration()
+		//checkBeforeMetricsGeneration(reportDir)
 
-		newMetrics := NewMetrics()
+		newMetrics := NewMetrics(cfg)
 		if newMetrics == nil {
 			return fmt.Errorf("error getting new metrics provider")
 		}
@@ -358,44 +629,48 @@ func runGinkgoTests() error {
 			return fmt.Errorf("error while writing prometheus metrics: %v", err)
 		}
 
-		jobName := viper.GetString(config.JobName)
+		jobName := cfg.JobName
 		if jobName == "" {
 			log.Printf("Skipping metrics upload for local osde2e run.")
 		} else if strings.HasPrefix(jobName, "rehearse-") {
 			log.Printf("Job %s is a rehearsal, so metrics upload is being skipped.", jobName)
 		} else {
-			if err := uploadFileToMetricsBucket(filepath.Join(reportDir, prometheusFilename)); err != nil {
+			if err := uploadFileToMetricsBucket(cfg, filepath.Join(reportDir, prometheusFilename)); err != nil {
 				return fmt.Errorf("error while uploading prometheus metrics: %v", err)
 			}
 		}
 	}
 
-	if viper.GetBool(config.Upgrade.MonitorRoutesDuringUpgrade) && !dryRun {
-		close(routeMonitorChan)
-	}
+	// Cleanup gets its own context so that it still happens when the run has been cancelled.
+	cleanupCtx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+	defer cancel()
 
-	if viper.GetBool(config.Cluster.DestroyAfterTest) {
+	if cfg.Cluster.DestroyAfterTest {
 		log.Printf("Destroying cluster '%s'...", clusterID)
 
-		if err = provider.DeleteCluster(clusterID); err != nil {
+		if err = provider.DeleteCluster(cleanupCtx, clusterID); err != nil {
 			return fmt.Errorf("error deleting cluster: %s", err.Error())
 		}
 	} else {
 		// When using a local kubeconfig, provider might not be set
 		if provider != nil {
-			log.Printf("For debugging, please look for cluster ID %s in environment %s", viper.GetString(config.Cluster.ID), provider.Environment())
+			log.Printf("For debugging, please look for cluster ID %s in environment %s", cfg.State.ClusterID, provider.Environment())
 		}
 	}
 
 	if !dryRun {
-		h := helper.NewOutsideGinkgo()
+		h := helper.NewOutsideGinkgo(cfg)
 
 		if h == nil {
 			return fmt.Errorf("Unable to generate helper object for cleanup")
 		}
 
-		cleanupAfterE2E(h)
+		cleanupAfterE2E(cleanupCtx, cfg, h)
+
+	}
 
+	if ctx.Err() != nil {
+		return fmt.Errorf("run was cancelled: %v", ctx.Err())
 	}
 
 	if !testsPassed || !upgradeTestsPassed {
@@ -405,30 +680,32 @@ func runGinkgoTests() error {
 	return nil
 }
 
-func cleanupAfterE2E(h *helper.H) (errors []error) {
+func cleanupAfterE2E(ctx context.Context, cfg *config.RunConfig, h *helper.H) (errors []error) {
 	var err error
 	defer ginkgo.GinkgoRecover()
 
-	if viper.GetBool(config.MustGather) {
+	if cfg.MustGather {
 		log.Print("Running Must Gather...")
 		mustGatherTimeoutInSeconds := 1800
 		h.SetServiceAccount("system:serviceaccount:%s:cluster-admin")
 		r := h.Runner(fmt.Sprintf("oc adm must-gather --dest-dir=%v", runner.DefaultRunner.OutputDir))
 		r.Name = "must-gather"
 		r.Tarball = true
+		r.Transport = runner.SidecarTransport{}
 		stopCh := make(chan struct{})
+		defer r.Cleanup()
 		err := r.Run(mustGatherTimeoutInSeconds, stopCh)
 
 		if err != nil {
 			log.Printf("Error running must-gather: %s", err.Error())
-		} else {
-			gatherResults, err := r.RetrieveResults()
-			if err != nil {
-				log.Printf("Error retrieving must-gather results: %s", err.Error())
-			} else {
-				h.WriteResults(gatherResults)
-			}
 		}
+
+		// the sidecar keeps what must-gather collected even if it failed
+		gatherResults, err := r.RetrieveResults()
+		if err != nil {
+			log.Printf("Error retrieving must-gather results: %s", err.Error())
+		}
+		h.WriteResults(gatherResults)
 	}
 
 	log.Print("Gathering Test Project State...")
@@ -462,16 +739,16 @@ func cleanupAfterE2E(h *helper.H) (errors []error) {
 	log.Println("Writing cluster state results")
 	h.WriteResults(stateResults)
 
-	clusterID := viper.GetString(config.Cluster.ID)
+	clusterID := cfg.State.ClusterID
 	if len(clusterID) > 0 {
-		if provider, err = providers.ClusterProvider(); err != nil {
+		if provider, err = providers.ClusterProvider(cfg); err != nil {
 			log.Printf("Error getting cluster provider: %s", err.Error())
 		}
 
 		// Get state from Provisioner
 		log.Printf("Gathering cluster state from %s", provider.Type())
 
-		cluster, err := provider.GetCluster(clusterID)
+		cluster, err := provider.GetCluster(ctx, clusterID)
 		if err != nil {
 			log.Printf("error getting Cluster state: %s", err.Error())
 		} else {
@@ -487,8 +764,8 @@ func cleanupAfterE2E(h *helper.H) (errors []error) {
 	}
 
 	// Do any addon cleanup if configured
-	log.Printf("Addon cleanup: %v", viper.GetBool(config.Addons.RunCleanup))
-	if viper.GetBool(config.Addons.RunCleanup) {
+	log.Printf("Addon cleanup: %v", cfg.Addons.RunCleanup)
+	if cfg.Addons.RunCleanup {
 		log.Println("Running addon cleanup...")
 		h.RunAddonTests("addon-cleanup", []string{"cleanup"})
 	}
@@ -500,9 +777,9 @@ func cleanupAfterE2E(h *helper.H) (errors []error) {
 }
 
 // nolint:gocyclo
-func runTestsInPhase(phase string, description string) bool {
-	viper.Set(config.Phase, phase)
-	reportDir := viper.GetString(config.ReportDir)
+func runTestsInPhase(ctx context.Context, cfg *config.RunConfig, phase string, description string) bool {
+	cfg.State.Phase = phase
+	reportDir := cfg.State.ReportDir
 	phaseDirectory := filepath.Join(reportDir, phase)
 	if _, err := os.Stat(phaseDirectory); os.IsNotExist(err) {
 		if err := os.Mkdir(phaseDirectory, os.FileMode(0755)); err != nil {
@@ -510,7 +787,7 @@ func runTestsInPhase(phase string, description string) bool {
 			return false
 		}
 	}
-	suffix := viper.GetString(config.Suffix)
+	suffix := cfg.State.Suffix
 	phaseReportPath := filepath.Join(phaseDirectory, fmt.Sprintf("junit_%v.xml", suffix))
 	phaseReporter := reporters.NewJUnitReporter(phaseReportPath)
 	ginkgoPassed := false
@@ -559,7 +836,10 @@ func runTestsInPhase(phase string, description string) bool {
 						numPassingTests++
 					}
 
-					testSuite.TestCases[i].Name = fmt.Sprintf("[%s] %s", phase, testcase.Name)
+					// Results from before a run was resumed have already been renamed.
+					if !strings.HasPrefix(testcase.Name, fmt.Sprintf("[%s] ", phase)) {
+						testSuite.TestCases[i].Name = fmt.Sprintf("[%s] %s", phase, testcase.Name)
+					}
 				}
 
 				data, err = xml.Marshal(&testSuite)
@@ -636,20 +916,20 @@ func runTestsInPhase(phase string, description string) bool {
 		return false
 	}
 
-	clusterID := viper.GetString(config.Cluster.ID)
+	clusterID := cfg.State.ClusterID
 
 	clusterState := spi.ClusterStateUnknown
 
 	if clusterID != "" {
-		cluster, err := provider.GetCluster(clusterID)
+		cluster, err := provider.GetCluster(ctx, clusterID)
 		if err != nil {
 			log.Printf("error getting cluster state after a test run: %v", err)
 			return false
 		}
 		clusterState = cluster.State()
 	}
-	if !viper.GetBool(config.DryRun) && clusterState == spi.ClusterStateReady {
-		h := helper.NewOutsideGinkgo()
+	if !cfg.DryRun && clusterState == spi.ClusterStateReady {
+		h := helper.NewOutsideGinkgo(cfg)
 		if h == nil {
 			log.Println("Unable to generate helper outside of ginkgo")
 			return ginkgoPassed
@@ -662,7 +942,7 @@ func runTestsInPhase(phase string, description string) bool {
 				log.Printf("Error writing dependencies.txt: %s", err.Error())
 			}
 
-			err := debug.GenerateDiff(phase, dependencies)
+			err := debug.GenerateDiff(cfg, phase, dependencies)
 			if err != nil {
 				log.Printf("Error generating diff: %s", err.Error())
 			}
@@ -673,72 +953,64 @@ func runTestsInPhase(phase string, description string) bool {
 }
 
 // checkBeforeMetricsGeneration runs a variety of checks before generating metrics.
-func checkBeforeMetricsGeneration() error {
+func checkBeforeMetricsGeneration(reportDir string) error {
 	// Check for hive-log.txt
-	if _, err := os.Stat(filepath.Join(viper.GetString(config.ReportDir), hiveLog)); os.IsNotExist(err) {
+	if _, err := os.Stat(filepath.Join(reportDir, hiveLog)); os.IsNotExist(err) {
 		events.RecordEvent(events.NoHiveLogs)
 	}
 
 	return nil
 }
 
-// uploadFileToMetricsBucket uploads the given file (with absolute path) to the metrics S3 bucket "incoming" directory.
-func uploadFileToMetricsBucket(filename string) error {
+// uploadFileToMetricsBucket uploads the given file (with absolute path) to the "incoming" directory of the metrics
+// S3 bucket configured by cfg.
+func uploadFileToMetricsBucket(cfg *config.RunConfig, filename string) error {
 	data, err := ioutil.ReadFile(filename)
 	if err != nil {
 		return err
 	}
 
-	return aws.WriteToS3(aws.CreateS3URL(viper.GetString(config.Tests.MetricsBucket), "incoming", filepath.Base(filename)), data)
+	return aws.WriteToS3(cfg.Metrics, aws.CreateS3URL(cfg.Tests.MetricsBucket, "incoming", filepath.Base(filename)), data)
 }
 
-// setupRouteMonitors initializes performance+availability monitoring of cluster routes,
-// returning a channel which can be used to terminate the monitoring.
-func setupRouteMonitors() chan struct{} {
-	routeMonitorChan := make(chan struct{})
-	go func() {
-		// Set up the route monitors
-		routeMonitors, err := routemonitors.Create()
-		if err != nil {
-			log.Printf("Error creating route monitors: %v\n", err)
-			return
+// startRouteMonitors initializes performance+availability monitoring of cluster routes.
+// If the monitors couldn't be created and required is true, the returned monitors report that as a missed SLO.
+// Otherwise the error is only logged and nil is returned.
+func startRouteMonitors(cfg *config.RunConfig, required bool) *routemonitors.RouteMonitors {
+	routeMonitors, err := routemonitors.Create(cfg)
+	if err != nil {
+		log.Printf("Error creating route monitors: %v\n", err)
+		if required {
+			return routemonitors.Failed(err)
 		}
+		return nil
+	}
 
-		// Set the route monitors to become active
-		routeMonitors.Start()
+	// Set the route monitors to become active
+	routeMonitors.Start()
+	return routeMonitors
+}
 
-		// Set up ongoing monitoring of metric gathering from the monitors
-		go func() {
-			// Create an aggregate channel of all individual metric channels
-			agg := make(chan *vegeta.Result)
-			for _, ch := range routeMonitors.Monitors {
-				go func(c <-chan *vegeta.Result) {
-					for msg := range c {
-						agg <- msg
-					}
-				}(ch)
-			}
-			for {
-				select {
-				// A metric is waiting for storage
-				case msg := <-agg:
-					routeMonitors.Metrics[msg.Attack].Add(msg)
-					routeMonitors.Plots[msg.Attack].Add(msg)
-				}
-			}
-		}()
+// stopRouteMonitors ends monitoring and writes the results to the current phase's report directory. The results
+// are only stored in the metadata if storeMetadata is true. It returns whether every SLO was met.
+func stopRouteMonitors(cfg *config.RunConfig, routeMonitors *routemonitors.RouteMonitors, storeMetadata bool) bool {
+	routeMonitors.End()
 
-		// Close down route monitoring when signalled to
-		for {
-			select {
-			case <-routeMonitorChan:
-				routeMonitors.End()
-				routeMonitors.SaveReports(viper.GetString(config.ReportDir))
-				routeMonitors.SavePlots(viper.GetString(config.ReportDir))
-				routeMonitors.StoreMetadata()
-				return
-			}
+	if reportDir := cfg.State.ReportDir; reportDir != "" {
+		phaseDirectory := filepath.Join(reportDir, cfg.State.Phase)
+		if err := routeMonitors.SaveReports(phaseDirectory); err != nil {
+			log.Printf("Error saving route monitor reports: %v", err)
 		}
-	}()
-	return routeMonitorChan
+		if err := routeMonitors.SavePlots(phaseDirectory); err != nil {
+			log.Printf("Error saving route monitor plots: %v", err)
+		}
+		if err := routeMonitors.WriteJUnit(filepath.Join(phaseDirectory, routemonitors.JUnitFile)); err != nil {
+			log.Printf("Error writing route monitor SLOs: %v", err)
+		}
+	}
+
+	if storeMetadata {
+		routeMonitors.StoreMetadata()
+	}
+	return routeMonitors.Passed()
 }
@@ -8,9 +8,7 @@ import (
 	"reflect"
 	"testing"
 
-	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/events"
-	"github.com/spf13/viper"
 )
 
 func TestNoHiveLogs(t *testing.T) {
@@ -24,9 +22,7 @@ func TestNoHiveLogs(t *testing.T) {
 		t.Errorf("list of events is not empty on start: %v", err)
 	}
 
-	viper.Set(config.ReportDir, tmpDir)
-
-	checkBeforeMetricsGeneration()
+	checkBeforeMetricsGeneration(tmpDir)
 	if !reflect.DeepEqual(events.GetListOfEvents(), []string{string(events.NoHiveLogs)}) {
 		t.Errorf("the NoHiveLogs event was not detected")
 	}
@@ -41,7 +37,7 @@ func TestNoHiveLogs(t *testing.T) {
 		t.Errorf("error creating dummy hive log: %v", err)
 	}
 
-	checkBeforeMetricsGeneration()
+	checkBeforeMetricsGeneration(tmpDir)
 
 	if !reflect.DeepEqual(events.GetListOfEvents(), []string{}) {
 		t.Errorf("the NoHiveLogs event should not have been detected")
@@ -21,7 +21,6 @@ import (
 	"github.com/openshift/osde2e/pkg/common/spi"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/expfmt"
-	"github.com/spf13/viper"
 )
 
 const (
@@ -47,10 +46,13 @@ type Metrics struct {
 
 	// Provider for getting metrics data
 	provider spi.Provider
+
+	// cfg is the config of the run the metrics are for.
+	cfg *config.RunConfig
 }
 
 // NewMetrics creates a new metrics object using the given config object.
-func NewMetrics() *Metrics {
+func NewMetrics(cfg *config.RunConfig) *Metrics {
 	// Set up Prometheus metrics registry and gatherers
 	metricRegistry := prometheus.NewRegistry()
 	jUnitGatherer := prometheus.NewGaugeVec(
@@ -82,7 +84,7 @@ func NewMetrics() *Metrics {
 	metricRegistry.MustRegister(addonGatherer)
 	metricRegistry.MustRegister(eventGatherer)
 
-	provider, err := providers.ClusterProvider()
+	provider, err := providers.ClusterProvider(cfg)
 
 	if err != nil {
 		log.Printf("unable to get provider for metrics, failing: %v", err)
@@ -96,6 +98,7 @@ func NewMetrics() *Metrics {
 		addonGatherer:    addonGatherer,
 		eventGatherer:    eventGatherer,
 		provider:         provider,
+		cfg:              cfg,
 	}
 }
 
@@ -141,7 +144,7 @@ func (m *Metrics) WritePrometheusFile(reportDir string) (string, error) {
 
 	m.processEvents(m.eventGatherer)
 
-	prometheusFileName := fmt.Sprintf(prometheusFileNamePattern, viper.GetString(config.Cluster.ID), viper.GetString(config.JobName))
+	prometheusFileName := fmt.Sprintf(prometheusFileNamePattern, m.cfg.State.ClusterID, m.cfg.JobName)
 	output, err := m.registryToExpositionFormat()
 
 	if err != nil {
@@ -185,17 +188,17 @@ func (m *Metrics) processJUnitXMLFile(phase string, junitFile string) (err error
 			result = "passed"
 		}
 
-		m.jUnitGatherer.WithLabelValues(viper.GetString(config.Cluster.Version),
-			viper.GetString(config.Upgrade.ReleaseName),
-			viper.GetString(config.CloudProvider.CloudProviderID),
+		m.jUnitGatherer.WithLabelValues(m.cfg.State.ClusterVersion,
+			m.cfg.State.UpgradeReleaseName,
+			m.cfg.State.CloudProviderID,
 			m.provider.Environment(),
-			viper.GetString(config.CloudProvider.Region),
+			m.cfg.State.Region,
 			phase,
 			testSuite.Name,
 			testcase.Name,
 			result,
-			viper.GetString(config.Cluster.ID),
-			strconv.Itoa(viper.GetInt(config.JobID))).Add(testcase.Time)
+			m.cfg.State.ClusterID,
+			strconv.Itoa(m.cfg.JobID)).Add(testcase.Time)
 	}
 
 	return nil
@@ -247,26 +250,26 @@ func (m *Metrics) jsonToPrometheusOutput(gatherer *prometheus.GaugeVec, phase st
 			stringValue := fmt.Sprintf("%v", jsonObject)
 
 			// We're only concerned with tracking float values in Prometheus as they're the only thing we can measure
-			jobID := viper.GetInt(config.JobID)
+			jobID := m.cfg.JobID
 			if floatValue, err := strconv.ParseFloat(stringValue, 64); err == nil {
 				if phase != "" {
-					gatherer.WithLabelValues(viper.GetString(config.Cluster.Version),
-						viper.GetString(config.Upgrade.ReleaseName),
-						viper.GetString(config.CloudProvider.CloudProviderID),
+					gatherer.WithLabelValues(m.cfg.State.ClusterVersion,
+						m.cfg.State.UpgradeReleaseName,
+						m.cfg.State.CloudProviderID,
 						m.provider.Environment(),
-						viper.GetString(config.CloudProvider.Region),
+						m.cfg.State.Region,
 						metadataName,
-						viper.GetString(config.Cluster.ID),
+						m.cfg.State.ClusterID,
 						strconv.Itoa(jobID),
 						phase).Add(floatValue)
 				} else {
-					gatherer.WithLabelValues(viper.GetString(config.Cluster.Version),
-						viper.GetString(config.Upgrade.ReleaseName),
-						viper.GetString(config.CloudProvider.CloudProviderID),
+					gatherer.WithLabelValues(m.cfg.State.ClusterVersion,
+						m.cfg.State.UpgradeReleaseName,
+						m.cfg.State.CloudProviderID,
 						m.provider.Environment(),
-						viper.GetString(config.CloudProvider.Region),
+						m.cfg.State.Region,
 						metadataName,
-						viper.GetString(config.Cluster.ID),
+						m.cfg.State.ClusterID,
 						strconv.Itoa(jobID)).Add(floatValue)
 				}
 			}
@@ -281,14 +284,14 @@ func (m *Metrics) jsonToPrometheusOutput(gatherer *prometheus.GaugeVec, phase st
 func (m *Metrics) processEvents(gatherer *prometheus.CounterVec) {
 	for _, event := range events.GetListOfEvents() {
 		gatherer.WithLabelValues(
-			viper.GetString(config.Cluster.Version),
-			viper.GetString(config.Upgrade.ReleaseName),
-			viper.GetString(config.CloudProvider.CloudProviderID),
+			m.cfg.State.ClusterVersion,
+			m.cfg.State.UpgradeReleaseName,
+			m.cfg.State.CloudProviderID,
 			m.provider.Environment(),
-			viper.GetString(config.CloudProvider.Region),
+			m.cfg.State.Region,
 			event,
-			viper.GetString(config.Cluster.ID),
-			strconv.Itoa(viper.GetInt(config.JobID))).Inc()
+			m.cfg.State.ClusterID,
+			strconv.Itoa(m.cfg.JobID)).Inc()
 	}
 }
 
@@ -10,21 +10,11 @@ import (
 
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/metadata"
-	"github.com/openshift/osde2e/pkg/common/providers/mock"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/spf13/viper"
 )
 
 func TestProcessJUnitXMLFile(t *testing.T) {
-	viper.Reset()
-	viper.Set(mock.Env, "prod")
-	viper.Set(config.Provider, "mock")
-	viper.Set(config.JobID, 123)
-	viper.Set(config.CloudProvider.CloudProviderID, "aws")
-	viper.Set(config.CloudProvider.Region, "us-east-1")
-	viper.Set(config.Cluster.ID, "1a2b3c")
-	viper.Set(config.Cluster.Version, "install-version")
-	viper.Set(config.Upgrade.ReleaseName, "upgrade-version")
+	cfg := newTestRunConfig()
 
 	tests := []struct {
 		testName       string
@@ -84,7 +74,7 @@ cicd_jUnitResult{cloud_provider="aws",cluster_id="1a2b3c",environment="prod",ins
 	defer os.RemoveAll(tmpDir)
 
 	for _, test := range tests {
-		m := NewMetrics()
+		m := NewMetrics(cfg)
 
 		if m == nil {
 			t.Error("error creating new metrics provider")
@@ -116,15 +106,7 @@ cicd_jUnitResult{cloud_provider="aws",cluster_id="1a2b3c",environment="prod",ins
 }
 
 func TestProcessJSONFile(t *testing.T) {
-	viper.Reset()
-	viper.Set(mock.Env, "prod")
-	viper.Set(config.Provider, "mock")
-	viper.Set(config.JobID, 123)
-	viper.Set(config.CloudProvider.CloudProviderID, "aws")
-	viper.Set(config.CloudProvider.Region, "us-east-1")
-	viper.Set(config.Cluster.ID, "1a2b3c")
-	viper.Set(config.Cluster.Version, "install-version")
-	viper.Set(config.Upgrade.ReleaseName, "upgrade-version")
+	cfg := newTestRunConfig()
 
 	tests := []struct {
 		testName         string
@@ -188,7 +170,7 @@ cicd_addon_metadata{cloud_provider="aws",cluster_id="1a2b3c",environment="prod",
 	defer os.RemoveAll(tmpDir)
 
 	for _, test := range tests {
-		m := NewMetrics()
+		m := NewMetrics(cfg)
 		if m == nil {
 			t.Error("error creating new metrics provider")
 		}
@@ -226,16 +208,8 @@ cicd_addon_metadata{cloud_provider="aws",cluster_id="1a2b3c",environment="prod",
 }
 
 func TestWritePrometheusFile(t *testing.T) {
-	viper.Reset()
-	viper.Set(mock.Env, "prod")
-	viper.Set(config.Provider, "mock")
-	viper.Set(config.JobID, 123)
-	viper.Set(config.JobName, "test-job")
-	viper.Set(config.CloudProvider.CloudProviderID, "aws")
-	viper.Set(config.CloudProvider.Region, "us-east-1")
-	viper.Set(config.Cluster.ID, "1a2b3c")
-	viper.Set(config.Cluster.Version, "install-version")
-	viper.Set(config.Upgrade.ReleaseName, "upgrade-version")
+	cfg := newTestRunConfig()
+	cfg.JobName = "test-job"
 
 	type jUnitFile struct {
 		fileContents string
@@ -340,7 +314,7 @@ cicd_addon_metadata{cloud_provider="aws",cluster_id="1a2b3c",environment="prod",
 	}
 
 	for _, test := range tests {
-		m := NewMetrics()
+		m := NewMetrics(cfg)
 		if m == nil {
 			t.Error("error creating new metrics provider")
 		}
@@ -391,7 +365,7 @@ cicd_addon_metadata{cloud_provider="aws",cluster_id="1a2b3c",environment="prod",
 			t.Errorf("error while processing report directory: %v", err)
 		}
 
-		if prometheusFile != fmt.Sprintf("%s.%s.metrics.prom", viper.GetString(config.Cluster.ID), viper.GetString(config.JobName)) {
+		if prometheusFile != fmt.Sprintf("%s.%s.metrics.prom", cfg.State.ClusterID, cfg.JobName) {
 			t.Errorf("unexpected prometheus filename: %s", prometheusFile)
 		}
 
@@ -412,6 +386,22 @@ cicd_addon_metadata{cloud_provider="aws",cluster_id="1a2b3c",environment="prod",
 	}
 }
 
+// newTestRunConfig returns the config of a run against a mock cluster.
+func newTestRunConfig() *config.RunConfig {
+	return &config.RunConfig{
+		Provider: "mock",
+		JobID:    123,
+		Mock:     config.MockConfig{Env: "prod"},
+		State: &config.RunState{
+			ClusterID:          "1a2b3c",
+			ClusterVersion:     "install-version",
+			CloudProviderID:    "aws",
+			Region:             "us-east-1",
+			UpgradeReleaseName: "upgrade-version",
+		},
+	}
+}
+
 func lengthWithoutComments(array []string) int {
 	length := 0
 	for _, line := range array {
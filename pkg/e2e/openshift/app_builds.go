@@ -11,6 +11,7 @@ import (
 	"github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/osde2e/pkg/common/alert"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 	"github.com/openshift/osde2e/pkg/common/runner"
 	kubev1 "k8s.io/api/core/v1"
@@ -44,10 +45,10 @@ func init() {
 	alert.RegisterGinkgoAlert(appBuildsTestName, "SD-CICD", "Jeffrey Sica", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(appBuildsTestName, func() {
+var _ = helper.Describe(appBuildsTestName, func(cfg *config.RunConfig) {
 	defer ginkgo.GinkgoRecover()
 
-	h := helper.New()
+	h := helper.New(cfg)
 
 	e2eTimeoutInSeconds := 3600
 	ginkgo.It("should get created in the cluster", func() {
@@ -84,11 +85,11 @@ var _ = ginkgo.Describe(appBuildsTestName, func() {
 
 			// The applications do not exist, so test the successful build of them.
 			// configure tests
-			cfg := BuildE2EConfig
+			e2eConfig := BuildE2EConfig
 			// Add run flags for the testing apps
-			cfg.Flags = append(cfg.Flags, "--run \"Building ("+strings.Join(testApplications, "|")+") app\"")
+			e2eConfig.Flags = append(e2eConfig.Flags, "--run \"Building ("+strings.Join(testApplications, "|")+") app\"")
 
-			cmd := cfg.Cmd()
+			cmd := e2eConfig.Cmd()
 
 			// setup runner
 			r := h.Runner(cmd)
@@ -97,6 +98,7 @@ var _ = ginkgo.Describe(appBuildsTestName, func() {
 
 			// run tests
 			stopCh := make(chan struct{})
+			defer r.Cleanup()
 			err := r.Run(e2eTimeoutInSeconds, stopCh)
 			Expect(err).NotTo(HaveOccurred())
 
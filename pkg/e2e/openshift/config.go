@@ -3,6 +3,7 @@ package openshift
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -49,9 +50,15 @@ func (c E2EConfig) Cmd() string {
 	return cmd.String()
 }
 
+// printTests prints each test quoted on its own line, escaped for printf and the shell.
 func printTests(strs []string) string {
-	testList := strings.Join(strs, "\"\n\"")
-	return fmt.Sprintf("printf '\"%s\"'", testList)
+	quoted := make([]string, len(strs))
+	for i, str := range strs {
+		quoted[i] = strconv.Quote(str)
+	}
+	testList := strings.Join(quoted, "\n")
+	testList = strings.NewReplacer(`\`, `\\`, "%", "%%", "'", `'\''`).Replace(testList)
+	return fmt.Sprintf("printf '%s'", testList)
 }
 
 // runs a suite unless tests are specified
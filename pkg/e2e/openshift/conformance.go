@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/openshift/osde2e/pkg/common/alert"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 	"github.com/openshift/osde2e/pkg/common/runner"
 )
@@ -28,17 +29,22 @@ func init() {
 	alert.RegisterGinkgoAlert(conformanceOpenshiftTestName, "SD-CICD", "Jeffrey Sica", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(conformanceK8sTestName, func() {
+var _ = helper.Describe(conformanceK8sTestName, func(cfg *config.RunConfig) {
 	defer ginkgo.GinkgoRecover()
-	h := helper.New()
+	h := helper.New(cfg)
 
 	e2eTimeoutInSeconds := 3600
 	ginkgo.It("should run until completion", func() {
 		// configure tests
 		h.SetServiceAccount("system:serviceaccount:%s:cluster-admin")
 
-		cfg := DefaultE2EConfig
-		cmd := cfg.Cmd()
+		e2eConfig := DefaultE2EConfig
+		if shards := h.Config().Tests.OpenshiftTestShards; shards > 1 {
+			err := RunSharded(h, e2eConfig, "k8s-conformance", shards, e2eTimeoutInSeconds)
+			Expect(err).NotTo(HaveOccurred())
+			return
+		}
+		cmd := e2eConfig.Cmd()
 
 		// setup runner
 		r := h.Runner(cmd)
@@ -47,6 +53,7 @@ var _ = ginkgo.Describe(conformanceK8sTestName, func() {
 
 		// run tests
 		stopCh := make(chan struct{})
+		defer r.Cleanup()
 
 		err := r.Run(e2eTimeoutInSeconds, stopCh)
 		Expect(err).NotTo(HaveOccurred())
@@ -60,17 +67,22 @@ var _ = ginkgo.Describe(conformanceK8sTestName, func() {
 	}, float64(e2eTimeoutInSeconds+30))
 })
 
-var _ = ginkgo.Describe(conformanceOpenshiftTestName, func() {
+var _ = helper.Describe(conformanceOpenshiftTestName, func(cfg *config.RunConfig) {
 	defer ginkgo.GinkgoRecover()
-	h := helper.New()
+	h := helper.New(cfg)
 
 	e2eTimeoutInSeconds := 7200
 	ginkgo.It("should run until completion", func() {
 		h.SetServiceAccount("system:serviceaccount:%s:cluster-admin")
 		// configure tests
-		cfg := DefaultE2EConfig
-		cfg.Suite = "openshift/conformance"
-		cmd := cfg.Cmd()
+		e2eConfig := DefaultE2EConfig
+		e2eConfig.Suite = "openshift/conformance"
+		if shards := h.Config().Tests.OpenshiftTestShards; shards > 1 {
+			err := RunSharded(h, e2eConfig, "openshift-conformance", shards, e2eTimeoutInSeconds)
+			Expect(err).NotTo(HaveOccurred())
+			return
+		}
+		cmd := e2eConfig.Cmd()
 
 		// setup runner
 		r := h.Runner(cmd)
@@ -79,6 +91,7 @@ var _ = ginkgo.Describe(conformanceOpenshiftTestName, func() {
 
 		// run tests
 		stopCh := make(chan struct{})
+		defer r.Cleanup()
 		err := r.Run(e2eTimeoutInSeconds, stopCh)
 		Expect(err).NotTo(HaveOccurred())
 
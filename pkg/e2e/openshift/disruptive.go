@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/openshift/osde2e/pkg/common/alert"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 )
 
@@ -16,16 +17,16 @@ func init() {
 }
 
 // Disruptive tests require SSH access to nodes.
-var _ = ginkgo.Describe(disruptiveTestName, func() {
+var _ = helper.Describe(disruptiveTestName, func(cfg *config.RunConfig) {
 	defer ginkgo.GinkgoRecover()
-	h := helper.New()
+	h := helper.New(cfg)
 
 	e2eTimeoutInSeconds := 3600
 	ginkgo.It("should run until completion", func() {
 		// configure tests
-		cfg := DefaultE2EConfig
-		cfg.Suite = "openshift/disruptive"
-		cmd := cfg.Cmd()
+		e2eConfig := DefaultE2EConfig
+		e2eConfig.Suite = "openshift/disruptive"
+		cmd := e2eConfig.Cmd()
 
 		// setup runner
 		r := h.Runner(cmd)
@@ -34,6 +35,7 @@ var _ = ginkgo.Describe(disruptiveTestName, func() {
 
 		// run tests
 		stopCh := make(chan struct{})
+		defer r.Cleanup()
 		err := r.Run(e2eTimeoutInSeconds, stopCh)
 		Expect(err).NotTo(HaveOccurred())
 
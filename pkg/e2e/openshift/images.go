@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/openshift/osde2e/pkg/common/alert"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 )
 
@@ -17,16 +18,16 @@ func init() {
 	alert.RegisterGinkgoAlert(imageEcosystemTestName, "SD-CICD", "Jeffrey Sica", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(imageRegistryTestName, func() {
+var _ = helper.Describe(imageRegistryTestName, func(cfg *config.RunConfig) {
 	defer ginkgo.GinkgoRecover()
-	h := helper.New()
+	h := helper.New(cfg)
 
 	e2eTimeoutInSeconds := 3600
 	ginkgo.It("should run until completion", func() {
 		// configure tests
-		cfg := DefaultE2EConfig
-		cfg.Suite = "openshift/image-registry"
-		cmd := cfg.Cmd()
+		e2eConfig := DefaultE2EConfig
+		e2eConfig.Suite = "openshift/image-registry"
+		cmd := e2eConfig.Cmd()
 
 		// setup runner
 		r := h.Runner(cmd)
@@ -35,6 +36,7 @@ var _ = ginkgo.Describe(imageRegistryTestName, func() {
 
 		// run tests
 		stopCh := make(chan struct{})
+		defer r.Cleanup()
 		err := r.Run(e2eTimeoutInSeconds, stopCh)
 		Expect(err).NotTo(HaveOccurred())
 
@@ -47,16 +49,16 @@ var _ = ginkgo.Describe(imageRegistryTestName, func() {
 	}, float64(e2eTimeoutInSeconds+30))
 })
 
-var _ = ginkgo.Describe(imageEcosystemTestName, func() {
+var _ = helper.Describe(imageEcosystemTestName, func(cfg *config.RunConfig) {
 	defer ginkgo.GinkgoRecover()
-	h := helper.New()
+	h := helper.New(cfg)
 
 	e2eTimeoutInSeconds := 3600
 	ginkgo.It("should run until completion", func() {
 		// configure tests
-		cfg := DefaultE2EConfig
-		cfg.Suite = "openshift/image-ecosystem"
-		cmd := cfg.Cmd()
+		e2eConfig := DefaultE2EConfig
+		e2eConfig.Suite = "openshift/image-ecosystem"
+		cmd := e2eConfig.Cmd()
 
 		// setup runner
 		r := h.Runner(cmd)
@@ -65,6 +67,7 @@ var _ = ginkgo.Describe(imageEcosystemTestName, func() {
 
 		// run tests
 		stopCh := make(chan struct{})
+		defer r.Cleanup()
 		err := r.Run(e2eTimeoutInSeconds, stopCh)
 		Expect(err).NotTo(HaveOccurred())
 
@@ -0,0 +1,254 @@
+package openshift
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/onsi/ginkgo/reporters"
+
+	"github.com/openshift/osde2e/pkg/common/helper"
+	"github.com/openshift/osde2e/pkg/common/runner"
+	"github.com/openshift/osde2e/pkg/metrics"
+)
+
+const (
+	// testListFile is where the tests in a suite are listed by a dry run.
+	testListFile = "tests.txt"
+
+	// listTimeoutInSeconds is how long listing the tests in a suite can take.
+	listTimeoutInSeconds = 600
+
+	// durationHistory is how far back the durations tests are balanced by are averaged over.
+	durationHistory = 7 * 24 * time.Hour
+)
+
+var junitFileRegex = regexp.MustCompile(`^junit.*\.xml$`)
+
+// RunSharded runs the suite of cfg split across shards runner Pods, which run in parallel. Tests are listed with a dry
+// run and balanced between shards by their average duration in past runs. The JUnit results of the shards are merged
+// into a single file, and everything else they write is put in a directory for each shard.
+func RunSharded(h *helper.H, cfg E2EConfig, name string, shards, timeoutInSeconds int) error {
+	deadline := time.Now().Add(time.Duration(timeoutInSeconds) * time.Second)
+
+	tests, err := listTests(h, cfg, name)
+	if err != nil {
+		return fmt.Errorf("couldn't list tests in suite %s: %v", cfg.Suite, err)
+	}
+	log.Printf("Splitting %d tests in suite %s across %d shards", len(tests), cfg.Suite, shards)
+
+	durations := map[string]time.Duration{}
+	if client, err := metrics.NewClient(h.Config()); err != nil {
+		log.Printf("Unable to create metrics client, shards won't be balanced by test duration: %v", err)
+	} else if durations, err = client.ListAverageDurationsByTestName(".+", time.Now().Add(-durationHistory), time.Now()); err != nil {
+		log.Printf("Unable to get test durations, shards won't be balanced by them: %v", err)
+	}
+
+	runners := []*runner.Runner{}
+	for i, group := range shardTests(tests, durations, shards) {
+		shardCfg := cfg
+		shardCfg.TestNames = group
+
+		r := h.Runner(shardCfg.Cmd())
+		r.Name = fmt.Sprintf("%s-%d", name, i)
+		defer r.Cleanup()
+		runners = append(runners, r)
+	}
+
+	shardTimeout := int(time.Until(deadline).Seconds())
+	results := make([]map[string][]byte, len(runners))
+	errs := make([]error, len(runners))
+	var wg sync.WaitGroup
+	for i, r := range runners {
+		wg.Add(1)
+		go func(i int, r *runner.Runner) {
+			defer wg.Done()
+
+			stopCh := make(chan struct{})
+			var runErr, retrieveErr error
+			if runErr = r.Run(shardTimeout, stopCh); runErr == nil {
+				results[i], retrieveErr = r.RetrieveResults()
+			}
+			errs[i] = multierror.Append(runErr, retrieveErr).ErrorOrNil()
+		}(i, r)
+	}
+	wg.Wait()
+
+	var allErrs *multierror.Error
+	for i, err := range errs {
+		if err != nil {
+			allErrs = multierror.Append(allErrs, fmt.Errorf("shard %s failed: %v", runners[i].Name, err))
+		}
+	}
+
+	shardNames := []string{}
+	for _, r := range runners {
+		shardNames = append(shardNames, r.Name)
+	}
+
+	merged, err := mergeShardResults(name, shardNames, results)
+	allErrs = multierror.Append(allErrs, err)
+	h.WriteResults(merged)
+
+	return allErrs.ErrorOrNil()
+}
+
+// listTests lists the tests in the suite of cfg with a dry run.
+func listTests(h *helper.H, cfg E2EConfig, name string) ([]string, error) {
+	cmd := fmt.Sprintf("%s openshift-tests %s %s --dry-run > %s", unwrap(cfg.Env), cfg.TestCmd, cfg.Suite,
+		filepath.Join(runner.DefaultRunner.OutputDir, testListFile))
+
+	r := h.Runner(cmd)
+	r.Name = name + "-list"
+	defer r.Cleanup()
+
+	stopCh := make(chan struct{})
+	if err := r.Run(listTimeoutInSeconds, stopCh); err != nil {
+		return nil, err
+	}
+
+	results, err := r.RetrieveResults()
+	if err != nil {
+		return nil, err
+	}
+
+	tests := parseTestList(results[testListFile])
+	if len(tests) == 0 {
+		return nil, fmt.Errorf("no tests were listed")
+	}
+	return tests, nil
+}
+
+// parseTestList parses the tests listed by a dry run, one per line and usually quoted.
+func parseTestList(data []byte) []string {
+	tests := []string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if unquoted, err := strconv.Unquote(line); err == nil {
+			line = unquoted
+		}
+		if line != "" {
+			tests = append(tests, line)
+		}
+	}
+	return tests
+}
+
+// shardTests splits tests into at most shards groups with similar total durations. Each test is added, longest first,
+// to the group with the shortest total. Tests without a known duration are assumed to take the average of those with
+// one.
+func shardTests(tests []string, durations map[string]time.Duration, shards int) [][]string {
+	if shards > len(tests) {
+		shards = len(tests)
+	}
+	if shards < 1 {
+		return [][]string{tests}
+	}
+
+	var known time.Duration
+	var numKnown int
+	for _, test := range tests {
+		if duration, ok := durations[test]; ok {
+			known += duration
+			numKnown++
+		}
+	}
+	assumed := time.Second
+	if numKnown > 0 && known > 0 {
+		assumed = known / time.Duration(numKnown)
+	}
+
+	duration := func(test string) time.Duration {
+		if d, ok := durations[test]; ok {
+			return d
+		}
+		return assumed
+	}
+
+	sorted := append([]string{}, tests...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if duration(sorted[i]) != duration(sorted[j]) {
+			return duration(sorted[i]) > duration(sorted[j])
+		}
+		return sorted[i] < sorted[j]
+	})
+
+	groups := make([][]string, shards)
+	totals := make([]time.Duration, shards)
+	for _, test := range sorted {
+		shortest := 0
+		for i := range totals {
+			if totals[i] < totals[shortest] {
+				shortest = i
+			}
+		}
+		groups[shortest] = append(groups[shortest], test)
+		totals[shortest] += duration(test)
+	}
+	return groups
+}
+
+// mergeShardResults merges the JUnit results of every shard into a single file named after the suite's runner. The
+// results of each shard, including its JUnit files, are put in a directory named after the shard, so they aren't
+// counted twice.
+func mergeShardResults(name string, shardNames []string, results []map[string][]byte) (map[string][]byte, error) {
+	merged := map[string][]byte{}
+	suite := reporters.JUnitTestSuite{}
+	var errs *multierror.Error
+
+	for i, shardName := range shardNames {
+		names := []string{}
+		for file := range results[i] {
+			names = append(names, file)
+		}
+		sort.Strings(names)
+
+		for _, file := range names {
+			data := results[i][file]
+			merged[path.Join(shardName, file)] = data
+
+			if !junitFileRegex.MatchString(path.Base(file)) {
+				continue
+			}
+
+			var shardSuite reporters.JUnitTestSuite
+			if err := xml.Unmarshal(data, &shardSuite); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error parsing %s from %s: %v", file, shardName, err))
+				continue
+			}
+
+			if suite.Name == "" {
+				suite.Name = shardSuite.Name
+			}
+			suite.TestCases = append(suite.TestCases, shardSuite.TestCases...)
+			suite.Tests += shardSuite.Tests
+			suite.Failures += shardSuite.Failures
+			suite.Errors += shardSuite.Errors
+
+			// shards run in parallel, so the suite took as long as its slowest shard
+			if shardSuite.Time > suite.Time {
+				suite.Time = shardSuite.Time
+			}
+		}
+	}
+
+	if suite.Name == "" {
+		suite.Name = name
+	}
+
+	data, err := xml.Marshal(&suite)
+	if err != nil {
+		return merged, multierror.Append(errs, fmt.Errorf("error marshalling JUnit: %v", err))
+	}
+	merged[fmt.Sprintf("junit_%s.xml", name)] = data
+	return merged, errs.ErrorOrNil()
+}
@@ -0,0 +1,122 @@
+package openshift
+
+import (
+	"encoding/xml"
+	"os/exec"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/ginkgo/reporters"
+)
+
+func TestShardTests(t *testing.T) {
+	tests := []struct {
+		name      string
+		tests     []string
+		durations map[string]time.Duration
+		shards    int
+		expected  [][]string
+	}{
+		{
+			name:  "balanced by duration",
+			tests: []string{"a", "b", "c", "d", "e"},
+			durations: map[string]time.Duration{
+				"a": 10 * time.Minute,
+				"b": 6 * time.Minute,
+				"c": 5 * time.Minute,
+				"d": 3 * time.Minute,
+				"e": 2 * time.Minute,
+			},
+			shards:   2,
+			expected: [][]string{{"a", "d"}, {"b", "c", "e"}},
+		},
+		{
+			name:      "unknown durations are the average",
+			tests:     []string{"a", "b", "c"},
+			durations: map[string]time.Duration{"a": 4 * time.Minute, "b": 2 * time.Minute},
+			shards:    2,
+			expected:  [][]string{{"a"}, {"c", "b"}},
+		},
+		{
+			name:     "no durations",
+			tests:    []string{"d", "c", "b", "a"},
+			shards:   2,
+			expected: [][]string{{"a", "c"}, {"b", "d"}},
+		},
+		{
+			name:     "more shards than tests",
+			tests:    []string{"a", "b"},
+			shards:   5,
+			expected: [][]string{{"a"}, {"b"}},
+		},
+	}
+
+	for _, test := range tests {
+		if groups := shardTests(test.tests, test.durations, test.shards); !reflect.DeepEqual(groups, test.expected) {
+			t.Errorf("test %s: expected %v, got %v", test.name, test.expected, groups)
+		}
+	}
+}
+
+func TestParseTestList(t *testing.T) {
+	data := []byte("\"[sig-storage] test one [Suite:k8s]\"\n\n\"[sig-cli] test \\\"two\\\"\"\nunquoted test\n")
+	expected := []string{"[sig-storage] test one [Suite:k8s]", "[sig-cli] test \"two\"", "unquoted test"}
+
+	if tests := parseTestList(data); !reflect.DeepEqual(tests, expected) {
+		t.Errorf("expected %v, got %v", expected, tests)
+	}
+}
+
+func TestPrintTests(t *testing.T) {
+	names := []string{"[sig-apps] plain", "it's 100% \"quoted\"", `back\slash`}
+
+	out, err := exec.Command("/bin/sh", "-c", printTests(names)).Output()
+	if err != nil {
+		t.Fatalf("failed to run printf: %v", err)
+	}
+
+	if printed := parseTestList(out); !reflect.DeepEqual(printed, names) {
+		t.Errorf("expected %q to be printed, got %q", names, printed)
+	}
+}
+
+func TestMergeShardResults(t *testing.T) {
+	shardJUnit := func(name string, time float64, cases ...string) []byte {
+		suite := reporters.JUnitTestSuite{Name: name, Tests: len(cases), Time: time}
+		for _, c := range cases {
+			suite.TestCases = append(suite.TestCases, reporters.JUnitTestCase{Name: c})
+		}
+		data, err := xml.Marshal(&suite)
+		if err != nil {
+			t.Fatalf("failed to marshal JUnit: %v", err)
+		}
+		return data
+	}
+
+	results := []map[string][]byte{
+		{"junit_e2e_1.xml": shardJUnit("openshift-tests", 30, "a", "b"), "e2e.log": []byte("log")},
+		{"junit_e2e_2.xml": shardJUnit("openshift-tests", 50, "c")},
+		{"junit_e2e_3.xml": []byte("not xml")},
+	}
+
+	merged, err := mergeShardResults("conformance", []string{"conformance-0", "conformance-1", "conformance-2"}, results)
+	if err == nil || !strings.Contains(err.Error(), "conformance-2") {
+		t.Errorf("expected an error parsing the JUnit of shard conformance-2, got %v", err)
+	}
+
+	for _, file := range []string{"conformance-0/junit_e2e_1.xml", "conformance-0/e2e.log", "conformance-1/junit_e2e_2.xml", "conformance-2/junit_e2e_3.xml"} {
+		if _, ok := merged[file]; !ok {
+			t.Errorf("expected %s in the merged results", file)
+		}
+	}
+
+	var suite reporters.JUnitTestSuite
+	if err = xml.Unmarshal(merged["junit_conformance.xml"], &suite); err != nil {
+		t.Fatalf("failed to parse merged JUnit: %v", err)
+	}
+	if suite.Name != "openshift-tests" || suite.Tests != 3 || len(suite.TestCases) != 3 || suite.Time != 50 {
+		t.Errorf("unexpected merged suite: %+v", suite)
+	}
+}
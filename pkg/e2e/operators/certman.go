@@ -10,7 +10,6 @@ import (
 	"github.com/openshift/osde2e/pkg/common/alert"
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
-	"github.com/spf13/viper"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -22,8 +21,8 @@ func init() {
 	alert.RegisterGinkgoAlert(certmanOperatorTestName, "SD-SREP", "Christoph Blecker", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(certmanOperatorTestName, func() {
-	h := helper.New()
+var _ = helper.Describe(certmanOperatorTestName, func(cfg *config.RunConfig) {
+	h := helper.New(cfg)
 
 	ginkgo.Context("certificate secret should be applied when cluster installed", func() {
 		var secretName string
@@ -48,7 +47,7 @@ var _ = ginkgo.Describe(certmanOperatorTestName, func() {
 			})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(len(secrets.Items)).Should(Equal(1))
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 
 		ginkgo.It("certificate secret should be applied to apiserver object", func() {
 			wait.PollImmediate(30*time.Second, 15*time.Minute, func() (bool, error) {
@@ -65,6 +64,6 @@ var _ = ginkgo.Describe(certmanOperatorTestName, func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(len(apiserver.Spec.ServingCerts.NamedCertificates)).Should(BeNumerically(">", 0))
 			Expect(apiserver.Spec.ServingCerts.NamedCertificates[0].ServingCertificate.Name).Should(Equal(secretName))
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 })
@@ -1,8 +1,8 @@
 package operators
 
 import (
-	"github.com/onsi/ginkgo"
 	"github.com/openshift/osde2e/pkg/common/alert"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 )
 
@@ -14,7 +14,7 @@ func init() {
 	alert.RegisterGinkgoAlert(configureAlertManagerInforming, "SD-SREP", "Matt Bargenquast", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(configureAlertManagerOperators, func() {
+var _ = helper.Describe(configureAlertManagerOperators, func(cfg *config.RunConfig) {
 	var operatorName = "configure-alertmanager-operator"
 	var operatorNamespace string = "openshift-monitoring"
 	var operatorLockFile string = "configure-alertmanager-operator-lock"
@@ -39,7 +39,7 @@ var _ = ginkgo.Describe(configureAlertManagerOperators, func() {
 		"configure-alertmanager-operator",
 	}
 
-	h := helper.New()
+	h := helper.New(cfg)
 	checkClusterServiceVersion(h, operatorNamespace, operatorName)
 	checkConfigMapLockfile(h, operatorNamespace, operatorLockFile)
 	checkDeployment(h, operatorNamespace, operatorName, defaultDesiredReplicas)
@@ -48,8 +48,8 @@ var _ = ginkgo.Describe(configureAlertManagerOperators, func() {
 	checkRoleBindings(h, operatorNamespace, roleBindings)
 })
 
-var _ = ginkgo.Describe(configureAlertManagerInforming, func() {
-	checkUpgrade(helper.New(), "openshift-monitoring", "configure-alertmanager-operator",
+var _ = helper.Describe(configureAlertManagerInforming, func(cfg *config.RunConfig) {
+	checkUpgrade(helper.New(cfg), "openshift-monitoring", "configure-alertmanager-operator",
 		"configure-alertmanager-operator.v0.1.171-dba3c73",
 	)
 })
@@ -12,7 +12,6 @@ import (
 
 	"github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	"github.com/spf13/viper"
 
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
@@ -31,7 +30,7 @@ func checkClusterServiceVersion(h *helper.H, namespace, name string) {
 			csvs, err := pollCsvList(h, namespace, name)
 			Expect(err).ToNot(HaveOccurred(), "failed fetching the clusterServiceVersions")
 			Expect(csvs).NotTo(BeNil())
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 }
 
@@ -42,7 +41,7 @@ func checkConfigMapLockfile(h *helper.H, namespace, operatorLockFile string) {
 			// Wait for lockfile to signal operator is active
 			err := pollLockFile(h, namespace, operatorLockFile)
 			Expect(err).ToNot(HaveOccurred(), "failed fetching the configMap lockfile")
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 }
 
@@ -53,7 +52,7 @@ func checkDeployment(h *helper.H, namespace string, name string, defaultDesiredR
 			deployment, err := pollDeployment(h, namespace, name)
 			Expect(err).ToNot(HaveOccurred(), "failed fetching deployment")
 			Expect(deployment).NotTo(BeNil(), "deployment is nil")
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 		ginkgo.It("should have all desired replicas ready", func() {
 			deployment, err := pollDeployment(h, namespace, name)
 			Expect(err).ToNot(HaveOccurred(), "failed fetching deployment")
@@ -66,7 +65,7 @@ func checkDeployment(h *helper.H, namespace string, name string, defaultDesiredR
 
 			// Desired replica count should match ready replica count
 			Expect(readyReplicas).To(BeNumerically("==", desiredReplicas), "All desired replicas should be ready.")
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 }
 
@@ -78,7 +77,7 @@ func checkClusterRoles(h *helper.H, clusterRoles []string) {
 				_, err := h.Kube().RbacV1().ClusterRoles().Get(context.TODO(), clusterRoleName, metav1.GetOptions{})
 				Expect(err).ToNot(HaveOccurred(), "failed to get clusterRole %v\n", clusterRoleName)
 			}
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 }
 
@@ -90,7 +89,7 @@ func checkClusterRoleBindings(h *helper.H, clusterRoleBindings []string) {
 				err := pollClusterRoleBinding(h, clusterRoleBindingName)
 				Expect(err).ToNot(HaveOccurred(), "failed to get clusterRoleBinding %v\n", clusterRoleBindingName)
 			}
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 }
 
@@ -102,7 +101,7 @@ func checkRole(h *helper.H, namespace string, roles []string) {
 				_, err := h.Kube().RbacV1().Roles(namespace).Get(context.TODO(), roleName, metav1.GetOptions{})
 				Expect(err).NotTo(HaveOccurred(), "failed to get role %v\n", roleName)
 			}
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 
 }
@@ -115,7 +114,7 @@ func checkRoleBindings(h *helper.H, namespace string, roleBindings []string) {
 				err := pollRoleBinding(h, namespace, roleBindingName)
 				Expect(err).NotTo(HaveOccurred(), "failed to get roleBinding %v\n", roleBindingName)
 			}
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 }
 
@@ -128,7 +127,7 @@ func checkSecrets(h *helper.H, namespace string, secrets []string) {
 				_, err := h.Kube().CoreV1().Secrets(namespace).Get(context.TODO(), secretName, metav1.GetOptions{})
 				Expect(err).NotTo(HaveOccurred(), "failed to get secret %v\n", secretName)
 			}
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 }
 
@@ -243,7 +242,7 @@ func checkUpgrade(h *helper.H, subNamespace string, subName string, previousCSV
 			err = ensureCSVIsInstalled(h, startingCSV, subNamespace)
 			Expect(err).NotTo(HaveOccurred(), fmt.Sprintf("CSV %s did not install successfully", startingCSV))
 
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 }
 
@@ -258,7 +257,7 @@ func pollClusterRoleBinding(h *helper.H, clusterRoleBindingName string) error {
 	interval := 5
 
 	// convert time.Duration type
-	timeoutDuration := time.Duration(viper.GetFloat64(config.Tests.PollingTimeout)) * time.Minute
+	timeoutDuration := time.Duration(h.Config().Tests.PollingTimeout) * time.Minute
 	intervalDuration := time.Duration(interval) * time.Second
 
 	start := time.Now()
@@ -299,7 +298,7 @@ func pollRoleBinding(h *helper.H, projectName string, roleBindingName string) er
 	interval := 5
 
 	// convert time.Duration type
-	timeoutDuration := time.Duration(viper.GetFloat64(config.Tests.PollingTimeout)) * time.Minute
+	timeoutDuration := time.Duration(h.Config().Tests.PollingTimeout) * time.Minute
 	intervalDuration := time.Duration(interval) * time.Second
 
 	start := time.Now()
@@ -341,7 +340,7 @@ func pollLockFile(h *helper.H, namespace, operatorLockFile string) error {
 	interval := 30
 
 	// convert time.Duration type
-	timeoutDuration := time.Duration(viper.GetFloat64(config.Tests.PollingTimeout)) * time.Minute
+	timeoutDuration := time.Duration(h.Config().Tests.PollingTimeout) * time.Minute
 	intervalDuration := time.Duration(interval) * time.Second
 
 	start := time.Now()
@@ -384,7 +383,7 @@ func pollDeployment(h *helper.H, namespace, deploymentName string) (*appsv1.Depl
 	interval := 5
 
 	// convert time.Duration type
-	timeoutDuration := time.Duration(viper.GetFloat64(config.Tests.PollingTimeout)) * time.Minute
+	timeoutDuration := time.Duration(h.Config().Tests.PollingTimeout) * time.Minute
 	intervalDuration := time.Duration(interval) * time.Second
 
 	start := time.Now()
@@ -428,7 +427,7 @@ func pollCsvList(h *helper.H, namespace, csvDisplayName string) (*operatorv1.Clu
 	interval := 5
 
 	// convert time.Duration type
-	timeoutDuration := time.Duration(viper.GetFloat64(config.Tests.PollingTimeout)) * time.Minute
+	timeoutDuration := time.Duration(h.Config().Tests.PollingTimeout) * time.Minute
 	intervalDuration := time.Duration(interval) * time.Second
 
 	start := time.Now()
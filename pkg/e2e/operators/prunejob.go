@@ -11,7 +11,6 @@ import (
 	"github.com/openshift/osde2e/pkg/common/alert"
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
-	"github.com/spf13/viper"
 
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
@@ -25,8 +24,8 @@ func init() {
 	alert.RegisterGinkgoAlert(pruneJobsTestName, "SD-SREP", "Haoran Wang", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(pruneJobsTestName, func() {
-	h := helper.New()
+var _ = helper.Describe(pruneJobsTestName, func(cfg *config.RunConfig) {
+	h := helper.New(cfg)
 	ginkgo.Context("pruner jobs should works", func() {
 		namespace := "openshift-sre-pruning"
 		cronJobs := []string{"builds-pruner", "deployments-pruner", "image-pruner"}
@@ -44,7 +43,7 @@ var _ = ginkgo.Describe(pruneJobsTestName, func() {
 				Expect(err).NotTo(HaveOccurred())
 				err = waitJobComplete(h, namespace, job.Name)
 				Expect(err).NotTo(HaveOccurred())
-			}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+			}, config.DefaultPollingTimeout)
 		}
 
 	})
@@ -77,7 +76,7 @@ func waitJobComplete(h *helper.H, namespace, jobName string) error {
 	interval := 5
 
 	// convert time.Duration type
-	timeoutDuration := time.Duration(viper.GetFloat64(config.Tests.PollingTimeout)) * time.Minute
+	timeoutDuration := time.Duration(h.Config().Tests.PollingTimeout) * time.Minute
 	intervalDuration := time.Duration(interval) * time.Second
 
 	start := time.Now()
@@ -7,7 +7,6 @@ import (
 	"github.com/onsi/ginkgo"
 	"github.com/openshift/osde2e/pkg/common/alert"
 	"github.com/openshift/osde2e/pkg/common/config"
-	"github.com/spf13/viper"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	. "github.com/onsi/gomega"
@@ -29,7 +28,7 @@ func init() {
 	alert.RegisterGinkgoAlert(subjectPermissionsTestName, "SD-SREP", "Matt Bargenquast", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(rbacOperatorBlocking, func() {
+var _ = helper.Describe(rbacOperatorBlocking, func(cfg *config.RunConfig) {
 	var operatorLockFile = "rbac-permissions-operator-lock"
 	var defaultDesiredReplicas int32 = 1
 
@@ -39,20 +38,20 @@ var _ = ginkgo.Describe(rbacOperatorBlocking, func() {
 		"rbac-permissions-operator-view",
 	}
 
-	h := helper.New()
+	h := helper.New(cfg)
 	checkClusterServiceVersion(h, operatorNamespace, operatorName)
 	checkConfigMapLockfile(h, operatorNamespace, operatorLockFile)
 	checkDeployment(h, operatorNamespace, operatorName, defaultDesiredReplicas)
 	checkClusterRoles(h, clusterRoles)
 })
 
-var _ = ginkgo.Describe(subjectPermissionsTestName, func() {
-	h := helper.New()
+var _ = helper.Describe(subjectPermissionsTestName, func(cfg *config.RunConfig) {
+	h := helper.New(cfg)
 	checkSubjectPermissions(h, "dedicated-admins")
 })
 
-var _ = ginkgo.Describe(rbacOperatorInforming, func() {
-	checkUpgrade(helper.New(), "openshift-rbac-permissions", "rbac-permissions-operator",
+var _ = helper.Describe(rbacOperatorInforming, func(cfg *config.RunConfig) {
+	checkUpgrade(helper.New(cfg), "openshift-rbac-permissions", "rbac-permissions-operator",
 		"rbac-permissions-operator.v0.1.97-68cf185",
 	)
 })
@@ -77,7 +76,7 @@ func checkSubjectPermissions(h *helper.H, spName string) {
 				Expect(err).NotTo(HaveOccurred(), "failed to get roleBinding %v\n", roleBindingName)
 			}
 
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 }
 
@@ -1,8 +1,8 @@
 package operators
 
 import (
-	"github.com/onsi/ginkgo"
 	"github.com/openshift/osde2e/pkg/common/alert"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 )
 
@@ -14,7 +14,7 @@ func init() {
 	alert.RegisterGinkgoAlert(splunkForwarderInforming, "SD-SREP", "Matt Bargenquast", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(splunkForwarderBlocking, func() {
+var _ = helper.Describe(splunkForwarderBlocking, func(cfg *config.RunConfig) {
 
 	var operatorName = "splunk-forwarder-operator"
 	var operatorNamespace string = "openshift-splunk-forwarder-operator"
@@ -32,7 +32,7 @@ var _ = ginkgo.Describe(splunkForwarderBlocking, func() {
 		"splunk-forwarder-operator-og-view",
 	}
 
-	h := helper.New()
+	h := helper.New(cfg)
 	checkClusterServiceVersion(h, operatorNamespace, operatorName)
 	checkConfigMapLockfile(h, operatorNamespace, operatorLockFile)
 	checkDeployment(h, operatorNamespace, operatorName, defaultDesiredReplicas)
@@ -40,8 +40,8 @@ var _ = ginkgo.Describe(splunkForwarderBlocking, func() {
 	checkClusterRoles(h, clusterRoles)
 })
 
-var _ = ginkgo.Describe(splunkForwarderInforming, func() {
-	checkUpgrade(helper.New(), "openshift-splunk-forwarder-operator", "openshift-splunk-forwarder-operator",
+var _ = helper.Describe(splunkForwarderInforming, func(cfg *config.RunConfig) {
+	checkUpgrade(helper.New(cfg), "openshift-splunk-forwarder-operator", "openshift-splunk-forwarder-operator",
 		"splunk-forwarder-operator.v0.1.157-3dca592",
 	)
 })
@@ -10,7 +10,6 @@ import (
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 	"github.com/openshift/osde2e/pkg/common/util"
-	"github.com/spf13/viper"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,10 +21,10 @@ func init() {
 	alert.RegisterGinkgoAlert(daemonSetsTestName, "SD-CICD", "Jeffrey Sica", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(daemonSetsTestName, func() {
+var _ = helper.Describe(daemonSetsTestName, func(cfg *config.RunConfig) {
 	ginkgo.Context("DaemonSets are not allowed", func() {
 		// setup helper
-		h := helper.New()
+		h := helper.New(cfg)
 		nodeLabels := make(map[string]string)
 
 		ginkgo.It("empty node-label daemonset should get created", func() {
@@ -36,7 +35,7 @@ var _ = ginkgo.Describe(daemonSetsTestName, func() {
 			ds := makeDaemonSet("empty-node-labels", h.GetNamespacedServiceAccount(), nodeLabels)
 			_, err := h.Kube().AppsV1().DaemonSets(h.CurrentProject()).Create(context.TODO(), &ds, metav1.CreateOptions{})
 			Expect(err).NotTo(HaveOccurred())
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 
 		ginkgo.It("worker node daemonset should get created", func() {
 			// Set it to a wildcard dedicated-admin
@@ -47,7 +46,7 @@ var _ = ginkgo.Describe(daemonSetsTestName, func() {
 			ds := makeDaemonSet("worker-node-labels", h.GetNamespacedServiceAccount(), nodeLabels)
 			_, err := h.Kube().AppsV1().DaemonSets(h.CurrentProject()).Create(context.TODO(), &ds, metav1.CreateOptions{})
 			Expect(err).NotTo(HaveOccurred())
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 
 		ginkgo.It("infra node daemonset should get created", func() {
 			// Set it to a wildcard dedicated-admin
@@ -58,7 +57,7 @@ var _ = ginkgo.Describe(daemonSetsTestName, func() {
 			ds := makeDaemonSet("infra-node-labels", h.GetNamespacedServiceAccount(), nodeLabels)
 			_, err := h.Kube().AppsV1().DaemonSets(h.CurrentProject()).Create(context.TODO(), &ds, metav1.CreateOptions{})
 			Expect(err).NotTo(HaveOccurred())
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 })
 
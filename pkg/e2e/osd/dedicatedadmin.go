@@ -8,7 +8,6 @@ import (
 	"github.com/openshift/osde2e/pkg/common/alert"
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
-	"github.com/spf13/viper"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 )
@@ -19,11 +18,11 @@ func init() {
 	alert.RegisterGinkgoAlert(dedicatedAdminTestName, "SD-SREP", "Matt Bargenquast", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(daemonSetsTestName, func() {
+var _ = helper.Describe(daemonSetsTestName, func(cfg *config.RunConfig) {
 	ginkgo.Context("dedicated-admin group permissions", func() {
 
 		// setup helper
-		h := helper.New()
+		h := helper.New(cfg)
 
 		ginkgo.It("cannot add members to cluster-admin", func() {
 
@@ -44,7 +43,7 @@ var _ = ginkgo.Describe(daemonSetsTestName, func() {
 			_, err = h.User().UserV1().Groups().Update(context.TODO(), daGroup, metav1.UpdateOptions{})
 			Expect(err).To(HaveOccurred())
 
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 
 		ginkgo.It("cannot delete members from cluster-admin", func() {
 
@@ -69,6 +68,6 @@ var _ = ginkgo.Describe(daemonSetsTestName, func() {
 			_, err = h.User().UserV1().Groups().Update(context.TODO(), daGroup, metav1.UpdateOptions{})
 			Expect(err).To(HaveOccurred())
 
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 })
@@ -8,6 +8,7 @@ import (
 
 	machineV1beta1 "github.com/openshift/machine-api-operator/pkg/apis/machine/v1beta1"
 	"github.com/openshift/osde2e/pkg/common/alert"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,8 +25,8 @@ func init() {
 	alert.RegisterGinkgoAlert(machineHealthTestName, "SD-SRE", "Alex Chvatal", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(machineHealthTestName, func() {
-	h := helper.New()
+var _ = helper.Describe(machineHealthTestName, func(cfg *config.RunConfig) {
+	h := helper.New(cfg)
 
 	ginkgo.It("should exist", func() {
 		machineHealthChecks, err := h.Machine().MachineV1beta1().MachineHealthChecks(OperatorNamespace).List(context.TODO(), metav1.ListOptions{})
@@ -84,6 +85,7 @@ var _ = ginkgo.Describe(machineHealthTestName, func() {
 
 		// execute the runner
 		stopCh := make(chan struct{})
+		defer r.Cleanup()
 		err = r.Run(30, stopCh)
 		Expect(err).NotTo(HaveOccurred())
 
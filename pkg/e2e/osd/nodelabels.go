@@ -8,7 +8,6 @@ import (
 	"github.com/openshift/osde2e/pkg/common/alert"
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
-	"github.com/spf13/viper"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -18,10 +17,10 @@ func init() {
 	alert.RegisterGinkgoAlert(nodeLabelsTestName, "SD-CICD", "Jeffrey Sica", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(nodeLabelsTestName, func() {
+var _ = helper.Describe(nodeLabelsTestName, func(cfg *config.RunConfig) {
 	ginkgo.Context("Modifying nodeLabels is not allowed", func() {
 		// setup helper
-		h := helper.New()
+		h := helper.New(cfg)
 		ginkgo.It("node-label cannot be added", func() {
 			// Set it to a wildcard dedicated-admin
 			h.SetServiceAccount("system:serviceaccount:%s:dedicated-admin-cluster")
@@ -36,6 +35,6 @@ var _ = ginkgo.Describe(nodeLabelsTestName, func() {
 
 			_, err = h.Kube().CoreV1().Nodes().Update(context.TODO(), &node, metav1.UpdateOptions{})
 			Expect(err).To(HaveOccurred())
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 })
@@ -1,12 +1,14 @@
 package osd
 
 import (
+	"context"
+
 	"github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/osde2e/pkg/common/alert"
 	"github.com/openshift/osde2e/pkg/common/config"
+	"github.com/openshift/osde2e/pkg/common/helper"
 	"github.com/openshift/osde2e/pkg/common/providers"
-	"github.com/spf13/viper"
 )
 
 var ocmTestName string = "[Suite: informing] [OSD] OCM"
@@ -15,14 +17,13 @@ func init() {
 	alert.RegisterGinkgoAlert(ocmTestName, "SD-CICD", "Jeffrey Sica", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(ocmTestName, func() {
+var _ = helper.Describe(ocmTestName, func(cfg *config.RunConfig) {
 	ginkgo.Context("Metrics", func() {
-		clusterID := viper.GetString(config.Cluster.ID)
 		ginkgo.It("do exist and are not empty", func() {
-			provider, err := providers.ClusterProvider()
+			provider, err := providers.ClusterProvider(cfg)
 			Expect(err).NotTo(HaveOccurred())
 
-			metrics, err := provider.Metrics(clusterID)
+			metrics, err := provider.Metrics(context.TODO(), cfg.State.ClusterID)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(metrics.CriticalAlertsFiring()).NotTo(BeNil())
@@ -32,6 +33,6 @@ var _ = ginkgo.Describe(ocmTestName, func() {
 			Expect(metrics.Nodes().Master()).NotTo(BeZero())
 			Expect(metrics.ComputeNodesSockets().Empty()).NotTo(BeFalse())
 
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 })
@@ -10,7 +10,6 @@ import (
 	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 	"github.com/openshift/osde2e/pkg/common/util"
-	"github.com/spf13/viper"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -41,10 +40,10 @@ func init() {
 	alert.RegisterGinkgoAlert(privilegedTestname, "SD-CICD", "Jeffrey Sica", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(privilegedTestname, func() {
+var _ = helper.Describe(privilegedTestname, func(cfg *config.RunConfig) {
 	ginkgo.Context("Privileged containers are not allowed", func() {
 		// setup helper
-		h := helper.New()
+		h := helper.New(cfg)
 
 		ginkgo.It("privileged container should not get created", func() {
 			// Set it to a wildcard dedicated-admin
@@ -61,6 +60,6 @@ var _ = ginkgo.Describe(privilegedTestname, func() {
 			_, err = h.Kube().CoreV1().Pods(h.CurrentProject()).Create(context.TODO(), &pod, metav1.CreateOptions{})
 			Expect(err).NotTo(HaveOccurred())
 
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 })
@@ -2,13 +2,20 @@ package routemonitors
 
 import (
 	"context"
+	"encoding/xml"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/onsi/ginkgo/reporters"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 	"github.com/openshift/osde2e/pkg/common/metadata"
 	vegeta "github.com/tsenart/vegeta/lib"
@@ -21,60 +28,65 @@ const (
 	consoleLabel     = "console"
 	oauthNamespace   = "openshift-authentication"
 	oauthName        = "oauth-openshift"
+
+	// JUnitFile is the name of the JUnit file in a phase's report directory recording whether SLOs were met.
+	JUnitFile = "junit_routemonitors.xml"
 )
 
 type RouteMonitors struct {
-	Monitors  map[string]<-chan *vegeta.Result
 	Metrics   map[string]*vegeta.Metrics
 	Plots     map[string]*plot.Plot
-	targeters map[string]vegeta.Targeter
+	targets   map[string]*target
 	attackers []*vegeta.Attacker
+	wg        sync.WaitGroup
+	mutex     sync.Mutex
+
+	// err is why the monitors couldn't be created, if they weren't.
+	err error
 }
 
-// Frequency of requests per second (per route)
-const pollFrequency = 3
-const timeoutSeconds = 3 * time.Second
+// target is a monitored URL and what is expected of it.
+type target struct {
+	name            string
+	url             string
+	rate            int
+	timeout         time.Duration
+	expectedStatus  int
+	availabilitySLO float64
+	latencySLO      time.Duration
 
-// Detects the available routes in the cluster and initializes monitors for their availability
-func Create() (*RouteMonitors, error) {
-	h := helper.NewOutsideGinkgo()
+	requests  int
+	successes int
+}
+
+// Detects the available routes in the cluster and initializes monitors for their availability, along with any
+// configured targets
+func Create(cfg *config.RunConfig) (*RouteMonitors, error) {
+	h := helper.NewOutsideGinkgo(cfg)
 
 	if h == nil {
 		return nil, fmt.Errorf("Unable to generate helper outside ginkgo")
 	}
 
-	// record all targeters created in a map, accessible via a key which is their URL
-	targeters := make(map[string]vegeta.Targeter, 0)
+	rm := &RouteMonitors{
+		Metrics: make(map[string]*vegeta.Metrics, 0),
+		Plots:   make(map[string]*plot.Plot, 0),
+		targets: make(map[string]*target, 0),
+	}
 
 	// Create a monitor for the web console
 	consoleRoute, err := h.Route().RouteV1().Routes(consoleNamespace).Get(context.TODO(), consoleLabel, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve console route %s", consoleLabel)
 	}
-	consoleUrl := fmt.Sprintf("https://%s", consoleRoute.Spec.Host)
-	u, err := url.Parse(consoleUrl)
-	if err == nil {
-		consoleTargeter := vegeta.NewStaticTargeter(vegeta.Target{
-			Method: "GET",
-			URL:    consoleUrl,
-		})
-		targeters[u.Host] = consoleTargeter
-	}
+	rm.addTarget(cfg, config.RouteMonitorTarget{URL: fmt.Sprintf("https://%s", consoleRoute.Spec.Host)})
 
 	// Create a monitor for the oauth URL
 	oauthRoute, err := h.Route().RouteV1().Routes(oauthNamespace).Get(context.TODO(), oauthName, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve oauth route %s", oauthName)
 	}
-	oauthUrl := fmt.Sprintf("https://%s/healthz", oauthRoute.Spec.Host)
-	u, err = url.Parse(oauthUrl)
-	if err == nil {
-		oauthTargeter := vegeta.NewStaticTargeter(vegeta.Target{
-			Method: "GET",
-			URL:    oauthUrl,
-		})
-		targeters[u.Host] = oauthTargeter
-	}
+	rm.addTarget(cfg, config.RouteMonitorTarget{URL: fmt.Sprintf("https://%s/healthz", oauthRoute.Spec.Host)})
 
 	// Create monitors for API Server URLs
 	apiservers, err := h.Cfg().ConfigV1().APIServers().List(context.TODO(), metav1.ListOptions{})
@@ -84,15 +96,7 @@ func Create() (*RouteMonitors, error) {
 	for _, apiServer := range apiservers.Items {
 		for _, servingCert := range apiServer.Spec.ServingCerts.NamedCertificates {
 			for _, name := range servingCert.Names {
-				apiUrl := fmt.Sprintf("https://%s:6443/healthz", name)
-				apiTargeter := vegeta.NewStaticTargeter(vegeta.Target{
-					Method: "GET",
-					URL:    apiUrl,
-				})
-				u, err := url.Parse(apiUrl)
-				if err == nil {
-					targeters[u.Host] = apiTargeter
-				}
+				rm.addTarget(cfg, config.RouteMonitorTarget{URL: fmt.Sprintf("https://%s:6443/healthz", name)})
 			}
 		}
 	}
@@ -103,41 +107,137 @@ func Create() (*RouteMonitors, error) {
 		return nil, fmt.Errorf("could not retrieve list of workload routes")
 	}
 	for _, workloadRoute := range workloadRoutes.Items {
-		workloadUrl := fmt.Sprintf("https://%s", workloadRoute.Spec.Host)
-		u, err := url.Parse(workloadUrl)
-		if err == nil {
-			workloadTargeter := vegeta.NewStaticTargeter(vegeta.Target{
-				Method: "GET",
-				URL:    workloadUrl,
-			})
-			targeters[u.Host] = workloadTargeter
+		rm.addTarget(cfg, config.RouteMonitorTarget{URL: fmt.Sprintf("https://%s", workloadRoute.Spec.Host)})
+	}
+
+	// Configured targets are added last, so they can set SLOs for the targets above by using their host as a name
+	for _, configured := range cfg.RouteMonitors.Targets {
+		if configured.URL == "" {
+			parts := strings.SplitN(configured.Route, "/", 2)
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("route monitor target '%s' must have a URL or a route of the form <namespace>/<name>", configured.Name)
+			}
+
+			route, err := h.Route().RouteV1().Routes(parts[0]).Get(context.TODO(), parts[1], metav1.GetOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("could not retrieve route %s: %v", configured.Route, err)
+			}
+			configured.URL = fmt.Sprintf("https://%s%s", route.Spec.Host, configured.Path)
+		}
+
+		if !rm.addTarget(cfg, configured) {
+			return nil, fmt.Errorf("invalid route monitor target URL '%s'", configured.URL)
 		}
 	}
 
+	return rm, nil
+}
+
+// Failed returns RouteMonitors for monitors that couldn't be created because of err. They monitor nothing and
+// report err as a missed SLO, so a run can't pass without its routes being monitored.
+func Failed(err error) *RouteMonitors {
 	return &RouteMonitors{
-		Monitors:  make(map[string]<-chan *vegeta.Result, 0),
-		Metrics:   make(map[string]*vegeta.Metrics, 0),
-		Plots:     make(map[string]*plot.Plot, 0),
-		targeters: targeters,
-	}, nil
+		Metrics: make(map[string]*vegeta.Metrics, 0),
+		Plots:   make(map[string]*plot.Plot, 0),
+		targets: make(map[string]*target, 0),
+		err:     err,
+	}
+}
+
+// addTarget adds a target to monitor, using the configured defaults for anything it doesn't set. Targets are
+// keyed by their name, which defaults to their host. It returns false if the target's URL can't be parsed.
+func (rm *RouteMonitors) addTarget(cfg *config.RunConfig, t config.RouteMonitorTarget) bool {
+	u, err := url.Parse(t.URL)
+	if err != nil || u.Host == "" {
+		return false
+	}
+
+	added := &target{
+		name:            t.Name,
+		url:             t.URL,
+		rate:            t.Rate,
+		timeout:         time.Duration(t.TimeoutInSeconds) * time.Second,
+		expectedStatus:  t.ExpectedStatus,
+		availabilitySLO: t.AvailabilitySLO,
+		latencySLO:      time.Duration(t.LatencySLOInMilliseconds * float64(time.Millisecond)),
+	}
+	if added.name == "" {
+		added.name = u.Host
+	}
+	if added.rate <= 0 {
+		added.rate = cfg.RouteMonitors.Rate
+	}
+	if added.timeout <= 0 {
+		added.timeout = time.Duration(cfg.RouteMonitors.TimeoutInSeconds) * time.Second
+	}
+	if added.availabilitySLO <= 0 {
+		added.availabilitySLO = cfg.RouteMonitors.AvailabilitySLO
+	}
+	if added.latencySLO <= 0 {
+		added.latencySLO = time.Duration(cfg.RouteMonitors.LatencySLOInMilliseconds * float64(time.Millisecond))
+	}
+
+	rm.targets[added.name] = added
+	return true
 }
 
 // Sets the RouteMonitors to begin polling the configured routes with traffic
 func (rm *RouteMonitors) Start() {
-	pollRate := vegeta.Rate{Freq: pollFrequency, Per: time.Second}
-	timeout := vegeta.Timeout(timeoutSeconds)
-
-	for url, targeter := range rm.targeters {
-		log.Printf("Setting up monitor for %s\n", url)
-		attacker := vegeta.NewAttacker(timeout)
-		rm.Monitors[url] = attacker.Attack(targeter, pollRate, 0, url)
-		rm.Plots[url] = createPlot(url)
+	for name, t := range rm.targets {
+		log.Printf("Setting up monitor for %s at %d rps\n", name, t.rate)
+		rm.Metrics[name] = &vegeta.Metrics{}
+		rm.Plots[name] = createPlot(name)
+
+		targeter := vegeta.NewStaticTargeter(vegeta.Target{
+			Method: "GET",
+			URL:    t.url,
+		})
+		attacker := vegeta.NewAttacker(vegeta.Timeout(t.timeout))
+		results := attacker.Attack(targeter, vegeta.Rate{Freq: t.rate, Per: time.Second}, 0, name)
 		rm.attackers = append(rm.attackers, attacker)
+
+		rm.wg.Add(1)
+		go func() {
+			defer rm.wg.Done()
+			for result := range results {
+				rm.add(result)
+			}
+		}()
 	}
+}
 
-	for title, _ := range rm.Monitors {
-		rm.Metrics[title] = &vegeta.Metrics{}
+// add records the result of a request.
+func (rm *RouteMonitors) add(result *vegeta.Result) {
+	rm.mutex.Lock()
+	defer rm.mutex.Unlock()
+
+	rm.Metrics[result.Attack].Add(result)
+	rm.Plots[result.Attack].Add(result)
+
+	t := rm.targets[result.Attack]
+	t.requests++
+	if t.succeeded(result) {
+		t.successes++
+	}
+}
+
+// succeeded returns true if the request got the status expected of the target.
+func (t *target) succeeded(result *vegeta.Result) bool {
+	if result.Error != "" {
+		return false
 	}
+	if t.expectedStatus != 0 {
+		return int(result.Code) == t.expectedStatus
+	}
+	return result.Code >= 200 && result.Code < 400
+}
+
+// availability is the ratio of requests that succeeded.
+func (t *target) availability() float64 {
+	if t.requests == 0 {
+		return 0
+	}
+	return float64(t.successes) / float64(t.requests)
 }
 
 // Sets the RouteMonitors to cease polling the configured routes with traffic
@@ -145,6 +245,8 @@ func (rm *RouteMonitors) End() {
 	for _, attacker := range rm.attackers {
 		attacker.Stop()
 	}
+	rm.wg.Wait()
+
 	for _, metric := range rm.Metrics {
 		metric.Close()
 	}
@@ -159,15 +261,87 @@ func (rm *RouteMonitors) StoreMetadata() {
 		}
 		metadata.Instance.SetRouteLatency(title, latency)
 		metadata.Instance.SetRouteThroughput(title, metric.Throughput)
-		metadata.Instance.SetRouteAvailability(title, metric.Success)
+		metadata.Instance.SetRouteAvailability(title, rm.targets[title].availability())
+	}
+}
+
+// JUnit returns the SLOs of every target as a JUnit test suite. Targets without SLOs aren't included. Monitors that
+// couldn't be created are reported as a single failed test case.
+func (rm *RouteMonitors) JUnit() reporters.JUnitTestSuite {
+	suite := reporters.JUnitTestSuite{
+		Name: "Route Monitors",
+	}
+
+	names := []string{}
+	for name := range rm.targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	addTestCase := func(name string, met bool, message string) {
+		testCase := reporters.JUnitTestCase{
+			ClassName: "Route Monitors",
+			Name:      fmt.Sprintf("[Route Monitor] %s", name),
+		}
+		if met {
+			testCase.PassedMessage = &reporters.JUnitPassedMessage{Message: message}
+		} else {
+			testCase.FailureMessage = &reporters.JUnitFailureMessage{Type: "SLO", Message: message}
+			suite.Failures++
+		}
+		suite.Tests++
+		suite.TestCases = append(suite.TestCases, testCase)
 	}
+
+	if rm.err != nil {
+		addTestCase("creation", false, fmt.Sprintf("route monitors couldn't be created: %v", rm.err))
+	}
+
+	for _, name := range names {
+		t := rm.targets[name]
+		if t.availabilitySLO > 0 {
+			availability := t.availability()
+			addTestCase(name+" availability", availability >= t.availabilitySLO,
+				fmt.Sprintf("%.4f of %d requests succeeded, the SLO is %.4f", availability, t.requests, t.availabilitySLO))
+		}
+		if t.latencySLO > 0 {
+			var p99 time.Duration
+			if metric, ok := rm.Metrics[name]; ok {
+				p99 = metric.Latencies.P99
+			}
+			addTestCase(name+" latency", t.requests > 0 && p99 <= t.latencySLO,
+				fmt.Sprintf("99th percentile latency of %d requests was %v, the SLO is %v", t.requests, p99, t.latencySLO))
+		}
+	}
+
+	return suite
+}
+
+// Passed returns true if every target met its SLOs.
+func (rm *RouteMonitors) Passed() bool {
+	return rm.JUnit().Failures == 0
+}
+
+// WriteJUnit writes the SLOs of every target as a JUnit XML file.
+func (rm *RouteMonitors) WriteJUnit(filename string) error {
+	suite := rm.JUnit()
+
+	data, err := xml.Marshal(&suite)
+	if err != nil {
+		return fmt.Errorf("error marshalling route monitor SLOs: %v", err)
+	}
+
+	if err = ioutil.WriteFile(filename, data, 0644); err != nil {
+		return fmt.Errorf("error writing route monitor SLOs: %v", err)
+	}
+	return nil
 }
 
 // Saves the measured RouteMonitor metrics in HDR Histogram reports in the specified base directory
 func (rm *RouteMonitors) SaveReports(baseDir string) error {
 	outputDirectory := filepath.Join(baseDir, "route-monitors")
 	if _, err := os.Stat(outputDirectory); os.IsNotExist(err) {
-		if err := os.Mkdir(outputDirectory, os.FileMode(0755)); err != nil {
+		if err := os.MkdirAll(outputDirectory, os.FileMode(0755)); err != nil {
 			return fmt.Errorf("error while creating route monitor report directory %s: %v", outputDirectory, err)
 		}
 	}
@@ -179,6 +353,7 @@ func (rm *RouteMonitors) SaveReports(baseDir string) error {
 			return fmt.Errorf("error while creating route monitor report: %v", err)
 		}
 		reporter.Report(out)
+		out.Close()
 		log.Printf("Wrote route monitor histogram: %s\n", histoPath)
 	}
 	return nil
@@ -188,7 +363,7 @@ func (rm *RouteMonitors) SaveReports(baseDir string) error {
 func (rm *RouteMonitors) SavePlots(baseDir string) error {
 	outputDirectory := filepath.Join(baseDir, "route-monitors")
 	if _, err := os.Stat(outputDirectory); os.IsNotExist(err) {
-		if err := os.Mkdir(outputDirectory, os.FileMode(0755)); err != nil {
+		if err := os.MkdirAll(outputDirectory, os.FileMode(0755)); err != nil {
 			return fmt.Errorf("error while creating route monitor report directory %s: %v", outputDirectory, err)
 		}
 	}
@@ -199,6 +374,7 @@ func (rm *RouteMonitors) SavePlots(baseDir string) error {
 			return fmt.Errorf("error while creating route monitor report: %v", err)
 		}
 		pl.WriteTo(out)
+		out.Close()
 		log.Printf("Wrote route monitor plot: %s\n", plotPath)
 
 	}
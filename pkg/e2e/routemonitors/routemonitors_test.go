@@ -0,0 +1,109 @@
+package routemonitors
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift/osde2e/pkg/common/config"
+	vegeta "github.com/tsenart/vegeta/lib"
+	"github.com/tsenart/vegeta/lib/plot"
+)
+
+func TestTargetsAndSLOs(t *testing.T) {
+	cfg := &config.RunConfig{
+		RouteMonitors: config.RouteMonitorsConfig{
+			Rate:             3,
+			TimeoutInSeconds: 3,
+			AvailabilitySLO:  0.9,
+		},
+	}
+
+	rm := &RouteMonitors{
+		Metrics: map[string]*vegeta.Metrics{},
+		Plots:   map[string]*plot.Plot{},
+		targets: map[string]*target{},
+	}
+
+	rm.addTarget(cfg, config.RouteMonitorTarget{URL: "https://console.example.com"})
+	rm.addTarget(cfg, config.RouteMonitorTarget{
+		Name:                     "app",
+		URL:                      "https://app.example.com/health",
+		Rate:                     10,
+		ExpectedStatus:           204,
+		AvailabilitySLO:          0.5,
+		LatencySLOInMilliseconds: 100,
+	})
+	if rm.addTarget(cfg, config.RouteMonitorTarget{URL: "not a url"}) {
+		t.Errorf("expected a target without a host to be rejected")
+	}
+
+	console, app := rm.targets["console.example.com"], rm.targets["app"]
+	if console == nil || console.rate != 3 || console.timeout != 3*time.Second || console.availabilitySLO != 0.9 || console.latencySLO != 0 {
+		t.Errorf("expected the console target to use the defaults: %+v", console)
+	}
+	if app == nil || app.rate != 10 || app.availabilitySLO != 0.5 || app.latencySLO != 100*time.Millisecond {
+		t.Errorf("expected the app target to use its own settings: %+v", app)
+	}
+
+	for name := range rm.targets {
+		rm.Metrics[name] = &vegeta.Metrics{}
+		rm.Plots[name] = createPlot(name)
+	}
+
+	// The console succeeds 8 of 10 times, and the app only counts its expected status as a success.
+	for i := 0; i < 10; i++ {
+		consoleResult := &vegeta.Result{Attack: "console.example.com", Code: 200, Latency: 10 * time.Millisecond}
+		if i < 2 {
+			consoleResult.Code = 503
+		}
+		rm.add(consoleResult)
+
+		appResult := &vegeta.Result{Attack: "app", Code: 204, Latency: 50 * time.Millisecond}
+		if i < 4 {
+			appResult.Code = 200
+		}
+		rm.add(appResult)
+	}
+
+	for _, metric := range rm.Metrics {
+		metric.Close()
+	}
+
+	if console.availability() != 0.8 || app.availability() != 0.6 {
+		t.Errorf("unexpected availability: console %v, app %v", console.availability(), app.availability())
+	}
+
+	suite := rm.JUnit()
+	if suite.Tests != 3 || suite.Failures != 1 || rm.Passed() {
+		t.Fatalf("expected the console availability SLO to be missed: %+v", suite)
+	}
+
+	expected := []struct {
+		name   string
+		failed bool
+	}{
+		{"[Route Monitor] app availability", false},
+		{"[Route Monitor] app latency", false},
+		{"[Route Monitor] console.example.com availability", true},
+	}
+	for i, testCase := range suite.TestCases {
+		if testCase.Name != expected[i].name || (testCase.FailureMessage != nil) != expected[i].failed {
+			t.Errorf("expected %s to have failed=%v: %+v", expected[i].name, expected[i].failed, testCase)
+		}
+	}
+}
+
+func TestFailedRouteMonitors(t *testing.T) {
+	rm := Failed(fmt.Errorf("no kubeconfig"))
+	rm.Start()
+	rm.End()
+
+	suite := rm.JUnit()
+	if suite.Tests != 1 || suite.Failures != 1 || rm.Passed() {
+		t.Fatalf("expected monitors that couldn't be created to fail: %+v", suite)
+	}
+	if message := suite.TestCases[0].FailureMessage; message == nil || message.Message != "route monitors couldn't be created: no kubeconfig" {
+		t.Errorf("unexpected failure: %+v", suite.TestCases[0])
+	}
+}
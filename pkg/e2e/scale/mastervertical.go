@@ -1,9 +1,10 @@
 package scale
 
 import (
+	"context"
+
 	"github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	"github.com/spf13/viper"
 	kubev1 "k8s.io/api/core/v1"
 
 	"github.com/openshift/osde2e/pkg/common/alert"
@@ -22,15 +23,15 @@ func init() {
 	alert.RegisterGinkgoAlert(masterVerticalTestName, "SD-CICD", "Michael Wilson", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(masterVerticalTestName, func() {
+var _ = helper.Describe(masterVerticalTestName, func(cfg *config.RunConfig) {
 	defer ginkgo.GinkgoRecover()
-	h := helper.New()
+	h := helper.New(cfg)
 
 	masterVerticalTimeoutInSeconds := 7200
 	ginkgo.It("should be tested with MasterVertical", func() {
 		var err error
 		// Before we do anything, scale the cluster.
-		err = cluster.ScaleCluster(viper.GetString(config.Cluster.ID), numNodesToScaleTo)
+		err = cluster.ScaleCluster(context.TODO(), h.Config(), h.Config().State.ClusterID, numNodesToScaleTo)
 		Expect(err).NotTo(HaveOccurred())
 
 		h.SetServiceAccount("system:serviceaccount:%s:cluster-admin")
@@ -48,6 +49,7 @@ var _ = ginkgo.Describe(masterVerticalTestName, func() {
 		})
 		// run tests
 		stopCh := make(chan struct{})
+		defer r.Cleanup()
 		err = r.Run(masterVerticalTimeoutInSeconds, stopCh)
 		Expect(err).NotTo(HaveOccurred())
 	}, float64(masterVerticalTimeoutInSeconds))
@@ -8,6 +8,7 @@ import (
 	kubev1 "k8s.io/api/core/v1"
 
 	"github.com/openshift/osde2e/pkg/common/alert"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 )
 
@@ -21,9 +22,9 @@ func init() {
 	alert.RegisterGinkgoAlert(nodesPodsTestName, "SD-CICD", "Michael Wilson", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(nodesPodsTestName, func() {
+var _ = helper.Describe(nodesPodsTestName, func(cfg *config.RunConfig) {
 	defer ginkgo.GinkgoRecover()
-	h := helper.New()
+	h := helper.New(cfg)
 
 	nodeVerticalTimeoutInSeconds := 3600
 	ginkgo.It("should be tested with NodeVertical", func() {
@@ -48,6 +49,7 @@ var _ = ginkgo.Describe(nodesPodsTestName, func() {
 		})
 		// run tests
 		stopCh := make(chan struct{})
+		defer r.Cleanup()
 		err := r.Run(nodeVerticalTimeoutInSeconds, stopCh)
 		Expect(err).NotTo(HaveOccurred())
 	}, float64(nodeVerticalTimeoutInSeconds))
@@ -64,6 +66,7 @@ var _ = ginkgo.Describe(nodesPodsTestName, func() {
 
 		// run tests
 		stopCh := make(chan struct{})
+		defer r.Cleanup()
 		err := r.Run(podVerticalTimeoutInSeconds, stopCh)
 		Expect(err).NotTo(HaveOccurred())
 	}, float64(podVerticalTimeoutInSeconds))
@@ -6,6 +6,7 @@ import (
 	kubev1 "k8s.io/api/core/v1"
 
 	"github.com/openshift/osde2e/pkg/common/alert"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 )
 
@@ -15,9 +16,9 @@ func init() {
 	alert.RegisterGinkgoAlert(performanceTestName, "SD-CICD", "Michael Wilson", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(performanceTestName, func() {
+var _ = helper.Describe(performanceTestName, func(cfg *config.RunConfig) {
 	defer ginkgo.GinkgoRecover()
-	h := helper.New()
+	h := helper.New(cfg)
 
 	httpTimeoutInSeconds := 7200
 	ginkgo.It("should be tested with HTTP", func() {
@@ -36,6 +37,7 @@ var _ = ginkgo.Describe(performanceTestName, func() {
 
 		// run tests
 		stopCh := make(chan struct{})
+		defer r.Cleanup()
 		err := r.Run(httpTimeoutInSeconds, stopCh)
 		Expect(err).NotTo(HaveOccurred())
 	}, float64(httpTimeoutInSeconds))
@@ -10,10 +10,8 @@ import (
 
 	"github.com/markbates/pkger"
 	. "github.com/onsi/gomega"
-	"github.com/spf13/viper"
 	kubev1 "k8s.io/api/core/v1"
 
-	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 	"github.com/openshift/osde2e/pkg/common/runner"
 )
@@ -65,9 +63,9 @@ func (sCfg scaleRunnerConfig) Runner(h *helper.H) *runner.Runner {
 		scaleRepos = runner.Repos{
 			{
 				Name:      "workloads",
-				URL:       viper.GetString(config.Scale.WorkloadsRepository),
+				URL:       h.Config().Scale.WorkloadsRepository,
 				MountPath: WorkloadsPath,
-				Branch:    viper.GetString(config.Scale.WorkloadsRepositoryBranch),
+				Branch:    h.Config().Scale.WorkloadsRepositoryBranch,
 			},
 		}
 	})
@@ -75,7 +73,7 @@ func (sCfg scaleRunnerConfig) Runner(h *helper.H) *runner.Runner {
 	// template command from config
 	sCfg.Name = "scale-" + sCfg.Name
 	sCfg.WorkloadsPath = WorkloadsPath
-	sCfg.Kubeconfig = viper.GetString(config.Kubeconfig.Contents)
+	sCfg.Kubeconfig = h.Config().State.Kubeconfig
 	cmd := sCfg.cmd()
 
 	// configure runner for scale testing
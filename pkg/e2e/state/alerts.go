@@ -9,7 +9,6 @@ import (
 	"github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/prometheus/common/log"
-	"github.com/spf13/viper"
 
 	"github.com/openshift/osde2e/pkg/common/alert"
 	"github.com/openshift/osde2e/pkg/common/config"
@@ -47,9 +46,9 @@ func init() {
 	alert.RegisterGinkgoAlert(clusterStateTestName, "SD-CICD", "Michael Wilson", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(clusterStateTestName, func() {
+var _ = helper.Describe(clusterStateTestName, func(cfg *config.RunConfig) {
 	defer ginkgo.GinkgoRecover()
-	h := helper.New()
+	h := helper.New(cfg)
 
 	alertsTimeoutInSeconds := 900
 	ginkgo.It("should have no alerts", func() {
@@ -70,6 +69,7 @@ var _ = ginkgo.Describe(clusterStateTestName, func() {
 		Eventually(func() bool {
 			// run tests
 			stopCh := make(chan struct{})
+			defer r.Cleanup()
 			err = r.Run(alertsTimeoutInSeconds, stopCh)
 			Expect(err).NotTo(HaveOccurred(), "failure running command on pod")
 
@@ -84,10 +84,10 @@ var _ = ginkgo.Describe(clusterStateTestName, func() {
 			err = json.Unmarshal(results["alerts.json"], &queryJSON)
 			Expect(err).NotTo(HaveOccurred(), "failure parsing JSON results from alert manager")
 
-			clusterProvider, err := providers.ClusterProvider()
+			clusterProvider, err := providers.ClusterProvider(h.Config())
 			Expect(err).NotTo(HaveOccurred(), "failure to get cluster provider")
 
-			foundCritical := findCriticalAlerts(queryJSON.Data.Results, viper.GetString(config.Provider), clusterProvider.Environment())
+			foundCritical := findCriticalAlerts(queryJSON.Data.Results, h.Config().Provider, clusterProvider.Environment())
 			Expect(foundCritical).To(BeFalse(), "found a critical alert")
 
 			return err == nil && !foundCritical
@@ -4,6 +4,7 @@ import (
 	"github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 	"github.com/openshift/osde2e/pkg/common/runner"
 )
@@ -13,9 +14,9 @@ const (
 	promCollectCmd = "oc exec -n openshift-monitoring prometheus-k8s-0 -c prometheus -- /bin/sh -c \"cp -ruf /prometheus /tmp/ && tar cvzO -C /tmp/prometheus . \""
 )
 
-var _ = ginkgo.Describe(clusterStateTestName, func() {
+var _ = helper.Describe(clusterStateTestName, func(cfg *config.RunConfig) {
 	defer ginkgo.GinkgoRecover()
-	h := helper.New()
+	h := helper.New(cfg)
 
 	prometheusTimeoutInSeconds := 900
 	ginkgo.It("should include Prometheus data", func() {
@@ -31,6 +32,7 @@ var _ = ginkgo.Describe(clusterStateTestName, func() {
 
 		// run tests
 		stopCh := make(chan struct{})
+		defer r.Cleanup()
 		err := r.Run(prometheusTimeoutInSeconds, stopCh)
 		Expect(err).NotTo(HaveOccurred())
 
@@ -9,6 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/openshift/osde2e/pkg/common/alert"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 )
 
@@ -18,8 +19,8 @@ func init() {
 	alert.RegisterGinkgoAlert(imageStreamsTestName, "SD-CICD", "Jeffrey Sica", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(imageStreamsTestName, func() {
-	h := helper.New()
+var _ = helper.Describe(imageStreamsTestName, func(cfg *config.RunConfig) {
+	h := helper.New(cfg)
 
 	ginkgo.It("should exist in the cluster", func() {
 		list, err := h.Image().ImageV1().ImageStreams(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
@@ -16,7 +16,6 @@ import (
 	"k8s.io/client-go/rest"
 
 	"github.com/openshift/osde2e/pkg/common/helper"
-	"github.com/spf13/viper"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -26,7 +25,7 @@ func init() {
 	alert.RegisterGinkgoAlert(namespaceWebhookTestName, "SD-SREP", "Matt Bargenquast", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(namespaceWebhookTestName, func() {
+var _ = helper.Describe(namespaceWebhookTestName, func(cfg *config.RunConfig) {
 	const (
 		// Group to use for impersonation
 		DUMMY_GROUP = "random-group-name"
@@ -69,7 +68,7 @@ var _ = ginkgo.Describe(namespaceWebhookTestName, func() {
 		"logger",
 	}
 
-	h := helper.New()
+	h := helper.New(cfg)
 
 	ginkgo.Context("namespace validating webhook", func() {
 
@@ -141,7 +140,7 @@ var _ = ginkgo.Describe(namespaceWebhookTestName, func() {
 				err := updateNamespace(namespace, DUMMY_USER, "dedicated-admins", h)
 				Expect(err).To(HaveOccurred())
 			}
-		}, viper.GetFloat64(config.Tests.PollingTimeout))
+		}, config.DefaultPollingTimeout)
 
 		ginkgo.It("Non-privileged users cannot manage privileged namespaces", func() {
 			for privilegedNamespace := range PRIVILEGED_NAMESPACES {
@@ -152,7 +151,7 @@ var _ = ginkgo.Describe(namespaceWebhookTestName, func() {
 				err := updateNamespace(namespace, DUMMY_USER, DUMMY_GROUP, h)
 				Expect(err).To(HaveOccurred())
 			}
-		}, viper.GetFloat64(config.Tests.PollingTimeout))
+		}, config.DefaultPollingTimeout)
 
 		ginkgo.It("Members of SRE groups can manage all namespaces", func() {
 			for _, sreGroup := range SRE_GROUPS {
@@ -165,7 +164,7 @@ var _ = ginkgo.Describe(namespaceWebhookTestName, func() {
 					Expect(err).NotTo(HaveOccurred())
 				}
 			}
-		}, viper.GetFloat64(config.Tests.PollingTimeout))
+		}, config.DefaultPollingTimeout)
 
 		ginkgo.It("Privileged users can manage all namespaces", func() {
 			for _, privilegedUser := range PRIVILEGED_USERS {
@@ -178,7 +177,7 @@ var _ = ginkgo.Describe(namespaceWebhookTestName, func() {
 					Expect(err).NotTo(HaveOccurred())
 				}
 			}
-		}, viper.GetFloat64(config.Tests.PollingTimeout))
+		}, config.DefaultPollingTimeout)
 
 		ginkgo.It("Non-privileged users can manage all non-privileged namespaces", func() {
 			// Non-privileged users can manage all non-privileged namespaces
@@ -186,7 +185,7 @@ var _ = ginkgo.Describe(namespaceWebhookTestName, func() {
 				err := updateNamespace(nonPrivilegedNamespace, DUMMY_USER, "dedicated-admins", h)
 				Expect(err).NotTo(HaveOccurred())
 			}
-		}, viper.GetFloat64(config.Tests.PollingTimeout))
+		}, config.DefaultPollingTimeout)
 	})
 })
 
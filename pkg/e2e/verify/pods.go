@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/openshift/osde2e/pkg/common/alert"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 )
 
@@ -23,8 +24,8 @@ func init() {
 	alert.RegisterGinkgoAlert(podsTestName, "SD-CICD", "Jeffrey Sica", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(podsTestName, func() {
-	h := helper.New()
+var _ = helper.Describe(podsTestName, func(cfg *config.RunConfig) {
+	h := helper.New(cfg)
 
 	ginkgo.It("should be Running or Succeeded", func() {
 		var (
@@ -7,7 +7,6 @@ import (
 
 	"github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	"github.com/spf13/viper"
 
 	"github.com/openshift/osde2e/pkg/common/alert"
 	"github.com/openshift/osde2e/pkg/common/cluster"
@@ -23,7 +22,7 @@ func init() {
 	alert.RegisterGinkgoAlert(promExportersTestname, "SD-SREP", "Matt Bargenquast", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(promExportersTestname, func() {
+var _ = helper.Describe(promExportersTestname, func(cfg *config.RunConfig) {
 	const (
 		// all represents all environments
 		allProviders = "all"
@@ -77,11 +76,11 @@ var _ = ginkgo.Describe(promExportersTestname, func() {
 		},
 	}
 
-	h := helper.New()
+	h := helper.New(cfg)
 
 	ginkgo.It("should exist and be running in the cluster", func() {
 
-		envs := []string{allProviders, viper.GetString(config.CloudProvider.CloudProviderID)}
+		envs := []string{allProviders, h.Config().State.CloudProviderID}
 
 		// Expect project to exist
 		_, err := h.Project().ProjectV1().Projects().Get(context.TODO(), promNamespace, metav1.GetOptions{})
@@ -133,10 +132,11 @@ func checkRoleBindings(namespace string, roleBindings map[string][]string, h *he
 }
 
 func checkDaemonSets(namespace string, daemonSets map[string][]string, h *helper.H, providers ...string) {
-	provider, err := clusterProviders.ClusterProvider()
+	cfg := h.Config()
+	provider, err := clusterProviders.ClusterProvider(cfg)
 	Expect(err).NotTo(HaveOccurred(), "error getting cluster provider")
-	currentClusterVersion, err := cluster.GetClusterVersion(provider, viper.GetString(config.Cluster.ID))
-	Expect(err).NotTo(HaveOccurred(), "error getting cluster version %s", viper.GetString(config.Cluster.Version))
+	currentClusterVersion, err := cluster.GetClusterVersion(context.TODO(), cfg, provider, cfg.State.ClusterID)
+	Expect(err).NotTo(HaveOccurred(), "error getting cluster version %s", cfg.State.ClusterVersion)
 
 	for _, provider := range providers {
 		for _, daemonSetName := range daemonSets[provider] {
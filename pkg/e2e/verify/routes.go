@@ -13,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/openshift/osde2e/pkg/common/alert"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 )
 
@@ -29,8 +30,8 @@ func init() {
 	alert.RegisterGinkgoAlert(routesTestName, "SD-CICD", "Jeffrey Sica", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(routesTestName, func() {
-	h := helper.New()
+var _ = helper.Describe(routesTestName, func(cfg *config.RunConfig) {
+	h := helper.New(cfg)
 
 	ginkgo.It("should be created for Console", func() {
 		consoleRoutes(h)
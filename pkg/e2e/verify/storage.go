@@ -9,6 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/openshift/osde2e/pkg/common/alert"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 )
 
@@ -18,8 +19,8 @@ func init() {
 	alert.RegisterGinkgoAlert(storageTestName, "SD-SREP", "Christoph Blecker", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(storageTestName, func() {
-	h := helper.New()
+var _ = helper.Describe(storageTestName, func(cfg *config.RunConfig) {
+	h := helper.New(cfg)
 	ginkgo.It("should be able to be expanded", func() {
 		scList, err := h.Kube().StorageV1().StorageClasses().List(context.TODO(), metav1.ListOptions{})
 		Expect(err).NotTo(HaveOccurred(), "couldn't list StorageClasses")
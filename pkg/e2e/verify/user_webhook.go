@@ -5,7 +5,6 @@ import (
 
 	"github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	"github.com/spf13/viper"
 
 	userv1 "github.com/openshift/api/user/v1"
 	"github.com/openshift/osde2e/pkg/common/alert"
@@ -28,8 +27,8 @@ func init() {
 	alert.RegisterGinkgoAlert(userWebhookTestName, "SD-SREP", "Haoran Wang", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(userWebhookTestName, func() {
-	h := helper.New()
+var _ = helper.Describe(userWebhookTestName, func(cfg *config.RunConfig) {
+	h := helper.New(cfg)
 
 	ginkgo.Context("user validating webhook", func() {
 		ginkgo.It("dedicated admins cannot manage redhat users", func() {
@@ -49,7 +48,7 @@ var _ = ginkgo.Describe(userWebhookTestName, func() {
 			})
 			err = deleteUser(userName, h)
 			Expect(err).To(HaveOccurred())
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 
 		ginkgo.It("dedicated admins cannot manage redhat user identity", func() {
 			providerUsername := util.RandomStr(5)
@@ -70,7 +69,7 @@ var _ = ginkgo.Describe(userWebhookTestName, func() {
 			})
 			err = deleteIdentity(idName, h)
 			Expect(err).To(HaveOccurred())
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 
 		ginkgo.It("dedicated admins can manage customer user identity", func() {
 			providerUsername := util.RandomStr(5)
@@ -91,7 +90,7 @@ var _ = ginkgo.Describe(userWebhookTestName, func() {
 			})
 			err = deleteIdentity(idName, h)
 			Expect(err).To(HaveOccurred())
-		}, float64(viper.GetFloat64(config.Tests.PollingTimeout)))
+		}, config.DefaultPollingTimeout)
 	})
 })
 
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/openshift/osde2e/pkg/common/alert"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 )
 
@@ -19,13 +20,13 @@ func init() {
 	alert.RegisterGinkgoAlert(validationWebhookTestName, "SD-SREP", "Matt Bargenquast", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(validationWebhookTestName, func() {
+var _ = helper.Describe(validationWebhookTestName, func(cfg *config.RunConfig) {
 	var namespace = "openshift-validation-webhook"
 	var service = "validation-webhook"
 	var configMapName = "webhook-cert"
 	var secretName = "webhook-cert"
 
-	h := helper.New()
+	h := helper.New(cfg)
 
 	ginkgo.It("should exist and be running in the cluster", func() {
 
@@ -1,6 +1,7 @@
 package e2e
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,29 +12,28 @@ import (
 	"github.com/openshift/osde2e/pkg/common/spi"
 	"github.com/openshift/osde2e/pkg/common/util"
 	"github.com/openshift/osde2e/pkg/common/versions"
-	"github.com/spf13/viper"
 )
 
 // ChooseVersions sets versions in cfg if not set based on defaults and upgrade options.
 // If a release stream is set for an upgrade the previous available version is used and it's image is used for upgrade.
-func ChooseVersions() (err error) {
+func ChooseVersions(ctx context.Context, cfg *config.RunConfig) (err error) {
 	// when defined, use set version
 	if provider == nil {
 		err = errors.New("osd must be setup when upgrading with release stream")
 	} else {
-		versionList, err := provider.Versions()
+		versionList, err := provider.Versions(ctx)
 
 		if err != nil {
 			return fmt.Errorf("error getting versions: %v", err)
 		}
 
-		clusterVersion, err := setupVersion(versionList)
+		clusterVersion, err := setupVersion(cfg, versionList)
 
 		if err != nil {
 			return fmt.Errorf("error while selecting install version: %v", err)
 		}
 
-		err = setupUpgradeVersion(clusterVersion, versionList)
+		err = setupUpgradeVersion(cfg, clusterVersion, versionList)
 
 		if err != nil {
 			return fmt.Errorf("error while selecting upgrade version: %v", err)
@@ -41,26 +41,26 @@ func ChooseVersions() (err error) {
 	}
 
 	// Set the versions in metadata. If upgrade hasn't been chosen, it should still be omitted from the end result.
-	metadata.Instance.SetClusterVersion(viper.GetString(config.Cluster.Version))
-	metadata.Instance.SetUpgradeVersion(viper.GetString(config.Upgrade.ReleaseName))
+	metadata.Instance.SetClusterVersion(cfg.State.ClusterVersion)
+	metadata.Instance.SetUpgradeVersion(cfg.State.UpgradeReleaseName)
 
 	return err
 }
 
 // chooses between default version and nightly based on target versions.
-func setupVersion(versionList *spi.VersionList) (*semver.Version, error) {
+func setupVersion(cfg *config.RunConfig, versionList *spi.VersionList) (*semver.Version, error) {
 	var selectedVersion *semver.Version
 
 	versionType := "user supplied version"
 
-	clusterVersion := viper.GetString(config.Cluster.Version)
+	clusterVersion := cfg.State.ClusterVersion
 	if len(clusterVersion) == 0 {
 		var err error
 
-		selectedVersion, versionType, err = versions.GetVersionForInstall(versionList)
+		selectedVersion, versionType, err = versions.GetVersionForInstall(cfg, versionList)
 		if err == nil {
-			if viper.GetBool(config.Cluster.EnoughVersionsForOldestOrMiddleTest) && viper.GetBool(config.Cluster.PreviousVersionFromDefaultFound) {
-				viper.Set(config.Cluster.Version, util.SemverToOpenshiftVersion(selectedVersion))
+			if cfg.State.EnoughVersionsForOldestOrMiddleTest && cfg.State.PreviousVersionFromDefaultFound {
+				cfg.State.ClusterVersion = util.SemverToOpenshiftVersion(selectedVersion)
 				clusterVersion = util.SemverToOpenshiftVersion(selectedVersion)
 			} else {
 				log.Printf("Unable to get the %s.", versionType)
@@ -88,10 +88,10 @@ func setupVersion(versionList *spi.VersionList) (*semver.Version, error) {
 }
 
 // chooses version based on optimal upgrade path
-func setupUpgradeVersion(clusterVersion *semver.Version, versionList *spi.VersionList) error {
+func setupUpgradeVersion(cfg *config.RunConfig, clusterVersion *semver.Version, versionList *spi.VersionList) error {
 	var err error
 
-	if viper.GetString(config.Upgrade.ReleaseName) != "" || viper.GetString(config.Upgrade.Image) != "" {
+	if cfg.State.UpgradeReleaseName != "" || cfg.State.UpgradeImage != "" {
 		log.Printf("Using user supplied upgrade state.")
 		return nil
 	}
@@ -102,7 +102,7 @@ func setupUpgradeVersion(clusterVersion *semver.Version, versionList *spi.Versio
 	}
 
 	upgradeSource := provider.UpgradeSource()
-	releaseName, image, err := versions.GetVersionForUpgrade(clusterVersion, versionList, upgradeSource)
+	releaseName, image, err := versions.GetVersionForUpgrade(cfg, clusterVersion, versionList, upgradeSource)
 
 	if err != nil {
 		return fmt.Errorf("error selecting an upgrade version: %v", err)
@@ -113,11 +113,11 @@ func setupUpgradeVersion(clusterVersion *semver.Version, versionList *spi.Versio
 		return nil
 	}
 
-	viper.Set(config.Upgrade.ReleaseName, releaseName)
-	viper.Set(config.Upgrade.Image, image)
+	cfg.State.UpgradeReleaseName = releaseName
+	cfg.State.UpgradeImage = image
 
 	// set upgrade image
 	log.Printf("Selecting version '%s' to be able to upgrade to '%s' using upgrade source '%s'",
-		viper.GetString(config.Cluster.Version), releaseName, upgradeSource)
+		cfg.State.ClusterVersion, releaseName, upgradeSource)
 	return nil
 }
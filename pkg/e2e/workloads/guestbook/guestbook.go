@@ -14,6 +14,7 @@ import (
 
 	"github.com/openshift/osde2e/pkg/common/alert"
 	"github.com/openshift/osde2e/pkg/common/cluster/healthchecks"
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
@@ -30,10 +31,10 @@ func init() {
 	alert.RegisterGinkgoAlert(testName, "SD-CICD", "Jeffrey Sica", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(testName, func() {
+var _ = helper.Describe(testName, func(cfg *config.RunConfig) {
 	defer ginkgo.GinkgoRecover()
 	// setup helper
-	h := helper.New()
+	h := helper.New(cfg)
 
 	ginkgo.It("should get created in the cluster", func() {
 		// Does this workload exist? If so, this must be a repeat run.
@@ -73,7 +74,7 @@ var _ = ginkgo.Describe(testName, func() {
 			// Wait for all pods to come up healthy
 			err = wait.PollImmediate(15*time.Second, 5*time.Minute, func() (bool, error) {
 				// This is pretty basic. Are all the pods up? Cool.
-				if check, err := healthchecks.CheckPodHealth(h.Kube().CoreV1(), nil); !check || err != nil {
+				if diagnostics, err := healthchecks.CheckPodHealth(context.TODO(), h.Kube().CoreV1(), nil); !diagnostics.Healthy || err != nil {
 					return false, nil
 				}
 				return true, nil
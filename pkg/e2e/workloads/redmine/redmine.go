@@ -12,12 +12,11 @@ import (
 
 	"github.com/openshift/osde2e/pkg/common/alert"
 	"github.com/openshift/osde2e/pkg/common/cluster/healthchecks"
-	"github.com/openshift/osde2e/pkg/common/config"
-	"github.com/spf13/viper"
 
 	"github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/helper"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
@@ -34,10 +33,10 @@ func init() {
 	alert.RegisterGinkgoAlert(testName, "SD-SREP", "Matt Bargenquast", "sd-cicd-alerts", "sd-cicd@redhat.com", 4)
 }
 
-var _ = ginkgo.Describe(testName, func() {
+var _ = helper.Describe(testName, func(cfg *config.RunConfig) {
 	defer ginkgo.GinkgoRecover()
 	// setup helper
-	h := helper.New()
+	h := helper.New(cfg)
 
 	redmineTimeoutInSeconds := 900
 	ginkgo.It("should get created in the cluster", func() {
@@ -59,7 +58,7 @@ var _ = ginkgo.Describe(testName, func() {
 			// Wait for all pods to come up healthy
 			err = wait.PollImmediate(15*time.Second, 10*time.Minute, func() (bool, error) {
 				// This is pretty basic. Are all the pods up? Cool.
-				if check, err := healthchecks.CheckPodHealth(h.Kube().CoreV1(), nil); !check || err != nil {
+				if diagnostics, err := healthchecks.CheckPodHealth(context.TODO(), h.Kube().CoreV1(), nil); !diagnostics.Healthy || err != nil {
 					return false, nil
 				}
 				return true, nil
@@ -115,7 +114,7 @@ func doTest(h *helper.H) {
 	interval := 5
 
 	// convert time.Duration type
-	timeoutDuration := time.Duration(viper.GetFloat64(config.Tests.PollingTimeout)) * time.Minute
+	timeoutDuration := time.Duration(h.Config().Tests.PollingTimeout) * time.Minute
 	intervalDuration := time.Duration(interval) * time.Second
 
 	start := time.Now()
@@ -18,43 +18,24 @@ import (
 	"github.com/prometheus/client_golang/api"
 	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
-	"github.com/spf13/viper"
 )
 
-const (
-	maxQueryTimeoutInSeconds = "osde2e.metricsLib.maxQueryTimeoutInSeconds"
-
-	stepDurationInHours = "osde2e.metricsLib.stepDurationInHours"
-)
-
-func init() {
-	// Set our max query timeout to 2 minutes for now.
-	viper.SetDefault(maxQueryTimeoutInSeconds, 120)
-	viper.BindEnv(maxQueryTimeoutInSeconds, "OSDE2E_METRICSLIB_MAX_QUERY_TIMEOUT_IN_SECONDS")
-
-	// Hard code our step duration to 4 for now. Our jobs are pretty coarse -- running every 4+ hours.
-	// We'll bake this into our client to prevent our users from getting oversampled data.
-	viper.SetDefault(stepDurationInHours, 4)
-	viper.BindEnv(stepDurationInHours, "OSDE2E_METRICSLIB_STEP_DURATION_IN_HOURS")
-}
-
 // Client is a metrics client that can be used to query osde2e's metrics.
 type Client struct {
+	cfg    *config.RunConfig
 	client api.Client
 }
 
-// NewClient returns a new metrics client.
-// If no arguments are supplied, the global config will be used.
-// If one argument is supplied, it will be used as the address for Prometheus, but will use the global config for the bearer token.
-// If two arguments are supplied, the first will be used as the address for Prometheus and the second will be used as the bearer token.
-func NewClient(args ...string) (*Client, error) {
-	client, err := prometheus.CreateClient(args...)
+// NewClient returns a new metrics client for the Prometheus instance configured by cfg.
+func NewClient(cfg *config.RunConfig) (*Client, error) {
+	client, err := prometheus.CreateClient(cfg.Prometheus)
 
 	if err != nil {
 		return nil, fmt.Errorf("error trying to create the metrics client: %v", err)
 	}
 
 	return &Client{
+		cfg:    cfg,
 		client: client,
 	}, nil
 }
@@ -179,10 +160,10 @@ func (c *Client) ListAllClusterIDs(cloudProvider, environment string, begin, end
 // Issues a query and prints out the associated warnings.
 func (c *Client) issueQuery(query string, begin, end time.Time) (model.Value, error) {
 	promAPI := v1.NewAPI(c.client)
-	context, cancel := context.WithTimeout(context.Background(), viper.GetDuration(maxQueryTimeoutInSeconds)*time.Second)
+	context, cancel := context.WithTimeout(context.Background(), time.Duration(c.cfg.Metrics.MaxQueryTimeoutInSeconds)*time.Second)
 	defer cancel()
 
-	results, warnings, err := promAPI.QueryRange(context, query, makeRange(begin, end))
+	results, warnings, err := promAPI.QueryRange(context, query, c.makeRange(begin, end))
 
 	if len(warnings) > 0 {
 		log.Printf("Job query warnings: %v", warnings)
@@ -253,6 +234,11 @@ func stringToPhase(inputString string) Phase {
 		return Upgrade
 	}
 
+	// Each hop of an upgrade path has its own upgrade phase, e.g. upgrade-2.
+	if strings.HasPrefix(lowerCaseInput, "upgrade-") {
+		return Upgrade
+	}
+
 	return UnknownPhase
 }
 
@@ -261,11 +247,11 @@ func escapeQuotes(stringToEscape string) string {
 }
 
 // makeRange will make a query range for metrics queries and bake in the 4 hour step, as it's the lowest granularity we have for any of our jobs.
-func makeRange(begin, end time.Time) v1.Range {
+func (c *Client) makeRange(begin, end time.Time) v1.Range {
 	return v1.Range{
-		Start: time.Now().Add(-time.Hour * (viper.GetDuration(config.Weather.StartOfTimeWindowInHours))),
+		Start: time.Now().Add(-time.Hour * time.Duration(c.cfg.Weather.StartOfTimeWindowInHours)),
 		End:   time.Now(),
-		Step:  viper.GetDuration(stepDurationInHours) * time.Hour,
+		Step:  time.Duration(c.cfg.Metrics.StepDurationInHours) * time.Hour,
 	}
 }
 
@@ -206,6 +206,11 @@ func TestStringToPhase(t *testing.T) {
 			stringToParse: "UpGrAdE",
 			expectedPhase: Upgrade,
 		},
+		{
+			name:          "upgrade hop",
+			stringToParse: "upgrade-2",
+			expectedPhase: Upgrade,
+		},
 		{
 			name:          "unknown",
 			stringToParse: "something else",
@@ -116,6 +116,18 @@ func (c *Client) ListFailedJUnitResultsByTestName(testName string, begin, end ti
 	return processJUnitResults(results)
 }
 
+// ListAverageDurationsByTestName will return the average duration of each test whose name matches the given regex in
+// the given time range. Skipped tests aren't included.
+func (c *Client) ListAverageDurationsByTestName(testNameRegex string, begin, end time.Time) (map[string]time.Duration, error) {
+	results, err := c.issueQuery(fmt.Sprintf("avg by (testname) (cicd_jUnitResult{result!=\"skipped\", testname=~\"%s\"})", escapeQuotes(testNameRegex)), begin, end)
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing test durations: %v", err)
+	}
+
+	return processAverageDurations(results)
+}
+
 func calculatePassRates(results []JUnitResult) map[string]float64 {
 	type counts struct {
 		numPasses        int
@@ -224,3 +236,23 @@ func sampleToJUnitResult(sample *model.SampleStream) (JUnitResult, error) {
 		Timestamp:      pickFirstTimestamp(sample.Values),
 	}, nil
 }
+
+func processAverageDurations(results model.Value) (map[string]time.Duration, error) {
+	durations := map[string]time.Duration{}
+
+	if matrixResults, ok := results.(model.Matrix); ok {
+		for _, sample := range matrixResults {
+			testName := extractMetricFromSample(sample, "testname")
+
+			if testName == "" {
+				continue
+			}
+
+			durations[testName] = time.Duration(averageValues(sample.Values) * float64(time.Second))
+		}
+	} else {
+		return nil, fmt.Errorf("unrecognized result type: %v", reflect.TypeOf(results))
+	}
+
+	return durations, nil
+}
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -267,3 +268,39 @@ func makeUpgradeTest(jobName string, result Result) JUnitResult {
 
 	return jUnitResult
 }
+
+func TestProcessAverageDurations(t *testing.T) {
+	results := model.Matrix{
+		{
+			Metric: map[model.LabelName]model.LabelValue{"testname": "test-1"},
+			Values: []model.SamplePair{{Timestamp: 1, Value: 10}, {Timestamp: 2, Value: 20}},
+		},
+		{
+			Metric: map[model.LabelName]model.LabelValue{"testname": "test-2"},
+			Values: []model.SamplePair{{Timestamp: 1, Value: 0.5}},
+		},
+		{
+			Metric: map[model.LabelName]model.LabelValue{},
+			Values: []model.SamplePair{{Timestamp: 1, Value: 1}},
+		},
+	}
+
+	durations, err := processAverageDurations(results)
+
+	if err != nil {
+		t.Fatalf("error processing durations: %v", err)
+	}
+
+	expected := map[string]time.Duration{
+		"test-1": 15 * time.Second,
+		"test-2": 500 * time.Millisecond,
+	}
+
+	if !reflect.DeepEqual(durations, expected) {
+		t.Errorf("expected durations %v, got %v", expected, durations)
+	}
+
+	if _, err = processAverageDurations(model.Vector{}); err == nil {
+		t.Errorf("expected an error processing a vector")
+	}
+}
@@ -3,12 +3,13 @@ package weather
 import (
 	"fmt"
 
+	"github.com/openshift/osde2e/pkg/common/config"
 	"github.com/openshift/osde2e/pkg/common/report"
 )
 
 // GenerateWeatherReportForOSD will generate a JSON report for all jobs run by osde2e.
-func GenerateWeatherReportForOSD(output string, outputType string) error {
-	report, err := report.GenerateReport()
+func GenerateWeatherReportForOSD(cfg *config.RunConfig, output string, outputType string) error {
+	report, err := report.GenerateReport(cfg)
 
 	if err != nil {
 		return fmt.Errorf("error while generating report: %v", err)
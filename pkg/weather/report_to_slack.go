@@ -9,7 +9,6 @@ import (
 	"github.com/openshift/osde2e/pkg/common/report"
 	"github.com/openshift/osde2e/pkg/common/templates"
 	"github.com/slack-go/slack"
-	"github.com/spf13/viper"
 )
 
 var (
@@ -25,14 +24,14 @@ func init() {
 	}
 }
 
-// SendReportToSlack will send the weather report to slack
-func SendReportToSlack() error {
-	slackWebhook := viper.GetString(config.Weather.SlackWebhook)
+// SendReportToSlack will send the weather report to the slack webhook configured by cfg
+func SendReportToSlack(cfg *config.RunConfig) error {
+	slackWebhook := cfg.Weather.SlackWebhook
 	if slackWebhook == "" {
 		return fmt.Errorf("no slack webhook configured")
 	}
 
-	report, err := report.GenerateReport()
+	report, err := report.GenerateReport(cfg)
 
 	if err != nil {
 		return fmt.Errorf("error while generating report: %v", err)
@@ -9,4 +9,4 @@ import (
 	"github.com/markbates/pkger/pkging/mem"
 )
 
-var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecbd6b73a338b736fc57ee4abddfeeee09e0908ebbeaf9606c832106b7055a023db5eb2e4e018c388cc1c7a7f67f7f0bec38cea93b3393cc74efcd544dc7802424215d5ad75a4b4bffef22c9ef8aeae2ebffbb88923a5e79bff945765994615ec5c95d7d5954412884cde351b2bcf87af1ff29b36f036b72592dfdcbef65f874a16665b1acbfb9757cf1f5bb657fba30dc2cbcf87a71ba1e15fee9f25f759c54ffba4b58f8af709b5475f5afbaf85715d6ff5a95ff2ad3285cfe76f1e9c2729751589f55af4ca34b96e4abed7fdc2cb8befa5e557f732f3e5da0a238cb7ef1e942776b3fbef8fa7f2f7ebbf8af4f1766edb2f0e26bbd5c85c70b14ba55915f7cbda89aab7f056119e64198fbbbafff3a7b57e62e53cfadc3eab2adeac5a70ba5901316564dc9a5eba76e14fe1615179f2e9ae741f3f3bfee7bae4df3ddb25e7fd8fc9be4d16516664d81a3b06c4bf3567749f3326f5787d5c5a70bbfc8ca6558559777ccadc3f31bd13e29dbebbc76933c5c5eb2a4aa8f37c26dfb6bb92bebe2f4e3d23d9478b8f093326eab78bc0ece1f0695fb7011fa8f2f034114f9feb31b97495e87cbdc659761b0719741f5341963495927fec39d3873cfae4ed9976e1eacea84bdf0a85a79350b1f1e6481f870d1e43bbbf2afce2ece1b50c52effe84a10af1f5d8bbc7076fde495353beba7adc8f51f5f5d9669b2bdf87411e67e11247974f6f3d2ad72fefcda73abf0faead19d247797bbf33b71785edae5a219d167d765983597cb65b16caa7597d58f075d5478abbb3b97159771b80cbf3b20bf3f5a7f98fde123656e59bd3169eda661915fbe98340e4bf79d8ab9bc4b581d2e7f50abc3847cd3a40d332f0cde3cbddf92aeaa83a2a95fec56f1f1cfa5bff47bcd483cb5b301059745e7b7fc72757e7997d555b1accf6fe5615d2f5d3f3cbf5754ed9039bf55168c9d5f3fcdb20cef58e8d72ca91fddae923c62e11d4ba2f8d15bab5de5bb8c5d86dbd00ff3f54b8f56793b531ebe6258d5ac685b57b4ff5c26c511070eb7b366a13afcb9f492e8e1675dddff3e62409664e1f1cf65b6627552ba6da7b4377e5f15751894cb24af5daf9dda79581ffebd8cebba3cfbd9fe73df7ba79bf7353ede6bd0b65c162dd236d7ab65f3a4fd9a45d57640b3781ceadefc69466378bc3ef66afb2b0ab7e5e9c765b5cb6bb7e99fe52aaf0fcd39febaf4dbe5e8feead47f6e5d642dbc3e7b72ecb867f7ab5d53c9e380a9eaa55fb45faaaa97491eb58f76b97ffcf350fcf1fb5d7cba38d66b95277e119cfdba5cd577fcf5e3eb9bf6b272ef9a74eb300f8ae5655430378f7e2b96d1e5f6f27e5d8a5d3f7605ee6da9ca82edf81e27fe2075fba7993d6f4d778fd5df4bbc5aaec3fb35ee3be9e234b8fb7e8ae7cbdb7712ffa0c5cd000cf2aaf93f0babca8d5e2beed1108f56ed14fa61ba72596c773f48285cc68dd8f49d544990bbaf3cae76d511d25e7adaccb4cb2af457cbf0d24b8264b97ab5b7daa4f5d2cdabbb62997d2fd1fd186d0a7c4bbabc29efbf3e5d5861559f44c57cc5d8e1d649323cdcd28ba0a9e4d7ff77f126315b7793fc5e88fda3d2bc52e845f0f61c9751f15b56046d46089755d2cacafc6f7cefe2bffffbbf3f5ddc1d5af65dd2f1f5d2adaab06e933524a5f91b84b59bb0f6567e200dc7349f2eaa641f5e7cbde2fad79f2eb20631be0afcd597ab9b2bfe8a6feffca74594af170227709fb99bcf9c68f1dc575ef82af47f13ae3e73575fb9061892ea3f41d339877e6a50ac213fe1fae2abc05d5f7dba50f3e2e2ebd5cdf5cdcda70b8325797af1f5a6fd14e1c557fefae6cb974f1738092ebef21cc77dba501e7edafff94fe906dcc557eed3050a9a02b94f17e659ad2596568f2e0b3faddad207759235d53043ffe22b2ff6afaf7bd75faefa9f2e8caab9d3e38efffdf7a70bfdfb4985ab87a4c3b727b5fff39f55beaac2e0e2ebffe53e719fb8ff6a3f6323e477d4b0a3861d35eca861470d3b6ad851c38e1a76d4b0a3861d35eca8e19fa28647c06aaa9d466f2507f73cf1bf3f5d046eedde7741e92ec3bc7e28eb2143fba23730cf4b37088afc4704f490e61f20a0fd130115fe6902ca7704b423a01d01ed086847403b02da11d08e807604b423a01d01ed08e8ff26027a4f173f8a871efe7c5eaef23c5cfe568759d98ac63f66a7cfb2dc9355fea67f754f56af04eecfb0d43b9755dfa5a97de14453f97b9adaebf137377f82a61eeafba769aa78f50bd1d4cb9365fb115b7d183da7e7074a7a4e351f58e3035d3c0cc6235b3c7eb5c774f19c021e523f99d307aef630e53b14781d055e99ac276cb870049953479b1be0faa6c96dbfcdf13cfa96483dafa72d3da51fd3a1283a84af8699bc7181323f374a4fb8ba56152d0e14a398f69ced30ab4b2f9b5fabe372ed44654d6d145345e61cabb85587d2ca213c9b25d2977027de7a82366baeef6ceed6ef49ccd9b769368eadc59eb2659ec2f69e5544fabc8854c5587bb654511b959e20ee67c9603b4c0691430cceb529430ac454d9965e061625c6dacbd0fef81eec129e793de09af7a843297188b1f4049a590aab5d7b7ead8e06cd7d8e127ee3293247dbba0e2275626c28d1af9bf71caf999fd3d211407204631d10913bbec3a2b626b8c46073622cbc1eac8209df3fe56bea9ea3d82522f3f3b3f2865ce465724dad2272084a3de1aa0e14d80713fdf0fef67fa9f4889c3769c25eb532091f5301fa774d9fdca799d45f548565ea5094fc2ce03dd2bc5f8f0eed3d9513fb5970df2767e98c17ca82151d4a4feed3b537819a62be6db7bf2fceca1ed4aa72b88f1599734787ba5a3de0fc09704861bbc77569fa448c3d82afd509627e6f5e7bc7b42ff58d3f81bd3be477d436786f82f60f690691dbf647534efd65ca24de1762f33edd5ce8af02456ec6c493f74b0b4fe06b8788e92c91bca9a0955ed2dfbbc34d34ed356544b59fc13e205bcedff537816d14535b637e0faa60a2af7c210eceeb1064fd2a203cb3489ff772b49f4567fdd6b4e3707feed8a838f6ffb7c0469bc04663d7d61ef7f370706a773b1686fcfa9076feddfe7e56efcdcb65beda97f7dfd77e797cbe5e178979b6c4851895fea2f9ee0ffd3c354ff3fd691f3ef91662e692c070ecc1f579bf9ee1c5abf9dbf62af22e90a5b5a7b0456815919581406df5f60cc76e87991107c3c1cd375902ccc57738050bf016e3dde0dfeae4e93717f7eea058a8669ff77beadac9d86a2ac02a48a4c4b18d621895b716a7dde1717ffc0d36e9add25fa84ac082a1b4f104b457877ca52ae5da49f8b6fedf889c52a5bf9adacd586f9fafbf9172ef09e2a61913dfcce0cb34630dfeecc95e0aa619cf02454e1d1bc5d3ac9927d0d776e92d2546e1ed0609cafabc97cd2333eb27aa2cade9a888ec213ad5498dca9eabb08a9a83587d54b7f9e374437ead2a4fea911b9c6323dedf89cd77e11cc256d4d6ac4091b9c03698cf5797b7e3c1ea302eb8be9a3d695bc2826319eccef4a3bba194fb196cd421bf6de77ac6975e267394ccbfa80a5aab13a3a20436ea68dc5e7b19bea5597fa72a69e492ab28dc8f57d305f4673b29f576d2dad9493bbfc756ceee857964deacd4e1bc7095fe3e38e04b39cbeb2fd3b4cf0713890fc6a8f473beaf0e9d4c55b41d25f272b693f69e02cc1f0eb6faa88a9ab6d079390730549bd764cc830eb27e1c3f2fad85edfd173022ba1d664ddf1bcd7b386a9ec679edd88367f3501d7235cdb5b567f2994bb6ec9bfde298bcf5043d726c69e34dd2684a9c2810fa3b57d8ae1d325f8544aebdc1fdb76ffb2aa7f63c72b37e3215de3006efbf7d8fcbd5c926a23dade997da37a5d8e9a132c8f0abdf6c98f14baab09d3ae437ea507be1dbf4cfc793ee09c15e3d9f47e6bc68da4605e0d489b4a6133d9a92cda1eea4fd96fd276b643425377d376fd656290e94a8aff5aa956b23e6d986a7cbdcca23c03904c581323e8c579bcb6fe765d32f55fbfec77361a12a4ffa687415a92f8c9fa970b69ea7db35ede96b6d529fcaba33f99836fd92f01cb58de6db559ee02787f1d0a68fb471f96dcefa738b9b4753a23f1f0f7ffeddcfc6a2a7f47335fa3fffe7e25d985fb92cd649102e7f60847c48f6f7db211b4a762478bdce0ed9d9213b3b646787ecec909d1db2b3437676c8ce0ed9d9213b3b646787ecec907fa705e2810dbebf29f254f66556f8e9f759699be21f20a4e2cfe318fba523a41d21ed086947483b42da11d28e907684b423a41d21ed08694748ff7712d20369fc68567a99aebcd02ff2bb24fa3e413d4b774f53c52bfecb87bbc47e793f97d8437d5f21a8fc75e713dbf9c4fe7400703e3fcf1c626da9840c76bec0d6dea28882c5f8d611b6bcdf4347474e2e3a5d2f5ae7d505b535ce25b4740499a3261f07362abc9e560693b43e3ac24696ac6f82f1d6d2b1610241b7a0b02192356232b000ca5b6c490a8066e913a4e3bdc4cd856a6b3203ac0598088b630cc12d928b0d5a1816e07248165235e765c0a9865dae5c3996a178c278e78de36f44317a98d40acef40d493513616a834c6fad6cbe01a6d900cdff5441a03974122c3cd01c2cf03d934f77fa484e10afa990891b8bc9bf63c64cc4b45b3a1a6c1150c71df74d04d003999a7e064b03824427b10c29deea3c02cc284396bcc50c0d2d4627c188624f2e053a925c008de054442e4f550c4801a6217fac35e975026806824c7c99591ea33170f4d6e7d3bd3f46481f538cf9384500c41bd796be6031e6834267e9c6c01a00d011265b65ceb409554413526d88539821592628a748cfd00a33b89d3344ac541b796969038e892f1bbfd3319fea0b665ba4e64c40b69903e89c86288e4d3fe5157f1c306f222d1dced81b98af71262297a3a563c96b5d5037904b96bb97458bc58ac10cd071fc0d64adb630752d014d20478b306553cce1adc99c1db28cd8ed052205840216c8168b19629a4532b6d733f97794060069293b3ca49819953b61c81bd3daca44c5026d34237202c2b6b6785a853295f5496040166b1617809ec9c41d33803dc35496d706d114d36689a754bcc562e232ba9c91adab731a60161b018f6492c7c4c357bcc3a35b2453c74a817958d461246f800f0858d23753861d8c69aea7dcc6efd1c41b8b25a471aacb726d2e204529dd3ac2d6c49c51bbbd60618e4b0c1057ba9ceeac85844d259e53d9bf0a786dec8e600196b473489d7a8cd566ba8d61ace914a0f23851d6719dea32d51d81774da6ee4d4ba6bab0dd3a7b6de3c9fa2e9051aa1354e1850c3a2f8bb3716d420ff15656dfea8c8ee7697faa2f60e22c3459576407657c0cf86a47c7f1c2188bd716d3621de8ad45448214c0c48e63644b3b8bd45796cc7eb7b27aaa37df4de109c6e2b537292de09dbd95a25948b48a2af26c6e4bd41a4986cf8c155a505d67d4c2424902c590319398a7dc70cd7cd6790d50aa51b0e42dc83043d97c8333f130ff4839c4201394325d1f97430030e7acd804130d03c61c5e487228531ba7b4e98fd15c48459d0720a988c0924718026a28f30d6ee6b32de9ce42de9899ac92acc42e474dcce06ace03a0145c3d434a830716d36a3347ae392e15c282ca4afbb265c91829a8472c03e958043aa106e2c4b523f04b934ff7546626ca908ef3f2d662c1ef60193320b163594641883199e1c040a936b648257a32255e4f73f51c5940c4d45764d9cad8cc9c0cf658e03948fb9399d5f6c90648ddf333d93630350197230703356484fc710c3a996f81c15ae783df510e448752c01c167d192d755ca6206c3684f9a2cba315ca4457671aa2008b20d3ae891d2c5016bb0ea326a4d5c65a180964c825d9d6d51504c4a60b246caf1c529bbe0275a894236f22cd81a1a5cf8ca5c9caa96e4b868561069cb8d4b106fa98e349c643c071fb3963dfcc89b4b2703c240c29c80e4c8fc41b0201218a8c715a1ac8d67796650c0d2283c528d559b96fca43b2b3d127b181783685713c7489bc74f65aecd9d22da481eca7a2ec8da9e9d9f116c8d6f5045091859007da9a8ea1b0e4f9d6ca99a14fa42b1849481f57bb7052c6baac6ef042327502155968091a8b732bdb0e758effddb2340aa968c158de84328099c936da336a4dcacae3351ba5c64c578c1d95d16d00a58a52c3f094edda59c88503c6354e112021ee0129159769a360822c60a56065db8d07eade5c4833b064192fa42b07f3cd7a8460244d1fd63b8a5d5eceb12511cc53d91b07166071082940fbbc59efc84dbb71c217601764b09b2552114cd0c6df17ebe97ebc3376579be962b0d2ad82332c7f73d81c72dc4ca0b49b4f6e9dd6f11fae82c9fd1a7dbc1e1dd6686f02fb4069cb5eba444c9bf7b5cecd8b22727b90505b5b511b317fc7c754e14b2f7af40edec960e765c0515baf1d826a97880357602b3a281781aded2811b929691d35593899ff284f5b8759228d0e0ec2fead3fd118cda02967416dfd5a9dd4fd610e7b6a6bfb5954d4dfafcb55d346bee9bffb8d088eb02d8f75627e8e4a9ab1856323363d38c75eabf77dbf974798416a3254ce5364425a02e6c135d9788318988d2c72f8964633f72cc01ce790ba595b6d9cc7ae3e76f6986fd6d662e38d4b4a644d87146e316f4ccc85b1d017e91ea75421cc5806136abbc2764d157e66f10df6518c7069628853c453191860c0a572c43e005c23a2c433bcd7e490d1da53641bd9832d08db020bdac45a50043c9dd0493934003563cf004b0680b8b0784d064bc66422d904c0d5395ef5c6224118ae1ad90567201b184c90e98c92edc6951bd94563735b9a50522bc0cb351df789cb954367219b88684b6324d330152d270d6e81182a66a58d2cd46bea630ab0d4c1889bb5838ee40a01ad10d34c040e878978ebf2cec69f18545f0cb64e1a98a64c57fe9827ba5ceee6027f4b144d6de729d98e301f6ce60c2dcd4c8c758eae1d4babcc71df717a013a62bb3b6732cc304290620e036e65453345ae3e4163dcc876acc122cd22e312397c701b2ae33dc921f1488c310f86c9f565c878d74be55e235dcd33c3f6f79a09bdb8b248e91a7260cd300fa69cee31e16f1d60bfd3314b500f6d71569a088ca5991aba9e4b2b676f0c03eeaa912d6790210ec6c155c0684d2732d517e3bd95950581b291f562cf8e3754a62400c056c6b04ee2adc3a35ce719b1f220464a5c5a18ddea7ce0d03d10532eaf1d3eae7441ab4dc60c18d3b1b318f0c089e50c6b86ceca3981c07439be76f792a14389080429066d4cf7f2c8b3e67b47a867beac6ecc8c517d81aea9c2f10e166b338558cfb60473b0015926de38a0c0684932b1b032cd26b99c20907bd03ea70add1b29806650c2579895cb060b8932df62525673a629382b176e664c2d4c67816c28f3bc199fce06b02e920c1160c60259c0018716baacad20d52ccf965410aa8d2197b299311bf6f1152515178ef925e4f14cc7da2dc8ba4818de181083be6018785a004fad19366cc0746371f25a279a8d730301274e01e299a568b56e01e86ccc018f880568822d0d85ac44560a334f76b6a4c16e52ed1d0e493aa81bc32e2dc4d42dc5b1ec671a0043a639d6d6801171811277625840e26b6ca722e28cdfbd3132f09ecde848aa502bcb68c8b3529e58466af254464c421e41df20ab0b9cf66533d55c378b8fb2c878636631220a52eee78b690709c2e5908e8c14c9c5d61b97962e201793fa16330a946bf000ddd291646216803fde26ba2ddd3a38765d8696cd7c033b1e018082a0d8d1316de6cb0c67a24900a9c4d6168873386c0d781034208cb9fa42b66161dcbaac1cbb9372aa33cce394de3ab8dad21e45d896780230c378b3f715d1008edb50a01b8321b9e9479da7dfb0908a482e9726a386296b3bb0e4ca90039b4ee837a4e81c49030e63be362d29c67b6683a5998418d89b18b129973266f41671dcde9b302bb475bee17e088bbfd3893c73f7c003175c850a02b00cea298862e6f3389397866d2cc26cce03a9ae0c59dfa2052cf43c9e93aca421c84b62338c48ac38444c3191b13b0970c8cad821b582808ee7ac9c8112db30d216268f6a638420b4d1b593d595a5c8135706dbb39105597d0bfc788b160675d3527148ed7a6088333b8ef514ae498a0accd40dca511c66db0ab29a0b53feda62e54227fd2bbc57b910f7314ab70b9d53f7146033cf50ee4fc0445c4930d00ae4c04129981ea43b8b545bb3e9749b612f75046761e486ac5598c5537d042b80063f4a0732d900be5c3944ac3c1ec62463048de91a006e010c3cb7cb146531b2f8a0b08061d3d2469ea27316c41516644c98967a24bea5445cfb0c59fe1ec55e2a1a30d22a932f9724ad4d34061146320a15a32269df065ceeb1206e4c19efe7e9d6865e3c210bb93018607d5cebc7f5728b185a6212205da616a45021de002bd5080238aea75be4ed8f1be714a37209ac8221bfa4f6f7750698c71b44024069790b59ddea0ce6e9d66cfafcbe5c7fdcae238ac3816232798952cdd2c762c331c83c156594960b6fac5d11222a7e866ac38618a5e22db6cb02b1b2267960984afc0d43acf8a9b884dc70619cee28a6cb802165bed066fab82c41e0359d3726e1441b79b634b152304d5e5e9a368d3d82ae1d2e96745e23c89289be900d27f545e08b9daf940951f49dc5d1c29365db9f1831912971b2ad1c32b69c018bbd1473d64253403126748fa89bca628313c6b84ff0425be81c4c29c46900aca2bdd8002c0aa06ca99e6e36c82e5d77cf0a9206282432c67649bcb15690853c44b83f861c663a578e1d8074ceb3dacac1d0f99207a1745dd026c1442240e22958924298562346a9070507b2bc09e4b2f6278689c61ac5c276d648b174249bc88e0407a8e281be6b388aced391c53738442756ce6661aa6187418189ba41d93645a92c001f1360b09aa7fd04327f6f355c0d64f027b2a9dbd2cce20273ce19b5c9ca244c1d0194520e417390650022db9935922b9051456594e8587481a32ea49b8dc94a0442c559ccdfba8226a3acb4bc31bdc67b950f142056567f4359b585315cb99cb8b41a4e266b63c2680a021a03ae2d5050cfc28898325a592923a6ec700e6f7081ac112b8505126264b1e016583009c7a5658ec5b13592a801f235b2296e701aa7a0602683996a89ae6c47ad5c02b23db38d86236f09a35ac840361714829e74e5407c1b64f3dd0c4aaaa7e2ced9cb431710cc889c78161b031fdf12c558a11ce2909523cb92655d81953fa231f0d40668d6e974efcbb16b2a9bbdb9378678cc37fd0344a978629748275aed4d0c0b8d293717b64b9d814c98e436eb2886d8d0c97c0fa93833650a4dfbe68286e719a32e470bcc07cb80e36136292d0f34d1c9d95ee71120bbe17e2d8ea406c0eff3540460e39d63330e78633283c06ae6018c34d9e4e19a2c80ea13a9c5614f19efdd49b020636e4f01f3211b6f21975cd8cb8ab3d0aebcf1d58ed871aa2f188f2120fe58fcddd94ba93716670e17cfb032dfa194a63a942ea4c1d59c5132678c40b35e8e633720d0e081ed326d4948b575b3f9c6958385ce1502e6e86dc0715b2b8d9987a9412ced369483252cb469986d78dac8554c1683b1363395edef242f671e50db1dd3466ec5d852b7c007639483e9f6063c857811cac198a474e4a57ccfc128f51800643586b1bc73841af999be9b59728a2c993a7679ab1379e2ec0d0a7cb98531dd58449e5829ef864c73a91c1ba162d8a152cff4b4d4611ca45ec30b52d1d4793a8191962226d738350ca40453aa9410a422013b001d3772bb94cef9464e361aceba83acde9819b2751b01107f43589c42261394c506cae229959166806607233905053920f0b33015c7a68512bd27c5540edc309b6f82314a3c282d50824d23dfb932a31ea304327e161243f694324572835df5cce5cb259519055b9a933c159b7511b32001658bc9425a8699bab752667b98d61607a68e79a0238421db02c9ea5b0f50e58f2417f194030657686cac702e53a2a0398540d6057969e6d4424cbbc2698c0c59ab491ab808c61b2b0d38074031d3be0b20f79c3458eb50d654965c2fe54567af8a16936b6b815c007a6d2d0c2368d62dbb44b0676aab43c6d59ee07ae6f66205f7ca5b4250e54dc07053ba73301603c5202893137d246b98d4b4d5b1e4520c16333111177e665cd3f116dc5eb4b5c8d69d67e0f82329d539b66be44f936913634253946dd700c8080444bc49b0c0a4dfa363207ea655eeb84f10a61c2cb4026760831d24de581ce185913a602824a531c8f295c5c51066a0a21c006cc900ac5fe940012fa405a422c502cf1162fc8e2ce91b52e261d33f01d3c0b40c03b2b83717caa10ea53ab74be2f285d0cc4f9da0893bd6a8ced119604a3cd9a8806931d892e8ec35d32486e28d6486f70c3944443a3fde85e306efd010169ae9b262432c2df63284284945c40713d3a65497cb1966c1dee4fa18318998e392608171c6b83f31d3d286462e9163621250f0c2406ea6734e33ffa1d8e1b4efba295d5a0bcdf518dea0858c512e39744c7303d7d7aec25bdeb88c310704cbf28a30a0b0875b2b2f49c88c9e2f976036f801416a28f31d1d1b36b2a30d8cb462ce901d287ceca61a0506eb100066846760c93d18c933030c051654d747b24b08e360ccdb668e1224c4154030331854566e60538904104425540c6c3169e46671cb27086398ca803cb9b4701a6c7cded9d3094a752edd83a58a3e1befa85c9a8061d7e0919969b29997337d041c2125e8581c9b399d21befc6611d10d04504c3b66c0d36f8063d3077d4f2734018e9fe28546ac0c6c838844c7c596e465ea35ac3f376c940fb620d361288fb7662edb8d9c89057e8378bc470b99ba3c1d81225604c65bb00c0bf5063b870f528b5195e40d7ed4d71423d3e7b5099561612a5bb1910f1001c7ccf858c75aedb078a3f340682f9e864c6bcb0b335421bb5c00a64b4c6a0e71fc84a42cd1955806160fe7bcb36bb8bcce8d1ba622c258ac3c59035331046b521a01c71333edc7a1c5d66461cc803396fe2498990ab21d1c7007de642c1099b7eb1f92f58d37a200a0fdee083c876534b12cb9910f058bf9573a41aa3b69d693744395baf2f9a076959a214c1b5e98860004323931c7577b0743e1e3bee27175ea59e9a6e1a1ba4c09ca35f06cf40d2c590a9971ed8d0085a958613e58848a3631000862da376721cdf40cc0576a4264cd8591641a32c8de5e221ed95a8063d757c08634a690d23526756510758b530db03dd8923498058ad6f06a1bf852c569b0f719adac8534d595cd1673fe36e0c489ab8886ce9500e37813806ce3544cd05e5e53996e7401c956261a1ed07923ef9a8a51874a99b81c16b12cafef759200f41b4e8d37c9dbde9e7ba78dd6cbf068a4fd9ebfc07da23fe9d37efd55ec7de5b9dffa5ffeb84ffbcd3fe9d37ecd7de973f7b6fd7eff7b1e038f937ef99ec7c0ab493b9ff6cea7bdf369ef7cda3b9ff6cea7bdf369ef7cda3b9ff6cea7bdf369ef7cda7f2a97d67b2ef8feceecc792db45342836f91b433d3f4f7ea2a9d7fc87bbb4f7dfcfa5bdad6e17e4b97368fff967ff0b73f4c1a15ddd49df3087989ee10804880325667e2299d496d67e3e6fa3f6e20c365e4fe3902273d4e6fbc3a858a83bc90a085f3bb6260ea3f24bd8d3622f0b983a6ca338efef6ceef63691bc36ea6f1b6991efdb66b438bfbe35070556e4bdbf934cc746f751a0e78e6dec71767857938670e5da615ce14e10e74ff4ebe9aebff1b37e6f9a1b82b31317ae29ae7d0556be1097349fafbc9e964ff7fddf3d41ed3f7e1ffb120ab0a293a60d2fd759dde9d1799e615aae9d451111615b7a591ddc2a31174ca4fd2cb959fb136d1decc47d90e92b4748575e4f625e6e142ea1dc34ebefe8eee6d2cdfac937bbfe324d0f515befccf476c868e910adf414c685c728ce90b1d8c9b66de4eb21bb8fa42d1ea2330b6d2467426d6def92fecaeff1fd439ee34684dce8dfd9f5174ab6fba6ce56d65f5153cabc5ed3d6e6be98b6e5e6f5179738d134a5b14b9af604b88d46d9e3fbc3446fbea9e21056b944cc0f5198d1bec9e367f28a0af8c57cb7876fd6bf9b97a7be0d7b35f33274ac53fbeef755805e56c1e7c3cf372e3b2fa43f1d2fc0ddbcf178815eefebd5f56f1cff87179e2f1cff8ec70bb4f5fd432bcf0d277cb95f23f8ef479fbce184eb53d2ef2e3cafa4ecd69d6eddf9ce2c7d58786e77d57298a33250b64c1d71919af62da4e01d350f8b809ff5059720d686679603e6d8a8a0b61add2f4687f0ee1af395fe2e18a338501a70556f1d1b3857e9ef9e942905365a7b44e61a0055a3326d169c364dfe72398122e7fe6ed057e52a094e0b23bf3b2e8cc9743848dac5b159043296d236a4b1160c7383f7081ffb791a7d338f697a507b44de8536df57a36279bbabda85e6747f5edcde0da55b4f50a376e131a51b559662bfa747ed42d85c8ff9b59fe1882ac03520ad4e36b77743ae9e9af7fff3370fbf5fbae6eaa9bdb90d7bf5ce21624ecdc1caccfa4903e27772ddf4f9f1e805fe1d163976a38eb67b5f9157eac4e042b265df4c6de109dbb5bf289b778ddadf39df9fedd47f87bd7ae3676c15284ea42678a5670ed34c359ab2a60f8c5638b8b3b99b69cfd838e4eadf77726d783d8da963c4da70fe3257a8bdc7ef6f16aefb85efa53637cfd521fadd1334cb11fa1b6a0eaebf9983953a392e8693b3f2e6c5add5a3cccfe4ca6b8fa330f6ea448b03055f7f643b875179d31e9b91481a1a366380adfc1e8a3d65139d16ffa4192bb0a13d2da60a7e72ffe9186a1770decf60ff74fc3c1e4b7ffcfed3b1f8587028d74ecac63e7f9c6713be4fdaef75b8ffca987b59b0ebd192da7e1be6daeb692515d86a4a8cf210a27bbef6947eeeeffa5fc2ddf9b7bd1fdbdcba3d3223eb2726468d3016dd0d5bbcd1bcdce01cb2ad1e842abeaf4e3607e1cc96723f93537a3ae244ecdf35fdf9fd6fdf08957f6d9c4f5e17d8be4da0faf603e1ec9bb22d9b34d39cef7f1bf62bd7124f42dab7619ff7460fd74fe6cb41809bbf5788f0d392c05c3ffd5cadb2cc5deede2abcbd9ce75e8013f8ebb7c86fd75f45e1ab78f5dbf5f59f90df7aef27bfb5d5fd8376ed2fe2bda4f5aa3dfbcbd57d92ebeb1fd8b35f4eda096e9de0f683597a26bcdd0b4c4349f332baf6333e0e9466d1296e41894b7f27dd0b4a5120c87b55099897c1ae01383a949ac5a901c8a396018d8fc077fb6cd1c8dbb3886a75589e0928dcefea309e3f5a840fcf1f6b0e92476c7fee2910fb028efc0cb246900c949be84ca3f068a1a102e3dc0924d38cada7427f4f5b21f2263a9c43f25810a3641eb944ccbc9e563bed595457abf701cdca77d98fb0b14df2b787affcc25dfd3ce12bbbf3143a579fced5a773f5e95c7d3a579fced5a773f5e95c7d3a579fced5a773f5f9dfc5dd0f4cf0fd1d7dda720ffffeb153dd5fce724f555b679c0f75f7f9c2bfa3d5b5ad6ee7eed369ef7e76047865a63ea8ee7c01b8f698df8954fa0a640e61d5303758301cd4d486ab61542ee8c1b6194d59b0f6b3baf20439f5f9f648d5e8cee692d6016862b060021b5561ab208355a0f477e1bcdc537b1eddf6a25b8fd4a96babd15d72b36a2368cd4be608f15a1df2ec701cec606536f7dba3d969497752ff0eb62b3519fc5b9d5cada759cd3b195e3b427f4533960fa3f2747cad3fd1d66e068b6028ed1d41aea8c92f5c93e73ca17f705e698ff3ed2f5405314f018e9ad2c6cffabf536270eac4583864cb8e36a3b5d73af6f8d1d4e47b0e61dce3237a07353d3fee7ea2af69c62a6aeb6b5f30624fc1b523a475a0f4d79ec25674c7affc9d287a64733896b7a7b1d6cefc704c7c7c7f4cbc3a69a377adbdde3c9a92abe8c5f2366d9b63aa7091af346d33c4f648da21bf7704bd0e949bba8d529634ed63824bb68c0a90aa43fefa8de52ffce1e3f6b94414281139afa789d30cae9cf6087c1c9ddf1f66b070959b48cdb6eba6ffdbe3958752ece546e9645b660bcd371599d36bd5a17d4fe92f1cb249d4d1d5bf9f8c97da1550e927d2bfa73b71ef0bd1addf338a29a9594802e6256dffdf3f5bbb04ddf9b911b747470ff9993a54936166141ee9a7eac8d9e8c387b4b7d1612c4eede87698a3d223387288b87789d68c978da7c8a293f5d7ee6e5007b9134d49faa88dedb1f743717e4af730fe6f87cc803967c898d7fbdae8e6f6d41fcce01dc1607e4f8f824cae0282234f70a2762eb5b664dce4bf5687c8026c8c1018961a952c5418777eef9dd4c7f58fd7e8fa910beedfa63ee63bf571a73eeed4c79dfab8531f77eae34e7ddca98f3bf571a73eeed4c79dfab8531fff53caa3fa919ae8fdd4c74db9972e0b9775f546c5f1d3c4279571ef8d1b75fe82ca587c479571ef8feed3e954c69dcaf81f98f5cf66e783b2788eb73266c8b239aa5963dc6f37cba47d3e98487c3046a59ff3fda92057d4d6387f27feee0bede69a9cdae8ce215be6e7687f3b4ca370733c76a0073b6f38a8d16e50fbbb416d0e07c9fcb861c7b5cb830fe9a288e619c47e063b75382f3c418f02215e3b842fd52157fb42bc0e763ce70935f3923469eeb521914d9ef7b32df332634d151c693d99a7b6267ec3b28e40c3b857c79e02bb606270ad52d6e2125f81156d15b76a75acefca217cfb0c0499738438f5043fb933fd48cd1e362868c3f841199df5f940c1ad92f5d1e618c2afbd8c715e4f6b15ccfe445b7ba4692bf0fe8e5fce267a342537919b1b6b2f6f958b7dad57adfc9ec49c9d58783d83bb33fd722ac81bd7ec0bbad9df060476a1a946df9241a28d8fede2fb8a89b7b21a95fd6154ce00f37738058c5326e33dc7e983b2fc660d6e877950b864cb54b98ad404956a32a8bdc9bcf9fd38cf4e8dec79997ac76fe61227524dc95387fc5e1daae9933191a8f2314cf50415b43d6a62101dfe978e0a7f4d0a268879b6c4a98ac87b44637e32485d53e2bc631f1efabee94bd80764cbf9bbabd53451cfcbda7b0a307f38d8eb83223add570216c8fde377d58fe1b269394cda3ce537735edc0e5179bb1f97b74d9b04910da3b2a4c9206efa616aeb4dfb256b0c26c8c61d4a998eccb6fdcd775a1dcbb96f0778999c780a6e7d87a9009caa3c19fb0a2d3d05afd4e3311bd48ecb6038d80e335a0ea3f7da4cb12996292bdce00781001f92fd030ade87d303af3a056fa7e0ed14bc9d82b753f0760ade4ec1db29783b056fa7e0ed14bc9d82b753f0fe9daa9e0736f8fe4ade53d997cdabbf4b4a0f75fbbbe9a8c0fd3c74f44b47473b3adad1d18e8e7674b4a3a31d1dede86847473b3adad1d18e8efeefa4a32d65fc604a7a19adc2aaf68a22fd3e397d48f60f5054fea7d91273f5ddc3d33a8ada51d48ea27614b5a3a81d45ed286a47513b8ada51d48ea27614f57f36453da3907f1b59bdbc5b3642701e7c0ec29215bb2cccebdf766ec6becf625fcd754f6a6f6e3e3cf69220bcdf469ab6badd469a6e23cdaf8715afcfe0873d368e2d95a743271645e4d8d2c6dff505dd1c2cd4a194793d3572f7f15e9d3c9c0aa62a1aa3597f47cdc176ba4857fa50e27d013fe4251a0b94f14e1d4ab197cd232783ac3d3065345ecd86579b36389029b1708298772c73da73b64e069c638d6f5d81ade8a88890021b4fe98b1e815530286b6aa3b8dd6b6315b7ea506af7cacc1229f3b3fe2a50604507e5de5760318b8a489d18cc53601128fdddecb81fc223ed9e1add211af326faf5617fc520521579e38f8a88f680f93d9478427f797a364125b5d56b55a13b4f10394ac4b479e6674dddd8c2b1f56b75a437f5e1681bac48e6685bbfa65c9e058a9c3a368aefeba00ea5ead9fb8783b6ef6689940704f681a2ad3da13adf7fc2b904768fdb7a28afddafb32f4e691da1792e975ef34dcfee4fcd537f6ddcc9a0f633485d5b3f7f47e91139a756d17eef69162cfc442cbd5dffbc4ed7c1e2519e1db58d75606b0b6a3f6ecfe199ccb787819cd5e354cf9ec4cf92c1561f49f5e37c877ef3487f175a45a45b838d45d247fb7abcdc79f4aeb3b64d10a03b73dcb7dafd344cfb66cd8b47651f03235dab0a5af99bc7cfd49da4d14412bd1eec54c5a802dbe0a8ad46f741c05405ada9ad475ed6e75485ad1c61cb5305478e29a55eae47be00bb20630b6a6ea240899997489cf7fc1da5971b1c2572aa2af2c21160efef9a36d1b2f9b6ed581f4a82636ba543b4aa795fbbdf27632b3a94f6d4d60497184c55e2b5df9b3fe957fd7016c06ed3d4b93e96b50eec795b1f7518080ed9f2cd37a68ab8d77652e51291a98a9c783dcaa6c7f91a1071e1097c4ddb4061a8a0f3676d38e6d398a7f47b0f63fbf4fce13d4414cebfd5e1001efde9f75b3473cb216c456d6deef5346e960cf6fa68b0f9807d4baf8164152ed7891ffe1119e7519693d6fe8afb7001e71d8f8469abdb09389d80f32b0b388f26e2ebd24db078902ef03d5acfdf22591491aa6c632783eab49abd2a35bcb45a9f492643fd113afb899452b26541bbb33366aa42d79eb25d074d3d4f48cb3275c2d68129f59c032aaf8f5254ac8eb76b87a0a143b6b19719cc6fa51f24fa0abe56c7e29a2ad022aa3a288e2b841385a4cffb89b4f026cdca0e3b7562f0fea43d0d67af2a8c9b0e25decb8cb54778e6e5f397eac5793b297e38d6d0883d652b9e4257ce8fed245711b5638edadaca219ba80df338e493fbbad2a1a4e1a394797cc749ca7a085b890f3b61771217da52f35d744f90d37926570e1117d4569b6fd61ecb78fc36b5dab665de48661b7d34689eef9b3e767a68ed2ffeccf72b3e78155a8641527dcedcaa0e977f8c707f37e7297a05f7e5c3d7a4ab778c5ed154b75b93ba35e9175c93be3b1fff4711ef1d25a8f4777ced1c88d2ed03197d096ef938508cc252e48492edfe8c10c7fe6470ad4e344615b67f20dedada6b9630858ffd1e3a2c6b8fc970e21063f932211fbf17213fb4f19cc4bd5aaf736581163b42cdbcec48d026c68692738583b1f67214374bbb9f9fbf978bbc4cae1be2e411b95d9acfebe4123ea642f3eeeacadf89b9d353572eb9591fc9fc35b534a60e070b55e9ef54a5e4fddefc21cfd3fe1db67db66fc86e43429f92383f836d4bfc274f897d5bff4d7024e7de537238946a4af8b59fa7cd77d8e863befc930450d0f7bef8f104f0d1847d3b097c3ddbe96cd05eefc317dd770c19d556b75b74bb45f7575f743f860c3e5bed9eaf1c2fad64d2ce13b64dfeb37c2faf1467abe72b64c6d91bfbf43e7fec67010be4a7cf5e273a8f54beafac6281c298df482399bc7085bf8ff854cc5d877f86f7bc9cf11e81fb476fd98f44e0ebf743e0b6ba1d027708fccb22f0cbd3f17f24ebd97b8a2cd0f95f33393e35c3f959bf6aa47f5fd8c641864ff75fc0e506c399af6c4b4790f7b364d032854081da57b6edb1d4c7f5e7493b0ee579cf58d8eb753a5bc38e6dfe18c6f3b4cde726492a18bbc371d8c1da13828a427f7f381b06f6532148a6f661cd3d9671ddaced9d99f2ddcd94b1977cc7547908a998b9adf21615d47c22f39c99291fea10ec8fa6ccaa1d234785b3d78383e9d11ce48ffa62e747edd941a6143b595f68dae01c350067ef58a813589d29d93957c1f7f99a71bf0e9e7d6bfdaccf61153c62f507c5f86386cc3d32b5e386d58e8ac858e8bdd9fcef9499fe28617d21d789af721fef99f5e51df92ad7796675d2d2af2f2d7d2c5d7d58b25fb15fbe480b2746e5d8943db1873d285cf387e5fd5ca137350fd4f5a4bc6b9e0b505162705e4f7d0b4d7e2462fc5d747419065992ff2078d07da27f6077e64db73bb3db9dd9edceec766776bb33bbdd99ddeecc6e7766b73bb3db9dd9edceec766776bb337f065e7fcf0c3f7a6fe6f13d97d9aefa9dfd316be9cb59eea92ccf7ff978ffd0fefba9fe0ef5ed747f9deeef57c38857e6ee1b2da40a5dfb8924505bdbbba4bff2775242095dfb198e74f34a9c2e0651601bac55ed4df475b090136aa3588fcaa54bc4749648636a4b95d763ede6ae61f6c41f5211638fdcfb0af2a597e1dab3d9de279b1f78bbb469dfc1fff350ce53abe7591df6c1448b0305f2f0de9a79bfd541d698d3d3986323f69e96508fb0d5cb164f5ef47b72752cfb6c7b477b7deecff8cc72f4e0c7792863b6c02be389ffe5c90ff4d4f673cb23ac82c5736be59985d12060204bee8fe780a4792a5be8999f66d3161a7b13b85717d72e11d9332be79985d6026661bcbd033064fc74d3df5f280f8f650bf3c1379c3eddd0d8f6e5613321a63b4fe09efba21ed2ec293176d446536a339312e795748387317eecfba92dc57e4f7fe1bd479f5b01c459226d1cdbd807427f4707cfda5d9fca1cf314f3b26ef3da378b47ff70bbb4b5d79bd7be22ef9f59901fca5f523bbd56274d1f183dafa73db1a43ef2447b3ad65edeb4b91f082fd5f1bbe37942d7de046a8af97540c4d7de773fb6ebd036b6de90dfb438681b5c836553dbe0bc9ed6ccab97fabaf67ab00ae4f6a0c36b75d8171c5b5d7b0a4ba6c2f93c3eab8bfdcc47f9cfbd7f22b59e2c7e0f312f47c413b6bc4760e42972e9592f781c08dbd825fcece9bb82845fb479e61fe30ffd787d2ad7fe9b85ca53da9334d9fff00db03dee1d85c97eb701b693257f5d59f234ffde683b56dafbfba01122e47be0352a87b0fa5581f061b114a6e49836792e3c9d6d9a392cfe67766287180b6a1b7b8bf4d33370ad5bc18d20dbcf1847717fe5b400fa92cbd6cbae5a7e2364660737311d071f71d2e90b9dbe2c8afa7315facbf0edecfb599e07fa7df3e180c9bf27fbbee900b303cc5f16309fcdc3373bdd2cfc0c5e67cd2f4adee543ba33297b9648d8b3d28d83832a80628b730a3aa315e602124289be59dc6d30619b9625f5a4d8b781fd3dc0f60731ed4538fb783fc2de3b4678e33b3fc20ece7e61387b19c99ea396bc71e17e57c5d5b53a71b66f10f75addc8997be1831e6454443052794fa62a9d50d3b3d2ad236c4d4f306ac836ff187abdd51bfa85f427fcba113e1cbfde3180535bdd0ebf3afcfa65f1eba3fc9f5b0e3a250f0abe3fc2579f042a388f29b4d747ce0fb7da9e1b0e5e30649c622f993068e3347919fe50803cfefdc39b6c5fccf420eaf11fafeb7bc7c04287fa7660d981e52f0796af4dc537988ee77fdafcfb8f9a7d69aeadbd1c1d3795fe4ca6dfc7b180ffb4f9f7a1edd7c6f06a3b5d0c6e5f34e9bdd0476f34039b0823c3c4a26c7368687307f3e44be6b9d3e6c7174dff6f2c9f9724cc1bb6c56be3d7df018a9ff5eb673163efb5b947ba620a20e20cb2d7d29dadf4271359434d5e378fd62cb48aa855caf482b59f3d37c9bede2eb01068b339e6e537f6dd5313e00b1b48ef4dc7671ba3d9d3a885afb6f915f3ba241ccd8b466bee7c2974d3f986ebfb316efe25532aeff7d4b5dfd316d3dea9fd6b9ab18a9e6d220fb27e15109e3d31edd62fb99cf8e7168a21bf0f94fece21c1d3b63eaa331ccb9fb7e6d317cce9cac16a61bdd0f607d3ea87ee2e3b21f8db8cabcf523f98573f9e9ebe6358a9b6ba9dc4d5495cbfacc4f5771858cfe16fe2d4072f10ee8f8a5fb12318ccef198627a033a8e5229c414ce560e7da885982b868c59a89c67ca1cffb99f190b6b967b70171cf45b51304cf92c11609e9eddf01937f28b2c1f31c0fdabc8fb746bc630c28be3b6fa683cb5f1a2e3f5c9ff7987dbd934eef8771c25fd3e93d61a92fe9f5de1d2eebb0aaabef63e221c9df1faba0f7e5a78955d0fbf20b816117aba08b55d0c52ae8621574b10aba58055dac822e564117aba08b55d0c52ae86215bc275b3f70c28f8c52d0bee1d27383b208dea0b83b4f784f557b571faeaebb793f755d53db4e5bd769eb7e9df9ff6876be4d3de7125170c8b6a4322a5c22e61fe1cb7ba8dbdb60e31966f0e2f54783c6d57bee3815ff8a5aab438d0e35fe7ed4f8839081957efabab1535ad321cf4245ae7d65fb7897e8f3f3cb1f7c4414317789787534849e0e07f0b2a0f4f2e8daefa1d8c9b66c4ae4ca571e34f5cfb4fe2ffadc449b0f83b4ea6d98563d0635f1e325a1ab77dc152a76a250076abf1ea855ef866a0acf7b13544ec919ba243f1fca1dcb7dcd53f0ac1e31e79d797f7aa4cf7b399a3b362a66c9601dd8c66eda330ac7d6d85438d4792a9cf29c95631ceca3f9d1e35261f5c195448abd89d47abfb68774da0677acdbc213f8da39782c7b6ad24f5c72b5f68528990e07c994e8893dbfb7f56af9b947e1d4947aaec2daa348fc1cd86c27a54f8f36a1c428bcdd20bd55501c28b85417dbc44f06eb6f891a7d5b5c45c736ac5d22a6d48e56ee04d5dea87ae2d1695494c0461de12fe79e8654e93fd8878f1e89d6a1df4ef6e9332fd32250f887c033ca21f80d5664ce1d15d1435f9ebed5b18c070fceb66ec7804494f09b60c2c6aead5eab93baff31abd9db9d725e3962e4e305f577dc1adc56b75bd3ba35ed175ad33ec6fd66d57a14364bcce0fcc4b3e3068cb1b8a60ab431c0be7be4c7e3a5edf14952a322f227dafa70b2d9b9c37c0377fd94c2c95567678cc6f7cbd8c6cffa9c27186b6f54443036e6f7f78ff1c8aef4c1bdbb8ebca30270f72735cd4683bfaac3f08bfc2e897ee087739fe8eff7c4e9f33f8f270ef70b2161e789d379e2749e389d274ee789d379e2749e389d274ee789d379e2749e389d27ce5fa0eaf724f01d5d6f8e455eba4150e49fab5552bf4529f72cf58996f63e5a2fd77f47bd5c53db4e2dd7a9e57ee2b9fe7c623ee8e20205f6f72697c36f037bbcc67b8ba329681878b80765a0e06b559153aaf4573ecfbd87dfcda9769bea73b8fabc09abfa73ef2dc0f13cfd839dfac3a1e31da3e5891d7474d0f1b343c7f3c976a6c817b6eb80a0b99ff505972076d8a7da2ac20fd7294b67891407eda126d28e92e010f053017e6a07ccefcd6b7df397239d9cd5f56df0f1b783c63b868deb40a3038d5f0034de0d2a26b09f12885ba8f8eb4191ee6bd8fc2d96999bfbe19b09cb2b794eb4e5c30f4ee8bf6328a4abeedc840e467e72187965c2fd59f262acbd8caefd8c8fbdcc60b6e9ffd55818f7f50c96bbcfcb55fe06087994f2b463e0e3e58ff70c0ad4c91f1d70fce4c0f1689a3dc0059d6822ce61354b0e2e99efa8c30885b7cb114fd29e6040f8701ffbfe9777c401a1f3b1ef80e02707822753edcf4a0e784781cb9bb453739013dee05d1bb15922ad7d05768e8dd67e6e04dae6e022a8f1b51510c6b5b15defdd194d3ea584965ec63897f4578df4f1827a75e30ff92420aca293a6bc7783a7c82fdf004ca75427cdc8874777edbfe3fe67b10beeda01d24f0e48a729f6873523b9d31bbca619d9bea32493b49c2bc9a337cb332fe63869453e3ce269bfff8e5a912ee06907213f3984bc38dd5e966cd4a1d4ee65f379b46e89cf69dfd72329a63cea454a2f0bde53ea48de74ae4ff2ec209f8fde9775c3bd6700856e5b5607193f3d643c3ba0c7138cc3c12787835b229788dc3b4a11ac883e6761bd4cfcb798649fa53e1190fe478b0f37dc7bc61de877f24307063f39183c9b6c67a0a0f4738b00e7676ce1ef8bdbb3fde1a758e253c2e79e3daf0d6bd06e7cf433c8a91d5dab436d64c960c1184cbce30dc4f1786ae18d3e541bda52b82428408977d4360a4fd8a6b364b03dc44ceff79edcaff57971b6675f5e3802ecfd1dcf7942cdbc84e38ff1d11b2ac4c25111a999117b49c0a94ac082d3d1137a1428fd25250d55426b5780557ba4444f5a7bb9c1d489c13936e2fd9d54fafb226adaa38ed9aa69a397c9952a1bcccf29f31349f6736ded277fb51db0a6d1e1a80b2ac0d52c192408f715b505e1b8a4428cddf6188c78ed29f36bf570ae63e5f582c7f787dc76983cecbb77842deff710f3137e438998b641b19e7c97fb6fa72a6caf2ae23a68fb4e4e437313393dd8f919ac82f6680d990b6c3dd27a12f332547a999fbf5e3f75f3b676c76bbf37afa922eea764bbf6849af7078ffbc1cb6e22bf070b7728155ecfe00eef64b997f57714b3cc2232e708f1e8fe24b3e67b91fb580acce05cb2add44910bb765be78866fd9d3a410535a5694034e667226be462752ccf4db3fd8e4153775761b92ba3c2cf60ef2afd8a8e8a489f37efdeae03fef1fda9357ebfc5318f3e37cb58b17a8b80fc3cf9895c7fb47eee867b4f4fe94e3fd72d8e3ffde2f874ae3d52d41d90f6a0a063e1442afd9c695eca975e0e1cb5f56b7534bfd28783853a8a2257e9f37eaebf97974156b86f394bfc3ed5894d7fb455f1867bcf03c43ba36287113f39469ca6d80334f8136d1d642c3d1cc9caaf9df7f354cc9328aed9eef33264a15b859fef8ae5e77259049f83f0ce5db1b708106f2be26f142aded11dba132a3ac0f8d901e36df3ef55416345ed98c3195494c87b8ae5aca17278a2ade918319ac9bc3769e8d8e0bd048dc2cf3ebb9beab35f64e5aa0e3f17659d64c93e7c4b38e41fe43d0556fff2e118f38ebed24d6d3b8ce930e667c6981f4cbc9714ffc6dab3253e50c0708851b8446430619b56a7b4c72b6312578eade574fe6e4681fb3a6661562c777f0a575ece7a82958fb71cbea32775afb31c76b0f28bc0cacbf3ee8fa18abfc0ab50d9c67e16b0774494c82fffb4a8f29dbc273ac47f38a6bca3577653db0e533a4cf9d931e53b13ef0f8a2a3dd8074abf9e5a51ad5bce7e369abfa7b8d2d4f34f8a2baf673d418bf8e1d0f28eeed54d6d3b68e9a0e5578096f710571e90655eeb7bb5672ca29ada31f75ee892870f0aa16591fd51a5ee1bf2ff8d1addf7f4c1ee34ba1dcefcec38f3e3c9f757d5b9e37753e7e66cf77955464b37083fd7c5e7fd7dcddfe299f9a3cc0fa1e43f1a62f877f4d96e6adb414c07313f37c47c7fe69dedf8b0a5dccfe4941e8ec9587bd95684fb7b80d68469cc5320f605d87fc0d6f7d393376f187b31c709493edc34c4bfa3c7f755671aea90e467479297a6db9bb7c23fdbd2eef524e6e50d57a29c6dfaef05238d04f596b32997cf0edcfd78c078472758be038c0e307e72c0789863afef173b9e8ff55eb37f194649917f5eba7950646f808117d2ff7d4660fe1d1d5e3b237087073f3b1ebc30d97e14be6270dcdf7338e0cfcfe41555fa752362bc1760acf2cf6ef5390883c477eb30f8ec0659f296107cdfcb780f215f3edc30c3bfa30bec97ce30d341c8ffcfdef9f5348e6b01fcab547d1e306da7bd33f7edded9a50362d8dd4ad3f247a395639f26268eedb51d6891f6bbafeca4690a344d878060950788cff1b1eb38e7fcf2cfb1df3a422aa2eee95b9162e6acefc73797fdcfbd40fc313af9727a0f17e74757b3a351913f5be79f5e7c1b5d8da7319e9d47743c8dcf66c77130e3e9d9ecdc5057eee28435c41f43308783041b0bfa16b46504f3da0f4076152e3e7f7ff95b9b0687c90edb5b9b96436f9c43bb22ef276708fc7a7e73395bf0b3592ff26f7ac4152762a22efbc7a6c17986b3c60b49c11c60410f94a4664fe66c2ffc8acc69700c6dcb9c9639ef8339db23efb9ccf10b9edf9fcd8ed3ab2fbdbba03fb96f9c390af4decb2554962c68f3e25f27f71a1c5d3b6cbf4e6e69f32e68b325ec9e899a8bff73f2324b289898a9830830b7d1018980c47506a76c2b543c097ef1c9c37a0d8eaf1db47387b57079eb70d91271db1ee158457e5d3d7a393db99a1d1b3a8e7ec1e3e90d1e7c1b9d7c9d2ce9ec7b630cb138ac7561b2b62bde21bff4da4d9f7a0d8e90edb56b37b5a478eba4580759c54be4c124baea3706007b278b41723f35a6be4e05af37a8bedfe488d776507d8b8c378e8c3ad1f7dc51f5274db1663532b781e999f6abead5d666f9d46f7266e4963f2d7fde387ff68bc3ade3ef6b9068320e06a75332a6cbcbd984ffc6fe77d7e078fcd56e3cf703c33dea79452635b9fc7ecba49649ef84497b7d70d800909a5c51aaf888e9b9b767fb54f48a486a7271ff16492d92de0992f6bb5d6b8049cb269964e02061947238b8cdbaa70e7fb6142a58f3e25345f59b5c2eb39d2aaa65cd5b67cd9688dbfa18a847fad3733c9bc4c1785a2c7b324da64bd2e7b7417c754b629e92c1240ac6774dbf7f728d959c82b17b32e5a942afc794ff34f866aa654acb94f7c094a722ae9229bf07e309278349634cc9dc0ef6f6bb870e576bdf0b39f3c54cecd88899ce9c71e8c082196b3a56760cd84eaa3a2a0e411f967d76d53c15878833912efec4091d7dac6aea215e3b7a5e7cedefd7ddc3ee8fc2e1339fdaf477e3a40e0505828220cbff764abf95601d07d88241bea9e5d8b8ee2a4c621cc26128dd518843a02ef9a31430d7ddcabab667baff4c842881c4559885dc753748e7ccfd58b0b4e0629ec844693006cd39b6505684f74c795958cc0468c499b1b902163ea597caca22817056632610a622dfc45ca6e54c6af05a00b229d2fe70d8fbfc488198b0a005e608e81dd6d43c34e39c29cbc85a1325b82415c5351634b58c3f9165d2c07258672474b8165cb992443e9684f20e9808f736a4fe70b4210f7bfd92fce0272d2ff5d36278f47953422a668bee872e08222913612989b011bdb21c6003a38f1b1a26b05e963511946b4337cea34bb282c4895a4bed9a354feca6d3853248e773cc25f28ca872c86a6fdd597c7d9012ac4c4d538b6390023d691a81c20d5583e68c5bd03b5a950564ada08524005a3bbcebd8194ba56b5f844d946f10d164e03cb1d84f0705ccc3b28aa8b42cce136ba4b66595006b352650d649e35da6ac5292f3b2fcb0888639076239b31b6ac344c861ce59186dfcaa591a823947b00002e2f6a9ac54f848591f4530964bbf77d2ff434ce61cc8d4893b51651b14b0709df457473e9d33207117b6d9062529b74c61df295ef1572a2d50a599b038f0a12dc066ff5164ad2a25fdbf55ef15ca558b739da3add2d293d6c9a97639fe684ae33ba09b5f67641be78d90cb79affa54080b552490590a8b5dffe854d86c77f21422fe74b4928afec356261eaf8f72f28e7ba47797f21fbab9c318ab89f447ca58cd44e8b39682e49b75f5f9f1eb7ee8e6ed4a0523ee62bf48a1d4ce7ba34df993170d9e3bbb5b10546a144a8e45782875881668755e8a308970ffa89e95927cd91b1c0d7758fb8d8b9eba762b565719a7fa1656e7b80abb28a6f36a8bc7a7b70ae31d7bec1c900ae3fe12300687dbaadb70f130f521b4d34e69b958ee30eca3c85d365558312af0966cb33439d29eca7591860c9054030a18653addda5bded46a2ccc5ceaa4ca68e5a3aec23a76c2d5f7e35f7e63f4f73f000000ffff010000ffff21460dfa21300200`)))
+var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecbd698fdbb8d22ffe55068dffbb93332dc956270e705fb46c518b5b724c894589170f0eb4b5b5504b2c797d70befb1f74bbb74cd2c9cc99ccbdcf5c0f906973278b6455fd4a45f2bfaf8ae6beedaf3efef7d5aa18f24dfc6bd2d6d76d97357d5edc0fd76d9f664a269267c5faeae3d5ff672c3eddfae675bf4eaedf2af0eecaaabb763d7c8a86fceae39b75bfbb72a33abbfa78f5149eb5c953f097212ffa5fee0b9efd92ed8b7ee87f19da5ffa6cf865d3fdd255ab6cfdebd5bb2b3f5aafb2e145f7ba6a75cd8b66b3ff5754a737e3b7bafa6b74f5ee0ab7ed8be257efae9c6848f2ab8ffffbead7abff7a77e50d11cfae3e0eeb4d760ee02ceadbe6eae3552f927e49b32e6bd2ac490e1f7f79d1561dadab381ab2fefad4d5ab7757468b0a9ef5a2e62e4aaa6895fdba6aafde5d89f454fcfcaf47ca9df2bc59d7b713c5ff8b66755d67b5e8fe2ceb4eb5c59bfb4234161f86acbf7a7795b475b7cefafefa9e4743f63262752cba53b819a2a2c9d6d7bce8877344b63ffd5a1fbaa17dfa711d3dd4788abd4e8a2ecfd6cfe1f46562da47cf812c791d4c15559527bf89b82e9a215b3711bfced25db44efb2fb3715e7443913cc7e475f422f4547c1d35e96628f85792fa4d3cf0ec39a14ed5e78028f722948c5f045e0ea0cf23f95548516f5e8555597911fea2c981bfa0d35e955e8c5084aebbaad85fbdbbca9aa44d8b66f5e2e775d437f2cb701cf5d9cdf8554cd144ebc3cb983c7b59db752956f48b7097d52279bd6ed7a25bf7f5f07ad1adda78737f1ff1f63acfd6d99b0bf2cdc4ef177f9ea43aeafa1fcc3a4455d636d75fcd9a675df42755737d5ff0215b7fa7570f1bf247f25c67759ca53f94536cef1fc9d70f692bfa97477d7efe739dac939158894fe3144c21e2ab975149b77919bcaf87be5d0f2fa39a6c18d65192bd8c6bfbd3927919d5b59cbf0c7f59649dddf32c197831bc8aee8b66c5b37b5eacf257adf6873e8938bfcef6599235dbaf256d9a62ff327ec8fa81b7a7d109a655b4d7457be6030fd1b510540f7faee3e231e63a2e86fef1f79907d4459d9dff5cd71b3e145d7422ca29e2f3a61db2b45b17cd10c5276ed26422b1c986eb7c18ba173f4fe147ea3d453ef6f81c3764fba15bb7274e2bf26cd68290a7d96cfb130184f078e87b170db9588dd9397ca6eae9d72adb774f3faefb433344823eeb4d333c0ce7fceb3a59b52f424ff48b86b62e92afa59c09f79bf8fe203a795e30fdb04edad34cf5c3ba6856a7a443939cff3c577f9ebfab7757e77e6d9a2269d317bfae37c3bd7cf33afce114eca37b916f9b3569bbbe5eb53c6a56bfb6ebd5f5fefacc44933c4af248917e2c57d7f2833c92d4efe43e1512bbe747f33df2eab7326fd6dbec51c6bd912fafd2fbb773fc56bcbd91f93b23160b306dfaebb4e9ebacefa3d5b7aa7bb5c4579ba1ff917cddbadd1fbe9351b9ce85daf446ae226da26f24f787feccd2be962a76da759f259b75761d1769b1de7c935aa7acc33a6afafb765dbf95e9718d8a0a7f245f23eafbaf77577ed60f4faa62b3e1fc21ea49337c8872da5474f2e37f5ffd909aed4445f3a8c4fe5e6dde689d36fdf112d7abf6d7ba1552cc68215bf7c54957967f954757fffef7bfdf5d092ef53dd0f1f13aeafb6c3865132045fc4db3212af829aa79000de73cefaefae2985d7d1c4b939b7757b5e0181f1579fc7efc612c8fe553ccbf4e1ce5e3952229d23fa50fff94545f963ecaca4765f2ab32fea734fe2809c650f4ff4a05711ee824b8d8c7ffbeba1d8a5a34eaf65972f571249dff7b77e589b0ac4e6e6e4637efc7937fbfbbd278f5b22b1a6f93aabffaf8e1ddd5f45525caf88d4a66d9f6eaa322dd8cdf5d19457af5513ee5b39af6eae3f8c3cd870fa7b9cfae3eca371fdebf7f77e5fc78cd2e2f9aead41d9c8a4644f32fba4b9e5b0bfef5af2e4aa55396e05fffda349b3e4baf3efe6fe99df44efaafd3340a25ff020d2fd0f0020d2fd0f0020d2fd0f0020d2fd0f0020d2fd0f0020d2fd0f00f41c333c31288b65afd283878c489ff7e77954643f448822e5a67cdf05cd7738153433f803cafbb75bb2dd26cfd1d0cfa9cedaf85a1f2ff59183a19ffc730747481a117187a81a117187a81a117187a81a117187a81a117187a81a11718fa3f1786be008d3f11905ed76d52bd8d4a4f39fe5a40fafeff302055ff6340aa5c00e905905e00e905905e00e905905e00e905905e00e905905e00e90590fe1d00e90368fcd9a8f4badac459d236f7c5ea6d80fa22df234c55c7f2fb47983a56a43f824fef23de7f0ba0aae3df0f50e59b3f13a1be7f44a8a3917c72e3fdf19acf0855fe0d427d20dacf47a8e7e9fe12a83e2f98a7f4072fdd9728f319303e23c5874d7a068ae7697b8d145fa2bf87dc5f6ce707f7d5e7dd7e6100df67002ff7e7132fb80a03ad831a0e89c2b771d9aed2529f87ca5e4e4698278d73332da4d553b86ce7d6542b59604b11655da8208979729e06b88d4776979ad5c00c24857ebbf291b34bf5bdef10d7038ae760f0294636f538f800dd9cf89a0160fb8e891d72d4a4a5d2ef3dee825f828789aa1348e718b53b5cba3e906e4a4bad5fca0848659348ea36a1ef1ab1a21f623dff440d7744e86090dad9d1caf63061012036f7ebe50eb81d00d801003330d82133d332063b248a3cf2e4eae0cc508165db825addf91c7d269c7b98db7336bbdd636061a44f3c0c3002c4bca486b50b69e1d01c4145f68e8c8170c6b18ff684e3a9cf9999ce188951a7b0991601d894542a8e646611c006701b27bacdb18f1c0a78010aa209e27ecc590e129b2772754c748c1d9d1122e71506a0b13ef84ec97322a7adc3ab9d4b6c00603342f7c692db2633540f2a7b4a2a586084286e18766abc211ce64b8ea95fd9b3b8ea0220394d90fb99e972e5943cf0e920798003af0170241b33927b49251b899ef2d8d4d6a1e41e5d220fa4567124b12ef4d1d651ac1d349a1f1d91eaf3dc70b90b0ec93f01b2079fb0c857b0090d2eb38adf1189ec3d1e1eb0efe6d1285519609cf214f93ce798db3eadf9d1a9d1675ca500558742192ac2dd3e32398e7536f8b56af860cf161415a0ec075f667d861872ccd4853ab77d2905a74634d239c0911386d0d6a5b6e105bc888d5ef6794e23ced60bba8f1cc906c273379531a24d4e6332964319cf3162a15f018f89eac00ced404e29f8da270fc10174d63895b44b46ac8875b5832aaf1c8406af840a576c1f2a7b8f48ee108dd2d2d33b0290f70eaa0e7ea911cfc8970c25e354b6f5680625f8da21a44315733e78d53e07dd7618401f4b2a72c850398839a122471eb78e9e8f98a3ecf7e1d1dec5c839a408570ec53d291138325217fae0c108cb7e3dcc1dcef46535b9734a30c3d2468e81425ccb3990f181e979e9eaea8dcfeddc0136f7a94ab1018406798e03ede0d361ec23fed9af873b47c12618322544bd89cdce07393cfa155e64d4ee998116cb4063fe4c7313ee6e70c91c87339f281d4d0d1711aef1d8f820115f331cd9065cd90c7cb407040b5c2f77a45603003b00da4d09208a2bee387a3705006fc9db5d6ada04089148a9a10cb18054ac72109b2d954a7564005aa9187c34239032d758ee88d8cf81e68425da7935b268dd9148621ee1305eca00b882c8a9b141e860f8dc1ebc06479ede1994a7bd5f4d90ef23820d3ca2be8b1da20233998b25751b2af2da93ab2343dcc3357648d3cd7d9e7e06df5d00cd43df775b4a5d7341521757b6eed35e8d11a3f1c88e9c06fb40d52a3110f26bbef0ccdb23516409aa89b9f06dc095bd033a8c921a052e611e906e1612602ec238d17370e8720f1cb68e9c7ec60d50073a8548444d105e3ba4ab40d9ed284fd448c61b5cab91c36dcc00cab4b66f689096b8cea390330faa7ee7976e01358e68bd8f1c03030d588995fd38a4839718306446378b4d6d091caf13eeae3ddedd3981e6fa041620a96b87d8e0e8924c6b1952493a2e39ffe499dac627f994726ce020f5629aef28a4941a8890aa7371e01c7cdf9dba1481cf19737877f489e047e1ce317317cbfc0ef47c1a51b40e8f761e07da1caa1425958a629d797190ef81eea358010bfb18c7606f990ead8f967bbfe1ae636a639869d8d1fb436676b983ac1d2935cfa1d0d3d22eb0ae2efd7a3f7524f9b3efdb0c2ad5071ded3204e0d528c047ce7cb3eb63d90e70e52e1cc33d3084e7297416ae5c3736f6dbb0446d08ee0da93060251f01ed8c88dbb3d4c43ef04ef1ebfd2e06ebe895da027c8448a98d432203a96c0c33edee59de3112c9a821be4689cc50aca73e10750a15c0491e0a79473f4ca6c5ed2a51e090d67058145a9b9a78971cdbeddd513fb887f1eeaebcdd387e2bb97eb27396edca32d43ca6e4c632862eae97f350996c5203c6a9f928a3cfe1d9838c8e4d38a6c6a9ee7544d54ab497062e4fca76158da06081bd6101e6c941ce992177f1ea551b7258c321ae4162813384140f11556f23856fd86d57a6817d605495eea8bb8d1bcc3373f9bd32a73e2c0a6d162b6a1dd1649e98366735887a4a16383796394ca60d1c59601f17ab7678bb2f63314659d06f21fa3dbd153a4a77ee134f1adcb19a976180f91dc5796ae837d623ed8f684638541ec7ddb2c21e541d1019228feb3bccc113bac8c35cba62eff9402429a4c302231490268f1c3d3c1259c8d67617eb1da3c876a08239915dd32bddd229ab23a99841b9bb4e4d1644ca7ecb0c79e1cb88e28a114c3a8f405e619921e040807406293594210640064c8d7c418e36ca381b62030538b8dd83b26f89629b7ec930c8cc646637750103a96c177c040079ebcb36021f116a6a0105881c49b6625da598c01810f3480dc825e001620b46f7bb086120dce6cb4033191d0c90d1c0f4098da46e1a96c8c3d45ebb33c4b24af5c32a9d03752dc2bb00fb7824fae329b076c0cd1d059b6c867a0cacc7dcf6308412a1ea3c92c35d62bacc296ff761957a1e629b4497a983bac35291e7d4b0add33ea5fb1991d3dd92e3b557abb923b16de8dbbda74fc27094e2336f8f961cc182600c1591089093aee85538724cac13a1db71c18b6c9fea1d0ee5749e19fa913650c434274406d79326086a398a2b3412dad5b27683e4687b30ca7b9f76918b527f4164f0507524549e87c03f339d177884f7a4ee3c0ceedaab5cc769b44d7874a7a93416bae5026a2c819e8e53ce066622e694fad1afbb96422774bd3c0ef21d438ca600c4af397168be0f65dc3832a77e93e6d8c83b9fe0b923a7213b02f5507713ca79ef28f6e071ee82cef4b0bc954152bb05b15d87774b0aa91749f2101d35d7810e53482b02b6ce8e6816fbcb63a80c8b04593bafe6cc29f10d33243924eae055903bf59e12097680108df59401671dadd5d6afed8036a8c08046704a67063bba1580ed322af784776bc10ba9b1dc13daf54b6e1ba4eecaa876ef7cc21629728d6523d667b803e2a8b4c614b85b621f249070e9207b0395edc7816681d2ef5cd421afe6011cf331a3bd94e9f21a9a7ce1107b0ec85129273b1772704a4e40662dc8cc5f103700c276be84b60eb503d2b81824f50e205ff8863d383e80c37509644c7dc026f16d9cf10efb152c6214ee69a92d80f6c750c29a03d6ce0d3a1f736bcf488e92da06e0d8f3747b0b04d308188d4cd7079adf90a052b1e47e8e75ec92235fb099d66310fbd9c6b15fc9d4772b4f6608730dc7147f827a684935415e6547519d9f75117de7d539a606361ef78b17a40526dd94cddc0aa3761feb9def283822749813ce8049821fe0399b691ee12924fabe70026d1e923c8a385e8bfd06413e030003437b603a13fb65416ad5a3802d1ad825964289f8b732283650ce23a7440194ee3ce29d1e99dd9dc3894c2a360f49bf67238649a0c9146041c8ee9818aa0b92b463c0762ec748d0d191d927a2542a46dddae3ccf5907d001ff52e4a0366b24fd870245aa51221f2e0f95a4e8e3c00dff62875496cbab9873a44389b63493ac626f7b3c09105f6c344fdcc4cb4888e2083948e330303f82e8b0dcc084f6452a3b51bb865562f65a0fdd845ce1e97503a4dbea475c732406b1a7082696e8454ad084524325392f12e0fe9606060fa92770b30f2006676e9c978706718b200df84f5d0fb06322304411c601fea610eb2bec7a5cba2aa33423a4431b8ea22c873a7821b5ae196706b871b9c67f5be877a90b24abef179573a743226474bcac884e06a5f3a92756400bb658d9bc4040f4b1d25c07a4069882bf062a80e3eedf71e0558049cc455a884a5dbb8c8ee09cfef9c196c0004ffe842a8910b72b709a9dac732e8b4e614eb6c0b007300972c83aec2758e7d396d7de0c4f3ed596c38920f794f144428b7ab98e67346d56dc2b19f1c711e57aa0b33bbf7e46e4dabc1c33aa830433833dc9e5693004877248abaf310392eab7d00a3dca4256a5d0ec4d107e72c2ff798e335a1297610f3a1821ecb2ef8954d31c0599eee717c94e6d6f47665196e1f51d8a45379cd82b76d0644263b4c53c05537877a38d90c96d5de13347fac37d14f72c40825303c8ed6b8b27d4757a7004097958a70d595b16e8f29558da4c6831b408e2b754e82aec5bc1b6893ba9e917f22901b49a5aea17123d0ab03236c9d726c2c4b7be1e85d078a6c3bb26b66a63d8b03cdf42bf03c19adbd80e531c537a1946b8e6c53ec23ea94c80dab4405b93d24465750c339f8126b638482c474738a180deb3dca385f2f80e7714524bfb40d305c931d318b2aa4b219ea5d7d424969978e04770cf22a05deb351ee02511530f6cca9763b1c745174e42dad529c514448d0d158b75b5aa22926131d1a583852a78700d552e683df80ebc89d0c4a1745609ba9a951a0f91df89a41b93d60ce580cad0408ed52d40d89e97a58b71951f60b80546733e4e160a584c08c189c8343baca91d9cc97c1c0c04cbfe18bacb249c8a125d4dae17a5fe10a2920e714386c96d5a4803a39fa15440e20484ce43981b6f0a5d45b4aeee0f1aec8aa5001a34319d821f65dc074bff067a807847b8670e1103502894550ed761eef3028bde4f3641f2936c275e7c73abb21474b4e0da07e3d7cc275bf071dc691a4ae7ddf068c6c9d725681827520830f061ef904530fe18d5f71eaa1500a65574a914dfd0a4aace4d8e7e91c786a667ae77bbaaafb338db9806e70c00835f09c5460108ec0abecc231f63302ccc0808245e0f2d8f8b0a79cd91907e4950cd291360e219fa7f5f2b0808e39957a088f681a0186054545ec731de47c4e0d77831bc833decd7c1f21c7804d326339c82c00801da0ea98a03cf28cddd13bba53a2cb3d4318a8d1cb34e8b043ed21365d1feb4c5a2afbb5c30151ae4540d88e40ee3a7479844a5d788881cfd3f952b1c9b2e62c92584be4749d4a322cccce8fc156c3861f1d19030e524a8d7ccea85ab9009f97950ac0f54318700964d75c40ea3bb24d6166234f861b5a02734c2d0fe960c4867e8cccb4a4ba746440e48ceb7b68b4088ec8084b7b1cebe3030df2ca29b94c20a589ae7e0e8f5a15ebea2294f2053196075cb1ca812e822a1d2f39a34bce290879a9e7514a0127ba1a44dc5e53daefa37ab98b505a3a52ab1089cd5349dafb55ce63c25ceadbf30ca56b28edbbacdec94ce8551ca9a96e2f3c63ff9936dd220616443a137a2b21beb50739d571035e34ba9519e46586529d566c1657f22824b88a3900d403011d1d4265c049ed1c163eaab08f581874738722333cba0ce46e0f3adbf914997e254719b72386723733dc2033868553750ee869150b5c50a99e233313667685391a48e5bad848ef98d1415aa91482141c22f476ad1236d205717de0dd01ea61e7d53870020c40931de5790535a2b8ce5d5ce7770c61db053b4867a8020387a0c88bac5275cfc78533d27286d228ab97bb54c7450c9d0f46ba13fa5d84388b39a350cb8b8cba2836ba0a23db0063584472b766883308b4256d2a55c845c2d3028c3da1a5b6ce6aebe8573c88091b7c093c87c8c0669840bd075a0ff318709fccb408cb4c020e63acbb1bd220460dbc64902247416baf613ee6f698543976913dd02a8d30e83bbf4aa510c0f0aa4904804661956e1de80686b428ae64353c5aaacfd1e097380260377ee9ba29c76b1274188edc127a1a21fd919261118d72838cba39a5b88f4d70a38a1d4242d4d47029ae51e1cc904de8c04e369646cbc1e71ea16a99d4ee0dd3f7108d567b9feea3650d6132d32a47e207a17f7adc365d9355b8de6f01b09b2a98c6665a123a19311d6852db7da44f28264c82d26e490d01046911ebea8c946e15826bd08ae580d0d89772c86ab070030081e60271c60e3020a55642a532a2c812a5ee67ec6b9fb0914f057d526e83e7bb2ed4f968a97453073a6b197434925b45e8490ec566a4dbcc91d80208a331727be0760e81a68647dbf3a86bc433c4c991e390aad891f543a6db8563e02994b617f176477d3b8f6b8c19ad542ca7a61730e6a06e41787af4a409c15ca39ede51a270c9d527a657750108bd04e5d4a36090d2c551ed4821a43481f640aa4914556ced97761473b2c32522b8d142a6b3c625c34d64c87eac7739918012843694038323ccfda6a319774709eac013fc03d2ca359607a6bb010e563b98d9ed92e32035e43caa6c061cb619002ca8ccc1472398a1850bae0125739c198a28e512e872e035b8c04ade03a40b9743ef372ef18c95028a6a64864b7caecda23a3fe109ca396108708c3a9f54e92e91c3233371e548d5117c4b4db87e60a8f380c041f023afb691d7740b670612a51d3844d5bd862db0dc7df2a91aa50a185e907390d92720b997807364262b4092ef486953bf86c0a52a7548bba74d57c5c825d0b8016e6ef780d83443fade6b501057a1421479876572c4256291cc6660a83d057d0fbeebe3d1ed2194d3cae7cca28de01fc30d23d84b64db64084acfd8ab423fc01442af967387d843c8f39d230365a3fc2ee3f6a9beacc63d0eba12085b133a4858924d5af1c23172043c9f2ee5f020b0bc23e902a9a8a0ab7d8c6cf00c57f1cdce4d25997ad524cf7cbea5a5bb00c95d2766baf00c1c8424951e70935b62ba3cc93f8c9c5d3c6300607f0e155922089bbe8f847ea8f83c193b145b91a955b15eed9831f4899c0e9131704cd8319470950150a851e1e9e36348a04dc8c488a5a18afd6a2770a88318c58d0d71803f818fb48cbb37f10c7056a93d91d332336cd305a098db9fc2525b383540620c94223b8299e6b908507cd4684cf73e903c4a0c08a0ca19546c4be8d0bbd4da93ca0612dcee69952e52c316b83a00b9b348951e13ce7abfd4ee1c63b72752b24f25d58c0cd575a40e40cf7729a080546a818f68cb10db390a467eadba31b0a5d0773dc31d32a32b2289a804a1eda34d12807d2295fb43fa767c94fed7d59fe241b0cece1f69dff21778ccf4077dda6f3eaaa38fb2f4ebe4fdefb9eb6b32f9ca27f91be9fd44fa1d3eed93f76f54f21d8f810f6ffab4bf59f3c5a7fde2d37ef169bff8b45f7cda2f3eed179ff68b4ffbc5a7fde2d37ef169bff8b4ff0d7cda1fb1e09fefcc7eaef92444d376d7fc3a647577528cdf3e6dfd9bec4f30f546febfcca5fd4f3d733df9091eede31bf9af80a71787f6ffa10eeddfdea34ffce0ca3a689f8884b95393152890a746ce9342f358a06d9366b9ca46fd86d4b08b47b6840d24b1409e4c576d691d343fa5f21006b63a5d75efb3913062a7dc9aaaf358b18ff781349f175a2ccafb351a44b9c05b952fc373efb625063a2607cd13ce678b427b9f1dd46518b847523fb425f250a9db865c6a23134b89e9dcdc1d26bba49e8cee1a57090f6a1979ea363160932879c79ae5261ed9cddd71f23956acc9ebf6f8fb4c810d33e5c9b7fa6c1d9cd5cb3e4fab6e1b96ed8a2afb2eae87746ee4526a6ac745f1619b98f6363da8c7b47636a1526de291c6e3c66d23caa4bb7a7260870fd7513d293e05c3fbbb4a38e8c1e4deabe653ceba90da5d6c7029f3db537b50f33cacf75cf461ca194f1ab78b15f578a289c26b6baa52e1ec17d1c92619c9a7713d1d4468dcc97d30bc67747f14e3f4ebc986795a1d8fc45845bc5a9dea6d86f7110d577715cb232ac6931216b892a86f5a38624e8d90f23ea26a63999827237c9c36c3fba4461ba690af969b8bfe1dd4c9fdb27ba26d361a785ce3739f4e6dffb906d0eb3efde7c3e2fe41b1f395fc8f7247963e8c7f4cf08c461fc737bf4af2ef123cf2576f9ffc2029ef7f87e0f9561d37df933bef25f94db9f356c5df143b0f14bbc89d8bdcf98edcf9caae7b163cf343bf9e36b84b8d3db766d2caaa263e36c881792786b24cea89125171aa4a9e5828e561805b1658ab47616499c3fb3b6ef3c4981c525d782d0be66acdc300a4c8981cbea8534b03bc8d29920403b5565d2504ce294ff3f57a520335c9e17662a1be489f04a37c380bc6e26e7a5b08264c8410a879c5027b72efd9e9b471e598ca79d254ab4fde39cf088698a24316c8136bd5aee787fe24689ee297edfc7eaacd63c55a9d8495a77db0909627236775128422accbdba4262b66802498b465eee6f75369b8f31effc91feebcb7c2d27017ece6d9683884546d9877bbf1ea492198ff3d1a04cd172195f97d20ff09428e7fb066fb6362a08d65ba5246f7fc936797b1b2df266527da9a9d7e37f26471b0fe918d865d52f34d6a842bab201ba70eb9ed59ab3b2e68e09e9483fb40fa70377277211dffe31e0d6e3cb2b9a5639e1aa88b91d45aa3d7ed4f9b27e1f3d5318b746b8a3fc78aed87ca64c7bcdb9b4fdeedc632cfc2d07c51dfb29dfb23c6931af5f154db0905c532ed3c35c8cdcf1ce774d57db0f46e1b169a8da7620df04d32c2796cec564fc2bf106b05766c64e7cc205fc47fb9864e825f4e6a387eb97e5eafa5df1fff3a2c7f78ad3874dbb0e27a229ff799294fe869be1ee2bfb1e6beaed88d58c78264cb46ce361ed91d53f8e68ebadd9d389d315a6e6363d22487c9fbecf0726e1fd7b6b415fb51ac7b8f60a18cadeea7277e63c78d2b8574df3fd1af912796b97b50ce02ad496a54317852cc26f79ef6bd352e14e1ff6c9d9bdf56d83e99d07ffa8e72f6c9d87722cf5d234f3e4d277de4ab4f4adaa7e9448e67cfe12ff6cb8302b7fc5f7fb6e2c6a3a4fa67bfa9eb687df851e5edeb651e153845bef911fdede6a3aa7c54c7bfdedcfc2efded956a7423bd7faffe0ebdede6e62bfa95a864fc7dc56df4a6e2f666cddfd4dc4ea4ba286e17c5ed7b8adbd777dc0be5ed51619a6a765cb36d52cb796a0861d4cec1c8bbe4a03d2a4aab544147cb48795cc341083836d58470120cf26c65c0fa99f1cd7f233404b25db68335ed5e2828d2676b9a2f5f09e169f75bcb41f10aed2f6303f24421aba4865a2892a9f161f5c2a2f04ad030854b9109c55dcdb777cae4c84e8ae98715ab2707cb78ad8831ba5c4554ade3913d8401ee6265bcf97398a6f86ef73dde78caf2075d7dfee0f595f21bb6ca9f6f4a7d2ffde7ef295c5c7d2eae3e17579f8babcfc5d5e7e2ea7371f5b9b8fa5c5c7d2eae3e17579f8babcfff64571fc1f9b39fe0e873aaf7e1ffff5c6f9a265bffa8d9eeab451ea1eacd64f2f775f7792fbffdd9f54730ea6fad7727925dac7717ebdd37ac776fedd467d35da280644de5ad656a5d62402d4c58d3c6e5e9f47660018ca7abae640fdf3657773cdd26f5d0c70aaa1259cb5363b5ba0fa4e2e40064ba3c356167197c93d6b0498dc9215b7647162c57f3d16a1ed3a18a026b755f7cd89c6ed05a763c54f2ad3595b935b5df6787db8d27e2c5ad5835ebd8419bdcc37e6315b7ffb0ccf1f6ae1ee4b0265b715317ab79335d75dbf0a035a27ef17d28aaa14ca7da315450cf3cb98c3c598a95c983f3ca34ac2d63525a06e6b10112f334e130f4995157b24cb70ce99e9fbf196de393634fb2baf3e45148b934ad4539f7c0a8f866763bb0421bc5237b1d1b939c99ce96d5bc6781b34d14378f0d32844a35a4c6641b1b7cc30ef22639a86a4c77f3587156e1c8e6c2b469992e4f1a710328e4a1e26e53aa4a9679babd6b1b8f96ab3b3a5e7db5bedd69cc3933a4556288b1b9aa65a08a4de563a838436a7c184eb79415627c5c89e89e33052a6b2adffc60fd65327d3dbe88aa8ab8192c1ed9ea5d0de390cabbd820ab97f1d31acac8f8b0b2eafd56d03f3226c774aae571e376c2892950c49caa3c1c9dcca193d8989421dd15d66cfc8f2fd6cb1029b84b0aed1f7707f59828ab793272db3b3af08ca63c2e4ef47f4cdb4614df278d9b5b53796f4de58535b58a69edb6319d54d62cdc39d3a77ab6f3d5c35abc0b5673e1671053b20aa97a8ca82dd6cb2e36901ad6936d74b81dd2265cddd1ead5182d537c1f56974ff99ed7ff7cca5d584a2e22b233b1671fe64ff4e0ae1c2a2e17df5cd31af52925ab580957a7bd74fa964c44f91b6b8a7d20e23e1dd7b7561dcf0c2ebd8cfb93ccc7c3f765f4100dff8f998fe58bf9f8623ebe988f2fe6e38bf9f8623ebe988f2fe6e38bf9f8623ebe988f2fe6e3ffc7cdc74334fc14f3b1a8f73ae2d97ae87fd070fc65e62793f168fc773619ab3fc3643cba9cd4b99cd4f9f6499dafefce6763f192ec11e1d80f2466fb3a9958c299bc9ac8a9a9898b3d3b71b0e14e1860035b4a0eeae744391dae695880ef85913569f0713ead56d9eefcecc0080ef1f476c087db2139dc0edef4b6589e0fec4441f7e0435ab6ab650d7952c3c19a2e5b61484d957c1b52b9b3a6d29028f9363d0863efc0e3a22a44dce94a644f96937acfe3dadd8a8310f608c92cb0d54f0439186c4246431e1b70484d573a19657da9103e9fec64b8b5fa737f37c2c7d4f2a5021424854a5ec54a52dc7bc9caaa9f0f28d8d3fcd9185d4fe4d4202723eeabc33154dec63517c6c493815918ad632ac60a727290d70bd359ddd10faba871b77173322e4eec51bf49461a0f0f6a1b8f5ce9de4bba3b05ed226fa238de649f5238649eb5fa54dc16b67e1e973c313cb247d6aa13a75a1740e47b5201211547e42849ce6dd77df26fe7d3266d8581d842fdca2a706715b7436c2ec5efd7650ed62a5876557c9e337100c1f2b4d89aca476b6a555fac89c242e76baa4ddcb2d35313b7a772d6543b1bfc6d2d35318f034db20c558ea9cd93e2b68a3c4d8acf347ca0fd52f8e61e53ba9792c3787357582feb3ac606f0647a7b746edb739cb81a3be5299a9ce7d5395f97cd3af17c8735d5ba4fdeb29d4f71373feadd5c8c4951f974d575acb8cd051dee02478c5ff375f1d4837b8f2bee60ef347e314f9b69f16a1c10d7a8880d72f21d66e2438af1c5da3758171b64639d9fd96041dea5d3dbfdb466dd74f5671da6d8b5eb8ab751fa9d8b009fb3fdbf65e07dff1f1b78c71703efc5c07b31f05e0cbc1703efc5c07b31f05e0cbc1703efc5c07b31f05e0cbcff830dbccf68f0cf37f23ed57d9d29df31ee66cab341f7af81a3efdfc07b7f011c55a40b1cbdc0d10b1cbdc0d10b1cbdc0d10b1cbdc0d10b1cbdc0d10b1cbdc0d10b1c1570f404197f3224bd5e6db27e88dbb67a1b9c3e67fb4b21ea78f20606fc2b20aafc1f4354e502512f10f502512f10f502512f10f502512f10f502512f10f502512f10f5ef02515f40c8bf0cac5edfafc5779a26fd679a75bc3dd45933fc7a886afe368afd66a94750fbe1c3dff9ee2545f90907694e24fbf960f6bc20bec4b4978334ffb71fa4f9dd3bf889895c8581d63d3d3a51b6ab30d076c961a238de6d694dc52b5ed62a3ae647cb7c7e15cc326c2eee2267deedfeaeac36ce549313853c97a5364f0dfd60894b79eae52aac41dc4fcead99be594cc7bbd3e5409ec633138bc75c4e2f8ddd8dc27d588314fafa3c52f886cdda153660171b1335a6b0496fbb8105383f9db5f1dbb935d54e676516855627f564938ab334b7dd3131a05c88f30fa62b2e5f2ac5453f8bf37908f1004fa8e44e486d1e9bcecdc3f90a718e03ed9259bb622310af8e15b132593fa599b86381756319ec102baa241ea91069897808c8e065183837d64c9cfdd02476baac0849ecd43f51af2c1e88118ffde48f7db0a65aff9bf6a7b727da2d0aad49291c53c3dec64afff2fc89145138bc1eeb437da7f33ac7737ba21e45a4a32e1673fa22fece7ba2d72e326f87a4862a0ace67564e79b42ea6a8617e7b9aefbb3a2d9342ede2c3e4659f6ed2f25599030bdc6d1ad8250b5e8fe7210dc9a707435ef4e3f15f38d2e44571bb7766daf0badc03dd623a3988d7e91cff76e7d3ea659b3c6ec2576dbd189b8901df7bfac43f9da7e1f6277ff948f7877fe78b916e2c036f92ddeb34eba0d9acd0d4780407cb70fb3470257157ffe325609681b72c7056713d91c44552a1b297c559afd0d3aab87156890287b4e625f3762bf192615c6852fcdb363af1d80ba3a8b20c54860a88170879dcb04ecced69ad4f35250c6cf14a5f2fda3b9df7a9f9864d357169971251975b46be4d46cb2fe8ea884ba4eaf8b013eb6038d7b54d83e5a93fd6345542ba97c51c33433dda074d5c58c62d0315f188f1bbf37e4da95ac68a3c888bb84ee7aabea0a1f554cee6b131192d56bf497f6e87aacacbb97a7880e7f55ab9f3b452ecad90f20d0beca578606b51dc1e9dd9edee279c5bfa1693ecb3f5b648b2dfa3e3bc2af264b51f4b7f670567f413149cf158ba28381705e78f2b38af36e2b7b59bb47cd62ec823b75efe8866d1ae2c639f8735f44f52f59b5ac3d7a4f50bcd64eabce2ce49a1558ceeb938c57be2d206dbc6c67e9b0a2de889d3f2da32f936f5b451f8c095b7672d2ab7f4fd36a4781ad27d1ed72e4f043736b19a8897697475cb0c387154eb74725548887095d1899c145a199b42db808365ba72628aa705f1d132b87437d564719239a6328f9be5d7fa254ecfe6cfcf1a8aeb1df7ead3d595cbf338e978c5825c6281bd09e96e75bac6722a178f7d6553cd26672df3dcc69396f57c6d257938097bd0a42cd0f8a2d01c7125e8b2467d48d59205e2a4ae767a23f83c3783751acb5268663b67762bd28f82c6e1086f93f28fcc5ffb93a5d03a4b8bfe9f75d40fd9faf701ee374b3ecaa41be9fddf59268d7f824c3a91ec22932e32e977caa437f7e3df0a781f18c55d7210cfb09f80d2fc198c7e8ddd8a47dadcd63750219e0a7f0188f3c4bcbdb14c9b33831f9f8081b8b042883043ce93117e106bafc170115277fd7540aeff5980fc618c2f41dc37fbf5d25860e7a1229e213f0334d3dd31faa27e435cba817301be92e665bbd22a162ff0faed2aa6e8249a9fd36e57119573a6089af4e3e4a036e1c8da44f4c3f60ce66f986f736b7a5b5a867828ae9393d1f2b9cc97f49d9e687614605780d0677a3ca5890b3f8e5f82b573ff77e9199cc75f82c3a936302a6f93a6bab166faced1e5ee559b3f0e0015e798a83f1f00bedab03f0e02bf5dec51e82aa3d1df59e8fe8c2ba34e24bb08dd8bd0fd4f84eecf0183bf9176bf951c5f9364da2156f6a2fc8b725f97142fa4e737c04c78748f6753a889f3a416d70f7d99f66da0f3cae4fb0d29961a9c27e233408dca48f9eb804fcfa36df64770cfd70b3e72e0c958fe3b73e09b9fc0814f24bb70e00b07fe431cf8ebdbf16f897a8eb18114b6fccf3e39bee2c9423bae27bdf83c9328fb3cadc953fc57f8b240223c31f65da8a0e3a2b83d88ba530386c4d8e7a9411e65d217e3786827fe0d0afb769f5ec8b0f3987f0ee2f972cc2f3f4932c53d3c3c879d6e6325ed194c8e0f6fc3c0f14e498bbbe001dd9cebb81186decb67ca3ffd33651e176f7caa7cb852b18e4ec65bdc32ef0b9de7e9dfcb3ea4c7f3a7ccde329e0dcef1088491bb65de6df38a168764757a3bc8d3f2b09e28620ce1d902f0a28dd23261f3c2c82e4506792c27d6fd36fdcd5c3b2f680e9bf4e5be381bc65fed95a9f4ea533b11a876d6aedcd2192d967fa5cef47b01eb574a3de155e96fed99f5fe27684b8a74f1ccba7866fd7ecfac6feec53f1dae3e8bec6f7cbffc2a2c34dd3e0c189f16df30b836cfe2fda541efced376af8c77c2534a815e3c7d178facb3387c1326bf5231fe2a38baced2ba68be7379d063a6bffa74e6ffcfdeb97527ca347bfcbbecebed7a68d04cb854135047c98872903be8e65194835b100f9f7eaf46418848480632efcbf4852b33a61b01d33faaabfe55f567a9f94cb233497626c9ce24d999243b93646792ec4c929d49b233497626c9ce24d999243b33c9ce8c778675e7665e3fe71fe7e4ff9f7d176529dcbce64f89b7b200fc68b43e94adc1f577b967c4f7477c7fa57c7fc56bb7648494d74268f5684d1d9d7585ddc353cfd2142d848eb49cccda9df1babb442a4e0ee30e703009d19ab3b07b70b2dcee7054e6cdeabd6a6acf37183b4aeeea3beff4907c676528b156106c0d470a0cd53e43e5f081da251a5b81fef3729cf751cfd4399cd160b442bcec9a7134334e75e046f68219d9b8b95a959150036b1273239ea00319cebf1e3b95de11fd3fad67cc1ebb4fa5749c9763bcada5bd9019d3bde940936bbffd2e8a50adbdec7bd908a3a0c88238e7d8d7a92cf6a61b6e2ea6af217e0db495319063777180a3b3ef22699908ed5cb6e79274fc5796054e5a56773ce9959b4b00fd9236d39fd9b1f8754d269470022e75fd2edfbfb0ea493869aa38d6547ba6298b07e3bab7bff1ebbd1fabbd1564263f3363522f9d963b386976a10a6744b3a738f136f50a9263be024d02dc4405a35f7320fee1eb1a8506330d20cf9def22c8c9abb7d3d4cdd37080ef81c018cce85d2435a3444bbd5f90b479ee66123ee357e1dff3400b8d811c68120891d279f479f1df7660aac2d1e88343c441acaa533ad458c52186115e577773f1da3718798fb8a8d1e1d3b0cfd20b75181abc6d8de9f43a4e9dcb3ba5c0973f7fd08b12e7b186dd7045c5a08fc050e41703478e531c8a5f0bfab8d215f0f6feb39005d6d19c693d7ae8ecf3691bc2d246653236b12659aac1c62443d5614cb214b125892df9255b32597f2563c77cf43e4e2ed9232e06afe02f143b786810de1e96f458b98eb5ee8da754d2cce5e19f8a132f1461ada9c279aeb09b940c2b880c374554a163539ac4ee1711c0f3245bf9522d888d4ce722139b48a88e4ea739377de77941cb37e1ce0c4a83f26ece6dfbfddc6460823a80099e09300930bf04ccbb7558129cb4bc868e4c7d0cc9b4e5bdbd8d4b59d96f564f32e69bc342423e92bda3e46af2c4d67c89428a296fc55f73ea271ad8070cb539d35b4155b6bf076c9f645a2ece1aad2364eaa8f0062896e08ce0ec4b38cb27d93db5b8832ec75915eda7e160712c61ee45be9194bcf0e60779f196f2cb10189c36d406dacc986f8e0bfa38336821909dc31fa3574a4b590a5fa9f109bf9ee926f3ab8e024ee09926fc22fcfa22bf526bb052fd73b4071d2b3707df67f6abef0a15a46b0a9d272f8b0f536dd381839c4046527b692677a33a4d8623d50ac8ebcfbbd05321251f4dba997a806a322beb282c74b967049604969f82e5a3a55822743cfd72f8f78f867d357784cbe45c934aff9342bfd95ac05f0effdeaefd49e8b78fe375f7676e482fe71e950c03cf4449146652875329b1af5297f064dfca8ecf249ce686fe4b1e1ff47a1210d43918bd3efe0c99870e1b64efc9ed05afdb95192d772447761e8d1bf6efc371786b92f7b9d18b0f6c9ca81b39651814c23851b9d475c973511ebd4d25c095bc77d963e726905ead8a7462b4fdbe6ae1c36bbe592c99f3e9d1d7f0a210853b6fd64b6ef83df91b9ffd5628154066184266b41e33c9f5879a63fb5a2a891c39ac8f1460bf0bedde42e7a9bf3b988e50f4c11927d42f14f4fe5a33e72c5f8f3f8dc2a739e174fe12b598e75cfb2db45a6b7659fcb36470f56e746273b18dde9ed651560ab0647b4ab6a79fdd9edeadc0929bd3af0458d3f81b2c828b0a84faacf9b55ad0820d1941306831855a6a2939f24ae3d04957457b4e77d6d1c614d70fa159001de13616bfa7460571538fab5e82605c0b43a4373fbf039319bf402954666624b86c74bf19a68e1a5080f49b21fd664af79b295cb775f8f3b2bbaf8a7c7a1fd6097fbf237cb04bcdf3eb558ecbc0f403bf98899721df5aab80f9f18759f883d42a20b50a48ad0252ab80d42a20b50a48ad0252ab80d42a20b50a48ad0252ab80d42a886a155cf684755629883ee11f43475b0f9570dca507c65b55a6dd6477dd730dee3aa6fd1d1b54e2adfbaff7d6ddafce72ee395de9e01cecadc6899eae74dc3ab4bc97732b878d3b6680ce5383a1d1ae25e3b4f32d6e2d428d8650e393c8907876f338d8d90bb53eb04d9ec33aad8c873ea77ff94d23c2775c5de9b4af81d0a43980e1a0ade12e9f2023ae16ced11e2b9c0ff99ba7feceeb9fabb9591e6a439a5f8e697e166a9d465b42ed3ab2423bc41422a6d0274ca1dba2ab806a3c00c640dc8e95145dacff3ccaf5ad42a560ea3c569491527f1a0a0b0c579c2e54d17bb3ba215285d39811bc853ab2c7f4e59cc7743227759ce83c701fe96b3cd50eb09aefcdeaad8c410f4b589ea2269daa405dcf6d6dd020c06d3ddeac9e31b4584b57da21a497d6b8dfb5c6cac452afd7bc5047eef5fec50a4e46e76d5f9bf528e8caf6dba9b779dfda445304cf3875373f79112b84b7c3f5d1825637fc650d97bfd6ede5f51a42ac98d6d4e55e1f8881f1e2279f11fd1c08bea6c887e18bf4e37adfa2df693c9b7c1fb1e2717eb96f497c3aa532f5100f6e8567f84bf11b89e728fdc58bcfe367eabbba1ee3a6e08cceed5a904853c0010dec571d2b9407015bcfd32c13dc2f7ca06546c6cf349a6ab4a15e476a304d11439d18eae50df5ccbaab4c7eb38f1485d890eea63b9e5d13305e3ba1c6cb51dfdec2961fd9475bb6931496d80c4661545f2d2398c7b863379a1c3fc22627e1e5357e8c1da0c352062d8406ce477e15a6f1fbd77a64edc9158f88e74e1a2d5371a7a6b797eeeffa30a0e7fe6b2d3fd0e1c483be5789431590e61b40c802a2c4214a1ca2c4214a1ca2c4214a1ca2c4214a1ca2c4214a1ca2c4214a9cbf5389136f022b94de5c0ff98f8e90e7b6fcbd159471cadd8d4eb6a54c83fd726c1d7eb93643dc72c42d77ef967bb8306fbe38c4cbe738e472f9b7201960048cf53514d44786c4c85bdcbb62c8731b1c568080aa4277939cddc16f99fbd6c1f4831653061cf7e36374741a8d8e3aaae575083a083a8ad071bfd86ef058d0c71029e2143a2cad2b22ae94f4f3ea08bffc7f636f706c15454d4d701e2cba14fce4653056910d99693039781582a41c3efe2668b409340834be1f1a95a162209fc78abc8a5031ad0c15f8a7b77374179a69bba8101c0fe6c4186937b971025b4729a4f633c108c1c8638c3c58705fddbc08a1e1e0068560653882adcee0cf8a608276a7d66eef96404866640c0ed068fba396a240c4fe20f64781fd915966375c6883514772e5fd9b75916456e8c330e9f2768449e7da0f806eb2c69efd510707e836010101c16310987425968374d264cac556c678d6751520005cbcf0cdea85b83eec421543e80a6814a9bba9e5080473a4d8145645c358ce38031b4dd1b6866353b85d32b63eee3f873bc03eb09062fbda001faf323c2de1b60498925131923a4daeeeca3ed750ddb543132011203d0652b2c43eed1971174cf79167e458a12583ed969d63b9cbd2f64cee8c1821ed26573c65d91a10d266094208421e232477b9e55b36c37e2fca6583400ca38d4f92f795b162b657bfc8d67050955687e506a5f0116491011a9c97f54cd55240e189208320a30819c17b4ce0de8851de14ae0cbf8eeaaa50155a11b6b76c3966b0b3609990ecdde818051db6c1e6c333554bdd0196d80fc47e28b01fee165b0a0a3cebce1599828ebdc6b9eca9fcf0a496f85801aea14e0361de8d121fa123bb9a8abbef8c5ee69c3c975fe5997402824801693c970e93fe106f5b3c5d419eccaf702f7dcfa08f1bdcbe01cf37789679f77e30997aa99c7d6ebda0e5333c01caa003dbb02870ad8f8ef524b6f9e22d878eb0322c440d7964a3a4f5c464897876a72978ab24863a2def715724c8f442c315ece140a016aa08e0a9b785676f89af67f86aef112f9f0c87f3879c604357b3a1d5e3a03b0aa1f5bbd721871a062e6e9741cbed37ab6b8912cb0ff17bfc6aabd12b49c7e747af4283c7c9a5515f47df6050f6fd3e75ec5bb7bcfb057d0490116d688103ae431f15c57af7bdc4dfdd90b7cf43be13a2681bc96dccd961b960e41374e43d8a5a6b70145227cb11d3b30d47dc1a0e741f9fdff050eeba57210ede6b7ce73c568ea141070076b3f7c1709e979091d77abfe7198c405d3ed3760d873d6992edcc158e5ad0ab97b89319febe94b896822d50ba72f48703b4d2d5e89c979ac39e8603d1d366bd31524636743a36b68b87afdc74368bbe4784cf5de76d57e7440f3af259e7591f27204f70cd04fe1822907d7f3c7fadeee1e82e5bd8a4f5f641a9a7e3fbe1f1e3b1dd60ffdc33558b529af8e7887faec03f77bfd66e4fc784b4518ca0679b83de16baf6c8d880ade1ca94a64e9e862fd3f6a4df5d0f5f964b9d6701742755a90c1c4f2f018b6454cc08d0e0a8e233554b037112542441c582a062b2c46e68808351881c7ba3e182473c0817d529155d9cb56b9f5a3bd33675df6cfdebed5adb9d875ac8fc57dfdb410926943bc4df6154d42187264605312a8a8c8a72ebefa1a1b1d7d4152539b88e1177d624cec15b3909d7277a156dcde18031c0dbb16e558686079d967ef05bb8acc93e305bde36b01ceb6ca212a8f9606ecc18e64793195387569af941184318f398311f2cbc3cc7bf101a6a0f205e16168a808ba9dbf2c03e443ea5b3b417062b1fd7b4d4a6950505e273744cc7db9dbec495fca909561a1d39ac4349cd90c821891c16440e8bd7dde7a802d7d2dee48f2be820bb42a22ce1f6cba64ac1dc98296dd064a6d4a1ca6e03c214c29462a6142cbc4f9a2a0c0e6db1c178be0c26f3c5f9ed655aa5b982cff38be6cae3a9095a3a4d464b1df2ea7687a085a0e563b45461aedcc8320d26e72123ac9701f6c4544517d7bc3984769e93e3112a46ccc7f3ff0e8f2e4b3cbac4a3fbcd1edd128bef77ddb9af95b9735dfbd4da6f973b1d99adc06b9de333f74b51a678728218aac188017568b6db14410c414c11628a57de8d2f48edb9d0e136da3ce24b6838c78e1cbf278ba1628f6c8397579096cf35a4be27bf49e7a31443256f464292268786401d8aef36090d91d0505168286fb9e5278ce5a4c2dfa5b41b581cece2bd9246a933581546f0f6a5043b6ec36260804603a30e112c20c020c02800c66d8de5794c2ef962d7fe5855adfe9db9b43cb7b5d35de439253090333ee641a383c0802141601204fee62070ce62fba87c45f79adf7369f0071d6eaff16c804d8caa80b1775bbadf4226b2a01e98a8a523c772cb90a360628c901f4d0ecc80760d08f94102332430531098295aaef95b91a4729684f33f71dfe229ce653c9baa40690af594fc5eb9fd7ea44e9e345edee88ab042bcbc192bdcc650ecfd58117c84e7a943ab22fef850b7cd96a3fb81b90bcd5d6041dd4eefaf0a19f4d1e498439d466f6dea90c976884c96c8640b64b21fadbcd26e916ce5be81b05e28b80f3e5845911e9c37ee8adb05cdf915d619be9cbceb21d36fe92e6a6d3de47f92398f27ff1dcc7922cc21ccf923cc79bcf27e973951c3f3f358e1f65a1f1c0c5a3c57ce9cadb9cbabc45e02380f6626b469727632a8435ddb21d9c9243bb9203bb970d9fd266ad49e0deb69a1e06fac6d6b65ea76b06ac19509377e19c23c9814c3856972f1305087be9621b5c348edb082da618f96693e57201d6ce16bec7a190d3585f311bf7ad17979ad33135c96eb8414a9328604fad22c838ddbb89814a0c1bd9b9e411d0a59407a3791de4d05bd9b528bac2088cc882b8dae0c00c1c14b44728f64b98564287380bf42544f5344544f44f5df2caa2fb3fa7e57553fac8a35b132b75c6d9742ea7cee507f436f9667ba96cac8843f843f05fcf9dc3a7ca8bf2f4122913798910c79745a28b80343f750a11e3fbe8c12394aa5b054e2387f079318d22f8af48bfae67e519f58849502a9ca8e52f13594b1ef4a11a9cc81fe0e24b509920892fe1092caacc24a9974aa9249bed9722c846cb3155e6e4f19fe3c98f477b0e6f97fffe7ffd9bbc2ded64d28fa57227f7e2a4dda747bfb034fda87a7a99bdaed55d584e11ab3606070ddd993f6dfa78b1d07b7899b27759ab4f54bcd39f740005f0e4e133b6fef35ef8f8a7a7f54d4c2a3a24eadb893ff065a8bcddd677e7fbb2b3fdd4d3f7bf2e3fdbafeb2b9fbe9cbcfc953be2fede7cb5feebbf80fdc0c4d1d764642c4aff4956395fe17bef2cdc7775f79f7957fc1578eadb8455ff9a1fc746bc4d5ed9bfacab0a5c157e7def3a43b6bfc131ef271802bac755c55dac00a3a1d31aed0ad22e0aaf52bbf53102ef2bcdd77cfef1433dab6ddafbc9137d74b5dbde087641fab1f72fea1b8281ea7a41ff26a9ef391d04a82072bc18afebb55f65a0d0fbb92234496ba9aaf8f87c273b1a34f21952377df299017ca158fd9a2792816db3a1da4bfda2ad640430d0edbf94351b695a6172b7b045af7c2353e408cac321c2127d49fda276c916b0b81191d7124a04ba5d07b745381f1a1c5c432a13d39d684651e94911f008839949bed76fdf10541bf1109c172c340fec1838ccf65c6688f5a1c98bae1199aaad32dbc2d6a732414db120d1c028ddc1e00d5cb90b8ce403e8058f3f50c6db63733bc5d6f32fcec25d164f3d46d2fb31112627ea7bbe243015638a9adca8a8c47bbce71c923dc5ccf186d79e873a686bc35f61b6574863d34140ec105ea56d5e03ce9942bdbaae2c6b1e4114b09b9187cbdfae12435dcc733a5c877e02c3b2aadc1f3376a8655da2084577a352cc873340c9a12e4594a5adee7e8224a47fdab79acc70313415c51264ee32453e046e594f06d0eab06a30b98531610031790732ea694c929ef8cc9f1f32a012a03028dc6191db555062a431f62cef89ebe466a187420c03e1d0bb55677398f10d1b8343a322ded9876a30f0c74431bd57060a5de33acd4e99d572a8f1ed0d0c5ed70604d6b507b9e262511bfb70e41faa02df232b989050a5a405623faac98f07ef62672dfe39143e8d007979c96346da0894c67d3c53401c578ad311c281b61c4e3aca69282ce4f05167b8b9ce627b4367de7742a31a15c86a6f9e3e81a2d8e45c6897bc1d3e5fc87624c988841b874a622066d550af5568c8743f3e3f92b3e1463bf5aab85935989b558ad6fe6f8db0423af48f70456bac09433dcaa0b1714ebd868a2a2e6a2e69bcbf354de997e7d75b97d459d9aa6d573ae6eefd54be2363cc17e8f5bd0d53b592d2b5e6e6f0be257464c09286d64d2c60662e4ea5473b314572dc673743eb8ae7f45b861355d362da8b4b4fc4438f671b4b463515a692c826803b0524b1dda93b395a418b88d74dbc992689fa3d4e0393a4bed3dfec7df1cfdf537000000ffff03007a7fc90ca7130200`)))
@@ -0,0 +1,410 @@
+// +build ignore
+
+// This will generate a golang file describing every config key used by osde2e. Keys are found by looking for
+// the viper calls that bind, default and read them, so a new key is picked up as soon as it's used.
+//
+// Usage: go run scripts/generate-config-schema.go <repo root>
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	modulePath = "github.com/openshift/osde2e"
+	viperPath  = "github.com/spf13/viper"
+	configPath = modulePath + "/pkg/common/config"
+)
+
+// getterTypes maps viper getters to the type of the key they read.
+var getterTypes = map[string]string{
+	"GetBool":        "TypeBool",
+	"GetInt":         "TypeInt",
+	"GetInt32":       "TypeInt",
+	"GetInt64":       "TypeInt",
+	"GetUint":        "TypeInt",
+	"GetDuration":    "TypeInt",
+	"GetFloat64":     "TypeFloat",
+	"GetStringSlice": "TypeList",
+	"GetString":      "TypeString",
+	"UnmarshalKey":   "TypeObject",
+}
+
+// key is what's known about a config key so far.
+type key struct {
+	name        string
+	pkg         string
+	typ         string
+	defaultType string
+	def         string
+	env         string
+	secret      string
+	description string
+}
+
+// value is a constant or key struct field declared in a package.
+type value struct {
+	value       string
+	kind        token.Token
+	description string
+}
+
+// pkg is a parsed package.
+type pkg struct {
+	path   string
+	files  []*ast.File
+	values map[string]value
+}
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatalf("usage: %s <repo root>", os.Args[0])
+	}
+	root := os.Args[1]
+
+	pkgs, err := parsePackages(root)
+	if err != nil {
+		log.Fatalf("error parsing packages: %v", err)
+	}
+
+	keys := map[string]*key{}
+	for _, p := range pkgs {
+		for _, file := range p.files {
+			imports := fileImports(file)
+			ast.Inspect(file, func(n ast.Node) bool {
+				if call, ok := n.(*ast.CallExpr); ok {
+					inspectCall(pkgs, p, imports, keys, call)
+				}
+				return true
+			})
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintln(&out, "package config")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "// DO NOT EDIT THIS FILE. It is generated by the Makefile.")
+	fmt.Fprintln(&out, "// It describes every config key bound, defaulted or read through viper in osde2e.")
+	fmt.Fprintln(&out)
+	fmt.Fprintln(&out, "// Schema is every known config key, sorted by name.")
+	fmt.Fprintln(&out, "var Schema = []Key{")
+
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		k := keys[name]
+		typ := k.typ
+		if typ == "" {
+			typ = k.defaultType
+		}
+		if typ == "" {
+			typ = "TypeString"
+		}
+
+		fmt.Fprintln(&out, "{")
+		fmt.Fprintf(&out, "Name: %q,\n", k.name)
+		fmt.Fprintf(&out, "Type: %s,\n", typ)
+		if k.def != "" {
+			fmt.Fprintf(&out, "Default: %q,\n", k.def)
+		}
+		if k.env != "" {
+			fmt.Fprintf(&out, "Env: %q,\n", k.env)
+		}
+		if k.secret != "" {
+			fmt.Fprintf(&out, "Secret: %q,\n", k.secret)
+		}
+		fmt.Fprintf(&out, "Package: %q,\n", k.pkg)
+		if k.description != "" {
+			fmt.Fprintf(&out, "Description: %q,\n", k.description)
+		}
+		fmt.Fprintln(&out, "},")
+	}
+	fmt.Fprintln(&out, "}")
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatalf("error formatting schema: %v", err)
+	}
+	os.Stdout.Write(src)
+}
+
+// parsePackages parses every non-test package under cmd and pkg.
+func parsePackages(root string) (map[string]*pkg, error) {
+	pkgs := map[string]*pkg{}
+	fset := token.NewFileSet()
+
+	for _, dir := range []string{"cmd", "pkg"} {
+		err := filepath.Walk(filepath.Join(root, dir), func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				if info.Name() == "testdata" || info.Name() == "vendor" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			// Generated files don't declare keys, and the schema itself may be being regenerated.
+			if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") || strings.HasSuffix(path, "_generated.go") {
+				return nil
+			}
+
+			rel, err := filepath.Rel(root, filepath.Dir(path))
+			if err != nil {
+				return err
+			}
+			importPath := modulePath + "/" + filepath.ToSlash(rel)
+
+			file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+			if err != nil {
+				return err
+			}
+
+			p, ok := pkgs[importPath]
+			if !ok {
+				p = &pkg{path: importPath, values: map[string]value{}}
+				pkgs[importPath] = p
+			}
+			p.files = append(p.files, file)
+			collectValues(p, file)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pkgs, nil
+}
+
+// collectValues records the literal constants and key struct fields declared in file.
+func collectValues(p *pkg, file *ast.File) {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || (gen.Tok != token.CONST && gen.Tok != token.VAR) {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			doc := valueSpec.Doc
+			if doc == nil && len(gen.Specs) == 1 {
+				doc = gen.Doc
+			}
+
+			for i, name := range valueSpec.Names {
+				if i >= len(valueSpec.Values) {
+					continue
+				}
+
+				if lit, kind, ok := literal(valueSpec.Values[i]); ok {
+					p.values[name.Name] = value{value: lit, kind: kind, description: docText(doc)}
+					continue
+				}
+
+				// Key structs are anonymous structs with a string field for each key.
+				composite, ok := valueSpec.Values[i].(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+				structType, ok := composite.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+
+				fieldDocs := map[string]string{}
+				for _, field := range structType.Fields.List {
+					for _, fieldName := range field.Names {
+						fieldDocs[fieldName.Name] = docText(field.Doc)
+					}
+				}
+
+				for _, elt := range composite.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+					if !ok {
+						continue
+					}
+					field, ok := kv.Key.(*ast.Ident)
+					if !ok {
+						continue
+					}
+					if lit, kind, ok := literal(kv.Value); ok {
+						p.values[name.Name+"."+field.Name] = value{value: lit, kind: kind, description: fieldDocs[field.Name]}
+					}
+				}
+			}
+		}
+	}
+}
+
+// fileImports maps the names a file refers to its imports by to their paths.
+func fileImports(file *ast.File) map[string]string {
+	imports := map[string]string{}
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = path
+	}
+	return imports
+}
+
+// inspectCall records what call says about a config key, if it's a viper or secret call.
+func inspectCall(pkgs map[string]*pkg, p *pkg, imports map[string]string, keys map[string]*key, call *ast.CallExpr) {
+	var function string
+	switch fun := call.Fun.(type) {
+	case *ast.SelectorExpr:
+		x, ok := fun.X.(*ast.Ident)
+		if !ok {
+			return
+		}
+		switch imports[x.Name] {
+		case viperPath:
+			function = fun.Sel.Name
+		case configPath:
+			if fun.Sel.Name == "RegisterSecret" {
+				function = fun.Sel.Name
+			}
+		}
+	case *ast.Ident:
+		if p.path == configPath && fun.Name == "RegisterSecret" {
+			function = fun.Name
+		}
+	}
+
+	if function == "" || len(call.Args) == 0 {
+		return
+	}
+
+	switch function {
+	case "BindEnv", "SetDefault", "RegisterSecret", "UnmarshalKey":
+	default:
+		if _, ok := getterTypes[function]; !ok {
+			return
+		}
+	}
+
+	name, declaredIn, val, ok := resolve(pkgs, p, imports, call.Args[0])
+	if !ok || val.kind != token.STRING {
+		return
+	}
+
+	k, ok := keys[name]
+	if !ok {
+		k = &key{name: name, pkg: declaredIn, description: val.description}
+		keys[name] = k
+	}
+
+	switch function {
+	case "BindEnv":
+		if len(call.Args) > 1 {
+			if _, _, env, ok := resolve(pkgs, p, imports, call.Args[1]); ok {
+				k.env = env.value
+			}
+		}
+	case "SetDefault":
+		if _, _, def, ok := resolve(pkgs, p, imports, call.Args[1]); ok {
+			k.def = def.value
+			switch def.kind {
+			case token.INT:
+				k.defaultType = "TypeInt"
+			case token.FLOAT:
+				k.defaultType = "TypeFloat"
+			case token.IDENT:
+				k.defaultType = "TypeBool"
+			}
+		}
+	case "RegisterSecret":
+		if _, _, secret, ok := resolve(pkgs, p, imports, call.Args[1]); ok {
+			k.secret = secret.value
+		}
+	default:
+		// Prefer the most specific getter used for a key.
+		if typ := getterTypes[function]; k.typ == "" || k.typ == "TypeString" {
+			k.typ = typ
+		}
+	}
+}
+
+// resolve finds the value of expr, which can be a literal, a constant or a key struct field.
+func resolve(pkgs map[string]*pkg, p *pkg, imports map[string]string, expr ast.Expr) (string, string, value, bool) {
+	if lit, kind, ok := literal(expr); ok {
+		return lit, p.path, value{value: lit, kind: kind}, true
+	}
+
+	var pkgPath, name string
+	switch e := expr.(type) {
+	case *ast.Ident:
+		pkgPath, name = p.path, e.Name
+	case *ast.SelectorExpr:
+		switch x := e.X.(type) {
+		case *ast.Ident:
+			// Either imported.Constant or KeyStruct.Field.
+			if path, ok := imports[x.Name]; ok {
+				pkgPath, name = path, e.Sel.Name
+			} else {
+				pkgPath, name = p.path, x.Name+"."+e.Sel.Name
+			}
+		case *ast.SelectorExpr:
+			// imported.KeyStruct.Field
+			pkgIdent, ok := x.X.(*ast.Ident)
+			if !ok {
+				return "", "", value{}, false
+			}
+			pkgPath, name = imports[pkgIdent.Name], x.Sel.Name+"."+e.Sel.Name
+		}
+	}
+
+	declared, ok := pkgs[pkgPath]
+	if !ok {
+		return "", "", value{}, false
+	}
+	val, ok := declared.values[name]
+	return val.value, pkgPath, val, ok
+}
+
+// literal returns the value of a basic literal, a negated number or a boolean.
+func literal(expr ast.Expr) (string, token.Token, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind == token.STRING {
+			s, err := strconv.Unquote(e.Value)
+			return s, e.Kind, err == nil
+		}
+		return e.Value, e.Kind, e.Kind == token.INT || e.Kind == token.FLOAT
+	case *ast.UnaryExpr:
+		if lit, ok := e.X.(*ast.BasicLit); ok && e.Op == token.SUB && (lit.Kind == token.INT || lit.Kind == token.FLOAT) {
+			return "-" + lit.Value, lit.Kind, true
+		}
+	case *ast.Ident:
+		if e.Name == "true" || e.Name == "false" {
+			return e.Name, token.IDENT, true
+		}
+	}
+	return "", token.ILLEGAL, false
+}
+
+// docText joins the lines of a doc comment.
+func docText(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+	return strings.Join(strings.Fields(doc.Text()), " ")
+}
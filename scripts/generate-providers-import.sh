@@ -11,7 +11,7 @@ echo ""
 echo "// DO NOT EDIT THIS FILE. It is generated by the Makefile."
 echo "// This import list is necessary due to the statically linked nature of go"
 echo "import ("
-find "$PROVIDERS_DIR" -mindepth 1 -type d -print0 | sort -z | while read -r -d $'\0' provider; do
+find "$PROVIDERS_DIR" -mindepth 1 -maxdepth 1 -type d -print0 | sort -z | while read -r -d $'\0' provider; do
 PROVIDER_NAME="$(basename "$provider")"
 echo -e "\t_ \"github.com/openshift/osde2e/pkg/common/providers/$PROVIDER_NAME\""
 done